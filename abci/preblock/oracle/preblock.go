@@ -54,6 +54,7 @@ func NewOraclePreBlockHandler(
 		logger,
 		aggregateFn,
 		strategy,
+		abciaggregator.WithParamsKeeper(oracleKeeper),
 	)
 	pa := abciaggregator.NewOraclePriceApplier(
 		va,
@@ -143,6 +144,17 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			return response, err
 		}
 
+		// record each validator's participation in the price updates
+		if err = h.recordParticipation(ctx, req.DecidedLastCommit, prices); err != nil {
+			h.logger.Error(
+				"failed to record validator participation",
+				"height", req.Height,
+				"error", err,
+			)
+
+			return response, err
+		}
+
 		return response, nil
 	}
 }
@@ -215,6 +227,17 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 			return &sdk.ResponsePreBlock{}, err
 		}
 
+		// record each validator's participation in the price updates
+		if err = h.recordParticipation(ctx, req.DecidedLastCommit, prices); err != nil {
+			h.logger.Error(
+				"failed to record validator participation",
+				"height", req.Height,
+				"error", err,
+			)
+
+			return &sdk.ResponsePreBlock{}, err
+		}
+
 		return &sdk.ResponsePreBlock{}, nil
 	}
 }
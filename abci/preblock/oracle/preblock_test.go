@@ -524,6 +524,7 @@ func (s *PreBlockTestSuite) TestPreBlockStatus() {
 		extCodec := codecmock.NewExtendedCommitCodec(s.T())
 		veCodec := codecmock.NewVoteExtensionCodec(s.T())
 		mockOracleKeeper := connectabcimocks.NewOracleKeeper(s.T())
+		mockOracleKeeper.On("GetParams", mock.Anything).Return(oracletypes.DefaultParams(), nil)
 		handler := preblock.NewOraclePreBlockHandler(
 			log.NewTestLogger(s.T()),
 			func(_ sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
@@ -561,6 +562,7 @@ func (s *PreBlockTestSuite) TestPreBlockStatus() {
 		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
 		s.ctx = s.ctx.WithBlockHeight(4)
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return(nil)
+		mockOracleKeeper.On("EmitPriceUpdateEvents", s.ctx, []connecttypes.CurrencyPair{}, mock.Anything).Return(nil)
 
 		// run preblocker
 		_, err := handler.WrappedPreBlocker(s.mm)(s.ctx, &cometabci.RequestFinalizeBlock{
@@ -638,7 +640,10 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 		val2 := sdk.ConsAddress("val2")
 		val3 := sdk.ConsAddress("val3")
 
+		params := oracletypes.DefaultParams()
+		params.ParticipationWindowBlocks = 10
 		mockOracleKeeper := connectabcimocks.NewOracleKeeper(s.T())
+		mockOracleKeeper.On("GetParams", mock.Anything).Return(params, nil)
 		currencyPairStrategyMock := currencypairmock.NewCurrencyPairStrategy(s.T())
 
 		btcUsd := connecttypes.NewCurrencyPair("BTC", "USD")
@@ -676,8 +681,33 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 
 		// mock oracle keeper calls
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return([]connecttypes.CurrencyPair{btcUsd, mogUsd}, nil)
+		mockOracleKeeper.On("AcceptsPrices", s.ctx, mock.Anything).Return(true, nil)
+		mockOracleKeeper.On("GetPriceForCurrencyPair", s.ctx, mock.Anything).Return(oracletypes.QuotePrice{}, oracletypes.NewQuotePriceNotExistError(btcUsd))
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything).Return(nil)
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, mogUsd, mock.Anything).Return(nil)
+		mockOracleKeeper.On("PriceUpdateHooks").Return(&oracletypes.NoopPriceUpdateHooks{})
+		mockOracleKeeper.On("ApplyCircuitBreaker", s.ctx, mock.Anything, mock.Anything).Return(
+			func(_ context.Context, _ connecttypes.CurrencyPair, price math.Int) (math.Int, bool, error) {
+				return price, true, nil
+			},
+		)
+
+		// expect events for both updated prices, val1 reported both and val2 only BTC/USD
+		mockOracleKeeper.On(
+			"EmitPriceUpdateEvents", s.ctx, []connecttypes.CurrencyPair{btcUsd, mogUsd}, map[connecttypes.CurrencyPair]uint32{btcUsd: 2, mogUsd: 1},
+		).Return(nil).Once()
+
+		// expect each validator's participation to be recorded
+		cps := map[uint64]connecttypes.CurrencyPair{0: btcUsd, 1: mogUsd}
+		mockOracleKeeper.On("GetCurrencyPairMapping", s.ctx).Return(cps, nil).Once()
+		finalPrices := map[connecttypes.CurrencyPair]*big.Int{btcUsd: big.NewInt(1), mogUsd: maxUint256}
+		mockOracleKeeper.On(
+			"RecordParticipation", s.ctx, params, cps, val1, false, map[connecttypes.CurrencyPair]*big.Int{btcUsd: big.NewInt(1), mogUsd: maxUint256}, finalPrices,
+		).Return(nil).Once()
+		mockOracleKeeper.On(
+			"RecordParticipation", s.ctx, params, cps, val2, false, map[connecttypes.CurrencyPair]*big.Int{btcUsd: big.NewInt(2)}, finalPrices,
+		).Return(nil).Once()
+		mockOracleKeeper.On("RecordParticipation", s.ctx, params, cps, val3, true, mock.Anything, finalPrices).Return(nil).Once()
 
 		// create extended commit info
 		val1Vote, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{
@@ -56,3 +56,38 @@ func (h *PreBlockHandler) recordValidatorReports(ctx sdk.Context, decidedCommit
 		}
 	}
 }
+
+// recordParticipation takes the commit decided for this block, and for each validator in the commit, records its
+// participation in the price updates of each currency-pair to state, given the final prices for this block. This is
+// a no-op if participation tracking is disabled.
+func (h *PreBlockHandler) recordParticipation(
+	ctx sdk.Context,
+	decidedCommit cometabci.CommitInfo,
+	prices map[connecttypes.CurrencyPair]*big.Int,
+) error {
+	params, err := h.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ParticipationWindowBlocks == 0 {
+		return nil
+	}
+
+	cps, err := h.keeper.GetCurrencyPairMapping(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range decidedCommit.Votes {
+		validator := sdk.ConsAddress(vote.Validator.Address)
+		absent := vote.BlockIdFlag != cometproto.BlockIDFlagCommit
+
+		err := h.keeper.RecordParticipation(ctx, params, cps, validator, absent, h.pa.GetPricesForValidator(validator), prices)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
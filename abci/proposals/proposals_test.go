@@ -25,6 +25,7 @@ import (
 	"github.com/skip-mev/connect/v2/abci/ve"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	servicemetricsmocks "github.com/skip-mev/connect/v2/service/metrics/mocks"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 var (
@@ -913,6 +914,36 @@ func (s *ProposalsTestSuite) TestProcessProposal() {
 				Status: cometabci.ResponseProcessProposal_REJECT,
 			},
 		},
+		{
+			name: "accepts previous version vote extensions created the block before the transition height",
+			request: func() *cometabci.RequestProcessProposal {
+				return s.createVersionedRequestProcessProposal(1, 3)
+			},
+			veEnabled: true,
+			currencyPairStrategy: func() currencypair.CurrencyPairStrategy {
+				// vote extensions are created at height 2 and the transition ends at height 3
+				return s.versionedCurrencyPairStrategy(oracletypes.NewParams(2, 1, 3))
+			},
+			expectedError: false,
+			expectedResp: &cometabci.ResponseProcessProposal{
+				Status: cometabci.ResponseProcessProposal_ACCEPT,
+			},
+		},
+		{
+			name: "rejects previous version vote extensions created at the transition height",
+			request: func() *cometabci.RequestProcessProposal {
+				return s.createVersionedRequestProcessProposal(1, 3)
+			},
+			veEnabled: true,
+			currencyPairStrategy: func() currencypair.CurrencyPairStrategy {
+				// vote extensions are created at height 2 and the transition ends at height 2
+				return s.versionedCurrencyPairStrategy(oracletypes.NewParams(2, 1, 2))
+			},
+			expectedError: true,
+			expectedResp: &cometabci.ResponseProcessProposal{
+				Status: cometabci.ResponseProcessProposal_REJECT,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1786,6 +1817,61 @@ func (s *ProposalsTestSuite) createRequestPrepareProposal(
 	}
 }
 
+// versionedCurrencyPairStrategy returns a versioned currency pair strategy for vote extension versions 1 and 2
+// with the given x/oracle params.
+func (s *ProposalsTestSuite) versionedCurrencyPairStrategy(params oracletypes.Params) currencypair.CurrencyPairStrategy {
+	s.T().Helper()
+
+	pk := currencypairmocks.NewParamsKeeper(s.T())
+	pk.On("GetParams", mock.Anything).Return(params, nil)
+
+	v1 := currencypairmocks.NewCurrencyPairStrategy(s.T())
+	v1.On("GetMaxNumCP", mock.Anything).Return(uint64(1), nil).Maybe()
+
+	return currencypair.NewVersionedCurrencyPairStrategy(pk, map[uint32]currencypair.CurrencyPairStrategy{
+		1: v1,
+		2: currencypairmocks.NewCurrencyPairStrategy(s.T()),
+	})
+}
+
+// createVersionedRequestProcessProposal creates a process proposal request whose extended commit contains a
+// single vote extension with the given version.
+func (s *ProposalsTestSuite) createVersionedRequestProcessProposal(
+	version uint32,
+	height int64,
+) *cometabci.RequestProcessProposal {
+	s.T().Helper()
+
+	voteExtension := testutils.CreateVoteExtension(prices1)
+	voteExtension.Version = version
+	voteExtensionBz, err := s.codec.Encode(voteExtension)
+	s.Require().NoError(err)
+
+	valVoteInfo := cometabci.ExtendedVoteInfo{
+		Validator: cometabci.Validator{
+			Address: val1,
+			Power:   1,
+		},
+		VoteExtension: voteExtensionBz,
+		BlockIdFlag:   cometproto.BlockIDFlagCommit,
+	}
+
+	ext, commitInfoBz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{valVoteInfo}, s.extCommitCodec)
+	s.Require().NoError(err)
+
+	lastCommit := cometabci.CommitInfo{
+		Round: ext.Round,
+		Votes: []cometabci.VoteInfo{
+			{
+				Validator:   valVoteInfo.Validator,
+				BlockIdFlag: valVoteInfo.BlockIdFlag,
+			},
+		},
+	}
+
+	return s.createRequestProcessProposal([][]byte{commitInfoBz}, lastCommit, height)
+}
+
 func (s *ProposalsTestSuite) createRequestProcessProposal(
 	proposal [][]byte,
 	lastCommit cometabci.CommitInfo,
@@ -102,7 +102,7 @@ func validateVoteExtension(
 	}
 
 	// The vote extensions are from the previous block.
-	if err := ve.ValidateOracleVoteExtension(ctx, voteExt, currencyPairStrategy); err != nil {
+	if err := ve.ValidateOracleVoteExtension(ctx, voteExt, ctx.BlockHeight()-1, currencyPairStrategy); err != nil {
 		return err
 	}
 
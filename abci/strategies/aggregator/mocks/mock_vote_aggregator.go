@@ -134,6 +134,53 @@ func (_c *VoteAggregator_GetPriceForValidator_Call) RunAndReturn(run func(types.
 	return _c
 }
 
+// GetProviderCounts provides a mock function with given fields:
+func (_m *VoteAggregator) GetProviderCounts() map[pkgtypes.CurrencyPair]uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderCounts")
+	}
+
+	var r0 map[pkgtypes.CurrencyPair]uint32
+	if rf, ok := ret.Get(0).(func() map[pkgtypes.CurrencyPair]uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[pkgtypes.CurrencyPair]uint32)
+		}
+	}
+
+	return r0
+}
+
+// VoteAggregator_GetProviderCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProviderCounts'
+type VoteAggregator_GetProviderCounts_Call struct {
+	*mock.Call
+}
+
+// GetProviderCounts is a helper method to define mock.On call
+func (_e *VoteAggregator_Expecter) GetProviderCounts() *VoteAggregator_GetProviderCounts_Call {
+	return &VoteAggregator_GetProviderCounts_Call{Call: _e.mock.On("GetProviderCounts")}
+}
+
+func (_c *VoteAggregator_GetProviderCounts_Call) Run(run func()) *VoteAggregator_GetProviderCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *VoteAggregator_GetProviderCounts_Call) Return(_a0 map[pkgtypes.CurrencyPair]uint32) *VoteAggregator_GetProviderCounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VoteAggregator_GetProviderCounts_Call) RunAndReturn(run func() map[pkgtypes.CurrencyPair]uint32) *VoteAggregator_GetProviderCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewVoteAggregator creates a new instance of VoteAggregator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewVoteAggregator(t interface {
@@ -21,9 +21,9 @@ import (
 //go:generate mockery --name PriceApplier --filename mock_price_applier.go
 type PriceApplier interface {
 	// ApplyPricesFromVoteExtensions derives the aggregate prices per asset in accordance with the given
-	// vote extensions + VoteAggregator. If a price exists for an asset, it is written to state. The
-	// prices aggregated from vote-extensions are returned if no errors are encountered in execution,
-	// otherwise an error is returned + nil prices.
+	// vote extensions + VoteAggregator. If a price exists for an asset, it is checked against the asset's
+	// circuit breaker and written to state. The prices aggregated from vote-extensions are returned if no
+	// errors are encountered in execution, otherwise an error is returned + nil prices.
 	ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[connecttypes.CurrencyPair]*big.Int, error)
 
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
@@ -100,7 +100,10 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 		return nil, err
 	}
 
+	providerCounts := opa.va.GetProviderCounts()
+
 	currencyPairs := opa.ok.GetAllCurrencyPairs(ctx)
+	updated := make([]connecttypes.CurrencyPair, 0, len(currencyPairs))
 	for _, cp := range currencyPairs {
 		price, ok := prices[cp]
 		if !ok || price == nil {
@@ -122,11 +125,61 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			continue
 		}
 
+		// Skip currency pairs whose market does not accept prices under its lifecycle status.
+		accepted, err := opa.ok.AcceptsPrices(ctx, cp)
+		if err != nil {
+			opa.logger.Error(
+				"failed to check market status for currency pair",
+				"currency_pair", cp.String(),
+				"err", err,
+			)
+
+			return nil, err
+		}
+
+		if !accepted {
+			opa.logger.Debug(
+				"market does not accept prices",
+				"currency_pair", cp.String(),
+			)
+
+			continue
+		}
+
+		// Check the price against the currency pair's circuit breaker, which may clamp or reject it.
+		applied, ok, err := opa.ok.ApplyCircuitBreaker(ctx, cp, math.NewIntFromBigInt(price))
+		if err != nil {
+			opa.logger.Error(
+				"failed to apply circuit breaker for currency pair",
+				"currency_pair", cp.String(),
+				"err", err,
+			)
+
+			return nil, err
+		}
+
+		if !ok {
+			opa.logger.Info(
+				"price update rejected by circuit breaker",
+				"currency_pair", cp.String(),
+				"price", price.String(),
+			)
+
+			continue
+		}
+
+		// Read the current price, if any, to pass to the price update hooks.
+		var oldPrice *oracletypes.QuotePrice
+		if current, err := opa.ok.GetPriceForCurrencyPair(ctx, cp); err == nil {
+			oldPrice = &current
+		}
+
 		// Convert the price to a quote price and write it to state.
 		quotePrice := oracletypes.QuotePrice{
-			Price:          math.NewIntFromBigInt(price),
+			Price:          applied,
 			BlockTimestamp: ctx.BlockHeader().Time,
 			BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
+			NumProviders:   providerCounts[cp],
 		}
 
 		if err := opa.ok.SetPriceForCurrencyPair(ctx, cp, quotePrice); err != nil {
@@ -145,11 +198,67 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			"currency_pair", cp.String(),
 			"quote_price", quotePrice.Price.String(),
 		)
+
+		opa.afterPriceUpdated(ctx, cp, oldPrice, quotePrice)
+
+		updated = append(updated, cp)
+	}
+
+	// Emit events for the prices written to state.
+	if err := opa.ok.EmitPriceUpdateEvents(ctx, updated, opa.countValidators(votes, updated)); err != nil {
+		opa.logger.Error(
+			"failed to emit price update events",
+			"height", req.Height,
+			"err", err,
+		)
+
+		return nil, err
 	}
 
 	return prices, nil
 }
 
+// afterPriceUpdated calls the x/oracle PriceUpdateHooks for a price written to state. The hooks are run in a cache
+// context that is only written if they succeed, so a failing hook is logged and its writes are discarded rather than
+// failing the block.
+func (opa *oraclePriceApplier) afterPriceUpdated(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	oldPrice *oracletypes.QuotePrice,
+	newPrice oracletypes.QuotePrice,
+) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := opa.ok.PriceUpdateHooks().AfterPriceUpdated(cacheCtx, cp, oldPrice, newPrice); err != nil {
+		opa.logger.Error(
+			"price update hooks failed",
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+
+		return
+	}
+
+	write()
+}
+
+// countValidators returns the number of validators that reported a price for each of the given currency pairs.
+func (opa *oraclePriceApplier) countValidators(
+	votes []Vote,
+	cps []connecttypes.CurrencyPair,
+) map[connecttypes.CurrencyPair]uint32 {
+	counts := make(map[connecttypes.CurrencyPair]uint32, len(cps))
+	for _, vote := range votes {
+		validatorPrices := opa.va.GetPriceForValidator(vote.ConsAddress)
+		for _, cp := range cps {
+			if price, ok := validatorPrices[cp]; ok && price != nil {
+				counts[cp]++
+			}
+		}
+	}
+
+	return counts
+}
+
 func (opa *oraclePriceApplier) GetPricesForValidator(validator sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int {
 	return opa.va.GetPriceForValidator(validator)
 }
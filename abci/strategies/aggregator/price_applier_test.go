@@ -16,6 +16,7 @@ import (
 	abcimocks "github.com/skip-mev/connect/v2/abci/types/mocks"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -23,6 +24,7 @@ import (
 	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	oraclemocks "github.com/skip-mev/connect/v2/x/oracle/types/mocks"
 )
 
 func TestPriceApplier(t *testing.T) {
@@ -128,11 +130,16 @@ func TestPriceApplier(t *testing.T) {
 		}).Return(map[connecttypes.CurrencyPair]*big.Int{
 			cp: big.NewInt(-100),
 		}, nil)
+		va.On("GetProviderCounts").Return(nil).Once()
 
 		ok.On("GetAllCurrencyPairs", ctx).Return(
 			[]connecttypes.CurrencyPair{cp},
 		)
 
+		// no prices are updated
+		va.On("GetPriceForValidator", ca).Return(map[connecttypes.CurrencyPair]*big.Int{cp: big.NewInt(-100)}).Once()
+		ok.On("EmitPriceUpdateEvents", ctx, []connecttypes.CurrencyPair{}, map[connecttypes.CurrencyPair]uint32{}).Return(nil).Once()
+
 		_, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
 			Txs: [][]byte{extCommitInfoBz},
 		})
@@ -174,7 +181,7 @@ func TestPriceApplier(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{
+		ctx := testutils.CreateBaseSDKContext(t).WithBlockHeader(cmtproto.Header{
 			Time: time.Now(),
 		}).WithBlockHeight(1)
 
@@ -197,20 +204,36 @@ func TestPriceApplier(t *testing.T) {
 		}).Return(map[connecttypes.CurrencyPair]*big.Int{
 			cp: big.NewInt(150),
 		}, nil)
+		va.On("GetProviderCounts").Return(map[connecttypes.CurrencyPair]uint32{
+			cp: 3,
+		}).Once()
 
 		// return multiple prices
 		ok.On("GetAllCurrencyPairs", ctx).Return(
 			[]connecttypes.CurrencyPair{cp, connecttypes.NewCurrencyPair("ETH", "USD")}, // ignore last cp
 		)
 
+		ok.On("AcceptsPrices", ctx, cp).Return(true, nil).Once()
+		ok.On("ApplyCircuitBreaker", ctx, cp, math.NewInt(150)).Return(math.NewInt(150), true, nil).Once()
+
+		ok.On("GetPriceForCurrencyPair", ctx, cp).Return(oracletypes.QuotePrice{}, fmt.Errorf("no price"))
+		ok.On("PriceUpdateHooks").Return(&oracletypes.NoopPriceUpdateHooks{})
 		ok.On("SetPriceForCurrencyPair", ctx, cp, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 			qp := args.Get(2).(oracletypes.QuotePrice)
 
 			require.Equal(t, qp.Price.BigInt(), big.NewInt(150))
 			require.Equal(t, qp.BlockTimestamp, ctx.BlockHeader().Time)
 			require.Equal(t, qp.BlockHeight, uint64(ctx.BlockHeight())) //nolint:gosec
+			require.Equal(t, uint32(3), qp.NumProviders)
 		})
 
+		// both validators reported a price for the updated currency pair
+		va.On("GetPriceForValidator", ca1).Return(map[connecttypes.CurrencyPair]*big.Int{cp: big.NewInt(100)}).Once()
+		va.On("GetPriceForValidator", ca2).Return(map[connecttypes.CurrencyPair]*big.Int{cp: big.NewInt(200)}).Once()
+		ok.On(
+			"EmitPriceUpdateEvents", ctx, []connecttypes.CurrencyPair{cp}, map[connecttypes.CurrencyPair]uint32{cp: 2},
+		).Return(nil).Once()
+
 		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
 			Txs: [][]byte{extCommitInfoBz},
 		})
@@ -229,4 +252,168 @@ func TestPriceApplier(t *testing.T) {
 		valPrices := pa.GetPricesForValidator(ca1)
 		require.Equal(t, expPrices, valPrices)
 	})
+
+	t.Run("circuit breakers clamp, reject, or fail price updates", func(t *testing.T) {
+		va := mocks.NewVoteAggregator(t)
+		ok := abcimocks.NewOracleKeeper(t)
+		pa := aggregator.NewOraclePriceApplier(va, ok, veCodec, extCommitcodec, log.NewNopLogger())
+
+		_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(nil, extCommitcodec)
+		require.NoError(t, err)
+
+		ctx := testutils.CreateBaseSDKContext(t).WithBlockHeader(cmtproto.Header{
+			Time: time.Now(),
+		}).WithBlockHeight(1)
+
+		btc := connecttypes.NewCurrencyPair("BTC", "USD")
+		eth := connecttypes.NewCurrencyPair("ETH", "USD")
+		aggregated := map[connecttypes.CurrencyPair]*big.Int{
+			btc: big.NewInt(200),
+			eth: big.NewInt(300),
+		}
+
+		va.On("AggregateOracleVotes", ctx, []aggregator.Vote{}).Return(aggregated, nil)
+		va.On("GetProviderCounts").Return(nil)
+		ok.On("GetAllCurrencyPairs", ctx).Return([]connecttypes.CurrencyPair{btc, eth})
+		ok.On("AcceptsPrices", ctx, mock.Anything).Return(true, nil)
+
+		// BTC/USD is clamped, ETH/USD is rejected
+		ok.On("ApplyCircuitBreaker", ctx, btc, math.NewInt(200)).Return(math.NewInt(110), true, nil).Once()
+		ok.On("ApplyCircuitBreaker", ctx, eth, math.NewInt(300)).Return(math.Int{}, false, nil).Once()
+		ok.On("GetPriceForCurrencyPair", ctx, btc).Return(oracletypes.QuotePrice{}, fmt.Errorf("no price"))
+		ok.On("PriceUpdateHooks").Return(&oracletypes.NoopPriceUpdateHooks{})
+		ok.On("SetPriceForCurrencyPair", ctx, btc, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			qp := args.Get(2).(oracletypes.QuotePrice)
+			require.Equal(t, math.NewInt(110), qp.Price)
+		}).Once()
+		ok.On("EmitPriceUpdateEvents", ctx, []connecttypes.CurrencyPair{btc}, map[connecttypes.CurrencyPair]uint32{}).Return(nil).Once()
+
+		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.NoError(t, err)
+		require.Equal(t, aggregated, prices)
+
+		// failing to apply the circuit breaker fails the block
+		ok.On("ApplyCircuitBreaker", ctx, btc, math.NewInt(200)).Return(math.Int{}, false, fmt.Errorf("fail")).Once()
+
+		prices, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.Error(t, err)
+		require.Nil(t, prices)
+
+		// failing to emit price update events fails the block
+		ok.On("ApplyCircuitBreaker", ctx, btc, math.NewInt(200)).Return(math.NewInt(200), true, nil).Once()
+		ok.On("ApplyCircuitBreaker", ctx, eth, math.NewInt(300)).Return(math.Int{}, false, nil).Once()
+		ok.On("SetPriceForCurrencyPair", ctx, btc, mock.Anything).Return(nil).Once()
+		ok.On("EmitPriceUpdateEvents", ctx, []connecttypes.CurrencyPair{btc}, mock.Anything).Return(fmt.Errorf("fail")).Once()
+
+		prices, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.Error(t, err)
+		require.Nil(t, prices)
+	})
+
+	t.Run("price update hooks are called after each price update, and failures do not fail the block", func(t *testing.T) {
+		va := mocks.NewVoteAggregator(t)
+		ok := abcimocks.NewOracleKeeper(t)
+		hooks := oraclemocks.NewPriceUpdateHooks(t)
+		pa := aggregator.NewOraclePriceApplier(va, ok, veCodec, extCommitcodec, log.NewNopLogger())
+
+		_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(nil, extCommitcodec)
+		require.NoError(t, err)
+
+		ctx := testutils.CreateBaseSDKContext(t).WithBlockHeader(cmtproto.Header{
+			Time: time.Now(),
+		}).WithBlockHeight(1)
+
+		btc := connecttypes.NewCurrencyPair("BTC", "USD")
+		eth := connecttypes.NewCurrencyPair("ETH", "USD")
+		aggregated := map[connecttypes.CurrencyPair]*big.Int{
+			btc: big.NewInt(200),
+			eth: big.NewInt(300),
+		}
+		oldPrice := oracletypes.QuotePrice{Price: math.NewInt(100)}
+
+		va.On("AggregateOracleVotes", ctx, []aggregator.Vote{}).Return(aggregated, nil)
+		va.On("GetProviderCounts").Return(nil)
+		ok.On("GetAllCurrencyPairs", ctx).Return([]connecttypes.CurrencyPair{btc, eth})
+		ok.On("AcceptsPrices", ctx, mock.Anything).Return(true, nil)
+		ok.On("ApplyCircuitBreaker", ctx, btc, math.NewInt(200)).Return(math.NewInt(200), true, nil).Once()
+		ok.On("ApplyCircuitBreaker", ctx, eth, math.NewInt(300)).Return(math.NewInt(300), true, nil).Once()
+		ok.On("GetPriceForCurrencyPair", ctx, btc).Return(oldPrice, nil).Once()
+		ok.On("GetPriceForCurrencyPair", ctx, eth).Return(oracletypes.QuotePrice{}, fmt.Errorf("no price")).Once()
+		ok.On("SetPriceForCurrencyPair", ctx, mock.Anything, mock.Anything).Return(nil).Twice()
+		ok.On("PriceUpdateHooks").Return(hooks)
+
+		// each hook emits an event, which is only kept if the hook succeeds
+		emit := func(args mock.Arguments) {
+			cp := args.Get(1).(connecttypes.CurrencyPair)
+			args.Get(0).(sdk.Context).EventManager().EmitEvent(sdk.NewEvent("hook", sdk.NewAttribute("currency_pair", cp.String())))
+		}
+		hooks.On("AfterPriceUpdated", mock.Anything, btc, &oldPrice, mock.Anything).Return(nil).Run(emit).Once()
+		hooks.On("AfterPriceUpdated", mock.Anything, eth, (*oracletypes.QuotePrice)(nil), mock.Anything).
+			Return(fmt.Errorf("liquidation failed")).Run(emit).Once()
+
+		ok.On("EmitPriceUpdateEvents", ctx, []connecttypes.CurrencyPair{btc, eth}, map[connecttypes.CurrencyPair]uint32{}).Return(nil).Once()
+
+		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.NoError(t, err)
+		require.Equal(t, aggregated, prices)
+		require.Equal(t, sdk.Events{
+			sdk.NewEvent("hook", sdk.NewAttribute("currency_pair", btc.String())),
+		}, ctx.EventManager().Events())
+	})
+
+	t.Run("markets that do not accept prices are skipped", func(t *testing.T) {
+		va := mocks.NewVoteAggregator(t)
+		ok := abcimocks.NewOracleKeeper(t)
+		pa := aggregator.NewOraclePriceApplier(va, ok, veCodec, extCommitcodec, log.NewNopLogger())
+
+		_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(nil, extCommitcodec)
+		require.NoError(t, err)
+
+		ctx := testutils.CreateBaseSDKContext(t).WithBlockHeader(cmtproto.Header{
+			Time: time.Now(),
+		}).WithBlockHeight(1)
+
+		btc := connecttypes.NewCurrencyPair("BTC", "USD")
+		eth := connecttypes.NewCurrencyPair("ETH", "USD")
+		aggregated := map[connecttypes.CurrencyPair]*big.Int{
+			btc: big.NewInt(200),
+			eth: big.NewInt(300),
+		}
+
+		va.On("AggregateOracleVotes", ctx, []aggregator.Vote{}).Return(aggregated, nil)
+		va.On("GetProviderCounts").Return(nil)
+		ok.On("GetAllCurrencyPairs", ctx).Return([]connecttypes.CurrencyPair{btc, eth})
+
+		// ETH/USD does not accept prices, so its circuit breaker is not applied
+		ok.On("AcceptsPrices", ctx, btc).Return(true, nil).Once()
+		ok.On("AcceptsPrices", ctx, eth).Return(false, nil).Once()
+		ok.On("ApplyCircuitBreaker", ctx, btc, math.NewInt(200)).Return(math.NewInt(200), true, nil).Once()
+		ok.On("GetPriceForCurrencyPair", ctx, btc).Return(oracletypes.QuotePrice{}, fmt.Errorf("no price"))
+		ok.On("PriceUpdateHooks").Return(&oracletypes.NoopPriceUpdateHooks{})
+		ok.On("SetPriceForCurrencyPair", ctx, btc, mock.Anything).Return(nil).Once()
+		ok.On("EmitPriceUpdateEvents", ctx, []connecttypes.CurrencyPair{btc}, map[connecttypes.CurrencyPair]uint32{}).Return(nil).Once()
+
+		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.NoError(t, err)
+		require.Equal(t, aggregated, prices)
+
+		// failing to check the market status fails the block
+		ok.On("AcceptsPrices", ctx, btc).Return(false, fmt.Errorf("fail")).Once()
+
+		prices, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.Error(t, err)
+		require.Nil(t, prices)
+	})
 }
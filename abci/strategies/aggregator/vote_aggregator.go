@@ -3,6 +3,7 @@ package aggregator
 import (
 	"fmt"
 	"math/big"
+	"sort"
 
 	"cosmossdk.io/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +14,7 @@ import (
 	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	"github.com/skip-mev/connect/v2/aggregator"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // Vote encapsulates the validator and oracle data contained within a vote extension.
@@ -87,20 +89,45 @@ type VoteAggregator interface {
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
 	// on the prices from the latest set of aggregated votes.
 	GetPriceForValidator(validator sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int
+
+	// GetProviderCounts gets the median number of providers reported by validators for each
+	// aggregated price. Prices for which no validator reported a provider count are omitted. This
+	// method depends on the prices from the latest set of aggregated votes.
+	GetProviderCounts() map[connecttypes.CurrencyPair]uint32
+}
+
+// VoteAggregatorOption is a function that configures a DefaultVoteAggregator.
+type VoteAggregatorOption func(*DefaultVoteAggregator)
+
+// WithParamsKeeper sets the keeper used to read the x/oracle params. When set, prices that report
+// an age older than the maximum price age param are ignored during aggregation.
+func WithParamsKeeper(paramsKeeper currencypair.ParamsKeeper) VoteAggregatorOption {
+	return func(dva *DefaultVoteAggregator) {
+		dva.paramsKeeper = paramsKeeper
+	}
 }
 
 func NewDefaultVoteAggregator(
 	logger log.Logger,
 	aggregateFn aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int],
 	strategy currencypair.CurrencyPairStrategy,
+	opts ...VoteAggregatorOption,
 ) VoteAggregator {
-	return &DefaultVoteAggregator{
+	dva := &DefaultVoteAggregator{
 		logger: logger,
 		priceAggregator: aggregator.NewDataAggregator(
 			aggregator.WithAggregateFnFromContext(aggregateFn),
 		),
 		currencyPairStrategy: strategy,
+		providerCounts:       make(map[string]map[connecttypes.CurrencyPair]uint32),
+		aggregatedCounts:     make(map[connecttypes.CurrencyPair]uint32),
 	}
+
+	for _, opt := range opts {
+		opt(dva)
+	}
+
+	return dva
 }
 
 type DefaultVoteAggregator struct {
@@ -110,19 +137,44 @@ type DefaultVoteAggregator struct {
 	// decoding prices / currency-pair ids
 	currencyPairStrategy currencypair.CurrencyPairStrategy
 
+	// paramsKeeper is used to read the maximum price age. If nil, prices are not filtered by age.
+	paramsKeeper currencypair.ParamsKeeper
+
+	// validator address -> currency-pair -> number of providers
+	providerCounts map[string]map[connecttypes.CurrencyPair]uint32
+
+	// currency-pair -> median number of providers for the latest aggregated prices
+	aggregatedCounts map[connecttypes.CurrencyPair]uint32
+
 	logger log.Logger
 }
 
 func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []Vote) (map[connecttypes.CurrencyPair]*big.Int, error) {
 	// Reset the price aggregator and set the aggregationFn to use the latest application-state.
 	dva.priceAggregator.ResetProviderData()
+	dva.providerCounts = make(map[string]map[connecttypes.CurrencyPair]uint32)
+	dva.aggregatedCounts = make(map[connecttypes.CurrencyPair]uint32)
+
+	// Determine the params used to filter stale prices, if configured.
+	var params oracletypes.Params
+	if dva.paramsKeeper != nil {
+		var err error
+		if params, err = dva.paramsKeeper.GetParams(ctx); err != nil {
+			dva.logger.Error(
+				"failed to get oracle params",
+				"err", err,
+			)
+
+			return nil, err
+		}
+	}
 
 	// Iterate through all vote extensions and consolidate all price info before
 	// aggregating.
 	for _, vote := range votes {
 		consAddrStr := vote.ConsAddress.String()
 
-		if err := dva.addVoteToAggregator(ctx, consAddrStr, vote.OracleVoteExtension); err != nil {
+		if err := dva.addVoteToAggregator(ctx, consAddrStr, vote.OracleVoteExtension, params); err != nil {
 			dva.logger.Error(
 				"failed to add vote to aggregator",
 				"validator_address", consAddrStr,
@@ -136,6 +188,7 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 	// Compute the final prices for each currency pair.
 	dva.priceAggregator.AggregateDataFromContext(ctx)
 	prices := dva.priceAggregator.GetAggregatedData()
+	dva.aggregateProviderCounts(prices)
 
 	dva.logger.Debug(
 		"aggregated oracle data",
@@ -149,13 +202,33 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 // into the price aggregator. The oracle data is provided in the form of a vote
 // extension. The vote extension contains the prices for each currency pair that
 // the validator is providing for the current block.
-func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address string, oracleData vetypes.OracleVoteExtension) error {
+func (dva *DefaultVoteAggregator) addVoteToAggregator(
+	ctx sdk.Context,
+	address string,
+	oracleData vetypes.OracleVoteExtension,
+	params oracletypes.Params,
+) error {
 	if len(oracleData.Prices) == 0 {
 		return nil
 	}
 
+	// Determine the strategy used to decode the prices in the vote extension. The vote extensions are from
+	// the previous block, and votes with a version that is not accepted at that height are ignored.
+	strategy, err := currencypair.StrategyForVersion(ctx, dva.currencyPairStrategy, oracleData.Version, ctx.BlockHeight()-1)
+	if err != nil {
+		dva.logger.Debug(
+			"ignoring vote with unsupported version",
+			"validator_address", address,
+			"version", oracleData.Version,
+			"err", err,
+		)
+
+		return nil
+	}
+
 	// Format all prices into a map of currency pair -> price.
 	prices := make(map[connecttypes.CurrencyPair]*big.Int, len(oracleData.Prices))
+	counts := make(map[connecttypes.CurrencyPair]uint32)
 	for cpID, priceBz := range oracleData.Prices {
 		if len(priceBz) > connectabci.MaximumPriceSize {
 			dva.logger.Debug(
@@ -167,7 +240,7 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 		}
 
 		// Convert the asset into a currency pair.
-		cp, err := dva.currencyPairStrategy.FromID(ctx, cpID)
+		cp, err := strategy.FromID(ctx, cpID)
 		if err != nil {
 			dva.logger.Debug(
 				"failed to convert currency pair id to currency pair",
@@ -179,7 +252,7 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 			continue
 		}
 
-		price, err := dva.currencyPairStrategy.GetDecodedPrice(ctx, cp, priceBz)
+		price, err := strategy.GetDecodedPrice(ctx, cp, priceBz)
 		if err != nil {
 			dva.logger.Debug(
 				"failed to decode price",
@@ -191,7 +264,23 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 			continue
 		}
 
+		// Ignore prices that are older than the maximum price age.
+		md := oracleData.Metadata[cpID]
+		if md != nil && params.IsPriceAgeExceeded(md.AgeMs) {
+			dva.logger.Debug(
+				"ignoring stale price",
+				"currency_pair_id", cpID,
+				"age_ms", md.AgeMs,
+				"max_price_age_ms", params.MaxPriceAgeMs,
+			)
+
+			continue
+		}
+
 		prices[cp] = price
+		if md != nil && md.NumProviders > 0 {
+			counts[cp] = md.NumProviders
+		}
 	}
 
 	dva.logger.Debug(
@@ -201,11 +290,36 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 	)
 
 	dva.priceAggregator.SetProviderData(address, prices)
+	dva.providerCounts[address] = counts
 
 	return nil
 }
 
+// aggregateProviderCounts computes the median number of providers reported by validators for each of
+// the aggregated prices.
+func (dva *DefaultVoteAggregator) aggregateProviderCounts(prices map[connecttypes.CurrencyPair]*big.Int) {
+	for cp := range prices {
+		var counts []uint32
+		for _, validatorCounts := range dva.providerCounts {
+			if count, ok := validatorCounts[cp]; ok {
+				counts = append(counts, count)
+			}
+		}
+
+		if len(counts) == 0 {
+			continue
+		}
+
+		sort.Slice(counts, func(i, j int) bool { return counts[i] < counts[j] })
+		dva.aggregatedCounts[cp] = counts[(len(counts)-1)/2]
+	}
+}
+
 func (dva *DefaultVoteAggregator) GetPriceForValidator(validator sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int {
 	consAddrStr := validator.String()
 	return dva.priceAggregator.GetDataByProvider(consAddrStr)
 }
+
+func (dva *DefaultVoteAggregator) GetProviderCounts() map[connecttypes.CurrencyPair]uint32 {
+	return dva.aggregatedCounts
+}
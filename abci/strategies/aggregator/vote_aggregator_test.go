@@ -1,6 +1,7 @@
 package aggregator_test
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -16,9 +17,11 @@ import (
 	"github.com/skip-mev/connect/v2/abci/strategies/codec"
 	currencypairmocks "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
 	"github.com/skip-mev/connect/v2/abci/testutils"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 var (
@@ -462,3 +465,102 @@ func (s *VoteAggregatorTestSuite) TestAggregateOracleVotes() {
 		s.Require().Len(prices, 0)
 	})
 }
+
+func (s *VoteAggregatorTestSuite) TestAggregateOracleVotesWithMetadata() {
+	mockValidatorStore := mocks.NewValidatorStore(s.T())
+	aggregationFn := voteweighted.MedianFromContext(
+		log.NewTestLogger(s.T()),
+		mockValidatorStore,
+		voteweighted.DefaultPowerThreshold,
+	)
+	mockValidatorStore.On("TotalBondedTokens", mock.Anything).Return(math.NewInt(100), nil)
+
+	cpID := currencypairmocks.NewCurrencyPairStrategy(s.T())
+	paramsKeeper := currencypairmocks.NewParamsKeeper(s.T())
+
+	handler := aggregator.NewDefaultVoteAggregator(
+		log.NewTestLogger(s.T()),
+		aggregationFn,
+		cpID,
+		aggregator.WithParamsKeeper(paramsKeeper),
+	)
+
+	params := oracletypes.DefaultParams()
+	params.MaxPriceAgeMs = 1000
+
+	votes := []aggregator.Vote{
+		{
+			ConsAddress: s.myVal,
+			OracleVoteExtension: vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{0: oneHundred.Bytes()},
+				Metadata: map[uint64]*vetypes.PriceMetadata{
+					0: {AgeMs: 500, NumProviders: 3},
+				},
+			},
+		},
+		{
+			// this price is older than the maximum price age and is ignored
+			ConsAddress: val1,
+			OracleVoteExtension: vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{0: twoHundred.Bytes()},
+				Metadata: map[uint64]*vetypes.PriceMetadata{
+					0: {AgeMs: 2000, NumProviders: 5},
+				},
+			},
+		},
+		{
+			// prices without metadata are always included
+			ConsAddress: val2,
+			OracleVoteExtension: vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{0: threeHundred.Bytes()},
+			},
+		},
+	}
+
+	s.Run("stale prices are ignored and provider counts are aggregated", func() {
+		paramsKeeper.On("GetParams", s.ctx).Return(params, nil).Once()
+
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, s.myVal).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(40),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		).Once()
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, val1).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(20),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		).Maybe()
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, val2).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(40),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		).Once()
+
+		cpID.On("FromID", s.ctx, uint64(0)).Return(btcUSD, nil).Times(3)
+		cpID.On("GetDecodedPrice", s.ctx, btcUSD, oneHundred.Bytes()).Return(oneHundred, nil).Once()
+		cpID.On("GetDecodedPrice", s.ctx, btcUSD, twoHundred.Bytes()).Return(twoHundred, nil).Once()
+		cpID.On("GetDecodedPrice", s.ctx, btcUSD, threeHundred.Bytes()).Return(threeHundred, nil).Once()
+
+		prices, err := handler.AggregateOracleVotes(s.ctx, votes)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 1)
+		s.Require().Equal(oneHundred.String(), prices[btcUSD].String())
+
+		// only the validator that reported a non-stale price with metadata contributes a count
+		s.Require().Equal(map[connecttypes.CurrencyPair]uint32{btcUSD: 3}, handler.GetProviderCounts())
+		s.Require().Empty(handler.GetPriceForValidator(val1))
+	})
+
+	s.Run("failing to read params returns an error", func() {
+		paramsKeeper.On("GetParams", s.ctx).Return(oracletypes.Params{}, fmt.Errorf("no params")).Once()
+
+		_, err := handler.AggregateOracleVotes(s.ctx, votes)
+		s.Require().Error(err)
+	})
+}
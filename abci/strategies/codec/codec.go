@@ -3,7 +3,9 @@ package codec
 import (
 	"bytes"
 	"compress/zlib"
+	"fmt"
 	"io"
+	"sort"
 
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/klauspost/compress/zstd"
@@ -57,6 +59,140 @@ func (codec *DefaultVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExt
 	return ve, ve.Unmarshal(bz)
 }
 
+// MaxDenseID is the exclusive upper bound on the price IDs that can be encoded by the DenseVoteExtensionCodec, which
+// bounds the size of the presence bitmap.
+const MaxDenseID = 1 << 20
+
+// DenseVoteExtensionCodec is a VoteExtensionCodec that encodes vote extensions as a DenseOracleVoteExtension, i.e. a
+// presence bitmap of the price IDs with the prices packed in ascending order of ID, rather than a map of ID to price.
+// This is intended to be used with the DenseCurrencyPairStrategy, whose IDs are dense indices, so that the bitmap
+// is (at most) a bit per currency pair.
+type DenseVoteExtensionCodec struct{}
+
+// NewDenseVoteExtensionCodec returns a new DenseVoteExtensionCodec.
+func NewDenseVoteExtensionCodec() *DenseVoteExtensionCodec {
+	return &DenseVoteExtensionCodec{}
+}
+
+// Encode encodes the vote extension as a DenseOracleVoteExtension. This method returns an error if a price ID is
+// not less than MaxDenseID, or if metadata is included for an ID without a price.
+func (codec *DenseVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	ids := make([]uint64, 0, len(ve.Prices))
+	for id := range ve.Prices {
+		if id >= MaxDenseID {
+			return nil, fmt.Errorf("id %d exceeds the maximum dense id %d", id, MaxDenseID-1)
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for id := range ve.Metadata {
+		if _, ok := ve.Prices[id]; !ok {
+			return nil, fmt.Errorf("metadata included for id %d without a price", id)
+		}
+	}
+
+	dense := vetypes.DenseOracleVoteExtension{
+		Version: ve.Version,
+	}
+	if len(ids) > 0 {
+		dense.Bitmap = make([]byte, ids[len(ids)-1]/8+1)
+		dense.Prices = make([][]byte, 0, len(ids))
+	}
+	if len(ve.Metadata) > 0 {
+		dense.MetadataBitmap = make([]byte, (len(ids)+7)/8)
+		dense.Metadata = make([]*vetypes.PriceMetadata, 0, len(ve.Metadata))
+	}
+
+	for i, id := range ids {
+		setBit(dense.Bitmap, int(id))
+		dense.Prices = append(dense.Prices, ve.Prices[id])
+
+		if md, ok := ve.Metadata[id]; ok && md != nil {
+			setBit(dense.MetadataBitmap, i)
+			dense.Metadata = append(dense.Metadata, md)
+		}
+	}
+
+	return dense.Marshal()
+}
+
+// Decode decodes a DenseOracleVoteExtension into a vote extension. This method returns an error if the number of
+// prices or metadata does not match the respective bitmap.
+func (codec *DenseVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	var dense vetypes.DenseOracleVoteExtension
+	if err := dense.Unmarshal(bz); err != nil {
+		return vetypes.OracleVoteExtension{}, err
+	}
+
+	if len(dense.Bitmap) > MaxDenseID/8 {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("bitmap of %d bytes exceeds the maximum dense id %d", len(dense.Bitmap), MaxDenseID-1)
+	}
+
+	ve := vetypes.OracleVoteExtension{
+		Version: dense.Version,
+	}
+	if len(dense.Prices) > 0 {
+		ve.Prices = make(map[uint64][]byte, len(dense.Prices))
+	}
+
+	ids := make([]uint64, 0, len(dense.Prices))
+	for i := 0; i < 8*len(dense.Bitmap); i++ {
+		if !hasBit(dense.Bitmap, i) {
+			continue
+		}
+
+		if len(ids) == len(dense.Prices) {
+			return vetypes.OracleVoteExtension{}, fmt.Errorf("bitmap includes more ids than the %d prices", len(dense.Prices))
+		}
+
+		ve.Prices[uint64(i)] = dense.Prices[len(ids)]
+		ids = append(ids, uint64(i))
+	}
+
+	if len(ids) != len(dense.Prices) {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("bitmap includes %d ids for %d prices", len(ids), len(dense.Prices))
+	}
+
+	if len(dense.Metadata) > 0 {
+		ve.Metadata = make(map[uint64]*vetypes.PriceMetadata, len(dense.Metadata))
+	}
+
+	var numMetadata int
+	for i := 0; i < 8*len(dense.MetadataBitmap); i++ {
+		if !hasBit(dense.MetadataBitmap, i) {
+			continue
+		}
+
+		if i >= len(ids) {
+			return vetypes.OracleVoteExtension{}, fmt.Errorf("metadata bitmap includes price %d of %d prices", i, len(ids))
+		}
+
+		if numMetadata == len(dense.Metadata) {
+			return vetypes.OracleVoteExtension{}, fmt.Errorf("metadata bitmap includes more prices than the %d metadata", len(dense.Metadata))
+		}
+
+		ve.Metadata[ids[i]] = dense.Metadata[numMetadata]
+		numMetadata++
+	}
+
+	if numMetadata != len(dense.Metadata) {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("metadata bitmap includes %d prices for %d metadata", numMetadata, len(dense.Metadata))
+	}
+
+	return ve, nil
+}
+
+// setBit sets bit i, the (i%8)-th least significant bit of byte i/8, of the given bitmap.
+func setBit(bitmap []byte, i int) {
+	bitmap[i/8] |= 1 << (i % 8)
+}
+
+// hasBit returns true if bit i of the given bitmap is set.
+func hasBit(bitmap []byte, i int) bool {
+	return bitmap[i/8]&(1<<(i%8)) != 0
+}
+
 type Compressor interface {
 	Compress([]byte) ([]byte, error)
 	Decompress([]byte) ([]byte, error)
@@ -82,6 +82,102 @@ func TestCompressionVoteExtensionCodec(t *testing.T) {
 	})
 }
 
+func TestDenseVoteExtensionCodec(t *testing.T) {
+	codec := compression.NewDenseVoteExtensionCodec()
+
+	t.Run("test encoding / decoding", func(t *testing.T) {
+		ve := vetypes.OracleVoteExtension{
+			Prices: map[uint64][]byte{
+				0:  []byte("0"),
+				3:  []byte("3"),
+				17: []byte("17"),
+			},
+			Version: 2,
+			Metadata: map[uint64]*vetypes.PriceMetadata{
+				3: {AgeMs: 100, NumProviders: 4},
+			},
+		}
+
+		bz, err := codec.Encode(ve)
+		require.NoError(t, err)
+
+		decodedVe, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve, decodedVe)
+	})
+
+	t.Run("test dense encoding is smaller than the default encoding", func(t *testing.T) {
+		samplePrice := []byte("nocapongodskiptoonicewititshiiiiiiiii")
+		ve := vetypes.OracleVoteExtension{
+			Prices: make(map[uint64][]byte),
+		}
+		for i := uint64(0); i < 200; i++ {
+			ve.Prices[i] = samplePrice
+		}
+
+		bz, err := codec.Encode(ve)
+		require.NoError(t, err)
+
+		defaultBz, err := compression.NewDefaultVoteExtensionCodec().Encode(ve)
+		require.NoError(t, err)
+		require.Less(t, len(bz), len(defaultBz))
+
+		decodedVe, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve.Prices, decodedVe.Prices)
+	})
+
+	t.Run("test decoding empty byte array", func(t *testing.T) {
+		ve, err := codec.Decode([]byte{})
+		require.NoError(t, err)
+		require.Empty(t, ve.Prices)
+	})
+
+	t.Run("test encoding ids exceeding the maximum dense id", func(t *testing.T) {
+		_, err := codec.Encode(vetypes.OracleVoteExtension{
+			Prices: map[uint64][]byte{compression.MaxDenseID: []byte("1")},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("test encoding metadata without a price", func(t *testing.T) {
+		_, err := codec.Encode(vetypes.OracleVoteExtension{
+			Prices:   map[uint64][]byte{1: []byte("1")},
+			Metadata: map[uint64]*vetypes.PriceMetadata{2: {AgeMs: 1}},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("test decoding mismatched bitmaps", func(t *testing.T) {
+		for _, dense := range []vetypes.DenseOracleVoteExtension{
+			// more ids than prices
+			{Bitmap: []byte{0b11}, Prices: [][]byte{[]byte("1")}},
+			// more prices than ids
+			{Bitmap: []byte{0b1}, Prices: [][]byte{[]byte("1"), []byte("2")}},
+			// metadata for a price that is not included
+			{
+				Bitmap:         []byte{0b1},
+				Prices:         [][]byte{[]byte("1")},
+				MetadataBitmap: []byte{0b10},
+				Metadata:       []*vetypes.PriceMetadata{{AgeMs: 1}},
+			},
+			// more metadata than set in the bitmap
+			{
+				Bitmap:         []byte{0b1},
+				Prices:         [][]byte{[]byte("1")},
+				MetadataBitmap: []byte{0b1},
+				Metadata:       []*vetypes.PriceMetadata{{AgeMs: 1}, {AgeMs: 2}},
+			},
+		} {
+			bz, err := dense.Marshal()
+			require.NoError(t, err)
+
+			_, err = codec.Decode(bz)
+			require.Error(t, err)
+		}
+	})
+}
+
 func TestDefaultExtendedCommitCodec(t *testing.T) {
 	t.Run("test encoding / decoding", func(t *testing.T) {
 		// create a sample extended commit info
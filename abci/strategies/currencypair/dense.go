@@ -0,0 +1,193 @@
+package currencypair
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// DenseCurrencyPairStrategy is a strategy that uses the index of a currency pair in the list of all currency pairs
+// in the x/oracle state, ordered by their x/oracle IDs, as the unique ID for a given currency pair. The IDs are
+// therefore contiguous, which allows vote extensions to be encoded as a dense vector of prices with a presence
+// bitmap (see codec.DenseVoteExtensionCodec). Raw prices are used as the price representation.
+//
+// As x/oracle IDs are assigned incrementally, adding a currency pair appends it to the ordering and does not
+// change the IDs of existing currency pairs. Removing a currency pair however shifts the IDs of all currency pairs
+// that follow it. The number of currency pairs removed in the previous block is used to version the ordering: the
+// vote extensions of the previous height were created before those removals, so FromID decodes them against the
+// ordering that is rebuilt from the current currency pairs and the currency pairs removed in the previous block.
+type DenseCurrencyPairStrategy struct {
+	*DefaultCurrencyPairStrategy
+
+	// mtx guards the cached orderings, as the same strategy is shared by the ABCI handlers, which may be called
+	// concurrently.
+	mtx sync.Mutex
+
+	// orderings are the orderings of all currency pairs in the x/oracle state, keyed by the number of currency pairs
+	// removed in the previous block that they were created before. Version zero is the current ordering. The
+	// orderings are cached for the x/oracle state described by orderingsKey, and are recomputed whenever a currency
+	// pair is created or removed.
+	orderings    map[uint64]denseOrdering
+	orderingsKey denseOrderingsKey
+}
+
+// denseOrderingsKey identifies the x/oracle state that the cached orderings were computed from. Creating a currency
+// pair changes the number of currency pairs, and removing one changes the number of removed currency pairs, so the
+// key changes whenever the ordering does.
+type denseOrderingsKey struct {
+	height     int64
+	numCPs     uint64
+	numRemoves uint64
+}
+
+// denseOrdering is a list of currency pairs ordered by x/oracle ID, along with the index of each currency pair in
+// the list.
+type denseOrdering struct {
+	cps   []orderedCurrencyPair
+	index map[connecttypes.CurrencyPair]uint64
+}
+
+// orderedCurrencyPair is a currency pair along with its x/oracle ID.
+type orderedCurrencyPair struct {
+	cp       connecttypes.CurrencyPair
+	oracleID uint64
+}
+
+// NewDenseCurrencyPairStrategy returns a new DenseCurrencyPairStrategy instance.
+func NewDenseCurrencyPairStrategy(oracleKeeper OracleKeeper) *DenseCurrencyPairStrategy {
+	return &DenseCurrencyPairStrategy{
+		DefaultCurrencyPairStrategy: NewDefaultCurrencyPairStrategy(oracleKeeper),
+	}
+}
+
+// ID returns the index of the given currency pair in the ordering of all currency pairs in the x/oracle state.
+// This method returns an error if the given currency pair is not found in the x/oracle state.
+func (s *DenseCurrencyPairStrategy) ID(ctx sdk.Context, cp connecttypes.CurrencyPair) (uint64, error) {
+	ordering, err := s.getOrdering(ctx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	id, ok := ordering.index[cp]
+	if !ok {
+		return 0, fmt.Errorf("currency pair %s not found in x/oracle state", cp.String())
+	}
+
+	return id, nil
+}
+
+// FromID returns the currency pair at the given index in the ordering of all currency pairs that the vote
+// extensions of the previous height were created with, i.e. the ordering before any currency pairs were removed in
+// the previous block. This method returns an error if the given index is out of range.
+func (s *DenseCurrencyPairStrategy) FromID(ctx sdk.Context, id uint64) (connecttypes.CurrencyPair, error) {
+	ordering, err := s.getOrdering(ctx, true)
+	if err != nil {
+		return connecttypes.CurrencyPair{}, err
+	}
+
+	if id >= uint64(len(ordering.cps)) {
+		return connecttypes.CurrencyPair{}, fmt.Errorf("id %d not found", id)
+	}
+
+	return ordering.cps[id].cp, nil
+}
+
+// getOrdering returns the current ordering of all currency pairs in the x/oracle state or, if beforeRemovals is set,
+// the ordering before any currency pairs were removed in the previous block. Each ordering is computed once for a
+// given x/oracle state.
+func (s *DenseCurrencyPairStrategy) getOrdering(ctx sdk.Context, beforeRemovals bool) (denseOrdering, error) {
+	numCPs, err := s.oracleKeeper.GetNumCurrencyPairs(ctx)
+	if err != nil {
+		return denseOrdering{}, err
+	}
+
+	numRemoves, err := s.oracleKeeper.GetNumRemovedCurrencyPairs(ctx)
+	if err != nil {
+		return denseOrdering{}, err
+	}
+
+	key := denseOrderingsKey{height: ctx.BlockHeight(), numCPs: numCPs, numRemoves: numRemoves}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.orderings == nil || key != s.orderingsKey {
+		s.orderings = make(map[uint64]denseOrdering)
+		s.orderingsKey = key
+	}
+
+	var version uint64
+	if beforeRemovals {
+		version = numRemoves
+	}
+
+	return s.computeOrdering(ctx, version)
+}
+
+// computeOrdering returns the ordering of all currency pairs in the x/oracle state before the given number of
+// currency pairs were removed in the previous block, computing it if it is not cached. The caller must hold mtx.
+func (s *DenseCurrencyPairStrategy) computeOrdering(ctx sdk.Context, version uint64) (denseOrdering, error) {
+	if ordering, ok := s.orderings[version]; ok {
+		return ordering, nil
+	}
+
+	var cps []orderedCurrencyPair
+	if version == 0 {
+		for _, cp := range s.oracleKeeper.GetAllCurrencyPairs(ctx) {
+			id, found := s.oracleKeeper.GetIDForCurrencyPair(ctx, cp)
+			if !found {
+				return denseOrdering{}, fmt.Errorf("currency pair %s not found in x/oracle state", cp.String())
+			}
+
+			cps = append(cps, orderedCurrencyPair{cp: cp, oracleID: id})
+		}
+	} else {
+		current, err := s.computeOrdering(ctx, 0)
+		if err != nil {
+			return denseOrdering{}, err
+		}
+
+		removed, err := s.oracleKeeper.GetRemovedCurrencyPairs(ctx)
+		if err != nil {
+			return denseOrdering{}, err
+		}
+
+		if uint64(len(removed)) != version {
+			return denseOrdering{}, fmt.Errorf(
+				"expected %d currency pairs removed in the previous block, found %d", version, len(removed),
+			)
+		}
+
+		cps = make([]orderedCurrencyPair, 0, len(current.cps)+len(removed))
+		cps = append(cps, current.cps...)
+		for id, cp := range removed {
+			cps = append(cps, orderedCurrencyPair{cp: cp, oracleID: id})
+		}
+	}
+
+	ordering := newDenseOrdering(cps)
+	s.orderings[version] = ordering
+
+	return ordering, nil
+}
+
+// newDenseOrdering orders the given currency pairs by x/oracle ID and indexes them. A currency pair that appears more
+// than once, i.e. one that was removed and added again, is indexed by its first appearance.
+func newDenseOrdering(cps []orderedCurrencyPair) denseOrdering {
+	sort.Slice(cps, func(i, j int) bool {
+		return cps[i].oracleID < cps[j].oracleID
+	})
+
+	index := make(map[connecttypes.CurrencyPair]uint64, len(cps))
+	for i, ocp := range cps {
+		if _, ok := index[ocp.cp]; !ok {
+			index[ocp.cp] = uint64(i)
+		}
+	}
+
+	return denseOrdering{cps: cps, index: index}
+}
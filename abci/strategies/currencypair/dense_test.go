@@ -0,0 +1,181 @@
+package currencypair_test
+
+import (
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	strategies "github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// expectDenseOrdering sets up the oracle keeper to return the given currency pairs with the given x/oracle IDs, and
+// the given number of currency pairs removed in the previous block, for the given context.
+func expectDenseOrdering(
+	ok *mocks.OracleKeeper,
+	ctx sdk.Context,
+	ids map[connecttypes.CurrencyPair]uint64,
+	numRemoves uint64,
+) {
+	cps := make([]connecttypes.CurrencyPair, 0, len(ids))
+	for cp, id := range ids {
+		cps = append(cps, cp)
+		ok.On("GetIDForCurrencyPair", ctx, cp).Return(id, true).Once()
+	}
+	ok.On("GetAllCurrencyPairs", ctx).Return(cps).Once()
+	ok.On("GetNumCurrencyPairs", ctx).Return(uint64(len(ids)), nil).Maybe()
+	ok.On("GetNumRemovedCurrencyPairs", ctx).Return(numRemoves, nil).Maybe()
+}
+
+func TestDenseCurrencyPairStrategyID(t *testing.T) {
+	ok := mocks.NewOracleKeeper(t)
+	strategy := strategies.NewDenseCurrencyPairStrategy(ok)
+
+	t.Run("ids are the indices of the currency pairs ordered by x/oracle id", func(t *testing.T) {
+		ctx := sdk.Context{}.WithBlockHeight(1)
+		expectDenseOrdering(ok, ctx, map[connecttypes.CurrencyPair]uint64{btcusd: 7, usdeth: 2, ethbtc: 4}, 0)
+
+		for cp, expected := range map[connecttypes.CurrencyPair]uint64{usdeth: 0, ethbtc: 1, btcusd: 2} {
+			id, err := strategy.ID(ctx, cp)
+			require.NoError(t, err)
+			require.Equal(t, expected, id)
+		}
+	})
+
+	t.Run("ids are stable when currency pairs are added", func(t *testing.T) {
+		ctx := sdk.Context{}.WithBlockHeight(2)
+		solusd := connecttypes.NewCurrencyPair("SOL", "USD")
+		expectDenseOrdering(ok, ctx, map[connecttypes.CurrencyPair]uint64{btcusd: 7, usdeth: 2, ethbtc: 4, solusd: 8}, 0)
+
+		for cp, expected := range map[connecttypes.CurrencyPair]uint64{usdeth: 0, ethbtc: 1, btcusd: 2, solusd: 3} {
+			id, err := strategy.ID(ctx, cp)
+			require.NoError(t, err)
+			require.Equal(t, expected, id)
+		}
+	})
+
+	t.Run("ids shift when currency pairs are removed", func(t *testing.T) {
+		ctx := sdk.Context{}.WithBlockHeight(3)
+		expectDenseOrdering(ok, ctx, map[connecttypes.CurrencyPair]uint64{btcusd: 7, ethbtc: 4}, 1)
+
+		id, err := strategy.ID(ctx, btcusd)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), id)
+
+		_, err = strategy.ID(ctx, usdeth)
+		require.Error(t, err)
+	})
+}
+
+func TestDenseCurrencyPairStrategyCache(t *testing.T) {
+	t.Run("orderings are recomputed when currency pairs change within a height", func(t *testing.T) {
+		ok := mocks.NewOracleKeeper(t)
+		strategy := strategies.NewDenseCurrencyPairStrategy(ok)
+
+		ctx := sdk.Context{}.WithBlockHeight(1)
+		ok.On("GetNumCurrencyPairs", ctx).Return(uint64(3), nil).Once()
+		ok.On("GetNumRemovedCurrencyPairs", ctx).Return(uint64(0), nil).Once()
+		ok.On("GetAllCurrencyPairs", ctx).Return([]connecttypes.CurrencyPair{btcusd, usdeth, ethbtc}).Once()
+		ok.On("GetIDForCurrencyPair", ctx, btcusd).Return(uint64(7), true).Once()
+		ok.On("GetIDForCurrencyPair", ctx, usdeth).Return(uint64(2), true).Once()
+		ok.On("GetIDForCurrencyPair", ctx, ethbtc).Return(uint64(4), true).Once()
+
+		id, err := strategy.ID(ctx, btcusd)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), id)
+
+		// usdeth is removed later in the same height
+		ok.On("GetNumCurrencyPairs", ctx).Return(uint64(2), nil).Once()
+		ok.On("GetNumRemovedCurrencyPairs", ctx).Return(uint64(1), nil).Once()
+		ok.On("GetAllCurrencyPairs", ctx).Return([]connecttypes.CurrencyPair{btcusd, ethbtc}).Once()
+		ok.On("GetIDForCurrencyPair", ctx, btcusd).Return(uint64(7), true).Once()
+		ok.On("GetIDForCurrencyPair", ctx, ethbtc).Return(uint64(4), true).Once()
+
+		id, err = strategy.ID(ctx, btcusd)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), id)
+	})
+
+	t.Run("orderings can be read concurrently", func(t *testing.T) {
+		ok := mocks.NewOracleKeeper(t)
+		strategy := strategies.NewDenseCurrencyPairStrategy(ok)
+
+		ctx := sdk.Context{}.WithBlockHeight(1)
+		ok.On("GetNumCurrencyPairs", ctx).Return(uint64(3), nil)
+		ok.On("GetNumRemovedCurrencyPairs", ctx).Return(uint64(0), nil)
+		ok.On("GetAllCurrencyPairs", ctx).Return([]connecttypes.CurrencyPair{btcusd, usdeth, ethbtc}).Once()
+		ok.On("GetIDForCurrencyPair", ctx, btcusd).Return(uint64(7), true).Once()
+		ok.On("GetIDForCurrencyPair", ctx, usdeth).Return(uint64(2), true).Once()
+		ok.On("GetIDForCurrencyPair", ctx, ethbtc).Return(uint64(4), true).Once()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				id, err := strategy.ID(ctx, btcusd)
+				require.NoError(t, err)
+				require.Equal(t, uint64(2), id)
+
+				cp, err := strategy.FromID(ctx, 0)
+				require.NoError(t, err)
+				require.Equal(t, usdeth, cp)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestDenseCurrencyPairStrategyFromID(t *testing.T) {
+	ok := mocks.NewOracleKeeper(t)
+	strategy := strategies.NewDenseCurrencyPairStrategy(ok)
+
+	t.Run("currency pairs are returned by index", func(t *testing.T) {
+		ctx := sdk.Context{}.WithBlockHeight(1)
+		expectDenseOrdering(ok, ctx, map[connecttypes.CurrencyPair]uint64{btcusd: 7, usdeth: 2, ethbtc: 4}, 0)
+
+		for id, expected := range []connecttypes.CurrencyPair{usdeth, ethbtc, btcusd} {
+			cp, err := strategy.FromID(ctx, uint64(id))
+			require.NoError(t, err)
+			require.Equal(t, expected, cp)
+		}
+
+		// expect an error for an index out of range
+		_, err := strategy.FromID(ctx, 3)
+		require.Error(t, err)
+	})
+
+	t.Run("ids are decoded against the ordering before currency pairs were removed in the previous block", func(t *testing.T) {
+		ctx := sdk.Context{}.WithBlockHeight(2)
+		ok.On("GetRemovedCurrencyPairs", ctx).Return(map[uint64]connecttypes.CurrencyPair{2: usdeth}, nil).Once()
+		expectDenseOrdering(ok, ctx, map[connecttypes.CurrencyPair]uint64{btcusd: 7, ethbtc: 4}, 1)
+
+		for id, expected := range []connecttypes.CurrencyPair{usdeth, ethbtc, btcusd} {
+			cp, err := strategy.FromID(ctx, uint64(id))
+			require.NoError(t, err)
+			require.Equal(t, expected, cp)
+		}
+
+		// expect an error for an index out of range
+		_, err := strategy.FromID(ctx, 3)
+		require.Error(t, err)
+
+		// the current ordering is still used to encode ids
+		id, err := strategy.ID(ctx, btcusd)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), id)
+	})
+
+	t.Run("ids are rejected if the removed currency pairs are inconsistent", func(t *testing.T) {
+		ctx := sdk.Context{}.WithBlockHeight(3)
+		ok.On("GetRemovedCurrencyPairs", ctx).Return(map[uint64]connecttypes.CurrencyPair{2: usdeth}, nil).Once()
+		expectDenseOrdering(ok, ctx, map[connecttypes.CurrencyPair]uint64{btcusd: 7, ethbtc: 4}, 2)
+
+		_, err := strategy.FromID(ctx, 0)
+		require.Error(t, err)
+	})
+}
@@ -356,6 +356,64 @@ func (_c *OracleKeeper_GetPriceForCurrencyPair_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetRemovedCurrencyPairs provides a mock function with given fields: ctx
+func (_m *OracleKeeper) GetRemovedCurrencyPairs(ctx context.Context) (map[uint64]types.CurrencyPair, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRemovedCurrencyPairs")
+	}
+
+	var r0 map[uint64]types.CurrencyPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[uint64]types.CurrencyPair, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[uint64]types.CurrencyPair); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]types.CurrencyPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleKeeper_GetRemovedCurrencyPairs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRemovedCurrencyPairs'
+type OracleKeeper_GetRemovedCurrencyPairs_Call struct {
+	*mock.Call
+}
+
+// GetRemovedCurrencyPairs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OracleKeeper_Expecter) GetRemovedCurrencyPairs(ctx interface{}) *OracleKeeper_GetRemovedCurrencyPairs_Call {
+	return &OracleKeeper_GetRemovedCurrencyPairs_Call{Call: _e.mock.On("GetRemovedCurrencyPairs", ctx)}
+}
+
+func (_c *OracleKeeper_GetRemovedCurrencyPairs_Call) Run(run func(ctx context.Context)) *OracleKeeper_GetRemovedCurrencyPairs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_GetRemovedCurrencyPairs_Call) Return(_a0 map[uint64]types.CurrencyPair, _a1 error) *OracleKeeper_GetRemovedCurrencyPairs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleKeeper_GetRemovedCurrencyPairs_Call) RunAndReturn(run func(context.Context) (map[uint64]types.CurrencyPair, error)) *OracleKeeper_GetRemovedCurrencyPairs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOracleKeeper creates a new instance of OracleKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracleKeeper(t interface {
@@ -2,6 +2,7 @@ package currencypair
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,7 @@ type OracleKeeper interface {
 	GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error)
 	GetNumCurrencyPairs(ctx context.Context) (uint64, error)
 	GetNumRemovedCurrencyPairs(ctx context.Context) (uint64, error)
+	GetRemovedCurrencyPairs(ctx context.Context) (map[uint64]connecttypes.CurrencyPair, error)
 	GetAllCurrencyPairs(ctx context.Context) []connecttypes.CurrencyPair
 }
 
@@ -56,3 +58,58 @@ type CurrencyPairStrategy interface { //nolint
 		ctx sdk.Context,
 	) (uint64, error)
 }
+
+// VersionedStrategy is a CurrencyPairStrategy that supports multiple vote extension versions. The version
+// used to create vote extensions is negotiated using the x/oracle module parameters.
+type VersionedStrategy interface {
+	CurrencyPairStrategy
+
+	// Version returns the vote extension version that should be used to create vote extensions at the
+	// current height.
+	Version(ctx sdk.Context) (uint32, error)
+
+	// ForVersion returns the strategy for the given vote extension version. This method returns an error
+	// if the version is not accepted for vote extensions created at the given height.
+	ForVersion(ctx sdk.Context, version uint32, height int64) (CurrencyPairStrategy, error)
+}
+
+// StrategyForVersion returns the strategy that should be used to process a vote extension of the given
+// version, created at the given height. Strategies that do not implement VersionedStrategy only support the
+// default vote extension version.
+func StrategyForVersion(
+	ctx sdk.Context,
+	strategy CurrencyPairStrategy,
+	version uint32,
+	height int64,
+) (CurrencyPairStrategy, error) {
+	if vs, ok := strategy.(VersionedStrategy); ok {
+		return vs.ForVersion(ctx, version, height)
+	}
+
+	if version != oracletypes.DefaultVoteExtensionVersion {
+		return nil, fmt.Errorf("vote extension version %d is not supported", version)
+	}
+
+	return strategy, nil
+}
+
+// CurrentVersion returns the vote extension version, and the strategy for that version, that should be used
+// to create vote extensions at the current height.
+func CurrentVersion(ctx sdk.Context, strategy CurrencyPairStrategy) (uint32, CurrencyPairStrategy, error) {
+	vs, ok := strategy.(VersionedStrategy)
+	if !ok {
+		return oracletypes.DefaultVoteExtensionVersion, strategy, nil
+	}
+
+	version, err := vs.Version(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	current, err := vs.ForVersion(ctx, version, ctx.BlockHeight())
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return version, current, nil
+}
@@ -0,0 +1,157 @@
+package currencypair
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+var _ VersionedStrategy = (*VersionedCurrencyPairStrategy)(nil)
+
+// ParamsKeeper is an interface for reading the x/oracle module parameters.
+//
+//go:generate mockery --name ParamsKeeper --filename mock_params_keeper.go
+type ParamsKeeper interface {
+	GetParams(ctx context.Context) (oracletypes.Params, error)
+}
+
+// VersionedCurrencyPairStrategy is a strategy that wraps a set of CurrencyPairStrategies, one per vote extension
+// version. The version used to create vote extensions, and the set of versions accepted from other validators, is
+// determined by the vote extension version parameters in the x/oracle module. This allows applications to migrate
+// between strategies without coordinating an upgrade height.
+type VersionedCurrencyPairStrategy struct {
+	paramsKeeper ParamsKeeper
+	strategies   map[uint32]CurrencyPairStrategy
+}
+
+// NewVersionedCurrencyPairStrategy returns a new VersionedCurrencyPairStrategy instance given the strategy
+// to use for each supported vote extension version.
+func NewVersionedCurrencyPairStrategy(
+	paramsKeeper ParamsKeeper,
+	strategies map[uint32]CurrencyPairStrategy,
+) *VersionedCurrencyPairStrategy {
+	return &VersionedCurrencyPairStrategy{
+		paramsKeeper: paramsKeeper,
+		strategies:   strategies,
+	}
+}
+
+// Version returns the vote extension version that should be used to create vote extensions at the current
+// height. The current version is preferred. If the current version is not supported locally but the chain is
+// transitioning from a previous version that is, the previous version is used instead.
+func (s *VersionedCurrencyPairStrategy) Version(ctx sdk.Context) (uint32, error) {
+	params, err := s.paramsKeeper.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, ok := s.strategies[params.VoteExtensionVersion]; ok {
+		return params.VoteExtensionVersion, nil
+	}
+
+	if params.InVoteExtensionTransition(ctx.BlockHeight()) {
+		if _, ok := s.strategies[params.PreviousVoteExtensionVersion]; ok {
+			return params.PreviousVoteExtensionVersion, nil
+		}
+	}
+
+	return 0, fmt.Errorf("vote extension version %d is not supported", params.VoteExtensionVersion)
+}
+
+// ForVersion returns the strategy for the given vote extension version. This method returns an error if the
+// version is not accepted by the x/oracle module for vote extensions created at the given height, or if no
+// strategy is registered for it.
+func (s *VersionedCurrencyPairStrategy) ForVersion(
+	ctx sdk.Context,
+	version uint32,
+	height int64,
+) (CurrencyPairStrategy, error) {
+	params, err := s.paramsKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.IsVoteExtensionVersionAccepted(version, height) {
+		return nil, fmt.Errorf("vote extension version %d is not accepted at height %d", version, height)
+	}
+
+	strategy, ok := s.strategies[version]
+	if !ok {
+		return nil, fmt.Errorf("vote extension version %d is not supported", version)
+	}
+
+	return strategy, nil
+}
+
+// ID returns the on-chain ID of the given currency pair using the strategy for the current version.
+func (s *VersionedCurrencyPairStrategy) ID(ctx sdk.Context, cp connecttypes.CurrencyPair) (uint64, error) {
+	strategy, err := s.current(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return strategy.ID(ctx, cp)
+}
+
+// FromID returns the currency pair with the given ID using the strategy for the current version.
+func (s *VersionedCurrencyPairStrategy) FromID(ctx sdk.Context, id uint64) (connecttypes.CurrencyPair, error) {
+	strategy, err := s.current(ctx)
+	if err != nil {
+		return connecttypes.CurrencyPair{}, err
+	}
+
+	return strategy.FromID(ctx, id)
+}
+
+// GetEncodedPrice returns the encoded price for the given currency pair using the strategy for the current version.
+func (s *VersionedCurrencyPairStrategy) GetEncodedPrice(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	price *big.Int,
+) ([]byte, error) {
+	strategy, err := s.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return strategy.GetEncodedPrice(ctx, cp, price)
+}
+
+// GetDecodedPrice returns the decoded price for the given currency pair using the strategy for the current version.
+func (s *VersionedCurrencyPairStrategy) GetDecodedPrice(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	priceBytes []byte,
+) (*big.Int, error) {
+	strategy, err := s.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return strategy.GetDecodedPrice(ctx, cp, priceBytes)
+}
+
+// GetMaxNumCP returns the number of pairs that the VEs should include using the strategy for the current version.
+func (s *VersionedCurrencyPairStrategy) GetMaxNumCP(ctx sdk.Context) (uint64, error) {
+	strategy, err := s.current(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return strategy.GetMaxNumCP(ctx)
+}
+
+// current returns the strategy for the version returned by Version.
+func (s *VersionedCurrencyPairStrategy) current(ctx sdk.Context) (CurrencyPairStrategy, error) {
+	version, err := s.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.strategies[version], nil
+}
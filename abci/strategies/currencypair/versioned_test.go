@@ -0,0 +1,138 @@
+package currencypair_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	strategies "github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestVersionedCurrencyPairStrategyVersion(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(10)
+
+	t.Run("returns the current version if it is supported", func(t *testing.T) {
+		pk := mocks.NewParamsKeeper(t)
+		strategy := strategies.NewVersionedCurrencyPairStrategy(pk, map[uint32]strategies.CurrencyPairStrategy{
+			0: mocks.NewCurrencyPairStrategy(t),
+			1: mocks.NewCurrencyPairStrategy(t),
+		})
+
+		pk.On("GetParams", ctx).Return(oracletypes.NewParams(1, 0, 20), nil).Once()
+		version, err := strategy.Version(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), version)
+	})
+
+	t.Run("falls back to the previous version during the transition", func(t *testing.T) {
+		pk := mocks.NewParamsKeeper(t)
+		strategy := strategies.NewVersionedCurrencyPairStrategy(pk, map[uint32]strategies.CurrencyPairStrategy{
+			0: mocks.NewCurrencyPairStrategy(t),
+		})
+
+		pk.On("GetParams", ctx).Return(oracletypes.NewParams(1, 0, 20), nil).Once()
+		version, err := strategy.Version(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), version)
+	})
+
+	t.Run("errors if the current version is unsupported after the transition", func(t *testing.T) {
+		pk := mocks.NewParamsKeeper(t)
+		strategy := strategies.NewVersionedCurrencyPairStrategy(pk, map[uint32]strategies.CurrencyPairStrategy{
+			0: mocks.NewCurrencyPairStrategy(t),
+		})
+
+		pk.On("GetParams", ctx).Return(oracletypes.NewParams(1, 0, 10), nil).Once()
+		_, err := strategy.Version(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestVersionedCurrencyPairStrategyForVersion(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(10)
+	v0 := mocks.NewCurrencyPairStrategy(t)
+	v1 := mocks.NewCurrencyPairStrategy(t)
+
+	t.Run("accepts both versions during the transition", func(t *testing.T) {
+		pk := mocks.NewParamsKeeper(t)
+		strategy := strategies.NewVersionedCurrencyPairStrategy(pk, map[uint32]strategies.CurrencyPairStrategy{
+			0: v0,
+			1: v1,
+		})
+
+		pk.On("GetParams", ctx).Return(oracletypes.NewParams(1, 0, 11), nil).Twice()
+		s, err := strategy.ForVersion(ctx, 0, ctx.BlockHeight())
+		require.NoError(t, err)
+		require.Equal(t, v0, s)
+
+		s, err = strategy.ForVersion(ctx, 1, ctx.BlockHeight())
+		require.NoError(t, err)
+		require.Equal(t, v1, s)
+	})
+
+	t.Run("rejects the previous version once the transition has ended", func(t *testing.T) {
+		pk := mocks.NewParamsKeeper(t)
+		strategy := strategies.NewVersionedCurrencyPairStrategy(pk, map[uint32]strategies.CurrencyPairStrategy{
+			0: v0,
+			1: v1,
+		})
+
+		pk.On("GetParams", ctx).Return(oracletypes.NewParams(1, 0, 10), nil).Once()
+		_, err := strategy.ForVersion(ctx, 0, ctx.BlockHeight())
+		require.Error(t, err)
+	})
+
+	t.Run("rejects accepted versions without a registered strategy", func(t *testing.T) {
+		pk := mocks.NewParamsKeeper(t)
+		strategy := strategies.NewVersionedCurrencyPairStrategy(pk, map[uint32]strategies.CurrencyPairStrategy{
+			0: v0,
+		})
+
+		pk.On("GetParams", ctx).Return(oracletypes.NewParams(1, 0, 0), nil).Once()
+		_, err := strategy.ForVersion(ctx, 1, ctx.BlockHeight())
+		require.Error(t, err)
+	})
+}
+
+func TestVersionedCurrencyPairStrategyDelegates(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(10)
+	pk := mocks.NewParamsKeeper(t)
+	v0 := mocks.NewCurrencyPairStrategy(t)
+	v1 := mocks.NewCurrencyPairStrategy(t)
+	strategy := strategies.NewVersionedCurrencyPairStrategy(pk, map[uint32]strategies.CurrencyPairStrategy{
+		0: v0,
+		1: v1,
+	})
+
+	pk.On("GetParams", ctx).Return(oracletypes.NewParams(1, 0, 0), nil)
+
+	v1.On("ID", ctx, btcusd).Return(uint64(3), nil).Once()
+	id, err := strategy.ID(ctx, btcusd)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), id)
+
+	v1.On("GetEncodedPrice", ctx, btcusd, big.NewInt(100)).Return([]byte{0x1}, nil).Once()
+	bz, err := strategy.GetEncodedPrice(ctx, btcusd, big.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1}, bz)
+}
+
+func TestStrategyForVersion(t *testing.T) {
+	ctx := sdk.Context{}
+	strategy := mocks.NewCurrencyPairStrategy(t)
+
+	t.Run("unversioned strategies support the default version", func(t *testing.T) {
+		s, err := strategies.StrategyForVersion(ctx, strategy, oracletypes.DefaultVoteExtensionVersion, ctx.BlockHeight())
+		require.NoError(t, err)
+		require.Equal(t, strategy, s)
+	})
+
+	t.Run("unversioned strategies reject other versions", func(t *testing.T) {
+		_, err := strategies.StrategyForVersion(ctx, strategy, 1, ctx.BlockHeight())
+		require.Error(t, err)
+	})
+}
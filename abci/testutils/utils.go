@@ -10,25 +10,33 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/mock"
 
 	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
 	"github.com/skip-mev/connect/v2/abci/ve/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/keeper"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
 )
 
-// CreateTestOracleKeeperWithGenesis creates a test oracle keeper with the given genesis state.
+// CreateTestOracleKeeperWithGenesis creates a test oracle keeper with the given genesis state. Every market is
+// reported as enabled by the market map keeper of the oracle keeper.
 func CreateTestOracleKeeperWithGenesis(t *testing.T, ctx sdk.Context, key *storetypes.KVStoreKey, genesis oracletypes.GenesisState) keeper.Keeper {
 	t.Helper()
 
 	ss := runtime.NewKVStoreService(key)
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
+	mmKeeper := mocks.NewMarketMapKeeper(t)
+	mmKeeper.On("GetMarket", mock.Anything, mock.Anything).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{Enabled: true},
+	}, nil).Maybe()
+
 	k := keeper.NewKeeper(
 		ss,
 		encCfg.Codec,
-		mocks.NewMarketMapKeeper(t),
+		mmKeeper,
 		sdk.AccAddress("authority"),
 	)
 
@@ -2,6 +2,10 @@ package types
 
 import (
 	"context"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"google.golang.org/grpc"
 
@@ -17,7 +21,26 @@ import (
 //go:generate mockery --name OracleKeeper --filename mock_oracle_keeper.go
 type OracleKeeper interface { //golint:ignore
 	GetAllCurrencyPairs(ctx context.Context) []connecttypes.CurrencyPair
+	GetCurrencyPairMapping(ctx context.Context) (map[uint64]connecttypes.CurrencyPair, error)
+	GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error)
 	SetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair, qp oracletypes.QuotePrice) error
+	PriceUpdateHooks() oracletypes.PriceUpdateHooks
+	GetParams(ctx context.Context) (oracletypes.Params, error)
+	AcceptsPrices(ctx context.Context, cp connecttypes.CurrencyPair) (bool, error)
+	ApplyCircuitBreaker(ctx context.Context, cp connecttypes.CurrencyPair, price math.Int) (math.Int, bool, error)
+	RecordParticipation(
+		ctx context.Context,
+		params oracletypes.Params,
+		cps map[uint64]connecttypes.CurrencyPair,
+		validator sdk.ConsAddress,
+		absent bool,
+		validatorPrices, finalPrices map[connecttypes.CurrencyPair]*big.Int,
+	) error
+	EmitPriceUpdateEvents(
+		ctx context.Context,
+		cps []connecttypes.CurrencyPair,
+		numValidators map[connecttypes.CurrencyPair]uint32,
+	) error
 }
 
 // OracleClient defines the interface that must be fulfilled by the connect client.
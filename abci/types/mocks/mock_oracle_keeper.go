@@ -4,6 +4,11 @@ package mocks
 
 import (
 	context "context"
+	big "math/big"
+
+	cosmos_sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+	math "cosmossdk.io/math"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -25,6 +30,176 @@ func (_m *OracleKeeper) EXPECT() *OracleKeeper_Expecter {
 	return &OracleKeeper_Expecter{mock: &_m.Mock}
 }
 
+// AcceptsPrices provides a mock function with given fields: ctx, cp
+func (_m *OracleKeeper) AcceptsPrices(ctx context.Context, cp types.CurrencyPair) (bool, error) {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptsPrices")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair) (bool, error)); ok {
+		return rf(ctx, cp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair) bool); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.CurrencyPair) error); ok {
+		r1 = rf(ctx, cp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleKeeper_AcceptsPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptsPrices'
+type OracleKeeper_AcceptsPrices_Call struct {
+	*mock.Call
+}
+
+// AcceptsPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cp types.CurrencyPair
+func (_e *OracleKeeper_Expecter) AcceptsPrices(ctx interface{}, cp interface{}) *OracleKeeper_AcceptsPrices_Call {
+	return &OracleKeeper_AcceptsPrices_Call{Call: _e.mock.On("AcceptsPrices", ctx, cp)}
+}
+
+func (_c *OracleKeeper_AcceptsPrices_Call) Run(run func(ctx context.Context, cp types.CurrencyPair)) *OracleKeeper_AcceptsPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.CurrencyPair))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_AcceptsPrices_Call) Return(_a0 bool, _a1 error) *OracleKeeper_AcceptsPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleKeeper_AcceptsPrices_Call) RunAndReturn(run func(context.Context, types.CurrencyPair) (bool, error)) *OracleKeeper_AcceptsPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyCircuitBreaker provides a mock function with given fields: ctx, cp, price
+func (_m *OracleKeeper) ApplyCircuitBreaker(ctx context.Context, cp types.CurrencyPair, price math.Int) (math.Int, bool, error) {
+	ret := _m.Called(ctx, cp, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyCircuitBreaker")
+	}
+
+	var r0 math.Int
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair, math.Int) (math.Int, bool, error)); ok {
+		return rf(ctx, cp, price)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair, math.Int) math.Int); ok {
+		r0 = rf(ctx, cp, price)
+	} else {
+		r0 = ret.Get(0).(math.Int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.CurrencyPair, math.Int) bool); ok {
+		r1 = rf(ctx, cp, price)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, types.CurrencyPair, math.Int) error); ok {
+		r2 = rf(ctx, cp, price)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OracleKeeper_ApplyCircuitBreaker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyCircuitBreaker'
+type OracleKeeper_ApplyCircuitBreaker_Call struct {
+	*mock.Call
+}
+
+// ApplyCircuitBreaker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cp types.CurrencyPair
+//   - price math.Int
+func (_e *OracleKeeper_Expecter) ApplyCircuitBreaker(ctx interface{}, cp interface{}, price interface{}) *OracleKeeper_ApplyCircuitBreaker_Call {
+	return &OracleKeeper_ApplyCircuitBreaker_Call{Call: _e.mock.On("ApplyCircuitBreaker", ctx, cp, price)}
+}
+
+func (_c *OracleKeeper_ApplyCircuitBreaker_Call) Run(run func(ctx context.Context, cp types.CurrencyPair, price math.Int)) *OracleKeeper_ApplyCircuitBreaker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.CurrencyPair), args[2].(math.Int))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_ApplyCircuitBreaker_Call) Return(_a0 math.Int, _a1 bool, _a2 error) *OracleKeeper_ApplyCircuitBreaker_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OracleKeeper_ApplyCircuitBreaker_Call) RunAndReturn(run func(context.Context, types.CurrencyPair, math.Int) (math.Int, bool, error)) *OracleKeeper_ApplyCircuitBreaker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EmitPriceUpdateEvents provides a mock function with given fields: ctx, cps, numValidators
+func (_m *OracleKeeper) EmitPriceUpdateEvents(ctx context.Context, cps []types.CurrencyPair, numValidators map[types.CurrencyPair]uint32) error {
+	ret := _m.Called(ctx, cps, numValidators)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmitPriceUpdateEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.CurrencyPair, map[types.CurrencyPair]uint32) error); ok {
+		r0 = rf(ctx, cps, numValidators)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleKeeper_EmitPriceUpdateEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmitPriceUpdateEvents'
+type OracleKeeper_EmitPriceUpdateEvents_Call struct {
+	*mock.Call
+}
+
+// EmitPriceUpdateEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cps []types.CurrencyPair
+//   - numValidators map[types.CurrencyPair]uint32
+func (_e *OracleKeeper_Expecter) EmitPriceUpdateEvents(ctx interface{}, cps interface{}, numValidators interface{}) *OracleKeeper_EmitPriceUpdateEvents_Call {
+	return &OracleKeeper_EmitPriceUpdateEvents_Call{Call: _e.mock.On("EmitPriceUpdateEvents", ctx, cps, numValidators)}
+}
+
+func (_c *OracleKeeper_EmitPriceUpdateEvents_Call) Run(run func(ctx context.Context, cps []types.CurrencyPair, numValidators map[types.CurrencyPair]uint32)) *OracleKeeper_EmitPriceUpdateEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]types.CurrencyPair), args[2].(map[types.CurrencyPair]uint32))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_EmitPriceUpdateEvents_Call) Return(_a0 error) *OracleKeeper_EmitPriceUpdateEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleKeeper_EmitPriceUpdateEvents_Call) RunAndReturn(run func(context.Context, []types.CurrencyPair, map[types.CurrencyPair]uint32) error) *OracleKeeper_EmitPriceUpdateEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllCurrencyPairs provides a mock function with given fields: ctx
 func (_m *OracleKeeper) GetAllCurrencyPairs(ctx context.Context) []types.CurrencyPair {
 	ret := _m.Called(ctx)
@@ -73,6 +248,276 @@ func (_c *OracleKeeper_GetAllCurrencyPairs_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetCurrencyPairMapping provides a mock function with given fields: ctx
+func (_m *OracleKeeper) GetCurrencyPairMapping(ctx context.Context) (map[uint64]types.CurrencyPair, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrencyPairMapping")
+	}
+
+	var r0 map[uint64]types.CurrencyPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[uint64]types.CurrencyPair, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[uint64]types.CurrencyPair); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]types.CurrencyPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleKeeper_GetCurrencyPairMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrencyPairMapping'
+type OracleKeeper_GetCurrencyPairMapping_Call struct {
+	*mock.Call
+}
+
+// GetCurrencyPairMapping is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OracleKeeper_Expecter) GetCurrencyPairMapping(ctx interface{}) *OracleKeeper_GetCurrencyPairMapping_Call {
+	return &OracleKeeper_GetCurrencyPairMapping_Call{Call: _e.mock.On("GetCurrencyPairMapping", ctx)}
+}
+
+func (_c *OracleKeeper_GetCurrencyPairMapping_Call) Run(run func(ctx context.Context)) *OracleKeeper_GetCurrencyPairMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_GetCurrencyPairMapping_Call) Return(_a0 map[uint64]types.CurrencyPair, _a1 error) *OracleKeeper_GetCurrencyPairMapping_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleKeeper_GetCurrencyPairMapping_Call) RunAndReturn(run func(context.Context) (map[uint64]types.CurrencyPair, error)) *OracleKeeper_GetCurrencyPairMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParams provides a mock function with given fields: ctx
+func (_m *OracleKeeper) GetParams(ctx context.Context) (oracletypes.Params, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParams")
+	}
+
+	var r0 oracletypes.Params
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (oracletypes.Params, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) oracletypes.Params); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(oracletypes.Params)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleKeeper_GetParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParams'
+type OracleKeeper_GetParams_Call struct {
+	*mock.Call
+}
+
+// GetParams is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OracleKeeper_Expecter) GetParams(ctx interface{}) *OracleKeeper_GetParams_Call {
+	return &OracleKeeper_GetParams_Call{Call: _e.mock.On("GetParams", ctx)}
+}
+
+func (_c *OracleKeeper_GetParams_Call) Run(run func(ctx context.Context)) *OracleKeeper_GetParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_GetParams_Call) Return(_a0 oracletypes.Params, _a1 error) *OracleKeeper_GetParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleKeeper_GetParams_Call) RunAndReturn(run func(context.Context) (oracletypes.Params, error)) *OracleKeeper_GetParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceForCurrencyPair provides a mock function with given fields: ctx, cp
+func (_m *OracleKeeper) GetPriceForCurrencyPair(ctx context.Context, cp types.CurrencyPair) (oracletypes.QuotePrice, error) {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceForCurrencyPair")
+	}
+
+	var r0 oracletypes.QuotePrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair) (oracletypes.QuotePrice, error)); ok {
+		return rf(ctx, cp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair) oracletypes.QuotePrice); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Get(0).(oracletypes.QuotePrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.CurrencyPair) error); ok {
+		r1 = rf(ctx, cp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleKeeper_GetPriceForCurrencyPair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceForCurrencyPair'
+type OracleKeeper_GetPriceForCurrencyPair_Call struct {
+	*mock.Call
+}
+
+// GetPriceForCurrencyPair is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cp types.CurrencyPair
+func (_e *OracleKeeper_Expecter) GetPriceForCurrencyPair(ctx interface{}, cp interface{}) *OracleKeeper_GetPriceForCurrencyPair_Call {
+	return &OracleKeeper_GetPriceForCurrencyPair_Call{Call: _e.mock.On("GetPriceForCurrencyPair", ctx, cp)}
+}
+
+func (_c *OracleKeeper_GetPriceForCurrencyPair_Call) Run(run func(ctx context.Context, cp types.CurrencyPair)) *OracleKeeper_GetPriceForCurrencyPair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.CurrencyPair))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_GetPriceForCurrencyPair_Call) Return(_a0 oracletypes.QuotePrice, _a1 error) *OracleKeeper_GetPriceForCurrencyPair_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleKeeper_GetPriceForCurrencyPair_Call) RunAndReturn(run func(context.Context, types.CurrencyPair) (oracletypes.QuotePrice, error)) *OracleKeeper_GetPriceForCurrencyPair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PriceUpdateHooks provides a mock function with given fields:
+func (_m *OracleKeeper) PriceUpdateHooks() oracletypes.PriceUpdateHooks {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceUpdateHooks")
+	}
+
+	var r0 oracletypes.PriceUpdateHooks
+	if rf, ok := ret.Get(0).(func() oracletypes.PriceUpdateHooks); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(oracletypes.PriceUpdateHooks)
+		}
+	}
+
+	return r0
+}
+
+// OracleKeeper_PriceUpdateHooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceUpdateHooks'
+type OracleKeeper_PriceUpdateHooks_Call struct {
+	*mock.Call
+}
+
+// PriceUpdateHooks is a helper method to define mock.On call
+func (_e *OracleKeeper_Expecter) PriceUpdateHooks() *OracleKeeper_PriceUpdateHooks_Call {
+	return &OracleKeeper_PriceUpdateHooks_Call{Call: _e.mock.On("PriceUpdateHooks")}
+}
+
+func (_c *OracleKeeper_PriceUpdateHooks_Call) Run(run func()) *OracleKeeper_PriceUpdateHooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_PriceUpdateHooks_Call) Return(_a0 oracletypes.PriceUpdateHooks) *OracleKeeper_PriceUpdateHooks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleKeeper_PriceUpdateHooks_Call) RunAndReturn(run func() oracletypes.PriceUpdateHooks) *OracleKeeper_PriceUpdateHooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordParticipation provides a mock function with given fields: ctx, params, cps, validator, absent, validatorPrices, finalPrices
+func (_m *OracleKeeper) RecordParticipation(ctx context.Context, params oracletypes.Params, cps map[uint64]types.CurrencyPair, validator cosmos_sdktypes.ConsAddress, absent bool, validatorPrices map[types.CurrencyPair]*big.Int, finalPrices map[types.CurrencyPair]*big.Int) error {
+	ret := _m.Called(ctx, params, cps, validator, absent, validatorPrices, finalPrices)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordParticipation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, oracletypes.Params, map[uint64]types.CurrencyPair, cosmos_sdktypes.ConsAddress, bool, map[types.CurrencyPair]*big.Int, map[types.CurrencyPair]*big.Int) error); ok {
+		r0 = rf(ctx, params, cps, validator, absent, validatorPrices, finalPrices)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleKeeper_RecordParticipation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordParticipation'
+type OracleKeeper_RecordParticipation_Call struct {
+	*mock.Call
+}
+
+// RecordParticipation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params oracletypes.Params
+//   - cps map[uint64]types.CurrencyPair
+//   - validator cosmos_sdktypes.ConsAddress
+//   - absent bool
+//   - validatorPrices map[types.CurrencyPair]*big.Int
+//   - finalPrices map[types.CurrencyPair]*big.Int
+func (_e *OracleKeeper_Expecter) RecordParticipation(ctx interface{}, params interface{}, cps interface{}, validator interface{}, absent interface{}, validatorPrices interface{}, finalPrices interface{}) *OracleKeeper_RecordParticipation_Call {
+	return &OracleKeeper_RecordParticipation_Call{Call: _e.mock.On("RecordParticipation", ctx, params, cps, validator, absent, validatorPrices, finalPrices)}
+}
+
+func (_c *OracleKeeper_RecordParticipation_Call) Run(run func(ctx context.Context, params oracletypes.Params, cps map[uint64]types.CurrencyPair, validator cosmos_sdktypes.ConsAddress, absent bool, validatorPrices map[types.CurrencyPair]*big.Int, finalPrices map[types.CurrencyPair]*big.Int)) *OracleKeeper_RecordParticipation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(oracletypes.Params), args[2].(map[uint64]types.CurrencyPair), args[3].(cosmos_sdktypes.ConsAddress), args[4].(bool), args[5].(map[types.CurrencyPair]*big.Int), args[6].(map[types.CurrencyPair]*big.Int))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_RecordParticipation_Call) Return(_a0 error) *OracleKeeper_RecordParticipation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleKeeper_RecordParticipation_Call) RunAndReturn(run func(context.Context, oracletypes.Params, map[uint64]types.CurrencyPair, cosmos_sdktypes.ConsAddress, bool, map[types.CurrencyPair]*big.Int, map[types.CurrencyPair]*big.Int) error) *OracleKeeper_RecordParticipation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPriceForCurrencyPair provides a mock function with given fields: ctx, cp, qp
 func (_m *OracleKeeper) SetPriceForCurrencyPair(ctx context.Context, cp types.CurrencyPair, qp oracletypes.QuotePrice) error {
 	ret := _m.Called(ctx, cp, qp)
@@ -0,0 +1,120 @@
+package ve
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/abci/ve/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+// MarketMapKeeper defines the interface for reading markets from the x/marketmap module, which is used to
+// prioritize the prices included in a vote extension.
+type MarketMapKeeper interface {
+	GetMarket(ctx context.Context, tickerStr string) (mmtypes.Market, error)
+}
+
+// maxVoteExtensionBytes returns the maximum size of an encoded vote extension. A value of zero indicates that
+// there is no limit, which is always the case if the handler is not configured with WithVoteExtensionBudget.
+func (h *VoteExtensionHandler) maxVoteExtensionBytes(ctx sdk.Context) (int, error) {
+	if h.paramsKeeper == nil {
+		return 0, nil
+	}
+
+	params, err := h.paramsKeeper.GetParams(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get oracle params: %w", err)
+	}
+
+	return int(params.MaxVoteExtensionBytes), nil
+}
+
+// truncateVoteExtension returns the largest encoding of the given vote extension that fits within maxBytes, by
+// dropping the prices (and their metadata) of the lowest priority markets. Markets with the same priority are
+// ordered by currency pair ID, and those with the highest IDs are dropped first. cps are the currency pairs of the
+// IDs in the vote extension, as encoded by the currency pair strategy. An empty vote extension is returned if no
+// prices fit.
+func (h *VoteExtensionHandler) truncateVoteExtension(
+	ctx sdk.Context,
+	voteExt types.OracleVoteExtension,
+	cps map[uint64]connecttypes.CurrencyPair,
+	maxBytes int,
+) ([]byte, error) {
+	ids := make([]uint64, 0, len(voteExt.Prices))
+	priorities := make(map[uint64]uint32, len(voteExt.Prices))
+	for id := range voteExt.Prices {
+		ids = append(ids, id)
+		priorities[id] = h.marketPriority(ctx, cps[id])
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		if priorities[ids[i]] != priorities[ids[j]] {
+			return priorities[ids[i]] > priorities[ids[j]]
+		}
+
+		return ids[i] < ids[j]
+	})
+
+	// encode returns the encoded vote extension with the prices of the n highest priority markets.
+	encode := func(n int) ([]byte, error) {
+		truncated := types.OracleVoteExtension{
+			Prices:  make(map[uint64][]byte, n),
+			Version: voteExt.Version,
+		}
+		for _, id := range ids[:n] {
+			truncated.Prices[id] = voteExt.Prices[id]
+			if md, ok := voteExt.Metadata[id]; ok {
+				if truncated.Metadata == nil {
+					truncated.Metadata = make(map[uint64]*types.PriceMetadata)
+				}
+				truncated.Metadata[id] = md
+			}
+		}
+
+		return h.voteExtensionCodec.Encode(truncated)
+	}
+
+	// Binary search for the largest number of prices that fit, given that the full vote extension does not.
+	var (
+		bz    []byte
+		found bool
+	)
+	lo, hi := 0, len(ids)-1
+	for lo <= hi {
+		mid := (lo + hi) / 2
+
+		candidate, err := encode(mid)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(candidate) <= maxBytes {
+			bz, found = candidate, true
+			lo = mid + 1
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	if !found {
+		return []byte{}, nil
+	}
+
+	return bz, nil
+}
+
+// marketPriority returns the priority of the market with the given currency pair, as given by its ticker metadata
+// in the x/marketmap module. Markets that cannot be found have a priority of zero.
+func (h *VoteExtensionHandler) marketPriority(ctx sdk.Context, cp connecttypes.CurrencyPair) uint32 {
+	market, err := h.marketMapKeeper.GetMarket(ctx, cp.String())
+	if err != nil {
+		return 0
+	}
+
+	return tickermetadata.TickerPriority(market.Ticker.Metadata_JSON)
+}
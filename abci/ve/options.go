@@ -0,0 +1,19 @@
+package ve
+
+import (
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+)
+
+// Option is a function that enables optional configuration of the VoteExtensionHandler.
+type Option func(*VoteExtensionHandler)
+
+// WithVoteExtensionBudget returns an Option that configures the VoteExtensionHandler to enforce the
+// MaxVoteExtensionBytes x/oracle parameter. Vote extensions exceeding the limit are truncated in ExtendVote by
+// dropping the prices of the lowest priority markets, and rejected in VerifyVoteExtension. Market priorities are
+// read from the ticker metadata in the x/marketmap module.
+func WithVoteExtensionBudget(paramsKeeper currencypair.ParamsKeeper, marketMapKeeper MarketMapKeeper) Option {
+	return func(h *VoteExtensionHandler) {
+		h.paramsKeeper = paramsKeeper
+		h.marketMapKeeper = marketMapKeeper
+	}
+}
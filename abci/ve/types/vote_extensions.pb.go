@@ -28,6 +28,14 @@ type OracleVoteExtension struct {
 	// 0x123.. (bytes). Notice the `id` function is determined by the
 	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
 	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Version is the version of the codec / currency pair strategy used to
+	// create this vote extension. A zero value denotes an unversioned vote
+	// extension. The set of accepted versions is determined by the x/oracle
+	// module parameters.
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Metadata defines a map of id(CurrencyPair) -> PriceMetadata. Metadata is
+	// optional and may only be present for ids that also have a price.
+	Metadata map[uint64]*PriceMetadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *OracleVoteExtension) Reset()         { *m = OracleVoteExtension{} }
@@ -70,9 +78,176 @@ func (m *OracleVoteExtension) GetPrices() map[uint64][]byte {
 	return nil
 }
 
+func (m *OracleVoteExtension) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OracleVoteExtension) GetMetadata() map[uint64]*PriceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// PriceMetadata defines compact metadata about a price included in a vote
+// extension.
+type PriceMetadata struct {
+	// AgeMs is the age of the price in milliseconds, measured from the oldest
+	// provider price used to compute it to the block time at which the vote
+	// extension was created.
+	AgeMs uint32 `protobuf:"varint,1,opt,name=age_ms,json=ageMs,proto3" json:"age_ms,omitempty"`
+	// NumProviders is the number of providers whose prices were used to compute
+	// the price.
+	NumProviders uint32 `protobuf:"varint,2,opt,name=num_providers,json=numProviders,proto3" json:"num_providers,omitempty"`
+}
+
+func (m *PriceMetadata) Reset()         { *m = PriceMetadata{} }
+func (m *PriceMetadata) String() string { return proto.CompactTextString(m) }
+func (*PriceMetadata) ProtoMessage()    {}
+func (*PriceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_185ec0708d9f4b6a, []int{1}
+}
+func (m *PriceMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMetadata.Merge(m, src)
+}
+func (m *PriceMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceMetadata proto.InternalMessageInfo
+
+func (m *PriceMetadata) GetAgeMs() uint32 {
+	if m != nil {
+		return m.AgeMs
+	}
+	return 0
+}
+
+func (m *PriceMetadata) GetNumProviders() uint32 {
+	if m != nil {
+		return m.NumProviders
+	}
+	return 0
+}
+
+// DenseOracleVoteExtension is the dense encoding of an OracleVoteExtension
+// whose price IDs are dense indices, as produced by the
+// DenseCurrencyPairStrategy. Rather than keying each price by its ID, the IDs
+// of the included prices are given by a presence bitmap, and the prices are
+// packed in ascending order of ID.
+type DenseOracleVoteExtension struct {
+	// Bitmap is the presence bitmap of the prices. Bit i, the (i%8)-th least
+	// significant bit of byte i/8, is set if a price is included for ID i.
+	Bitmap []byte `protobuf:"bytes,1,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	// Prices are the prices of the IDs set in the bitmap, in ascending order of
+	// ID.
+	Prices [][]byte `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	// Version is the version of the codec / currency pair strategy used to
+	// create this vote extension.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// MetadataBitmap is the presence bitmap of the price metadata. Bit j is set
+	// if metadata is included for the j-th price in Prices.
+	MetadataBitmap []byte `protobuf:"bytes,4,opt,name=metadata_bitmap,json=metadataBitmap,proto3" json:"metadata_bitmap,omitempty"`
+	// Metadata are the metadata of the prices set in the MetadataBitmap, in the
+	// order of Prices.
+	Metadata []*PriceMetadata `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *DenseOracleVoteExtension) Reset()         { *m = DenseOracleVoteExtension{} }
+func (m *DenseOracleVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*DenseOracleVoteExtension) ProtoMessage()    {}
+func (*DenseOracleVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_185ec0708d9f4b6a, []int{2}
+}
+func (m *DenseOracleVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenseOracleVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenseOracleVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenseOracleVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenseOracleVoteExtension.Merge(m, src)
+}
+func (m *DenseOracleVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenseOracleVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenseOracleVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenseOracleVoteExtension proto.InternalMessageInfo
+
+func (m *DenseOracleVoteExtension) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+func (m *DenseOracleVoteExtension) GetPrices() [][]byte {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *DenseOracleVoteExtension) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DenseOracleVoteExtension) GetMetadataBitmap() []byte {
+	if m != nil {
+		return m.MetadataBitmap
+	}
+	return nil
+}
+
+func (m *DenseOracleVoteExtension) GetMetadata() []*PriceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OracleVoteExtension)(nil), "connect.abci.v2.OracleVoteExtension")
+	proto.RegisterMapType((map[uint64]*PriceMetadata)(nil), "connect.abci.v2.OracleVoteExtension.MetadataEntry")
 	proto.RegisterMapType((map[uint64][]byte)(nil), "connect.abci.v2.OracleVoteExtension.PricesEntry")
+	proto.RegisterType((*PriceMetadata)(nil), "connect.abci.v2.PriceMetadata")
+	proto.RegisterType((*DenseOracleVoteExtension)(nil), "connect.abci.v2.DenseOracleVoteExtension")
 }
 
 func init() {
@@ -80,22 +255,33 @@ func init() {
 }
 
 var fileDescriptor_185ec0708d9f4b6a = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0x4f, 0x4c, 0x4a, 0xce, 0xd4, 0x2f, 0x33, 0xd2, 0x2f, 0xcb, 0x2f, 0x49,
-	0x8d, 0x4f, 0xad, 0x28, 0x49, 0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x87, 0x2a, 0xd3, 0x03, 0x29, 0xd3, 0x2b, 0x33, 0x52, 0x9a, 0xc5, 0xc8, 0x25,
-	0xec, 0x5f, 0x94, 0x98, 0x9c, 0x93, 0x1a, 0x96, 0x5f, 0x92, 0xea, 0x0a, 0x53, 0x2f, 0xe4, 0xc1,
-	0xc5, 0x56, 0x50, 0x94, 0x99, 0x9c, 0x5a, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x64, 0xa0,
-	0x87, 0xa6, 0x53, 0x0f, 0x8b, 0x2e, 0xbd, 0x00, 0xb0, 0x16, 0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20,
-	0xa8, 0x7e, 0x29, 0x4b, 0x2e, 0x6e, 0x24, 0x61, 0x21, 0x01, 0x2e, 0xe6, 0xec, 0xd4, 0x4a, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x10, 0x53, 0x48, 0x84, 0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x34,
-	0x55, 0x82, 0x49, 0x81, 0x51, 0x83, 0x27, 0x08, 0xc2, 0xb1, 0x62, 0xb2, 0x60, 0x74, 0x72, 0x3b,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x9d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2, 0xec, 0xcc, 0x02, 0xdd, 0xdc, 0xd4, 0x32, 0x7d, 0x58,
-	0x10, 0x94, 0x19, 0x41, 0x43, 0x21, 0x55, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec,
-	0x79, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x31, 0x8c, 0x89, 0x25, 0x01, 0x00, 0x00,
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0xeb, 0x64, 0x1b, 0x90, 0xdb, 0xb0, 0xc8, 0xfc, 0x51, 0xb4, 0x87, 0x28, 0x2a, 0x42,
+	0xf4, 0x00, 0x09, 0x0a, 0x1c, 0x60, 0x8f, 0x2b, 0x16, 0x21, 0xa1, 0x85, 0x55, 0x0e, 0x1c, 0xe0,
+	0x10, 0x39, 0xd9, 0x51, 0x89, 0x76, 0x63, 0x47, 0xb1, 0x63, 0xd1, 0xb7, 0xe0, 0xb1, 0xe0, 0xd6,
+	0x23, 0x47, 0x68, 0x5f, 0x04, 0xc5, 0x75, 0xaa, 0x74, 0xdb, 0x43, 0x6f, 0x9e, 0xf1, 0x7c, 0xbf,
+	0x19, 0xcd, 0x37, 0xf8, 0x69, 0xce, 0x19, 0x83, 0x5c, 0x46, 0x34, 0xcb, 0x8b, 0x48, 0xc5, 0x91,
+	0xe2, 0x12, 0x52, 0xf8, 0x21, 0x81, 0x89, 0x82, 0x33, 0x11, 0x56, 0x35, 0x97, 0x9c, 0x1c, 0x9b,
+	0xb2, 0xb0, 0x2d, 0x0b, 0x55, 0x3c, 0xf9, 0x67, 0xe1, 0x07, 0x9f, 0x6b, 0x9a, 0xdf, 0xc0, 0x17,
+	0x2e, 0xe1, 0xbc, 0xab, 0x27, 0x1f, 0xb0, 0x53, 0xd5, 0x45, 0x0e, 0xc2, 0x43, 0x81, 0x3d, 0x1d,
+	0xc5, 0x2f, 0xc3, 0x5b, 0xca, 0x70, 0x8f, 0x2a, 0xbc, 0xd4, 0x92, 0x73, 0x26, 0xeb, 0x79, 0x62,
+	0xf4, 0xc4, 0xc3, 0x77, 0x14, 0xd4, 0xed, 0xb7, 0x67, 0x05, 0x68, 0xea, 0x26, 0x5d, 0x48, 0x3e,
+	0xe1, 0xbb, 0x25, 0x48, 0x7a, 0x45, 0x25, 0xf5, 0x6c, 0xdd, 0x25, 0x3e, 0xa8, 0xcb, 0x85, 0x11,
+	0xad, 0xfb, 0x6c, 0x18, 0x27, 0x6f, 0xf1, 0xa8, 0x37, 0x00, 0xb9, 0x8f, 0xed, 0x6b, 0x98, 0x7b,
+	0x28, 0x40, 0xd3, 0xa3, 0xa4, 0x7d, 0x92, 0x87, 0x78, 0xa8, 0xe8, 0x4d, 0x03, 0x7a, 0x90, 0x71,
+	0xb2, 0x0e, 0x4e, 0xad, 0x37, 0xe8, 0xe4, 0x1b, 0x76, 0xb7, 0xa8, 0x7b, 0xc4, 0xaf, 0xfb, 0xe2,
+	0x51, 0xec, 0xef, 0x8c, 0xaa, 0x7b, 0x77, 0x94, 0x1e, 0x7c, 0xf2, 0x11, 0xbb, 0x5b, 0x7f, 0xe4,
+	0x11, 0x76, 0xe8, 0x0c, 0xd2, 0x52, 0x68, 0xbe, 0x9b, 0x0c, 0xe9, 0x0c, 0x2e, 0x04, 0x79, 0x82,
+	0x5d, 0xd6, 0x94, 0x69, 0x55, 0x73, 0x55, 0x5c, 0x41, 0x2d, 0xcc, 0xbe, 0xc6, 0xac, 0x29, 0x2f,
+	0xbb, 0xdc, 0xe4, 0x37, 0xc2, 0xde, 0x3b, 0x60, 0x02, 0xf6, 0xb9, 0xf6, 0x18, 0x3b, 0x59, 0x21,
+	0x4b, 0x5a, 0x69, 0xf0, 0x38, 0x31, 0x51, 0x9b, 0x37, 0x6e, 0x5a, 0x81, 0xdd, 0xe6, 0x77, 0xbd,
+	0xb1, 0xb7, 0xbd, 0x79, 0x86, 0x8f, 0xbb, 0xbd, 0xa6, 0x06, 0x79, 0xa4, 0x91, 0xf7, 0xba, 0xf4,
+	0xd9, 0x1a, 0x7d, 0xda, 0x33, 0x71, 0x18, 0xd8, 0x07, 0x6c, 0x66, 0x53, 0x7f, 0xf6, 0xfe, 0xd7,
+	0xd2, 0x47, 0x8b, 0xa5, 0x8f, 0xfe, 0x2e, 0x7d, 0xf4, 0x73, 0xe5, 0x0f, 0x16, 0x2b, 0x7f, 0xf0,
+	0x67, 0xe5, 0x0f, 0xbe, 0x3e, 0x9f, 0x15, 0xf2, 0x7b, 0x93, 0x85, 0x39, 0x2f, 0x23, 0x71, 0x5d,
+	0x54, 0x2f, 0x4a, 0x50, 0x51, 0x77, 0xe2, 0x2a, 0x36, 0x57, 0x0e, 0x91, 0x9c, 0x57, 0x20, 0x32,
+	0x47, 0x1f, 0xf7, 0xab, 0xff, 0x03, 0x00, 0xc1, 0xec, 0xf0, 0x73, 0x05, 0x03, 0x00, 0x00,
 }
 
 func (m *OracleVoteExtension) Marshal() (dAtA []byte, err error) {
@@ -118,6 +304,35 @@ func (m *OracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintVoteExtensions(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintVoteExtensions(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintVoteExtensions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Prices) > 0 {
 		for k := range m.Prices {
 			v := m.Prices[k]
@@ -140,6 +355,104 @@ func (m *OracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumProviders != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.NumProviders))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AgeMs != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.AgeMs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenseOracleVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenseOracleVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenseOracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtensions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MetadataBitmap) > 0 {
+		i -= len(m.MetadataBitmap)
+		copy(dAtA[i:], m.MetadataBitmap)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.MetadataBitmap)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Version != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prices[iNdEx])
+			copy(dAtA[i:], m.Prices[iNdEx])
+			i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.Prices[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVoteExtensions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVoteExtensions(v)
 	base := offset
@@ -169,6 +482,69 @@ func (m *OracleVoteExtension) Size() (n int) {
 			n += mapEntrySize + 1 + sovVoteExtensions(uint64(mapEntrySize))
 		}
 	}
+	if m.Version != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.Version))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovVoteExtensions(uint64(l))
+			}
+			mapEntrySize := 1 + sovVoteExtensions(uint64(k)) + l
+			n += mapEntrySize + 1 + sovVoteExtensions(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PriceMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgeMs != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.AgeMs))
+	}
+	if m.NumProviders != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.NumProviders))
+	}
+	return n
+}
+
+func (m *DenseOracleVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
+	if len(m.Prices) > 0 {
+		for _, b := range m.Prices {
+			l = len(b)
+			n += 1 + l + sovVoteExtensions(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.Version))
+	}
+	l = len(m.MetadataBitmap)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovVoteExtensions(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -321,6 +697,431 @@ func (m *OracleVoteExtension) Unmarshal(dAtA []byte) error {
 			}
 			m.Prices[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[uint64]*PriceMetadata)
+			}
+			var mapkey uint64
+			var mapvalue *PriceMetadata
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVoteExtensions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVoteExtensions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVoteExtensions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PriceMetadata{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeMs", wireType)
+			}
+			m.AgeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProviders", wireType)
+			}
+			m.NumProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumProviders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenseOracleVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenseOracleVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenseOracleVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, make([]byte, postIndex-iNdEx))
+			copy(m.Prices[len(m.Prices)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataBitmap = append(m.MetadataBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetadataBitmap == nil {
+				m.MetadataBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, &PriceMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
@@ -20,12 +20,19 @@ import (
 	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 )
 
-// ValidateOracleVoteExtension validates the vote extension provided by a validator.
+// ValidateOracleVoteExtension validates the vote extension provided by a validator, created at the given height.
+// The vote extension's version must be accepted at that height, as determined by the given strategy.
 func ValidateOracleVoteExtension(
 	ctx sdk.Context,
 	ve vetypes.OracleVoteExtension,
+	height int64,
 	strategy currencypair.CurrencyPairStrategy,
 ) error {
+	strategy, err := currencypair.StrategyForVersion(ctx, strategy, ve.Version, height)
+	if err != nil {
+		return fmt.Errorf("invalid vote extension version: %w", err)
+	}
+
 	maxNumCP, err := strategy.GetMaxNumCP(ctx)
 	if err != nil {
 		return fmt.Errorf("unable to get max price bytes size: %w", err)
@@ -43,6 +50,17 @@ func ValidateOracleVoteExtension(
 		}
 	}
 
+	// Verify metadata is only included for prices that are present.
+	for id, md := range ve.Metadata {
+		if md == nil {
+			return fmt.Errorf("metadata for currency pair id %d is nil", id)
+		}
+
+		if _, ok := ve.Prices[id]; !ok {
+			return fmt.Errorf("metadata included for currency pair id %d without a price", id)
+		}
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"time"
 
@@ -47,6 +48,12 @@ type VoteExtensionHandler struct {
 
 	// metrics is the service metrics interface that the vote-extension handler will use to report metrics.
 	metrics servicemetrics.Metrics
+
+	// paramsKeeper is used to read the maximum vote extension size. If nil, the size is not limited.
+	paramsKeeper currencypair.ParamsKeeper
+
+	// marketMapKeeper is used to read the market priorities used to truncate vote extensions.
+	marketMapKeeper MarketMapKeeper
 }
 
 // NewVoteExtensionHandler returns a new VoteExtensionHandler.
@@ -58,8 +65,9 @@ func NewVoteExtensionHandler(
 	codec compression.VoteExtensionCodec,
 	priceApplier aggregator.PriceApplier,
 	metrics servicemetrics.Metrics,
+	opts ...Option,
 ) *VoteExtensionHandler {
-	return &VoteExtensionHandler{
+	handler := &VoteExtensionHandler{
 		logger:               logger,
 		oracleClient:         oracleClient,
 		timeout:              timeout,
@@ -68,6 +76,13 @@ func NewVoteExtensionHandler(
 		metrics:              metrics,
 		priceApplier:         priceApplier,
 	}
+
+	// apply options
+	for _, opt := range opts {
+		opt(handler)
+	}
+
+	return handler
 }
 
 // ExtendVoteHandler returns a handler that extends a vote with the oracle's
@@ -167,7 +182,7 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 		}
 
 		// Transform the response prices into a vote extension.
-		voteExt, err := h.transformOracleServicePrices(ctx, oracleResp.Prices)
+		voteExt, cps, err := h.transformOracleServicePrices(ctx, oracleResp.Prices, oracleResp.Metadata)
 		if err != nil {
 			h.logger.Error(
 				"failed to transform oracle prices for vote extension; returning empty vote extension",
@@ -197,6 +212,44 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
 		}
 
+		// Drop the prices of the lowest priority markets if the vote extension exceeds the maximum size.
+		maxBytes, err := h.maxVoteExtensionBytes(ctx)
+		if err != nil {
+			h.logger.Error(
+				"failed to get maximum vote extension size; returning empty vote extension",
+				"height", req.Height,
+				"err", err,
+			)
+
+			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
+		}
+
+		if maxBytes > 0 && len(bz) > maxBytes {
+			size := len(bz)
+			bz, err = h.truncateVoteExtension(ctx, voteExt, cps, maxBytes)
+			if err != nil {
+				h.logger.Error(
+					"failed to truncate vote extension; returning empty vote extension",
+					"height", req.Height,
+					"err", err,
+				)
+
+				err = TransformPricesError{
+					Err: err,
+				}
+
+				return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
+			}
+
+			h.logger.Info(
+				"truncated vote extension exceeding maximum size",
+				"height", req.Height,
+				"size (bytes)", size,
+				"truncated size (bytes)", len(bz),
+				"max size (bytes)", maxBytes,
+			)
+		}
+
 		h.logger.Debug(
 			"extending vote with oracle prices",
 			"req_height", req.Height,
@@ -243,6 +296,32 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_ACCEPT}, nil
 		}
 
+		// Reject vote extensions exceeding the maximum size.
+		maxBytes, err := h.maxVoteExtensionBytes(ctx)
+		if err != nil {
+			h.logger.Error(
+				"failed to get maximum vote extension size",
+				"height", req.Height,
+				"err", err,
+			)
+
+			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
+		}
+
+		if maxBytes > 0 && len(req.VoteExtension) > maxBytes {
+			h.logger.Error(
+				"vote extension exceeds maximum size",
+				"height", req.Height,
+				"size (bytes)", len(req.VoteExtension),
+				"max size (bytes)", maxBytes,
+			)
+			err = ValidateVoteExtensionError{
+				Err: fmt.Errorf("vote extension of %d bytes exceeds maximum size of %d bytes", len(req.VoteExtension), maxBytes),
+			}
+
+			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
+		}
+
 		// decode the vote-extension bytes
 		voteExtension, err := h.voteExtensionCodec.Decode(req.VoteExtension)
 		if err != nil {
@@ -258,7 +337,7 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
 		}
 
-		if err := ValidateOracleVoteExtension(ctx, voteExtension, h.currencyPairStrategy); err != nil {
+		if err := ValidateOracleVoteExtension(ctx, voteExtension, req.Height, h.currencyPairStrategy); err != nil {
 			h.logger.Error(
 				"failed to validate vote extension",
 				"height", req.Height,
@@ -286,24 +365,38 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 
 // transformOracleServicePrices transforms the oracle service prices into a vote extension. It
 // does this by iterating over the prices submitted by the oracle service and determining the
-// correct decoded price / ID based on the currency pair strategy.
-func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, prices map[string]string) (types.OracleVoteExtension, error) {
+// correct decoded price / ID based on the currency pair strategy. Any metadata reported by the
+// oracle service for a price is attached to the vote extension in its compact form. The currency
+// pair of each ID in the vote extension is returned alongside it.
+func (h *VoteExtensionHandler) transformOracleServicePrices(
+	ctx sdk.Context,
+	prices map[string]string,
+	metadata map[string]servicetypes.PriceMetadata,
+) (types.OracleVoteExtension, map[uint64]connecttypes.CurrencyPair, error) {
+	// Determine the vote extension version (and the strategy for that version) to use at the current height.
+	version, strategy, err := currencypair.CurrentVersion(ctx, h.currencyPairStrategy)
+	if err != nil {
+		return types.OracleVoteExtension{}, nil, err
+	}
+
 	strategyPrices := make(map[uint64][]byte)
+	strategyMetadata := make(map[uint64]*types.PriceMetadata)
+	cps := make(map[uint64]connecttypes.CurrencyPair)
 
 	// Iterate over the prices and transform them into the correct format.
 	for currencyPairID, priceString := range prices {
 		cp, err := connecttypes.CurrencyPairFromString(currencyPairID)
 		if err != nil {
-			return types.OracleVoteExtension{}, err
+			return types.OracleVoteExtension{}, nil, err
 		}
 
 		rawPrice, converted := new(big.Int).SetString(priceString, 10)
 		if !converted {
-			return types.OracleVoteExtension{}, fmt.Errorf("failed to convert price string to big.Int: %s", priceString)
+			return types.OracleVoteExtension{}, nil, fmt.Errorf("failed to convert price string to big.Int: %s", priceString)
 		}
 
 		// Determine if the currency pair is supported by the network.
-		cpID, err := h.currencyPairStrategy.ID(ctx, cp)
+		cpID, err := strategy.ID(ctx, cp)
 		if err != nil {
 			h.logger.Debug(
 				"failed to get currency pair ID",
@@ -315,7 +408,7 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 		}
 
 		// Determine the encoded price for the currency pair based on the strategy.
-		encodedPrice, err := h.currencyPairStrategy.GetEncodedPrice(ctx, cp, rawPrice)
+		encodedPrice, err := strategy.GetEncodedPrice(ctx, cp, rawPrice)
 		if err != nil {
 			h.logger.Debug(
 				"failed to get current price for currency pair",
@@ -333,11 +426,46 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 		)
 
 		strategyPrices[cpID] = encodedPrice
+		cps[cpID] = cp
+
+		// Attach the compact metadata for the price if the oracle reported any.
+		if md, ok := metadata[currencyPairID]; ok {
+			strategyMetadata[cpID] = &types.PriceMetadata{
+				AgeMs:        priceAgeMs(ctx.BlockTime(), md.Timestamp),
+				NumProviders: md.NumProviders,
+			}
+		}
+	}
+
+	h.logger.Debug(
+		"transformed oracle prices",
+		"prices", len(strategyPrices),
+		"metadata", len(strategyMetadata),
+		"version", version,
+	)
+
+	voteExt := types.OracleVoteExtension{
+		Prices:  strategyPrices,
+		Version: version,
+	}
+	if len(strategyMetadata) > 0 {
+		voteExt.Metadata = strategyMetadata
 	}
 
-	h.logger.Debug("transformed oracle prices", "prices", len(strategyPrices))
+	return voteExt, cps, nil
+}
+
+// priceAgeMs returns the age of a price with the given timestamp relative to the block time, in
+// milliseconds. The age is clamped to the range of a uint32.
+func priceAgeMs(blockTime, timestamp time.Time) uint32 {
+	if timestamp.IsZero() || !blockTime.After(timestamp) {
+		return 0
+	}
+
+	age := blockTime.Sub(timestamp).Milliseconds()
+	if age > math.MaxUint32 {
+		return math.MaxUint32
+	}
 
-	return types.OracleVoteExtension{
-		Prices: strategyPrices,
-	}, nil
+	return uint32(age)
 }
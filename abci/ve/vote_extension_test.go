@@ -26,6 +26,9 @@ import (
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	metricsmocks "github.com/skip-mev/connect/v2/service/metrics/mocks"
 	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	oraclemocks "github.com/skip-mev/connect/v2/x/oracle/types/mocks"
 )
 
 var (
@@ -157,6 +160,53 @@ func (s *VoteExtensionTestSuite) TestExtendVoteExtension() {
 				},
 			},
 		},
+		{
+			name: "oracle service returns prices with metadata",
+			oracleService: func() client.OracleClient {
+				mockServer := mocks.NewOracleClient(s.T())
+
+				mockServer.On("Prices", mock.Anything, mock.Anything).Return(
+					&servicetypes.QueryPricesResponse{
+						Prices: multiplePrices,
+						Metadata: map[string]servicetypes.PriceMetadata{
+							btcUSD.String(): {
+								Timestamp:    s.ctx.BlockTime().Add(-1500 * time.Millisecond),
+								NumProviders: 3,
+							},
+							// timestamps ahead of the block time are reported with a zero age
+							ethUSD.String(): {
+								Timestamp:    s.ctx.BlockTime().Add(time.Second),
+								NumProviders: 1,
+							},
+						},
+					},
+					nil,
+				)
+
+				return mockServer
+			},
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+
+				cps.On("ID", mock.Anything, btcUSD).Return(uint64(0), nil)
+				cps.On("GetEncodedPrice", mock.Anything, btcUSD, oneHundred).Return(oneHundred.Bytes(), nil)
+
+				cps.On("ID", mock.Anything, ethUSD).Return(uint64(1), nil)
+				cps.On("GetEncodedPrice", mock.Anything, ethUSD, twoHundred).Return(twoHundred.Bytes(), nil)
+
+				return cps
+			},
+			expectedResponse: &abcitypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{
+					0: oneHundred.Bytes(),
+					1: twoHundred.Bytes(),
+				},
+				Metadata: map[uint64]*abcitypes.PriceMetadata{
+					0: {AgeMs: 1500, NumProviders: 3},
+					1: {AgeMs: 0, NumProviders: 1},
+				},
+			},
+		},
 		{
 			name: "oracle service panics",
 			oracleService: func() client.OracleClient {
@@ -315,6 +365,7 @@ func (s *VoteExtensionTestSuite) TestExtendVoteExtension() {
 				ext, err := cdc.Decode(resp.VoteExtension)
 				s.Require().NoError(err)
 				s.Require().Equal(tc.expectedResponse.Prices, ext.Prices)
+				s.Require().Equal(tc.expectedResponse.Metadata, ext.Metadata)
 			} else {
 				s.Require().Error(err)
 			}
@@ -474,6 +525,34 @@ func (s *VoteExtensionTestSuite) TestVerifyVoteExtension() {
 			},
 			expectedError: false,
 		},
+		{
+			name: "vote extension with metadata for a missing price",
+			getReq: func() *cometabci.RequestVerifyVoteExtension {
+				ext, err := cdc.Encode(abcitypes.OracleVoteExtension{
+					Prices: map[uint64][]byte{
+						0: oneHundred.Bytes(),
+					},
+					Metadata: map[uint64]*abcitypes.PriceMetadata{
+						1: {AgeMs: 100, NumProviders: 1},
+					},
+				})
+				s.Require().NoError(err)
+
+				return &cometabci.RequestVerifyVoteExtension{
+					VoteExtension: ext,
+					Height:        1,
+				}
+			},
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_REJECT,
+			},
+			expectedError: true,
+		},
 		{
 			name: "vote extension with malformed prices",
 			getReq: func() *cometabci.RequestVerifyVoteExtension {
@@ -920,3 +999,127 @@ func (s *VoteExtensionTestSuite) TestVoteExtensionSize() {
 	})
 	s.Require().NoError(err)
 }
+
+func (s *VoteExtensionTestSuite) TestVoteExtensionBudget() {
+	solUSD := connecttypes.NewCurrencyPair("SOL", "USD")
+	threeHundred := big.NewInt(300)
+
+	cdc := codec.NewDefaultVoteExtensionCodec()
+
+	newHandler := func(maxBytes uint32) *ve.VoteExtensionHandler {
+		mockClient := mocks.NewOracleClient(s.T())
+		mockClient.On("Prices", mock.Anything, mock.Anything).Return(
+			&servicetypes.QueryPricesResponse{
+				Prices: map[string]string{
+					btcUSD.String(): oneHundred.String(),
+					ethUSD.String(): twoHundred.String(),
+					solUSD.String(): threeHundred.String(),
+				},
+			},
+			nil,
+		).Maybe()
+
+		cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+		for id, cp := range []connecttypes.CurrencyPair{btcUSD, ethUSD, solUSD} {
+			cps.On("ID", mock.Anything, cp).Return(uint64(id), nil).Maybe()
+		}
+		cps.On("GetEncodedPrice", mock.Anything, mock.Anything, mock.Anything).Return(
+			func(_ sdk.Context, _ connecttypes.CurrencyPair, price *big.Int) ([]byte, error) {
+				return price.Bytes(), nil
+			},
+		).Maybe()
+		cps.On("GetMaxNumCP", mock.Anything).Return(uint64(3), nil).Maybe()
+
+		paramsKeeper := mockstrategies.NewParamsKeeper(s.T())
+		paramsKeeper.On("GetParams", mock.Anything).Return(oracletypes.Params{MaxVoteExtensionBytes: maxBytes}, nil)
+
+		// ETH/USD has the highest priority, BTC/USD has a priority, and SOL/USD has no market
+		mmKeeper := oraclemocks.NewMarketMapKeeper(s.T())
+		mmKeeper.On("GetMarket", mock.Anything, ethUSD.String()).Return(
+			mmtypes.Market{Ticker: mmtypes.Ticker{CurrencyPair: ethUSD, Metadata_JSON: `{"priority":5}`}}, nil,
+		).Maybe()
+		mmKeeper.On("GetMarket", mock.Anything, btcUSD.String()).Return(
+			mmtypes.Market{Ticker: mmtypes.Ticker{CurrencyPair: btcUSD, Metadata_JSON: `{"priority":1}`}}, nil,
+		).Maybe()
+		mmKeeper.On("GetMarket", mock.Anything, solUSD.String()).Return(
+			mmtypes.Market{}, fmt.Errorf("market not found"),
+		).Maybe()
+
+		mockPriceApplier := aggregatormocks.NewPriceApplier(s.T())
+		mockPriceApplier.On("ApplyPricesFromVoteExtensions", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+		return ve.NewVoteExtensionHandler(
+			log.NewTestLogger(s.T()),
+			mockClient,
+			time.Second*1,
+			cps,
+			cdc,
+			mockPriceApplier,
+			servicemetrics.NewNopMetrics(),
+			ve.WithVoteExtensionBudget(paramsKeeper, mmKeeper),
+		)
+	}
+
+	allPrices := map[uint64][]byte{
+		0: oneHundred.Bytes(),
+		1: twoHundred.Bytes(),
+		2: threeHundred.Bytes(),
+	}
+	fullBz, err := cdc.Encode(abcitypes.OracleVoteExtension{Prices: allPrices})
+	s.Require().NoError(err)
+
+	truncatedPrices := map[uint64][]byte{
+		0: oneHundred.Bytes(),
+		1: twoHundred.Bytes(),
+	}
+	truncatedBz, err := cdc.Encode(abcitypes.OracleVoteExtension{Prices: truncatedPrices})
+	s.Require().NoError(err)
+
+	s.Run("vote extensions within the budget are not truncated", func() {
+		resp, err := newHandler(uint32(len(fullBz))).ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{})
+		s.Require().NoError(err)
+
+		ext, err := cdc.Decode(resp.VoteExtension)
+		s.Require().NoError(err)
+		s.Require().Equal(allPrices, ext.Prices)
+	})
+
+	s.Run("lowest priority markets are dropped to fit the budget", func() {
+		resp, err := newHandler(uint32(len(truncatedBz))).ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(resp.VoteExtension), len(truncatedBz))
+
+		ext, err := cdc.Decode(resp.VoteExtension)
+		s.Require().NoError(err)
+		s.Require().Equal(truncatedPrices, ext.Prices)
+	})
+
+	s.Run("only the highest priority market fits", func() {
+		resp, err := newHandler(uint32(len(truncatedBz)-1)).ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{})
+		s.Require().NoError(err)
+
+		ext, err := cdc.Decode(resp.VoteExtension)
+		s.Require().NoError(err)
+		s.Require().Equal(map[uint64][]byte{1: twoHundred.Bytes()}, ext.Prices)
+	})
+
+	s.Run("an empty vote extension is returned if no prices fit", func() {
+		resp, err := newHandler(1).ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.VoteExtension)
+	})
+
+	s.Run("vote extensions exceeding the budget are rejected", func() {
+		resp, err := newHandler(uint32(len(truncatedBz))).VerifyVoteExtensionHandler()(s.ctx, &cometabci.RequestVerifyVoteExtension{
+			VoteExtension: fullBz,
+		})
+		s.Require().ErrorAs(err, &ve.ValidateVoteExtensionError{})
+		s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, resp.Status)
+
+		resp, err = newHandler(uint32(len(fullBz))).VerifyVoteExtensionHandler()(s.ctx, &cometabci.RequestVerifyVoteExtension{
+			VoteExtension: fullBz,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, resp.Status)
+	})
+}
@@ -95,15 +95,109 @@ func (x *_OracleVoteExtension_1_map) IsValid() bool {
 	return x.m != nil
 }
 
+var _ protoreflect.Map = (*_OracleVoteExtension_3_map)(nil)
+
+type _OracleVoteExtension_3_map struct {
+	m *map[uint64]*PriceMetadata
+}
+
+func (x *_OracleVoteExtension_3_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_OracleVoteExtension_3_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_OracleVoteExtension_3_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Uint()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_OracleVoteExtension_3_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_OracleVoteExtension_3_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleVoteExtension_3_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceMetadata)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_OracleVoteExtension_3_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(PriceMetadata)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_OracleVoteExtension_3_map) NewValue() protoreflect.Value {
+	v := new(PriceMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleVoteExtension_3_map) IsValid() bool {
+	return x.m != nil
+}
+
 var (
-	md_OracleVoteExtension        protoreflect.MessageDescriptor
-	fd_OracleVoteExtension_prices protoreflect.FieldDescriptor
+	md_OracleVoteExtension          protoreflect.MessageDescriptor
+	fd_OracleVoteExtension_prices   protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_version  protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_metadata protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_abci_v2_vote_extensions_proto_init()
 	md_OracleVoteExtension = File_connect_abci_v2_vote_extensions_proto.Messages().ByName("OracleVoteExtension")
 	fd_OracleVoteExtension_prices = md_OracleVoteExtension.Fields().ByName("prices")
+	fd_OracleVoteExtension_version = md_OracleVoteExtension.Fields().ByName("version")
+	fd_OracleVoteExtension_metadata = md_OracleVoteExtension.Fields().ByName("metadata")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleVoteExtension)(nil)
@@ -177,6 +271,18 @@ func (x *fastReflection_OracleVoteExtension) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Version != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Version)
+		if !f(fd_OracleVoteExtension_version, value) {
+			return
+		}
+	}
+	if len(x.Metadata) != 0 {
+		value := protoreflect.ValueOfMap(&_OracleVoteExtension_3_map{m: &x.Metadata})
+		if !f(fd_OracleVoteExtension_metadata, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -194,6 +300,10 @@ func (x *fastReflection_OracleVoteExtension) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		return len(x.Prices) != 0
+	case "connect.abci.v2.OracleVoteExtension.version":
+		return x.Version != uint32(0)
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		return len(x.Metadata) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -212,6 +322,10 @@ func (x *fastReflection_OracleVoteExtension) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		x.Prices = nil
+	case "connect.abci.v2.OracleVoteExtension.version":
+		x.Version = uint32(0)
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		x.Metadata = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -234,6 +348,15 @@ func (x *fastReflection_OracleVoteExtension) Get(descriptor protoreflect.FieldDe
 		}
 		mapValue := &_OracleVoteExtension_1_map{m: &x.Prices}
 		return protoreflect.ValueOfMap(mapValue)
+	case "connect.abci.v2.OracleVoteExtension.version":
+		value := x.Version
+		return protoreflect.ValueOfUint32(value)
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		if len(x.Metadata) == 0 {
+			return protoreflect.ValueOfMap(&_OracleVoteExtension_3_map{})
+		}
+		mapValue := &_OracleVoteExtension_3_map{m: &x.Metadata}
+		return protoreflect.ValueOfMap(mapValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -258,6 +381,12 @@ func (x *fastReflection_OracleVoteExtension) Set(fd protoreflect.FieldDescriptor
 		mv := value.Map()
 		cmv := mv.(*_OracleVoteExtension_1_map)
 		x.Prices = *cmv.m
+	case "connect.abci.v2.OracleVoteExtension.version":
+		x.Version = uint32(value.Uint())
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		mv := value.Map()
+		cmv := mv.(*_OracleVoteExtension_3_map)
+		x.Metadata = *cmv.m
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -284,6 +413,14 @@ func (x *fastReflection_OracleVoteExtension) Mutable(fd protoreflect.FieldDescri
 		}
 		value := &_OracleVoteExtension_1_map{m: &x.Prices}
 		return protoreflect.ValueOfMap(value)
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		if x.Metadata == nil {
+			x.Metadata = make(map[uint64]*PriceMetadata)
+		}
+		value := &_OracleVoteExtension_3_map{m: &x.Metadata}
+		return protoreflect.ValueOfMap(value)
+	case "connect.abci.v2.OracleVoteExtension.version":
+		panic(fmt.Errorf("field version of message connect.abci.v2.OracleVoteExtension is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -300,6 +437,11 @@ func (x *fastReflection_OracleVoteExtension) NewField(fd protoreflect.FieldDescr
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		m := make(map[uint64][]byte)
 		return protoreflect.ValueOfMap(&_OracleVoteExtension_1_map{m: &m})
+	case "connect.abci.v2.OracleVoteExtension.version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		m := make(map[uint64]*PriceMetadata)
+		return protoreflect.ValueOfMap(&_OracleVoteExtension_3_map{m: &m})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -393,6 +535,37 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 				}
 			}
 		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.Metadata) > 0 {
+			SiZeMaP := func(k uint64, v *PriceMetadata) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint64, 0, len(x.Metadata))
+				for k := range x.Metadata {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.Metadata[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Metadata {
+					SiZeMaP(k, v)
+				}
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -422,6 +595,59 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Metadata) > 0 {
+			MaRsHaLmAp := func(k uint64, v *PriceMetadata) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForMetadata := make([]uint64, 0, len(x.Metadata))
+				for k := range x.Metadata {
+					keysForMetadata = append(keysForMetadata, uint64(k))
+				}
+				sort.Slice(keysForMetadata, func(i, j int) bool {
+					return keysForMetadata[i] < keysForMetadata[j]
+				})
+				for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Metadata[uint64(keysForMetadata[iNdEx])]
+					out, err := MaRsHaLmAp(keysForMetadata[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Metadata {
+					v := x.Metadata[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.Prices) > 0 {
 			MaRsHaLmAp := func(k uint64, v []byte) (protoiface.MarshalOutput, error) {
 				baseI := i
@@ -626,6 +852,140 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 				}
 				x.Prices[mapkey] = mapvalue
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = make(map[uint64]*PriceMetadata)
+				}
+				var mapkey uint64
+				var mapvalue *PriceMetadata
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &PriceMetadata{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.Metadata[mapkey] = mapvalue
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -661,29 +1021,1287 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: connect/abci/v2/vote_extensions.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_PriceMetadata               protoreflect.MessageDescriptor
+	fd_PriceMetadata_age_ms        protoreflect.FieldDescriptor
+	fd_PriceMetadata_num_providers protoreflect.FieldDescriptor
 )
 
-// OracleVoteExtension defines the vote extension structure for oracle prices.
-type OracleVoteExtension struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func init() {
+	file_connect_abci_v2_vote_extensions_proto_init()
+	md_PriceMetadata = File_connect_abci_v2_vote_extensions_proto.Messages().ByName("PriceMetadata")
+	fd_PriceMetadata_age_ms = md_PriceMetadata.Fields().ByName("age_ms")
+	fd_PriceMetadata_num_providers = md_PriceMetadata.Fields().ByName("num_providers")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceMetadata)(nil)
+
+type fastReflection_PriceMetadata PriceMetadata
+
+func (x *PriceMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceMetadata)(x)
+}
+
+func (x *PriceMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_abci_v2_vote_extensions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceMetadata_messageType fastReflection_PriceMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_PriceMetadata_messageType{}
+
+type fastReflection_PriceMetadata_messageType struct{}
+
+func (x fastReflection_PriceMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceMetadata)(nil)
+}
+func (x fastReflection_PriceMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceMetadata)
+}
+func (x fastReflection_PriceMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_PriceMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceMetadata) New() protoreflect.Message {
+	return new(fastReflection_PriceMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceMetadata) Interface() protoreflect.ProtoMessage {
+	return (*PriceMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AgeMs != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.AgeMs)
+		if !f(fd_PriceMetadata_age_ms, value) {
+			return
+		}
+	}
+	if x.NumProviders != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.NumProviders)
+		if !f(fd_PriceMetadata_num_providers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.age_ms":
+		return x.AgeMs != uint32(0)
+	case "connect.abci.v2.PriceMetadata.num_providers":
+		return x.NumProviders != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.age_ms":
+		x.AgeMs = uint32(0)
+	case "connect.abci.v2.PriceMetadata.num_providers":
+		x.NumProviders = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.abci.v2.PriceMetadata.age_ms":
+		value := x.AgeMs
+		return protoreflect.ValueOfUint32(value)
+	case "connect.abci.v2.PriceMetadata.num_providers":
+		value := x.NumProviders
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.age_ms":
+		x.AgeMs = uint32(value.Uint())
+	case "connect.abci.v2.PriceMetadata.num_providers":
+		x.NumProviders = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.age_ms":
+		panic(fmt.Errorf("field age_ms of message connect.abci.v2.PriceMetadata is not mutable"))
+	case "connect.abci.v2.PriceMetadata.num_providers":
+		panic(fmt.Errorf("field num_providers of message connect.abci.v2.PriceMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.age_ms":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.abci.v2.PriceMetadata.num_providers":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.abci.v2.PriceMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AgeMs != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgeMs))
+		}
+		if x.NumProviders != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumProviders))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NumProviders != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumProviders))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.AgeMs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgeMs))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgeMs", wireType)
+				}
+				x.AgeMs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgeMs |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumProviders", wireType)
+				}
+				x.NumProviders = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumProviders |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DenseOracleVoteExtension_2_list)(nil)
+
+type _DenseOracleVoteExtension_2_list struct {
+	list *[][]byte
+}
+
+func (x *_DenseOracleVoteExtension_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenseOracleVoteExtension_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_DenseOracleVoteExtension_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenseOracleVoteExtension_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenseOracleVoteExtension_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DenseOracleVoteExtension at list field Prices as it is not of Message kind"))
+}
+
+func (x *_DenseOracleVoteExtension_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenseOracleVoteExtension_2_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_DenseOracleVoteExtension_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DenseOracleVoteExtension_5_list)(nil)
+
+type _DenseOracleVoteExtension_5_list struct {
+	list *[]*PriceMetadata
+}
+
+func (x *_DenseOracleVoteExtension_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenseOracleVoteExtension_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DenseOracleVoteExtension_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceMetadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenseOracleVoteExtension_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceMetadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenseOracleVoteExtension_5_list) AppendMutable() protoreflect.Value {
+	v := new(PriceMetadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenseOracleVoteExtension_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenseOracleVoteExtension_5_list) NewElement() protoreflect.Value {
+	v := new(PriceMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenseOracleVoteExtension_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DenseOracleVoteExtension                 protoreflect.MessageDescriptor
+	fd_DenseOracleVoteExtension_bitmap          protoreflect.FieldDescriptor
+	fd_DenseOracleVoteExtension_prices          protoreflect.FieldDescriptor
+	fd_DenseOracleVoteExtension_version         protoreflect.FieldDescriptor
+	fd_DenseOracleVoteExtension_metadata_bitmap protoreflect.FieldDescriptor
+	fd_DenseOracleVoteExtension_metadata        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_abci_v2_vote_extensions_proto_init()
+	md_DenseOracleVoteExtension = File_connect_abci_v2_vote_extensions_proto.Messages().ByName("DenseOracleVoteExtension")
+	fd_DenseOracleVoteExtension_bitmap = md_DenseOracleVoteExtension.Fields().ByName("bitmap")
+	fd_DenseOracleVoteExtension_prices = md_DenseOracleVoteExtension.Fields().ByName("prices")
+	fd_DenseOracleVoteExtension_version = md_DenseOracleVoteExtension.Fields().ByName("version")
+	fd_DenseOracleVoteExtension_metadata_bitmap = md_DenseOracleVoteExtension.Fields().ByName("metadata_bitmap")
+	fd_DenseOracleVoteExtension_metadata = md_DenseOracleVoteExtension.Fields().ByName("metadata")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenseOracleVoteExtension)(nil)
+
+type fastReflection_DenseOracleVoteExtension DenseOracleVoteExtension
+
+func (x *DenseOracleVoteExtension) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenseOracleVoteExtension)(x)
+}
+
+func (x *DenseOracleVoteExtension) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_abci_v2_vote_extensions_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenseOracleVoteExtension_messageType fastReflection_DenseOracleVoteExtension_messageType
+var _ protoreflect.MessageType = fastReflection_DenseOracleVoteExtension_messageType{}
+
+type fastReflection_DenseOracleVoteExtension_messageType struct{}
+
+func (x fastReflection_DenseOracleVoteExtension_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenseOracleVoteExtension)(nil)
+}
+func (x fastReflection_DenseOracleVoteExtension_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenseOracleVoteExtension)
+}
+func (x fastReflection_DenseOracleVoteExtension_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenseOracleVoteExtension
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenseOracleVoteExtension) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenseOracleVoteExtension
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenseOracleVoteExtension) Type() protoreflect.MessageType {
+	return _fastReflection_DenseOracleVoteExtension_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenseOracleVoteExtension) New() protoreflect.Message {
+	return new(fastReflection_DenseOracleVoteExtension)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenseOracleVoteExtension) Interface() protoreflect.ProtoMessage {
+	return (*DenseOracleVoteExtension)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenseOracleVoteExtension) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Bitmap) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bitmap)
+		if !f(fd_DenseOracleVoteExtension_bitmap, value) {
+			return
+		}
+	}
+	if len(x.Prices) != 0 {
+		value := protoreflect.ValueOfList(&_DenseOracleVoteExtension_2_list{list: &x.Prices})
+		if !f(fd_DenseOracleVoteExtension_prices, value) {
+			return
+		}
+	}
+	if x.Version != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Version)
+		if !f(fd_DenseOracleVoteExtension_version, value) {
+			return
+		}
+	}
+	if len(x.MetadataBitmap) != 0 {
+		value := protoreflect.ValueOfBytes(x.MetadataBitmap)
+		if !f(fd_DenseOracleVoteExtension_metadata_bitmap, value) {
+			return
+		}
+	}
+	if len(x.Metadata) != 0 {
+		value := protoreflect.ValueOfList(&_DenseOracleVoteExtension_5_list{list: &x.Metadata})
+		if !f(fd_DenseOracleVoteExtension_metadata, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenseOracleVoteExtension) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.abci.v2.DenseOracleVoteExtension.bitmap":
+		return len(x.Bitmap) != 0
+	case "connect.abci.v2.DenseOracleVoteExtension.prices":
+		return len(x.Prices) != 0
+	case "connect.abci.v2.DenseOracleVoteExtension.version":
+		return x.Version != uint32(0)
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata_bitmap":
+		return len(x.MetadataBitmap) != 0
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata":
+		return len(x.Metadata) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.DenseOracleVoteExtension"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.DenseOracleVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenseOracleVoteExtension) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.abci.v2.DenseOracleVoteExtension.bitmap":
+		x.Bitmap = nil
+	case "connect.abci.v2.DenseOracleVoteExtension.prices":
+		x.Prices = nil
+	case "connect.abci.v2.DenseOracleVoteExtension.version":
+		x.Version = uint32(0)
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata_bitmap":
+		x.MetadataBitmap = nil
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata":
+		x.Metadata = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.DenseOracleVoteExtension"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.DenseOracleVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenseOracleVoteExtension) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.abci.v2.DenseOracleVoteExtension.bitmap":
+		value := x.Bitmap
+		return protoreflect.ValueOfBytes(value)
+	case "connect.abci.v2.DenseOracleVoteExtension.prices":
+		if len(x.Prices) == 0 {
+			return protoreflect.ValueOfList(&_DenseOracleVoteExtension_2_list{})
+		}
+		listValue := &_DenseOracleVoteExtension_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.abci.v2.DenseOracleVoteExtension.version":
+		value := x.Version
+		return protoreflect.ValueOfUint32(value)
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata_bitmap":
+		value := x.MetadataBitmap
+		return protoreflect.ValueOfBytes(value)
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata":
+		if len(x.Metadata) == 0 {
+			return protoreflect.ValueOfList(&_DenseOracleVoteExtension_5_list{})
+		}
+		listValue := &_DenseOracleVoteExtension_5_list{list: &x.Metadata}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.DenseOracleVoteExtension"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.DenseOracleVoteExtension does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenseOracleVoteExtension) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.abci.v2.DenseOracleVoteExtension.bitmap":
+		x.Bitmap = value.Bytes()
+	case "connect.abci.v2.DenseOracleVoteExtension.prices":
+		lv := value.List()
+		clv := lv.(*_DenseOracleVoteExtension_2_list)
+		x.Prices = *clv.list
+	case "connect.abci.v2.DenseOracleVoteExtension.version":
+		x.Version = uint32(value.Uint())
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata_bitmap":
+		x.MetadataBitmap = value.Bytes()
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata":
+		lv := value.List()
+		clv := lv.(*_DenseOracleVoteExtension_5_list)
+		x.Metadata = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.DenseOracleVoteExtension"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.DenseOracleVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenseOracleVoteExtension) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.abci.v2.DenseOracleVoteExtension.prices":
+		if x.Prices == nil {
+			x.Prices = [][]byte{}
+		}
+		value := &_DenseOracleVoteExtension_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(value)
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata":
+		if x.Metadata == nil {
+			x.Metadata = []*PriceMetadata{}
+		}
+		value := &_DenseOracleVoteExtension_5_list{list: &x.Metadata}
+		return protoreflect.ValueOfList(value)
+	case "connect.abci.v2.DenseOracleVoteExtension.bitmap":
+		panic(fmt.Errorf("field bitmap of message connect.abci.v2.DenseOracleVoteExtension is not mutable"))
+	case "connect.abci.v2.DenseOracleVoteExtension.version":
+		panic(fmt.Errorf("field version of message connect.abci.v2.DenseOracleVoteExtension is not mutable"))
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata_bitmap":
+		panic(fmt.Errorf("field metadata_bitmap of message connect.abci.v2.DenseOracleVoteExtension is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.DenseOracleVoteExtension"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.DenseOracleVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenseOracleVoteExtension) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.abci.v2.DenseOracleVoteExtension.bitmap":
+		return protoreflect.ValueOfBytes(nil)
+	case "connect.abci.v2.DenseOracleVoteExtension.prices":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_DenseOracleVoteExtension_2_list{list: &list})
+	case "connect.abci.v2.DenseOracleVoteExtension.version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata_bitmap":
+		return protoreflect.ValueOfBytes(nil)
+	case "connect.abci.v2.DenseOracleVoteExtension.metadata":
+		list := []*PriceMetadata{}
+		return protoreflect.ValueOfList(&_DenseOracleVoteExtension_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.DenseOracleVoteExtension"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.DenseOracleVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenseOracleVoteExtension) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.abci.v2.DenseOracleVoteExtension", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenseOracleVoteExtension) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenseOracleVoteExtension) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenseOracleVoteExtension) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenseOracleVoteExtension) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenseOracleVoteExtension)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Bitmap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Prices) > 0 {
+			for _, b := range x.Prices {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.MetadataBitmap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Metadata) > 0 {
+			for _, e := range x.Metadata {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenseOracleVoteExtension)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Metadata) > 0 {
+			for iNdEx := len(x.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Metadata[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.MetadataBitmap) > 0 {
+			i -= len(x.MetadataBitmap)
+			copy(dAtA[i:], x.MetadataBitmap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetadataBitmap)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Prices) > 0 {
+			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Prices[iNdEx])
+				copy(dAtA[i:], x.Prices[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Prices[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Bitmap) > 0 {
+			i -= len(x.Bitmap)
+			copy(dAtA[i:], x.Bitmap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bitmap)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenseOracleVoteExtension)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenseOracleVoteExtension: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenseOracleVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bitmap = append(x.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bitmap == nil {
+					x.Bitmap = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Prices = append(x.Prices, make([]byte, postIndex-iNdEx))
+				copy(x.Prices[len(x.Prices)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataBitmap", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetadataBitmap = append(x.MetadataBitmap[:0], dAtA[iNdEx:postIndex]...)
+				if x.MetadataBitmap == nil {
+					x.MetadataBitmap = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = append(x.Metadata, &PriceMetadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata[len(x.Metadata)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/abci/v2/vote_extensions.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OracleVoteExtension defines the vote extension structure for oracle prices.
+type OracleVoteExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Prices defines a map of id(CurrencyPair) -> price.Bytes() . i.e. 1 ->
 	// 0x123.. (bytes). Notice the `id` function is determined by the
 	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
 	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Version is the version of the codec / currency pair strategy used to
+	// create this vote extension. A zero value denotes an unversioned vote
+	// extension. The set of accepted versions is determined by the x/oracle
+	// module parameters.
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Metadata defines a map of id(CurrencyPair) -> PriceMetadata. Metadata is
+	// optional and may only be present for ids that also have a price.
+	Metadata map[uint64]*PriceMetadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *OracleVoteExtension) Reset() {
@@ -713,23 +2331,199 @@ func (x *OracleVoteExtension) GetPrices() map[uint64][]byte {
 	return nil
 }
 
+func (x *OracleVoteExtension) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OracleVoteExtension) GetMetadata() map[uint64]*PriceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// PriceMetadata defines compact metadata about a price included in a vote
+// extension.
+type PriceMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AgeMs is the age of the price in milliseconds, measured from the oldest
+	// provider price used to compute it to the block time at which the vote
+	// extension was created.
+	AgeMs uint32 `protobuf:"varint,1,opt,name=age_ms,json=ageMs,proto3" json:"age_ms,omitempty"`
+	// NumProviders is the number of providers whose prices were used to compute
+	// the price.
+	NumProviders uint32 `protobuf:"varint,2,opt,name=num_providers,json=numProviders,proto3" json:"num_providers,omitempty"`
+}
+
+func (x *PriceMetadata) Reset() {
+	*x = PriceMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_abci_v2_vote_extensions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceMetadata) ProtoMessage() {}
+
+// Deprecated: Use PriceMetadata.ProtoReflect.Descriptor instead.
+func (*PriceMetadata) Descriptor() ([]byte, []int) {
+	return file_connect_abci_v2_vote_extensions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PriceMetadata) GetAgeMs() uint32 {
+	if x != nil {
+		return x.AgeMs
+	}
+	return 0
+}
+
+func (x *PriceMetadata) GetNumProviders() uint32 {
+	if x != nil {
+		return x.NumProviders
+	}
+	return 0
+}
+
+// DenseOracleVoteExtension is the dense encoding of an OracleVoteExtension
+// whose price IDs are dense indices, as produced by the
+// DenseCurrencyPairStrategy. Rather than keying each price by its ID, the IDs
+// of the included prices are given by a presence bitmap, and the prices are
+// packed in ascending order of ID.
+type DenseOracleVoteExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bitmap is the presence bitmap of the prices. Bit i, the (i%8)-th least
+	// significant bit of byte i/8, is set if a price is included for ID i.
+	Bitmap []byte `protobuf:"bytes,1,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	// Prices are the prices of the IDs set in the bitmap, in ascending order of
+	// ID.
+	Prices [][]byte `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	// Version is the version of the codec / currency pair strategy used to
+	// create this vote extension.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// MetadataBitmap is the presence bitmap of the price metadata. Bit j is set
+	// if metadata is included for the j-th price in Prices.
+	MetadataBitmap []byte `protobuf:"bytes,4,opt,name=metadata_bitmap,json=metadataBitmap,proto3" json:"metadata_bitmap,omitempty"`
+	// Metadata are the metadata of the prices set in the MetadataBitmap, in the
+	// order of Prices.
+	Metadata []*PriceMetadata `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *DenseOracleVoteExtension) Reset() {
+	*x = DenseOracleVoteExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_abci_v2_vote_extensions_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenseOracleVoteExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenseOracleVoteExtension) ProtoMessage() {}
+
+// Deprecated: Use DenseOracleVoteExtension.ProtoReflect.Descriptor instead.
+func (*DenseOracleVoteExtension) Descriptor() ([]byte, []int) {
+	return file_connect_abci_v2_vote_extensions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DenseOracleVoteExtension) GetBitmap() []byte {
+	if x != nil {
+		return x.Bitmap
+	}
+	return nil
+}
+
+func (x *DenseOracleVoteExtension) GetPrices() [][]byte {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *DenseOracleVoteExtension) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DenseOracleVoteExtension) GetMetadataBitmap() []byte {
+	if x != nil {
+		return x.MetadataBitmap
+	}
+	return nil
+}
+
+func (x *DenseOracleVoteExtension) GetMetadata() []*PriceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 var File_connect_abci_v2_vote_extensions_proto protoreflect.FileDescriptor
 
 var file_connect_abci_v2_vote_extensions_proto_rawDesc = []byte{
 	0x0a, 0x25, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76,
 	0x32, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x4f, 0x72, 0x61,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x22, 0xe1, 0x02, 0x0a, 0x13, 0x4f, 0x72, 0x61,
 	0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
 	0x12, 0x48, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
 	0x76, 0x32, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74,
 	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xb1, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
+	0x72, 0x79, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56,
+	0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x5b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69,
+	0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4b, 0x0a, 0x0d,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x15, 0x0a,
+	0x06, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61,
+	0x67, 0x65, 0x4d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x75, 0x6d,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x18, 0x44, 0x65,
+	0x6e, 0x73, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0xb1, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
 	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x42, 0x13, 0x56,
 	0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f,
 	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
@@ -756,18 +2550,24 @@ func file_connect_abci_v2_vote_extensions_proto_rawDescGZIP() []byte {
 	return file_connect_abci_v2_vote_extensions_proto_rawDescData
 }
 
-var file_connect_abci_v2_vote_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_connect_abci_v2_vote_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_connect_abci_v2_vote_extensions_proto_goTypes = []interface{}{
-	(*OracleVoteExtension)(nil), // 0: connect.abci.v2.OracleVoteExtension
-	nil,                         // 1: connect.abci.v2.OracleVoteExtension.PricesEntry
+	(*OracleVoteExtension)(nil),      // 0: connect.abci.v2.OracleVoteExtension
+	(*PriceMetadata)(nil),            // 1: connect.abci.v2.PriceMetadata
+	(*DenseOracleVoteExtension)(nil), // 2: connect.abci.v2.DenseOracleVoteExtension
+	nil,                              // 3: connect.abci.v2.OracleVoteExtension.PricesEntry
+	nil,                              // 4: connect.abci.v2.OracleVoteExtension.MetadataEntry
 }
 var file_connect_abci_v2_vote_extensions_proto_depIdxs = []int32{
-	1, // 0: connect.abci.v2.OracleVoteExtension.prices:type_name -> connect.abci.v2.OracleVoteExtension.PricesEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: connect.abci.v2.OracleVoteExtension.prices:type_name -> connect.abci.v2.OracleVoteExtension.PricesEntry
+	4, // 1: connect.abci.v2.OracleVoteExtension.metadata:type_name -> connect.abci.v2.OracleVoteExtension.MetadataEntry
+	1, // 2: connect.abci.v2.DenseOracleVoteExtension.metadata:type_name -> connect.abci.v2.PriceMetadata
+	1, // 3: connect.abci.v2.OracleVoteExtension.MetadataEntry.value:type_name -> connect.abci.v2.PriceMetadata
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_connect_abci_v2_vote_extensions_proto_init() }
@@ -788,6 +2588,30 @@ func file_connect_abci_v2_vote_extensions_proto_init() {
 				return nil
 			}
 		}
+		file_connect_abci_v2_vote_extensions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_abci_v2_vote_extensions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenseOracleVoteExtension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -795,7 +2619,7 @@ func file_connect_abci_v2_vote_extensions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_connect_abci_v2_vote_extensions_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,1051 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package marketmapv2
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_MarketAuthorityGrant_2_list)(nil)
+
+type _MarketAuthorityGrant_2_list struct {
+	list *[]string
+}
+
+func (x *_MarketAuthorityGrant_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketAuthorityGrant_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MarketAuthorityGrant_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketAuthorityGrant_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketAuthorityGrant_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityGrant at list field Tickers as it is not of Message kind"))
+}
+
+func (x *_MarketAuthorityGrant_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketAuthorityGrant_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketAuthorityGrant_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketAuthorityGrant_3_list)(nil)
+
+type _MarketAuthorityGrant_3_list struct {
+	list *[]string
+}
+
+func (x *_MarketAuthorityGrant_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketAuthorityGrant_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MarketAuthorityGrant_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketAuthorityGrant_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketAuthorityGrant_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityGrant at list field BaseAssets as it is not of Message kind"))
+}
+
+func (x *_MarketAuthorityGrant_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketAuthorityGrant_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketAuthorityGrant_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketAuthorityGrant_4_list)(nil)
+
+type _MarketAuthorityGrant_4_list struct {
+	list *[]string
+}
+
+func (x *_MarketAuthorityGrant_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketAuthorityGrant_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MarketAuthorityGrant_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketAuthorityGrant_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketAuthorityGrant_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityGrant at list field Providers as it is not of Message kind"))
+}
+
+func (x *_MarketAuthorityGrant_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketAuthorityGrant_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketAuthorityGrant_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MarketAuthorityGrant             protoreflect.MessageDescriptor
+	fd_MarketAuthorityGrant_grantee     protoreflect.FieldDescriptor
+	fd_MarketAuthorityGrant_tickers     protoreflect.FieldDescriptor
+	fd_MarketAuthorityGrant_base_assets protoreflect.FieldDescriptor
+	fd_MarketAuthorityGrant_providers   protoreflect.FieldDescriptor
+	fd_MarketAuthorityGrant_can_remove  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_authority_proto_init()
+	md_MarketAuthorityGrant = File_connect_marketmap_v2_authority_proto.Messages().ByName("MarketAuthorityGrant")
+	fd_MarketAuthorityGrant_grantee = md_MarketAuthorityGrant.Fields().ByName("grantee")
+	fd_MarketAuthorityGrant_tickers = md_MarketAuthorityGrant.Fields().ByName("tickers")
+	fd_MarketAuthorityGrant_base_assets = md_MarketAuthorityGrant.Fields().ByName("base_assets")
+	fd_MarketAuthorityGrant_providers = md_MarketAuthorityGrant.Fields().ByName("providers")
+	fd_MarketAuthorityGrant_can_remove = md_MarketAuthorityGrant.Fields().ByName("can_remove")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketAuthorityGrant)(nil)
+
+type fastReflection_MarketAuthorityGrant MarketAuthorityGrant
+
+func (x *MarketAuthorityGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityGrant)(x)
+}
+
+func (x *MarketAuthorityGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_authority_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketAuthorityGrant_messageType fastReflection_MarketAuthorityGrant_messageType
+var _ protoreflect.MessageType = fastReflection_MarketAuthorityGrant_messageType{}
+
+type fastReflection_MarketAuthorityGrant_messageType struct{}
+
+func (x fastReflection_MarketAuthorityGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityGrant)(nil)
+}
+func (x fastReflection_MarketAuthorityGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityGrant)
+}
+func (x fastReflection_MarketAuthorityGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityGrant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketAuthorityGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityGrant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketAuthorityGrant) Type() protoreflect.MessageType {
+	return _fastReflection_MarketAuthorityGrant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketAuthorityGrant) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityGrant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketAuthorityGrant) Interface() protoreflect.ProtoMessage {
+	return (*MarketAuthorityGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketAuthorityGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_MarketAuthorityGrant_grantee, value) {
+			return
+		}
+	}
+	if len(x.Tickers) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityGrant_2_list{list: &x.Tickers})
+		if !f(fd_MarketAuthorityGrant_tickers, value) {
+			return
+		}
+	}
+	if len(x.BaseAssets) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityGrant_3_list{list: &x.BaseAssets})
+		if !f(fd_MarketAuthorityGrant_base_assets, value) {
+			return
+		}
+	}
+	if len(x.Providers) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityGrant_4_list{list: &x.Providers})
+		if !f(fd_MarketAuthorityGrant_providers, value) {
+			return
+		}
+	}
+	if x.CanRemove != false {
+		value := protoreflect.ValueOfBool(x.CanRemove)
+		if !f(fd_MarketAuthorityGrant_can_remove, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketAuthorityGrant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityGrant.grantee":
+		return x.Grantee != ""
+	case "connect.marketmap.v2.MarketAuthorityGrant.tickers":
+		return len(x.Tickers) != 0
+	case "connect.marketmap.v2.MarketAuthorityGrant.base_assets":
+		return len(x.BaseAssets) != 0
+	case "connect.marketmap.v2.MarketAuthorityGrant.providers":
+		return len(x.Providers) != 0
+	case "connect.marketmap.v2.MarketAuthorityGrant.can_remove":
+		return x.CanRemove != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityGrant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityGrant.grantee":
+		x.Grantee = ""
+	case "connect.marketmap.v2.MarketAuthorityGrant.tickers":
+		x.Tickers = nil
+	case "connect.marketmap.v2.MarketAuthorityGrant.base_assets":
+		x.BaseAssets = nil
+	case "connect.marketmap.v2.MarketAuthorityGrant.providers":
+		x.Providers = nil
+	case "connect.marketmap.v2.MarketAuthorityGrant.can_remove":
+		x.CanRemove = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketAuthorityGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityGrant.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "connect.marketmap.v2.MarketAuthorityGrant.tickers":
+		if len(x.Tickers) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityGrant_2_list{})
+		}
+		listValue := &_MarketAuthorityGrant_2_list{list: &x.Tickers}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketAuthorityGrant.base_assets":
+		if len(x.BaseAssets) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityGrant_3_list{})
+		}
+		listValue := &_MarketAuthorityGrant_3_list{list: &x.BaseAssets}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketAuthorityGrant.providers":
+		if len(x.Providers) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityGrant_4_list{})
+		}
+		listValue := &_MarketAuthorityGrant_4_list{list: &x.Providers}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketAuthorityGrant.can_remove":
+		value := x.CanRemove
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityGrant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityGrant.grantee":
+		x.Grantee = value.Interface().(string)
+	case "connect.marketmap.v2.MarketAuthorityGrant.tickers":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityGrant_2_list)
+		x.Tickers = *clv.list
+	case "connect.marketmap.v2.MarketAuthorityGrant.base_assets":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityGrant_3_list)
+		x.BaseAssets = *clv.list
+	case "connect.marketmap.v2.MarketAuthorityGrant.providers":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityGrant_4_list)
+		x.Providers = *clv.list
+	case "connect.marketmap.v2.MarketAuthorityGrant.can_remove":
+		x.CanRemove = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityGrant.tickers":
+		if x.Tickers == nil {
+			x.Tickers = []string{}
+		}
+		value := &_MarketAuthorityGrant_2_list{list: &x.Tickers}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketAuthorityGrant.base_assets":
+		if x.BaseAssets == nil {
+			x.BaseAssets = []string{}
+		}
+		value := &_MarketAuthorityGrant_3_list{list: &x.BaseAssets}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketAuthorityGrant.providers":
+		if x.Providers == nil {
+			x.Providers = []string{}
+		}
+		value := &_MarketAuthorityGrant_4_list{list: &x.Providers}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketAuthorityGrant.grantee":
+		panic(fmt.Errorf("field grantee of message connect.marketmap.v2.MarketAuthorityGrant is not mutable"))
+	case "connect.marketmap.v2.MarketAuthorityGrant.can_remove":
+		panic(fmt.Errorf("field can_remove of message connect.marketmap.v2.MarketAuthorityGrant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketAuthorityGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityGrant.grantee":
+		return protoreflect.ValueOfString("")
+	case "connect.marketmap.v2.MarketAuthorityGrant.tickers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityGrant_2_list{list: &list})
+	case "connect.marketmap.v2.MarketAuthorityGrant.base_assets":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityGrant_3_list{list: &list})
+	case "connect.marketmap.v2.MarketAuthorityGrant.providers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityGrant_4_list{list: &list})
+	case "connect.marketmap.v2.MarketAuthorityGrant.can_remove":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketAuthorityGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketAuthorityGrant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketAuthorityGrant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityGrant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketAuthorityGrant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketAuthorityGrant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketAuthorityGrant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Tickers) > 0 {
+			for _, s := range x.Tickers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BaseAssets) > 0 {
+			for _, s := range x.BaseAssets {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Providers) > 0 {
+			for _, s := range x.Providers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CanRemove {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityGrant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CanRemove {
+			i--
+			if x.CanRemove {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Providers) > 0 {
+			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Providers[iNdEx])
+				copy(dAtA[i:], x.Providers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Providers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.BaseAssets) > 0 {
+			for iNdEx := len(x.BaseAssets) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BaseAssets[iNdEx])
+				copy(dAtA[i:], x.BaseAssets[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseAssets[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Tickers) > 0 {
+			for iNdEx := len(x.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Tickers[iNdEx])
+				copy(dAtA[i:], x.Tickers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tickers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityGrant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityGrant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tickers = append(x.Tickers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAssets", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseAssets = append(x.BaseAssets, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Providers = append(x.Providers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CanRemove", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CanRemove = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/marketmap/v2/authority.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MarketAuthorityGrant delegates market management to an address, restricted
+// to the markets in its scope. A market is in scope if it matches every
+// non-empty set of tickers, base assets and providers in the grant. Ticker and
+// base asset patterns match case-insensitively, and may use '*' to match any
+// sequence of characters.
+type MarketAuthorityGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Grantee is the address the grant is made to.
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Tickers are the ticker patterns in scope, i.e. BTC/USD or *,UNISWAP_V3*.
+	Tickers []string `protobuf:"bytes,2,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	// BaseAssets are the base asset patterns in scope, i.e. ETH.
+	BaseAssets []string `protobuf:"bytes,3,rep,name=base_assets,json=baseAssets,proto3" json:"base_assets,omitempty"`
+	// Providers are the provider names in scope. A market is only in scope if
+	// every one of its provider configs is for a provider in the set.
+	Providers []string `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
+	// CanRemove permits the grantee to remove markets in scope.
+	CanRemove bool `protobuf:"varint,5,opt,name=can_remove,json=canRemove,proto3" json:"can_remove,omitempty"`
+}
+
+func (x *MarketAuthorityGrant) Reset() {
+	*x = MarketAuthorityGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_authority_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketAuthorityGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketAuthorityGrant) ProtoMessage() {}
+
+// Deprecated: Use MarketAuthorityGrant.ProtoReflect.Descriptor instead.
+func (*MarketAuthorityGrant) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_authority_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarketAuthorityGrant) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *MarketAuthorityGrant) GetTickers() []string {
+	if x != nil {
+		return x.Tickers
+	}
+	return nil
+}
+
+func (x *MarketAuthorityGrant) GetBaseAssets() []string {
+	if x != nil {
+		return x.BaseAssets
+	}
+	return nil
+}
+
+func (x *MarketAuthorityGrant) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *MarketAuthorityGrant) GetCanRemove() bool {
+	if x != nil {
+		return x.CanRemove
+	}
+	return false
+}
+
+var File_connect_marketmap_v2_authority_proto protoreflect.FileDescriptor
+
+var file_connect_marketmap_v2_authority_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x1a, 0x19, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0xcf, 0x01, 0x0a,
+	0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x42, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f,
+	0x76, 0x32, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x32, 0xa2, 0x02,
+	0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x14, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c,
+	0x56, 0x32, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a,
+	0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_connect_marketmap_v2_authority_proto_rawDescOnce sync.Once
+	file_connect_marketmap_v2_authority_proto_rawDescData = file_connect_marketmap_v2_authority_proto_rawDesc
+)
+
+func file_connect_marketmap_v2_authority_proto_rawDescGZIP() []byte {
+	file_connect_marketmap_v2_authority_proto_rawDescOnce.Do(func() {
+		file_connect_marketmap_v2_authority_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_marketmap_v2_authority_proto_rawDescData)
+	})
+	return file_connect_marketmap_v2_authority_proto_rawDescData
+}
+
+var file_connect_marketmap_v2_authority_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_connect_marketmap_v2_authority_proto_goTypes = []interface{}{
+	(*MarketAuthorityGrant)(nil), // 0: connect.marketmap.v2.MarketAuthorityGrant
+}
+var file_connect_marketmap_v2_authority_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_connect_marketmap_v2_authority_proto_init() }
+func file_connect_marketmap_v2_authority_proto_init() {
+	if File_connect_marketmap_v2_authority_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_connect_marketmap_v2_authority_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketAuthorityGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_connect_marketmap_v2_authority_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_connect_marketmap_v2_authority_proto_goTypes,
+		DependencyIndexes: file_connect_marketmap_v2_authority_proto_depIdxs,
+		MessageInfos:      file_connect_marketmap_v2_authority_proto_msgTypes,
+	}.Build()
+	File_connect_marketmap_v2_authority_proto = out.File
+	file_connect_marketmap_v2_authority_proto_rawDesc = nil
+	file_connect_marketmap_v2_authority_proto_goTypes = nil
+	file_connect_marketmap_v2_authority_proto_depIdxs = nil
+}
@@ -13,11 +13,220 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ScheduledMarketChange
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledMarketChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledMarketChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledMarketChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ScheduledMarketChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*MarketAuthorityGrant
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketAuthorityGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketAuthorityGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(MarketAuthorityGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(MarketAuthorityGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*MarketChangeRecord
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketChangeRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketChangeRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(MarketChangeRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(MarketChangeRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*ProviderInfo
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(ProviderInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState              protoreflect.MessageDescriptor
-	fd_GenesisState_market_map   protoreflect.FieldDescriptor
-	fd_GenesisState_last_updated protoreflect.FieldDescriptor
-	fd_GenesisState_params       protoreflect.FieldDescriptor
+	md_GenesisState                                 protoreflect.MessageDescriptor
+	fd_GenesisState_market_map                      protoreflect.FieldDescriptor
+	fd_GenesisState_last_updated                    protoreflect.FieldDescriptor
+	fd_GenesisState_params                          protoreflect.FieldDescriptor
+	fd_GenesisState_scheduled_market_changes        protoreflect.FieldDescriptor
+	fd_GenesisState_next_scheduled_market_change_id protoreflect.FieldDescriptor
+	fd_GenesisState_market_authority_grants         protoreflect.FieldDescriptor
+	fd_GenesisState_market_history                  protoreflect.FieldDescriptor
+	fd_GenesisState_providers                       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +235,11 @@ func init() {
 	fd_GenesisState_market_map = md_GenesisState.Fields().ByName("market_map")
 	fd_GenesisState_last_updated = md_GenesisState.Fields().ByName("last_updated")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_scheduled_market_changes = md_GenesisState.Fields().ByName("scheduled_market_changes")
+	fd_GenesisState_next_scheduled_market_change_id = md_GenesisState.Fields().ByName("next_scheduled_market_change_id")
+	fd_GenesisState_market_authority_grants = md_GenesisState.Fields().ByName("market_authority_grants")
+	fd_GenesisState_market_history = md_GenesisState.Fields().ByName("market_history")
+	fd_GenesisState_providers = md_GenesisState.Fields().ByName("providers")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -111,6 +325,36 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ScheduledMarketChanges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ScheduledMarketChanges})
+		if !f(fd_GenesisState_scheduled_market_changes, value) {
+			return
+		}
+	}
+	if x.NextScheduledMarketChangeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextScheduledMarketChangeId)
+		if !f(fd_GenesisState_next_scheduled_market_change_id, value) {
+			return
+		}
+	}
+	if len(x.MarketAuthorityGrants) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.MarketAuthorityGrants})
+		if !f(fd_GenesisState_market_authority_grants, value) {
+			return
+		}
+	}
+	if len(x.MarketHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.MarketHistory})
+		if !f(fd_GenesisState_market_history, value) {
+			return
+		}
+	}
+	if len(x.Providers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.Providers})
+		if !f(fd_GenesisState_providers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -132,6 +376,16 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.LastUpdated != uint64(0)
 	case "connect.marketmap.v2.GenesisState.params":
 		return x.Params != nil
+	case "connect.marketmap.v2.GenesisState.scheduled_market_changes":
+		return len(x.ScheduledMarketChanges) != 0
+	case "connect.marketmap.v2.GenesisState.next_scheduled_market_change_id":
+		return x.NextScheduledMarketChangeId != uint64(0)
+	case "connect.marketmap.v2.GenesisState.market_authority_grants":
+		return len(x.MarketAuthorityGrants) != 0
+	case "connect.marketmap.v2.GenesisState.market_history":
+		return len(x.MarketHistory) != 0
+	case "connect.marketmap.v2.GenesisState.providers":
+		return len(x.Providers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.GenesisState"))
@@ -154,6 +408,16 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.LastUpdated = uint64(0)
 	case "connect.marketmap.v2.GenesisState.params":
 		x.Params = nil
+	case "connect.marketmap.v2.GenesisState.scheduled_market_changes":
+		x.ScheduledMarketChanges = nil
+	case "connect.marketmap.v2.GenesisState.next_scheduled_market_change_id":
+		x.NextScheduledMarketChangeId = uint64(0)
+	case "connect.marketmap.v2.GenesisState.market_authority_grants":
+		x.MarketAuthorityGrants = nil
+	case "connect.marketmap.v2.GenesisState.market_history":
+		x.MarketHistory = nil
+	case "connect.marketmap.v2.GenesisState.providers":
+		x.Providers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.GenesisState"))
@@ -179,6 +443,33 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "connect.marketmap.v2.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "connect.marketmap.v2.GenesisState.scheduled_market_changes":
+		if len(x.ScheduledMarketChanges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ScheduledMarketChanges}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.GenesisState.next_scheduled_market_change_id":
+		value := x.NextScheduledMarketChangeId
+		return protoreflect.ValueOfUint64(value)
+	case "connect.marketmap.v2.GenesisState.market_authority_grants":
+		if len(x.MarketAuthorityGrants) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.MarketAuthorityGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.GenesisState.market_history":
+		if len(x.MarketHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.MarketHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.GenesisState.providers":
+		if len(x.Providers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.Providers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.GenesisState"))
@@ -205,6 +496,24 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.LastUpdated = value.Uint()
 	case "connect.marketmap.v2.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "connect.marketmap.v2.GenesisState.scheduled_market_changes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ScheduledMarketChanges = *clv.list
+	case "connect.marketmap.v2.GenesisState.next_scheduled_market_change_id":
+		x.NextScheduledMarketChangeId = value.Uint()
+	case "connect.marketmap.v2.GenesisState.market_authority_grants":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.MarketAuthorityGrants = *clv.list
+	case "connect.marketmap.v2.GenesisState.market_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.MarketHistory = *clv.list
+	case "connect.marketmap.v2.GenesisState.providers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.Providers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.GenesisState"))
@@ -235,8 +544,34 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "connect.marketmap.v2.GenesisState.scheduled_market_changes":
+		if x.ScheduledMarketChanges == nil {
+			x.ScheduledMarketChanges = []*ScheduledMarketChange{}
+		}
+		value := &_GenesisState_4_list{list: &x.ScheduledMarketChanges}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.GenesisState.market_authority_grants":
+		if x.MarketAuthorityGrants == nil {
+			x.MarketAuthorityGrants = []*MarketAuthorityGrant{}
+		}
+		value := &_GenesisState_6_list{list: &x.MarketAuthorityGrants}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.GenesisState.market_history":
+		if x.MarketHistory == nil {
+			x.MarketHistory = []*MarketChangeRecord{}
+		}
+		value := &_GenesisState_7_list{list: &x.MarketHistory}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.GenesisState.providers":
+		if x.Providers == nil {
+			x.Providers = []*ProviderInfo{}
+		}
+		value := &_GenesisState_8_list{list: &x.Providers}
+		return protoreflect.ValueOfList(value)
 	case "connect.marketmap.v2.GenesisState.last_updated":
 		panic(fmt.Errorf("field last_updated of message connect.marketmap.v2.GenesisState is not mutable"))
+	case "connect.marketmap.v2.GenesisState.next_scheduled_market_change_id":
+		panic(fmt.Errorf("field next_scheduled_market_change_id of message connect.marketmap.v2.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.GenesisState"))
@@ -258,6 +593,20 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "connect.marketmap.v2.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "connect.marketmap.v2.GenesisState.scheduled_market_changes":
+		list := []*ScheduledMarketChange{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "connect.marketmap.v2.GenesisState.next_scheduled_market_change_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.marketmap.v2.GenesisState.market_authority_grants":
+		list := []*MarketAuthorityGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "connect.marketmap.v2.GenesisState.market_history":
+		list := []*MarketChangeRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "connect.marketmap.v2.GenesisState.providers":
+		list := []*ProviderInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.GenesisState"))
@@ -338,6 +687,33 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ScheduledMarketChanges) > 0 {
+			for _, e := range x.ScheduledMarketChanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextScheduledMarketChangeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextScheduledMarketChangeId))
+		}
+		if len(x.MarketAuthorityGrants) > 0 {
+			for _, e := range x.MarketAuthorityGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MarketHistory) > 0 {
+			for _, e := range x.MarketHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Providers) > 0 {
+			for _, e := range x.Providers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -367,6 +743,75 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Providers) > 0 {
+			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Providers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.MarketHistory) > 0 {
+			for iNdEx := len(x.MarketHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MarketHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.MarketAuthorityGrants) > 0 {
+			for iNdEx := len(x.MarketAuthorityGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MarketAuthorityGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.NextScheduledMarketChangeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextScheduledMarketChangeId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ScheduledMarketChanges) > 0 {
+			for iNdEx := len(x.ScheduledMarketChanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduledMarketChanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -540,6 +985,161 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledMarketChanges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduledMarketChanges = append(x.ScheduledMarketChanges, &ScheduledMarketChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledMarketChanges[len(x.ScheduledMarketChanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextScheduledMarketChangeId", wireType)
+				}
+				x.NextScheduledMarketChangeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextScheduledMarketChangeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketAuthorityGrants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarketAuthorityGrants = append(x.MarketAuthorityGrants, &MarketAuthorityGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketAuthorityGrants[len(x.MarketAuthorityGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarketHistory = append(x.MarketHistory, &MarketChangeRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketHistory[len(x.MarketHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Providers = append(x.Providers, &ProviderInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Providers[len(x.Providers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -603,6 +1203,19 @@ type GenesisState struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// Params are the parameters for the x/marketmap module.
 	Params *Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	// ScheduledMarketChanges are the pending market changes scheduled for
+	// future blocks.
+	ScheduledMarketChanges []*ScheduledMarketChange `protobuf:"bytes,4,rep,name=scheduled_market_changes,json=scheduledMarketChanges,proto3" json:"scheduled_market_changes,omitempty"`
+	// NextScheduledMarketChangeId is the identifier of the next scheduled
+	// market change.
+	NextScheduledMarketChangeId uint64 `protobuf:"varint,5,opt,name=next_scheduled_market_change_id,json=nextScheduledMarketChangeId,proto3" json:"next_scheduled_market_change_id,omitempty"`
+	// MarketAuthorityGrants are the scoped market authority grants.
+	MarketAuthorityGrants []*MarketAuthorityGrant `protobuf:"bytes,6,rep,name=market_authority_grants,json=marketAuthorityGrants,proto3" json:"market_authority_grants,omitempty"`
+	// MarketHistory is the change log of every market, ordered by ticker and
+	// then by version.
+	MarketHistory []*MarketChangeRecord `protobuf:"bytes,7,rep,name=market_history,json=marketHistory,proto3" json:"market_history,omitempty"`
+	// Providers is the registry of known providers.
+	Providers []*ProviderInfo `protobuf:"bytes,8,rep,name=providers,proto3" json:"providers,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -646,6 +1259,41 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetScheduledMarketChanges() []*ScheduledMarketChange {
+	if x != nil {
+		return x.ScheduledMarketChanges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextScheduledMarketChangeId() uint64 {
+	if x != nil {
+		return x.NextScheduledMarketChangeId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetMarketAuthorityGrants() []*MarketAuthorityGrant {
+	if x != nil {
+		return x.MarketAuthorityGrants
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMarketHistory() []*MarketChangeRecord {
+	if x != nil {
+		return x.MarketHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviders() []*ProviderInfo {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
 var File_connect_marketmap_v2_genesis_proto protoreflect.FileDescriptor
 
 var file_connect_marketmap_v2_genesis_proto_rawDesc = []byte{
@@ -658,18 +1306,55 @@ var file_connect_marketmap_v2_genesis_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72,
 	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e,
-	0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x21, 0x0a,
-	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xcd, 0x01, 0x0a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76,
+	0x32, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x22, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x04, 0x0a, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x6b, 0x0a, 0x18, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x44, 0x0a,
+	0x1f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x55, 0x0a,
+	0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x46, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x42, 0xcd, 0x01, 0x0a,
 	0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72,
 	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
 	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
@@ -700,18 +1385,26 @@ func file_connect_marketmap_v2_genesis_proto_rawDescGZIP() []byte {
 
 var file_connect_marketmap_v2_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_connect_marketmap_v2_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: connect.marketmap.v2.GenesisState
-	(*MarketMap)(nil),    // 1: connect.marketmap.v2.MarketMap
-	(*Params)(nil),       // 2: connect.marketmap.v2.Params
+	(*GenesisState)(nil),          // 0: connect.marketmap.v2.GenesisState
+	(*MarketMap)(nil),             // 1: connect.marketmap.v2.MarketMap
+	(*Params)(nil),                // 2: connect.marketmap.v2.Params
+	(*ScheduledMarketChange)(nil), // 3: connect.marketmap.v2.ScheduledMarketChange
+	(*MarketAuthorityGrant)(nil),  // 4: connect.marketmap.v2.MarketAuthorityGrant
+	(*MarketChangeRecord)(nil),    // 5: connect.marketmap.v2.MarketChangeRecord
+	(*ProviderInfo)(nil),          // 6: connect.marketmap.v2.ProviderInfo
 }
 var file_connect_marketmap_v2_genesis_proto_depIdxs = []int32{
 	1, // 0: connect.marketmap.v2.GenesisState.market_map:type_name -> connect.marketmap.v2.MarketMap
 	2, // 1: connect.marketmap.v2.GenesisState.params:type_name -> connect.marketmap.v2.Params
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: connect.marketmap.v2.GenesisState.scheduled_market_changes:type_name -> connect.marketmap.v2.ScheduledMarketChange
+	4, // 3: connect.marketmap.v2.GenesisState.market_authority_grants:type_name -> connect.marketmap.v2.MarketAuthorityGrant
+	5, // 4: connect.marketmap.v2.GenesisState.market_history:type_name -> connect.marketmap.v2.MarketChangeRecord
+	6, // 5: connect.marketmap.v2.GenesisState.providers:type_name -> connect.marketmap.v2.ProviderInfo
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_connect_marketmap_v2_genesis_proto_init() }
@@ -721,6 +1414,10 @@ func file_connect_marketmap_v2_genesis_proto_init() {
 	}
 	file_connect_marketmap_v2_market_proto_init()
 	file_connect_marketmap_v2_params_proto_init()
+	file_connect_marketmap_v2_schedule_proto_init()
+	file_connect_marketmap_v2_authority_proto_init()
+	file_connect_marketmap_v2_history_proto_init()
+	file_connect_marketmap_v2_registry_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_connect_marketmap_v2_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
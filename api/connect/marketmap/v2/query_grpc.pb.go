@@ -19,10 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_MarketMap_FullMethodName   = "/connect.marketmap.v2.Query/MarketMap"
-	Query_Market_FullMethodName      = "/connect.marketmap.v2.Query/Market"
-	Query_LastUpdated_FullMethodName = "/connect.marketmap.v2.Query/LastUpdated"
-	Query_Params_FullMethodName      = "/connect.marketmap.v2.Query/Params"
+	Query_MarketMap_FullMethodName               = "/connect.marketmap.v2.Query/MarketMap"
+	Query_Market_FullMethodName                  = "/connect.marketmap.v2.Query/Market"
+	Query_LastUpdated_FullMethodName             = "/connect.marketmap.v2.Query/LastUpdated"
+	Query_Params_FullMethodName                  = "/connect.marketmap.v2.Query/Params"
+	Query_ValidateMarketMapUpdate_FullMethodName = "/connect.marketmap.v2.Query/ValidateMarketMapUpdate"
+	Query_ScheduledMarketChanges_FullMethodName  = "/connect.marketmap.v2.Query/ScheduledMarketChanges"
+	Query_MarketAuthorityGrants_FullMethodName   = "/connect.marketmap.v2.Query/MarketAuthorityGrants"
+	Query_MarketHistory_FullMethodName           = "/connect.marketmap.v2.Query/MarketHistory"
+	Query_MarketVersion_FullMethodName           = "/connect.marketmap.v2.Query/MarketVersion"
+	Query_Providers_FullMethodName               = "/connect.marketmap.v2.Query/Providers"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,8 +37,8 @@ const (
 //
 // Query is the query service for the x/marketmap module.
 type QueryClient interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.
+	// MarketMap returns the market map stored in the x/marketmap module,
+	// optionally filtered and paginated.
 	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
 	// Market returns a market stored in the x/marketmap
 	// module.
@@ -41,6 +47,23 @@ type QueryClient interface {
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// ValidateMarketMapUpdate applies a proposed set of market upserts to a
+	// cached copy of state, and returns the validation result of each market
+	// without committing any changes.
+	ValidateMarketMapUpdate(ctx context.Context, in *ValidateMarketMapUpdateRequest, opts ...grpc.CallOption) (*ValidateMarketMapUpdateResponse, error)
+	// ScheduledMarketChanges returns the pending scheduled market changes,
+	// ordered by the height they are applied at.
+	ScheduledMarketChanges(ctx context.Context, in *ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*ScheduledMarketChangesResponse, error)
+	// MarketAuthorityGrants returns the scoped market authority grants.
+	MarketAuthorityGrants(ctx context.Context, in *MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*MarketAuthorityGrantsResponse, error)
+	// MarketHistory returns the change log of a market, or of every market,
+	// ordered by ticker and then by version.
+	MarketHistory(ctx context.Context, in *MarketHistoryRequest, opts ...grpc.CallOption) (*MarketHistoryResponse, error)
+	// MarketVersion returns the change that produced a specific version of a
+	// market, along with the market at that version.
+	MarketVersion(ctx context.Context, in *MarketVersionRequest, opts ...grpc.CallOption) (*MarketVersionResponse, error)
+	// Providers returns the registry of known providers.
+	Providers(ctx context.Context, in *ProvidersRequest, opts ...grpc.CallOption) (*ProvidersResponse, error)
 }
 
 type queryClient struct {
@@ -91,14 +114,74 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) ValidateMarketMapUpdate(ctx context.Context, in *ValidateMarketMapUpdateRequest, opts ...grpc.CallOption) (*ValidateMarketMapUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateMarketMapUpdateResponse)
+	err := c.cc.Invoke(ctx, Query_ValidateMarketMapUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMarketChanges(ctx context.Context, in *ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*ScheduledMarketChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledMarketChangesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledMarketChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketAuthorityGrants(ctx context.Context, in *MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*MarketAuthorityGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketAuthorityGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_MarketAuthorityGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketHistory(ctx context.Context, in *MarketHistoryRequest, opts ...grpc.CallOption) (*MarketHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_MarketHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketVersion(ctx context.Context, in *MarketVersionRequest, opts ...grpc.CallOption) (*MarketVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketVersionResponse)
+	err := c.cc.Invoke(ctx, Query_MarketVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Providers(ctx context.Context, in *ProvidersRequest, opts ...grpc.CallOption) (*ProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProvidersResponse)
+	err := c.cc.Invoke(ctx, Query_Providers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
 //
 // Query is the query service for the x/marketmap module.
 type QueryServer interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.
+	// MarketMap returns the market map stored in the x/marketmap module,
+	// optionally filtered and paginated.
 	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
 	// Market returns a market stored in the x/marketmap
 	// module.
@@ -107,6 +190,23 @@ type QueryServer interface {
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// ValidateMarketMapUpdate applies a proposed set of market upserts to a
+	// cached copy of state, and returns the validation result of each market
+	// without committing any changes.
+	ValidateMarketMapUpdate(context.Context, *ValidateMarketMapUpdateRequest) (*ValidateMarketMapUpdateResponse, error)
+	// ScheduledMarketChanges returns the pending scheduled market changes,
+	// ordered by the height they are applied at.
+	ScheduledMarketChanges(context.Context, *ScheduledMarketChangesRequest) (*ScheduledMarketChangesResponse, error)
+	// MarketAuthorityGrants returns the scoped market authority grants.
+	MarketAuthorityGrants(context.Context, *MarketAuthorityGrantsRequest) (*MarketAuthorityGrantsResponse, error)
+	// MarketHistory returns the change log of a market, or of every market,
+	// ordered by ticker and then by version.
+	MarketHistory(context.Context, *MarketHistoryRequest) (*MarketHistoryResponse, error)
+	// MarketVersion returns the change that produced a specific version of a
+	// market, along with the market at that version.
+	MarketVersion(context.Context, *MarketVersionRequest) (*MarketVersionResponse, error)
+	// Providers returns the registry of known providers.
+	Providers(context.Context, *ProvidersRequest) (*ProvidersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -129,6 +229,24 @@ func (UnimplementedQueryServer) LastUpdated(context.Context, *LastUpdatedRequest
 func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ValidateMarketMapUpdate(context.Context, *ValidateMarketMapUpdateRequest) (*ValidateMarketMapUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMarketMapUpdate not implemented")
+}
+func (UnimplementedQueryServer) ScheduledMarketChanges(context.Context, *ScheduledMarketChangesRequest) (*ScheduledMarketChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMarketChanges not implemented")
+}
+func (UnimplementedQueryServer) MarketAuthorityGrants(context.Context, *MarketAuthorityGrantsRequest) (*MarketAuthorityGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketAuthorityGrants not implemented")
+}
+func (UnimplementedQueryServer) MarketHistory(context.Context, *MarketHistoryRequest) (*MarketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketHistory not implemented")
+}
+func (UnimplementedQueryServer) MarketVersion(context.Context, *MarketVersionRequest) (*MarketVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketVersion not implemented")
+}
+func (UnimplementedQueryServer) Providers(context.Context, *ProvidersRequest) (*ProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -222,6 +340,114 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidateMarketMapUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateMarketMapUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateMarketMapUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidateMarketMapUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateMarketMapUpdate(ctx, req.(*ValidateMarketMapUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMarketChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledMarketChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMarketChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledMarketChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMarketChanges(ctx, req.(*ScheduledMarketChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketAuthorityGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketAuthorityGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketAuthorityGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketAuthorityGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketAuthorityGrants(ctx, req.(*MarketAuthorityGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketHistory(ctx, req.(*MarketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketVersion(ctx, req.(*MarketVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Providers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Providers(ctx, req.(*ProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -245,6 +471,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidateMarketMapUpdate",
+			Handler:    _Query_ValidateMarketMapUpdate_Handler,
+		},
+		{
+			MethodName: "ScheduledMarketChanges",
+			Handler:    _Query_ScheduledMarketChanges_Handler,
+		},
+		{
+			MethodName: "MarketAuthorityGrants",
+			Handler:    _Query_MarketAuthorityGrants_Handler,
+		},
+		{
+			MethodName: "MarketHistory",
+			Handler:    _Query_MarketHistory_Handler,
+		},
+		{
+			MethodName: "MarketVersion",
+			Handler:    _Query_MarketVersion_Handler,
+		},
+		{
+			MethodName: "Providers",
+			Handler:    _Query_Providers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/marketmap/v2/query.proto",
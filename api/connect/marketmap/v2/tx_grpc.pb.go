@@ -19,12 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/CreateMarkets"
-	Msg_UpdateMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/UpdateMarkets"
-	Msg_UpdateParams_FullMethodName            = "/connect.marketmap.v2.Msg/UpdateParams"
-	Msg_RemoveMarketAuthorities_FullMethodName = "/connect.marketmap.v2.Msg/RemoveMarketAuthorities"
-	Msg_UpsertMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/UpsertMarkets"
-	Msg_RemoveMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/RemoveMarkets"
+	Msg_CreateMarkets_FullMethodName                = "/connect.marketmap.v2.Msg/CreateMarkets"
+	Msg_UpdateMarkets_FullMethodName                = "/connect.marketmap.v2.Msg/UpdateMarkets"
+	Msg_UpdateParams_FullMethodName                 = "/connect.marketmap.v2.Msg/UpdateParams"
+	Msg_RemoveMarketAuthorities_FullMethodName      = "/connect.marketmap.v2.Msg/RemoveMarketAuthorities"
+	Msg_UpsertMarkets_FullMethodName                = "/connect.marketmap.v2.Msg/UpsertMarkets"
+	Msg_RemoveMarkets_FullMethodName                = "/connect.marketmap.v2.Msg/RemoveMarkets"
+	Msg_ScheduleMarketUpdates_FullMethodName        = "/connect.marketmap.v2.Msg/ScheduleMarketUpdates"
+	Msg_ScheduleMarketRemovals_FullMethodName       = "/connect.marketmap.v2.Msg/ScheduleMarketRemovals"
+	Msg_CancelScheduledMarketChanges_FullMethodName = "/connect.marketmap.v2.Msg/CancelScheduledMarketChanges"
+	Msg_SetMarketAuthorityGrants_FullMethodName     = "/connect.marketmap.v2.Msg/SetMarketAuthorityGrants"
+	Msg_RevokeMarketAuthorityGrants_FullMethodName  = "/connect.marketmap.v2.Msg/RevokeMarketAuthorityGrants"
+	Msg_UpsertProviders_FullMethodName              = "/connect.marketmap.v2.Msg/UpsertProviders"
+	Msg_RemoveProviders_FullMethodName              = "/connect.marketmap.v2.Msg/RemoveProviders"
 )
 
 // MsgClient is the client API for Msg service.
@@ -51,6 +58,29 @@ type MsgClient interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules market creates and updates to be applied
+	// at the beginning of a future block. The signer must be a market authority.
+	ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error)
+	// ScheduleMarketRemovals schedules market removals to be applied at the
+	// beginning of a future block. The signer must be the admin.
+	ScheduleMarketRemovals(ctx context.Context, in *MsgScheduleMarketRemovals, opts ...grpc.CallOption) (*MsgScheduleMarketRemovalsResponse, error)
+	// CancelScheduledMarketChanges cancels pending scheduled market changes. The
+	// signer must be the module authority, the admin, or the address that
+	// scheduled each change.
+	CancelScheduledMarketChanges(ctx context.Context, in *MsgCancelScheduledMarketChanges, opts ...grpc.CallOption) (*MsgCancelScheduledMarketChangesResponse, error)
+	// SetMarketAuthorityGrants sets scoped market authority grants, overwriting
+	// any existing grant to the same grantee. The signer must be the module
+	// authority or the admin.
+	SetMarketAuthorityGrants(ctx context.Context, in *MsgSetMarketAuthorityGrants, opts ...grpc.CallOption) (*MsgSetMarketAuthorityGrantsResponse, error)
+	// RevokeMarketAuthorityGrants revokes the scoped market authority grants of
+	// the given grantees. The signer must be the module authority or the admin.
+	RevokeMarketAuthorityGrants(ctx context.Context, in *MsgRevokeMarketAuthorityGrants, opts ...grpc.CallOption) (*MsgRevokeMarketAuthorityGrantsResponse, error)
+	// UpsertProviders creates or updates entries in the registry of known
+	// providers. The signer must be the module authority.
+	UpsertProviders(ctx context.Context, in *MsgUpsertProviders, opts ...grpc.CallOption) (*MsgUpsertProvidersResponse, error)
+	// RemoveProviders removes entries from the registry of known providers. The
+	// signer must be the module authority.
+	RemoveProviders(ctx context.Context, in *MsgRemoveProviders, opts ...grpc.CallOption) (*MsgRemoveProvidersResponse, error)
 }
 
 type msgClient struct {
@@ -121,6 +151,76 @@ func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opt
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMarketUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleMarketRemovals(ctx context.Context, in *MsgScheduleMarketRemovals, opts ...grpc.CallOption) (*MsgScheduleMarketRemovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleMarketRemovalsResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMarketRemovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMarketChanges(ctx context.Context, in *MsgCancelScheduledMarketChanges, opts ...grpc.CallOption) (*MsgCancelScheduledMarketChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledMarketChangesResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledMarketChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMarketAuthorityGrants(ctx context.Context, in *MsgSetMarketAuthorityGrants, opts ...grpc.CallOption) (*MsgSetMarketAuthorityGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMarketAuthorityGrantsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMarketAuthorityGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeMarketAuthorityGrants(ctx context.Context, in *MsgRevokeMarketAuthorityGrants, opts ...grpc.CallOption) (*MsgRevokeMarketAuthorityGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeMarketAuthorityGrantsResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeMarketAuthorityGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpsertProviders(ctx context.Context, in *MsgUpsertProviders, opts ...grpc.CallOption) (*MsgUpsertProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpsertProvidersResponse)
+	err := c.cc.Invoke(ctx, Msg_UpsertProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveProviders(ctx context.Context, in *MsgRemoveProviders, opts ...grpc.CallOption) (*MsgRemoveProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveProvidersResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -145,6 +245,29 @@ type MsgServer interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules market creates and updates to be applied
+	// at the beginning of a future block. The signer must be a market authority.
+	ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error)
+	// ScheduleMarketRemovals schedules market removals to be applied at the
+	// beginning of a future block. The signer must be the admin.
+	ScheduleMarketRemovals(context.Context, *MsgScheduleMarketRemovals) (*MsgScheduleMarketRemovalsResponse, error)
+	// CancelScheduledMarketChanges cancels pending scheduled market changes. The
+	// signer must be the module authority, the admin, or the address that
+	// scheduled each change.
+	CancelScheduledMarketChanges(context.Context, *MsgCancelScheduledMarketChanges) (*MsgCancelScheduledMarketChangesResponse, error)
+	// SetMarketAuthorityGrants sets scoped market authority grants, overwriting
+	// any existing grant to the same grantee. The signer must be the module
+	// authority or the admin.
+	SetMarketAuthorityGrants(context.Context, *MsgSetMarketAuthorityGrants) (*MsgSetMarketAuthorityGrantsResponse, error)
+	// RevokeMarketAuthorityGrants revokes the scoped market authority grants of
+	// the given grantees. The signer must be the module authority or the admin.
+	RevokeMarketAuthorityGrants(context.Context, *MsgRevokeMarketAuthorityGrants) (*MsgRevokeMarketAuthorityGrantsResponse, error)
+	// UpsertProviders creates or updates entries in the registry of known
+	// providers. The signer must be the module authority.
+	UpsertProviders(context.Context, *MsgUpsertProviders) (*MsgUpsertProvidersResponse, error)
+	// RemoveProviders removes entries from the registry of known providers. The
+	// signer must be the module authority.
+	RemoveProviders(context.Context, *MsgRemoveProviders) (*MsgRemoveProvidersResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -173,6 +296,27 @@ func (UnimplementedMsgServer) UpsertMarkets(context.Context, *MsgUpsertMarkets)
 func (UnimplementedMsgServer) RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
 }
+func (UnimplementedMsgServer) ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketUpdates not implemented")
+}
+func (UnimplementedMsgServer) ScheduleMarketRemovals(context.Context, *MsgScheduleMarketRemovals) (*MsgScheduleMarketRemovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketRemovals not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledMarketChanges(context.Context, *MsgCancelScheduledMarketChanges) (*MsgCancelScheduledMarketChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMarketChanges not implemented")
+}
+func (UnimplementedMsgServer) SetMarketAuthorityGrants(context.Context, *MsgSetMarketAuthorityGrants) (*MsgSetMarketAuthorityGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketAuthorityGrants not implemented")
+}
+func (UnimplementedMsgServer) RevokeMarketAuthorityGrants(context.Context, *MsgRevokeMarketAuthorityGrants) (*MsgRevokeMarketAuthorityGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMarketAuthorityGrants not implemented")
+}
+func (UnimplementedMsgServer) UpsertProviders(context.Context, *MsgUpsertProviders) (*MsgUpsertProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProviders not implemented")
+}
+func (UnimplementedMsgServer) RemoveProviders(context.Context, *MsgRemoveProviders) (*MsgRemoveProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProviders not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -302,6 +446,132 @@ func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, req.(*MsgScheduleMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleMarketRemovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketRemovals)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketRemovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMarketRemovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketRemovals(ctx, req.(*MsgScheduleMarketRemovals))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMarketChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMarketChanges)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMarketChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledMarketChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMarketChanges(ctx, req.(*MsgCancelScheduledMarketChanges))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarketAuthorityGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarketAuthorityGrants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarketAuthorityGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMarketAuthorityGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarketAuthorityGrants(ctx, req.(*MsgSetMarketAuthorityGrants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeMarketAuthorityGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeMarketAuthorityGrants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeMarketAuthorityGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeMarketAuthorityGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeMarketAuthorityGrants(ctx, req.(*MsgRevokeMarketAuthorityGrants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpsertProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpsertProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertProviders(ctx, req.(*MsgUpsertProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveProviders(ctx, req.(*MsgRemoveProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -333,6 +603,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMarkets",
 			Handler:    _Msg_RemoveMarkets_Handler,
 		},
+		{
+			MethodName: "ScheduleMarketUpdates",
+			Handler:    _Msg_ScheduleMarketUpdates_Handler,
+		},
+		{
+			MethodName: "ScheduleMarketRemovals",
+			Handler:    _Msg_ScheduleMarketRemovals_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMarketChanges",
+			Handler:    _Msg_CancelScheduledMarketChanges_Handler,
+		},
+		{
+			MethodName: "SetMarketAuthorityGrants",
+			Handler:    _Msg_SetMarketAuthorityGrants_Handler,
+		},
+		{
+			MethodName: "RevokeMarketAuthorityGrants",
+			Handler:    _Msg_RevokeMarketAuthorityGrants_Handler,
+		},
+		{
+			MethodName: "UpsertProviders",
+			Handler:    _Msg_UpsertProviders_Handler,
+		},
+		{
+			MethodName: "RemoveProviders",
+			Handler:    _Msg_RemoveProviders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/marketmap/v2/tx.proto",
@@ -0,0 +1,2068 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package oraclev2
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	v2 "github.com/skip-mev/connect/v2/api/connect/types/v2"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Params                                  protoreflect.MessageDescriptor
+	fd_Params_vote_extension_version           protoreflect.FieldDescriptor
+	fd_Params_previous_vote_extension_version  protoreflect.FieldDescriptor
+	fd_Params_vote_extension_transition_height protoreflect.FieldDescriptor
+	fd_Params_max_price_age_ms                 protoreflect.FieldDescriptor
+	fd_Params_aggregation_method               protoreflect.FieldDescriptor
+	fd_Params_power_threshold_bps              protoreflect.FieldDescriptor
+	fd_Params_min_validators                   protoreflect.FieldDescriptor
+	fd_Params_trim_bps                         protoreflect.FieldDescriptor
+	fd_Params_participation_window_blocks      protoreflect.FieldDescriptor
+	fd_Params_participation_deviation_bps      protoreflect.FieldDescriptor
+	fd_Params_price_update_events              protoreflect.FieldDescriptor
+	fd_Params_price_history_length             protoreflect.FieldDescriptor
+	fd_Params_max_vote_extension_bytes         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_oracle_v2_params_proto_init()
+	md_Params = File_connect_oracle_v2_params_proto.Messages().ByName("Params")
+	fd_Params_vote_extension_version = md_Params.Fields().ByName("vote_extension_version")
+	fd_Params_previous_vote_extension_version = md_Params.Fields().ByName("previous_vote_extension_version")
+	fd_Params_vote_extension_transition_height = md_Params.Fields().ByName("vote_extension_transition_height")
+	fd_Params_max_price_age_ms = md_Params.Fields().ByName("max_price_age_ms")
+	fd_Params_aggregation_method = md_Params.Fields().ByName("aggregation_method")
+	fd_Params_power_threshold_bps = md_Params.Fields().ByName("power_threshold_bps")
+	fd_Params_min_validators = md_Params.Fields().ByName("min_validators")
+	fd_Params_trim_bps = md_Params.Fields().ByName("trim_bps")
+	fd_Params_participation_window_blocks = md_Params.Fields().ByName("participation_window_blocks")
+	fd_Params_participation_deviation_bps = md_Params.Fields().ByName("participation_deviation_bps")
+	fd_Params_price_update_events = md_Params.Fields().ByName("price_update_events")
+	fd_Params_price_history_length = md_Params.Fields().ByName("price_history_length")
+	fd_Params_max_vote_extension_bytes = md_Params.Fields().ByName("max_vote_extension_bytes")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_oracle_v2_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VoteExtensionVersion != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.VoteExtensionVersion)
+		if !f(fd_Params_vote_extension_version, value) {
+			return
+		}
+	}
+	if x.PreviousVoteExtensionVersion != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PreviousVoteExtensionVersion)
+		if !f(fd_Params_previous_vote_extension_version, value) {
+			return
+		}
+	}
+	if x.VoteExtensionTransitionHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VoteExtensionTransitionHeight)
+		if !f(fd_Params_vote_extension_transition_height, value) {
+			return
+		}
+	}
+	if x.MaxPriceAgeMs != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxPriceAgeMs)
+		if !f(fd_Params_max_price_age_ms, value) {
+			return
+		}
+	}
+	if x.AggregationMethod != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AggregationMethod))
+		if !f(fd_Params_aggregation_method, value) {
+			return
+		}
+	}
+	if x.PowerThresholdBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PowerThresholdBps)
+		if !f(fd_Params_power_threshold_bps, value) {
+			return
+		}
+	}
+	if x.MinValidators != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinValidators)
+		if !f(fd_Params_min_validators, value) {
+			return
+		}
+	}
+	if x.TrimBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TrimBps)
+		if !f(fd_Params_trim_bps, value) {
+			return
+		}
+	}
+	if x.ParticipationWindowBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipationWindowBlocks)
+		if !f(fd_Params_participation_window_blocks, value) {
+			return
+		}
+	}
+	if x.ParticipationDeviationBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ParticipationDeviationBps)
+		if !f(fd_Params_participation_deviation_bps, value) {
+			return
+		}
+	}
+	if x.PriceUpdateEvents != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PriceUpdateEvents))
+		if !f(fd_Params_price_update_events, value) {
+			return
+		}
+	}
+	if x.PriceHistoryLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceHistoryLength)
+		if !f(fd_Params_price_history_length, value) {
+			return
+		}
+	}
+	if x.MaxVoteExtensionBytes != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxVoteExtensionBytes)
+		if !f(fd_Params_max_vote_extension_bytes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.vote_extension_version":
+		return x.VoteExtensionVersion != uint32(0)
+	case "connect.oracle.v2.Params.previous_vote_extension_version":
+		return x.PreviousVoteExtensionVersion != uint32(0)
+	case "connect.oracle.v2.Params.vote_extension_transition_height":
+		return x.VoteExtensionTransitionHeight != uint64(0)
+	case "connect.oracle.v2.Params.max_price_age_ms":
+		return x.MaxPriceAgeMs != uint32(0)
+	case "connect.oracle.v2.Params.aggregation_method":
+		return x.AggregationMethod != 0
+	case "connect.oracle.v2.Params.power_threshold_bps":
+		return x.PowerThresholdBps != uint32(0)
+	case "connect.oracle.v2.Params.min_validators":
+		return x.MinValidators != uint32(0)
+	case "connect.oracle.v2.Params.trim_bps":
+		return x.TrimBps != uint32(0)
+	case "connect.oracle.v2.Params.participation_window_blocks":
+		return x.ParticipationWindowBlocks != uint64(0)
+	case "connect.oracle.v2.Params.participation_deviation_bps":
+		return x.ParticipationDeviationBps != uint32(0)
+	case "connect.oracle.v2.Params.price_update_events":
+		return x.PriceUpdateEvents != 0
+	case "connect.oracle.v2.Params.price_history_length":
+		return x.PriceHistoryLength != uint64(0)
+	case "connect.oracle.v2.Params.max_vote_extension_bytes":
+		return x.MaxVoteExtensionBytes != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.vote_extension_version":
+		x.VoteExtensionVersion = uint32(0)
+	case "connect.oracle.v2.Params.previous_vote_extension_version":
+		x.PreviousVoteExtensionVersion = uint32(0)
+	case "connect.oracle.v2.Params.vote_extension_transition_height":
+		x.VoteExtensionTransitionHeight = uint64(0)
+	case "connect.oracle.v2.Params.max_price_age_ms":
+		x.MaxPriceAgeMs = uint32(0)
+	case "connect.oracle.v2.Params.aggregation_method":
+		x.AggregationMethod = 0
+	case "connect.oracle.v2.Params.power_threshold_bps":
+		x.PowerThresholdBps = uint32(0)
+	case "connect.oracle.v2.Params.min_validators":
+		x.MinValidators = uint32(0)
+	case "connect.oracle.v2.Params.trim_bps":
+		x.TrimBps = uint32(0)
+	case "connect.oracle.v2.Params.participation_window_blocks":
+		x.ParticipationWindowBlocks = uint64(0)
+	case "connect.oracle.v2.Params.participation_deviation_bps":
+		x.ParticipationDeviationBps = uint32(0)
+	case "connect.oracle.v2.Params.price_update_events":
+		x.PriceUpdateEvents = 0
+	case "connect.oracle.v2.Params.price_history_length":
+		x.PriceHistoryLength = uint64(0)
+	case "connect.oracle.v2.Params.max_vote_extension_bytes":
+		x.MaxVoteExtensionBytes = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.oracle.v2.Params.vote_extension_version":
+		value := x.VoteExtensionVersion
+		return protoreflect.ValueOfUint32(value)
+	case "connect.oracle.v2.Params.previous_vote_extension_version":
+		value := x.PreviousVoteExtensionVersion
+		return protoreflect.ValueOfUint32(value)
+	case "connect.oracle.v2.Params.vote_extension_transition_height":
+		value := x.VoteExtensionTransitionHeight
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.Params.max_price_age_ms":
+		value := x.MaxPriceAgeMs
+		return protoreflect.ValueOfUint32(value)
+	case "connect.oracle.v2.Params.aggregation_method":
+		value := x.AggregationMethod
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "connect.oracle.v2.Params.power_threshold_bps":
+		value := x.PowerThresholdBps
+		return protoreflect.ValueOfUint32(value)
+	case "connect.oracle.v2.Params.min_validators":
+		value := x.MinValidators
+		return protoreflect.ValueOfUint32(value)
+	case "connect.oracle.v2.Params.trim_bps":
+		value := x.TrimBps
+		return protoreflect.ValueOfUint32(value)
+	case "connect.oracle.v2.Params.participation_window_blocks":
+		value := x.ParticipationWindowBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.Params.participation_deviation_bps":
+		value := x.ParticipationDeviationBps
+		return protoreflect.ValueOfUint32(value)
+	case "connect.oracle.v2.Params.price_update_events":
+		value := x.PriceUpdateEvents
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "connect.oracle.v2.Params.price_history_length":
+		value := x.PriceHistoryLength
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.Params.max_vote_extension_bytes":
+		value := x.MaxVoteExtensionBytes
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.vote_extension_version":
+		x.VoteExtensionVersion = uint32(value.Uint())
+	case "connect.oracle.v2.Params.previous_vote_extension_version":
+		x.PreviousVoteExtensionVersion = uint32(value.Uint())
+	case "connect.oracle.v2.Params.vote_extension_transition_height":
+		x.VoteExtensionTransitionHeight = value.Uint()
+	case "connect.oracle.v2.Params.max_price_age_ms":
+		x.MaxPriceAgeMs = uint32(value.Uint())
+	case "connect.oracle.v2.Params.aggregation_method":
+		x.AggregationMethod = (AggregationMethod)(value.Enum())
+	case "connect.oracle.v2.Params.power_threshold_bps":
+		x.PowerThresholdBps = uint32(value.Uint())
+	case "connect.oracle.v2.Params.min_validators":
+		x.MinValidators = uint32(value.Uint())
+	case "connect.oracle.v2.Params.trim_bps":
+		x.TrimBps = uint32(value.Uint())
+	case "connect.oracle.v2.Params.participation_window_blocks":
+		x.ParticipationWindowBlocks = value.Uint()
+	case "connect.oracle.v2.Params.participation_deviation_bps":
+		x.ParticipationDeviationBps = uint32(value.Uint())
+	case "connect.oracle.v2.Params.price_update_events":
+		x.PriceUpdateEvents = (PriceUpdateEventMode)(value.Enum())
+	case "connect.oracle.v2.Params.price_history_length":
+		x.PriceHistoryLength = value.Uint()
+	case "connect.oracle.v2.Params.max_vote_extension_bytes":
+		x.MaxVoteExtensionBytes = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.vote_extension_version":
+		panic(fmt.Errorf("field vote_extension_version of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.previous_vote_extension_version":
+		panic(fmt.Errorf("field previous_vote_extension_version of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.vote_extension_transition_height":
+		panic(fmt.Errorf("field vote_extension_transition_height of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.max_price_age_ms":
+		panic(fmt.Errorf("field max_price_age_ms of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.aggregation_method":
+		panic(fmt.Errorf("field aggregation_method of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.power_threshold_bps":
+		panic(fmt.Errorf("field power_threshold_bps of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.min_validators":
+		panic(fmt.Errorf("field min_validators of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.trim_bps":
+		panic(fmt.Errorf("field trim_bps of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.participation_window_blocks":
+		panic(fmt.Errorf("field participation_window_blocks of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.participation_deviation_bps":
+		panic(fmt.Errorf("field participation_deviation_bps of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.price_update_events":
+		panic(fmt.Errorf("field price_update_events of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.price_history_length":
+		panic(fmt.Errorf("field price_history_length of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.max_vote_extension_bytes":
+		panic(fmt.Errorf("field max_vote_extension_bytes of message connect.oracle.v2.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.vote_extension_version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.oracle.v2.Params.previous_vote_extension_version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.oracle.v2.Params.vote_extension_transition_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.Params.max_price_age_ms":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.oracle.v2.Params.aggregation_method":
+		return protoreflect.ValueOfEnum(0)
+	case "connect.oracle.v2.Params.power_threshold_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.oracle.v2.Params.min_validators":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.oracle.v2.Params.trim_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.oracle.v2.Params.participation_window_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.Params.participation_deviation_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.oracle.v2.Params.price_update_events":
+		return protoreflect.ValueOfEnum(0)
+	case "connect.oracle.v2.Params.price_history_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.Params.max_vote_extension_bytes":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.oracle.v2.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.VoteExtensionVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.VoteExtensionVersion))
+		}
+		if x.PreviousVoteExtensionVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousVoteExtensionVersion))
+		}
+		if x.VoteExtensionTransitionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.VoteExtensionTransitionHeight))
+		}
+		if x.MaxPriceAgeMs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceAgeMs))
+		}
+		if x.AggregationMethod != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregationMethod))
+		}
+		if x.PowerThresholdBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.PowerThresholdBps))
+		}
+		if x.MinValidators != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinValidators))
+		}
+		if x.TrimBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrimBps))
+		}
+		if x.ParticipationWindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipationWindowBlocks))
+		}
+		if x.ParticipationDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipationDeviationBps))
+		}
+		if x.PriceUpdateEvents != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceUpdateEvents))
+		}
+		if x.PriceHistoryLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistoryLength))
+		}
+		if x.MaxVoteExtensionBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxVoteExtensionBytes))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxVoteExtensionBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxVoteExtensionBytes))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.PriceHistoryLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistoryLength))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.PriceUpdateEvents != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceUpdateEvents))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ParticipationDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipationDeviationBps))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ParticipationWindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipationWindowBlocks))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.TrimBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrimBps))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MinValidators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinValidators))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.PowerThresholdBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PowerThresholdBps))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.AggregationMethod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregationMethod))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxPriceAgeMs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceAgeMs))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.VoteExtensionTransitionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoteExtensionTransitionHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PreviousVoteExtensionVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousVoteExtensionVersion))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.VoteExtensionVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoteExtensionVersion))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionVersion", wireType)
+				}
+				x.VoteExtensionVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VoteExtensionVersion |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousVoteExtensionVersion", wireType)
+				}
+				x.PreviousVoteExtensionVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousVoteExtensionVersion |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionTransitionHeight", wireType)
+				}
+				x.VoteExtensionTransitionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VoteExtensionTransitionHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAgeMs", wireType)
+				}
+				x.MaxPriceAgeMs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceAgeMs |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+				}
+				x.AggregationMethod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PowerThresholdBps", wireType)
+				}
+				x.PowerThresholdBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PowerThresholdBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+				}
+				x.MinValidators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinValidators |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrimBps", wireType)
+				}
+				x.TrimBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrimBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationWindowBlocks", wireType)
+				}
+				x.ParticipationWindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipationWindowBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationDeviationBps", wireType)
+				}
+				x.ParticipationDeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipationDeviationBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceUpdateEvents", wireType)
+				}
+				x.PriceUpdateEvents = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceUpdateEvents |= PriceUpdateEventMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+				}
+				x.PriceHistoryLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistoryLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVoteExtensionBytes", wireType)
+				}
+				x.MaxVoteExtensionBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxVoteExtensionBytes |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketQuorum                     protoreflect.MessageDescriptor
+	fd_MarketQuorum_currency_pair       protoreflect.FieldDescriptor
+	fd_MarketQuorum_power_threshold_bps protoreflect.FieldDescriptor
+	fd_MarketQuorum_min_validators      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_oracle_v2_params_proto_init()
+	md_MarketQuorum = File_connect_oracle_v2_params_proto.Messages().ByName("MarketQuorum")
+	fd_MarketQuorum_currency_pair = md_MarketQuorum.Fields().ByName("currency_pair")
+	fd_MarketQuorum_power_threshold_bps = md_MarketQuorum.Fields().ByName("power_threshold_bps")
+	fd_MarketQuorum_min_validators = md_MarketQuorum.Fields().ByName("min_validators")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketQuorum)(nil)
+
+type fastReflection_MarketQuorum MarketQuorum
+
+func (x *MarketQuorum) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketQuorum)(x)
+}
+
+func (x *MarketQuorum) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_oracle_v2_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketQuorum_messageType fastReflection_MarketQuorum_messageType
+var _ protoreflect.MessageType = fastReflection_MarketQuorum_messageType{}
+
+type fastReflection_MarketQuorum_messageType struct{}
+
+func (x fastReflection_MarketQuorum_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketQuorum)(nil)
+}
+func (x fastReflection_MarketQuorum_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketQuorum)
+}
+func (x fastReflection_MarketQuorum_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketQuorum
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketQuorum) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketQuorum
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketQuorum) Type() protoreflect.MessageType {
+	return _fastReflection_MarketQuorum_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketQuorum) New() protoreflect.Message {
+	return new(fastReflection_MarketQuorum)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketQuorum) Interface() protoreflect.ProtoMessage {
+	return (*MarketQuorum)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketQuorum) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_MarketQuorum_currency_pair, value) {
+			return
+		}
+	}
+	if x.PowerThresholdBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PowerThresholdBps)
+		if !f(fd_MarketQuorum_power_threshold_bps, value) {
+			return
+		}
+	}
+	if x.MinValidators != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinValidators)
+		if !f(fd_MarketQuorum_min_validators, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketQuorum) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.oracle.v2.MarketQuorum.currency_pair":
+		return x.CurrencyPair != nil
+	case "connect.oracle.v2.MarketQuorum.power_threshold_bps":
+		return x.PowerThresholdBps != uint32(0)
+	case "connect.oracle.v2.MarketQuorum.min_validators":
+		return x.MinValidators != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.MarketQuorum"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.MarketQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketQuorum) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.MarketQuorum.currency_pair":
+		x.CurrencyPair = nil
+	case "connect.oracle.v2.MarketQuorum.power_threshold_bps":
+		x.PowerThresholdBps = uint32(0)
+	case "connect.oracle.v2.MarketQuorum.min_validators":
+		x.MinValidators = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.MarketQuorum"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.MarketQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketQuorum) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.oracle.v2.MarketQuorum.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "connect.oracle.v2.MarketQuorum.power_threshold_bps":
+		value := x.PowerThresholdBps
+		return protoreflect.ValueOfUint32(value)
+	case "connect.oracle.v2.MarketQuorum.min_validators":
+		value := x.MinValidators
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.MarketQuorum"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.MarketQuorum does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketQuorum) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.MarketQuorum.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v2.CurrencyPair)
+	case "connect.oracle.v2.MarketQuorum.power_threshold_bps":
+		x.PowerThresholdBps = uint32(value.Uint())
+	case "connect.oracle.v2.MarketQuorum.min_validators":
+		x.MinValidators = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.MarketQuorum"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.MarketQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketQuorum) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.MarketQuorum.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v2.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+	case "connect.oracle.v2.MarketQuorum.power_threshold_bps":
+		panic(fmt.Errorf("field power_threshold_bps of message connect.oracle.v2.MarketQuorum is not mutable"))
+	case "connect.oracle.v2.MarketQuorum.min_validators":
+		panic(fmt.Errorf("field min_validators of message connect.oracle.v2.MarketQuorum is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.MarketQuorum"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.MarketQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketQuorum) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.MarketQuorum.currency_pair":
+		m := new(v2.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "connect.oracle.v2.MarketQuorum.power_threshold_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.oracle.v2.MarketQuorum.min_validators":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.MarketQuorum"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.MarketQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketQuorum) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.oracle.v2.MarketQuorum", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketQuorum) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketQuorum) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketQuorum) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketQuorum) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketQuorum)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PowerThresholdBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.PowerThresholdBps))
+		}
+		if x.MinValidators != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinValidators))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketQuorum)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinValidators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinValidators))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PowerThresholdBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PowerThresholdBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketQuorum)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketQuorum: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketQuorum: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v2.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PowerThresholdBps", wireType)
+				}
+				x.PowerThresholdBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PowerThresholdBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+				}
+				x.MinValidators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinValidators |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/oracle/v2/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AggregationMethod defines the function used to aggregate the prices reported
+// by validators in their vote extensions into a single on-chain price.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN computes the stake-weighted median
+	// of the reported prices. This is the default.
+	AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN AggregationMethod = 0
+	// AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN computes the stake-weighted
+	// mean of the reported prices after trimming TrimBps of the reporting stake
+	// from each tail.
+	AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN AggregationMethod = 1
+)
+
+// Enum value maps for AggregationMethod.
+var (
+	AggregationMethod_name = map[int32]string{
+		0: "AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN",
+		1: "AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN",
+	}
+	AggregationMethod_value = map[string]int32{
+		"AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN":       0,
+		"AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN": 1,
+	}
+)
+
+func (x AggregationMethod) Enum() *AggregationMethod {
+	p := new(AggregationMethod)
+	*p = x
+	return p
+}
+
+func (x AggregationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_oracle_v2_params_proto_enumTypes[0].Descriptor()
+}
+
+func (AggregationMethod) Type() protoreflect.EnumType {
+	return &file_connect_oracle_v2_params_proto_enumTypes[0]
+}
+
+func (x AggregationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregationMethod.Descriptor instead.
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_params_proto_rawDescGZIP(), []int{0}
+}
+
+// PriceUpdateEventMode defines the events emitted when prices are written to
+// state.
+type PriceUpdateEventMode int32
+
+const (
+	// PRICE_UPDATE_EVENT_MODE_DISABLED emits no price update events. This is the
+	// default.
+	PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_DISABLED PriceUpdateEventMode = 0
+	// PRICE_UPDATE_EVENT_MODE_PER_PRICE emits an EventPriceUpdate for each price
+	// written to state.
+	PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_PER_PRICE PriceUpdateEventMode = 1
+	// PRICE_UPDATE_EVENT_MODE_AGGREGATED emits a single EventPriceUpdates per
+	// block with all of the prices written to state.
+	PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_AGGREGATED PriceUpdateEventMode = 2
+)
+
+// Enum value maps for PriceUpdateEventMode.
+var (
+	PriceUpdateEventMode_name = map[int32]string{
+		0: "PRICE_UPDATE_EVENT_MODE_DISABLED",
+		1: "PRICE_UPDATE_EVENT_MODE_PER_PRICE",
+		2: "PRICE_UPDATE_EVENT_MODE_AGGREGATED",
+	}
+	PriceUpdateEventMode_value = map[string]int32{
+		"PRICE_UPDATE_EVENT_MODE_DISABLED":   0,
+		"PRICE_UPDATE_EVENT_MODE_PER_PRICE":  1,
+		"PRICE_UPDATE_EVENT_MODE_AGGREGATED": 2,
+	}
+)
+
+func (x PriceUpdateEventMode) Enum() *PriceUpdateEventMode {
+	p := new(PriceUpdateEventMode)
+	*p = x
+	return p
+}
+
+func (x PriceUpdateEventMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceUpdateEventMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_oracle_v2_params_proto_enumTypes[1].Descriptor()
+}
+
+func (PriceUpdateEventMode) Type() protoreflect.EnumType {
+	return &file_connect_oracle_v2_params_proto_enumTypes[1]
+}
+
+func (x PriceUpdateEventMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceUpdateEventMode.Descriptor instead.
+func (PriceUpdateEventMode) EnumDescriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_params_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the parameters for the x/oracle module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VoteExtensionVersion is the version of the vote extension (codec +
+	// currency pair strategy) that validators are expected to produce.
+	VoteExtensionVersion uint32 `protobuf:"varint,1,opt,name=vote_extension_version,json=voteExtensionVersion,proto3" json:"vote_extension_version,omitempty"`
+	// PreviousVoteExtensionVersion is the vote extension version that is being
+	// phased out. Vote extensions with this version are accepted alongside the
+	// current version until the VoteExtensionTransitionHeight is reached.
+	PreviousVoteExtensionVersion uint32 `protobuf:"varint,2,opt,name=previous_vote_extension_version,json=previousVoteExtensionVersion,proto3" json:"previous_vote_extension_version,omitempty"`
+	// VoteExtensionTransitionHeight is the block height (exclusive) until which
+	// vote extensions created with either the current or previous vote extension
+	// version are accepted. Vote extensions are checked against the height they
+	// were created at, so vote extensions created at the previous height are
+	// still accepted at the transition height. A value of zero indicates that no
+	// transition is in progress.
+	VoteExtensionTransitionHeight uint64 `protobuf:"varint,3,opt,name=vote_extension_transition_height,json=voteExtensionTransitionHeight,proto3" json:"vote_extension_transition_height,omitempty"`
+	// MaxPriceAgeMs is the maximum age, in milliseconds, of a price reported in
+	// a vote extension for it to be included in price aggregation. Prices that
+	// do not report an age are always included. A value of zero disables the
+	// check.
+	MaxPriceAgeMs uint32 `protobuf:"varint,4,opt,name=max_price_age_ms,json=maxPriceAgeMs,proto3" json:"max_price_age_ms,omitempty"`
+	// AggregationMethod is the function used to aggregate validator prices.
+	AggregationMethod AggregationMethod `protobuf:"varint,5,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=connect.oracle.v2.AggregationMethod" json:"aggregation_method,omitempty"`
+	// PowerThresholdBps is the minimum share of the total bonded stake, in basis
+	// points, that must report a price for a currency pair for it to be
+	// aggregated. A value of zero uses the default threshold of 2/3.
+	PowerThresholdBps uint32 `protobuf:"varint,6,opt,name=power_threshold_bps,json=powerThresholdBps,proto3" json:"power_threshold_bps,omitempty"`
+	// MinValidators is the minimum number of distinct validators that must report
+	// a price for a currency pair for it to be aggregated, in addition to the
+	// power threshold. A value of zero disables the check.
+	MinValidators uint32 `protobuf:"varint,7,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// TrimBps is the share of the reporting stake, in basis points, that is
+	// trimmed from each tail when using the stake-weighted trimmed mean. Must be
+	// less than 5000.
+	TrimBps uint32 `protobuf:"varint,8,opt,name=trim_bps,json=trimBps,proto3" json:"trim_bps,omitempty"`
+	// ParticipationWindowBlocks is the number of blocks, counted per validator
+	// over the blocks in which it was part of the decided commit, over which
+	// validator participation in price updates is tracked. A value of zero
+	// disables participation tracking. Changing this value resets all tracked
+	// participation.
+	ParticipationWindowBlocks uint64 `protobuf:"varint,9,opt,name=participation_window_blocks,json=participationWindowBlocks,proto3" json:"participation_window_blocks,omitempty"`
+	// ParticipationDeviationBps is the deviation, in basis points, from the
+	// aggregated price above which a validator's reported price is recorded as
+	// deviated. A value of zero disables the check.
+	ParticipationDeviationBps uint32 `protobuf:"varint,10,opt,name=participation_deviation_bps,json=participationDeviationBps,proto3" json:"participation_deviation_bps,omitempty"`
+	// PriceUpdateEvents controls the events emitted when prices are written to
+	// state.
+	PriceUpdateEvents PriceUpdateEventMode `protobuf:"varint,11,opt,name=price_update_events,json=priceUpdateEvents,proto3,enum=connect.oracle.v2.PriceUpdateEventMode" json:"price_update_events,omitempty"`
+	// PriceHistoryLength is the number of historical prices, and the
+	// PriceAccumulators used to compute TWAPs over past intervals, retained per
+	// currency pair. Older prices are pruned at the beginning of each block. A
+	// value of zero disables price history.
+	PriceHistoryLength uint64 `protobuf:"varint,12,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	// MaxVoteExtensionBytes is the maximum size, in bytes, of an encoded vote
+	// extension. Validators drop the prices of their lowest priority markets,
+	// as given by the priority in the marketmap ticker metadata, until their
+	// vote extension fits, and vote extensions exceeding the size are rejected.
+	// A value of zero disables the limit.
+	MaxVoteExtensionBytes uint32 `protobuf:"varint,13,opt,name=max_vote_extension_bytes,json=maxVoteExtensionBytes,proto3" json:"max_vote_extension_bytes,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_oracle_v2_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetVoteExtensionVersion() uint32 {
+	if x != nil {
+		return x.VoteExtensionVersion
+	}
+	return 0
+}
+
+func (x *Params) GetPreviousVoteExtensionVersion() uint32 {
+	if x != nil {
+		return x.PreviousVoteExtensionVersion
+	}
+	return 0
+}
+
+func (x *Params) GetVoteExtensionTransitionHeight() uint64 {
+	if x != nil {
+		return x.VoteExtensionTransitionHeight
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceAgeMs() uint32 {
+	if x != nil {
+		return x.MaxPriceAgeMs
+	}
+	return 0
+}
+
+func (x *Params) GetAggregationMethod() AggregationMethod {
+	if x != nil {
+		return x.AggregationMethod
+	}
+	return AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN
+}
+
+func (x *Params) GetPowerThresholdBps() uint32 {
+	if x != nil {
+		return x.PowerThresholdBps
+	}
+	return 0
+}
+
+func (x *Params) GetMinValidators() uint32 {
+	if x != nil {
+		return x.MinValidators
+	}
+	return 0
+}
+
+func (x *Params) GetTrimBps() uint32 {
+	if x != nil {
+		return x.TrimBps
+	}
+	return 0
+}
+
+func (x *Params) GetParticipationWindowBlocks() uint64 {
+	if x != nil {
+		return x.ParticipationWindowBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetParticipationDeviationBps() uint32 {
+	if x != nil {
+		return x.ParticipationDeviationBps
+	}
+	return 0
+}
+
+func (x *Params) GetPriceUpdateEvents() PriceUpdateEventMode {
+	if x != nil {
+		return x.PriceUpdateEvents
+	}
+	return PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_DISABLED
+}
+
+func (x *Params) GetPriceHistoryLength() uint64 {
+	if x != nil {
+		return x.PriceHistoryLength
+	}
+	return 0
+}
+
+func (x *Params) GetMaxVoteExtensionBytes() uint32 {
+	if x != nil {
+		return x.MaxVoteExtensionBytes
+	}
+	return 0
+}
+
+// MarketQuorum defines the quorum that validator votes for a single currency
+// pair must reach for a price to be aggregated. When set for a currency pair,
+// it replaces the module-wide PowerThresholdBps and MinValidators in Params.
+type MarketQuorum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CurrencyPair is the currency pair that this quorum applies to.
+	CurrencyPair *v2.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// PowerThresholdBps is the minimum share of the total bonded stake, in basis
+	// points, that must report a price for the currency pair. Must be non-zero.
+	PowerThresholdBps uint32 `protobuf:"varint,2,opt,name=power_threshold_bps,json=powerThresholdBps,proto3" json:"power_threshold_bps,omitempty"`
+	// MinValidators is the minimum number of distinct validators that must report
+	// a price for the currency pair. A value of zero disables the check.
+	MinValidators uint32 `protobuf:"varint,3,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+}
+
+func (x *MarketQuorum) Reset() {
+	*x = MarketQuorum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_oracle_v2_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketQuorum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketQuorum) ProtoMessage() {}
+
+// Deprecated: Use MarketQuorum.ProtoReflect.Descriptor instead.
+func (*MarketQuorum) Descriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarketQuorum) GetCurrencyPair() *v2.CurrencyPair {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return nil
+}
+
+func (x *MarketQuorum) GetPowerThresholdBps() uint32 {
+	if x != nil {
+		return x.PowerThresholdBps
+	}
+	return 0
+}
+
+func (x *MarketQuorum) GetMinValidators() uint32 {
+	if x != nil {
+		return x.MinValidators
+	}
+	return 0
+}
+
+var File_connect_oracle_v2_params_proto protoreflect.FileDescriptor
+
+var file_connect_oracle_v2_params_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x32, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x82, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x76, 0x6f, 0x74, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x45, 0x0a, 0x1f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x1d, 0x76, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x67,
+	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x41, 0x67, 0x65, 0x4d, 0x73, 0x12, 0x53, 0x0a, 0x12, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x70, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x62, 0x70,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x69, 0x6d, 0x42, 0x70, 0x73,
+	0x12, 0x3e, 0x0a, 0x1b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x3e, 0x0a, 0x1b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73,
+	0x12, 0x57, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x18, 0x6d,
+	0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d,
+	0x61, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x51,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x2e, 0x0a, 0x13, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x70, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2a, 0x75, 0x0a, 0x11, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x28,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45,
+	0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x32, 0x0a, 0x2e, 0x41, 0x47,
+	0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
+	0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f,
+	0x54, 0x52, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x2a, 0x8b,
+	0x01, 0x0a, 0x14, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x49, 0x43, 0x45,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a,
+	0x21, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x42, 0xb7, 0x01, 0x0a,
+	0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x32,
+	0xe2, 0x02, 0x1d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_connect_oracle_v2_params_proto_rawDescOnce sync.Once
+	file_connect_oracle_v2_params_proto_rawDescData = file_connect_oracle_v2_params_proto_rawDesc
+)
+
+func file_connect_oracle_v2_params_proto_rawDescGZIP() []byte {
+	file_connect_oracle_v2_params_proto_rawDescOnce.Do(func() {
+		file_connect_oracle_v2_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_oracle_v2_params_proto_rawDescData)
+	})
+	return file_connect_oracle_v2_params_proto_rawDescData
+}
+
+var file_connect_oracle_v2_params_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_connect_oracle_v2_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_connect_oracle_v2_params_proto_goTypes = []interface{}{
+	(AggregationMethod)(0),    // 0: connect.oracle.v2.AggregationMethod
+	(PriceUpdateEventMode)(0), // 1: connect.oracle.v2.PriceUpdateEventMode
+	(*Params)(nil),            // 2: connect.oracle.v2.Params
+	(*MarketQuorum)(nil),      // 3: connect.oracle.v2.MarketQuorum
+	(*v2.CurrencyPair)(nil),   // 4: connect.types.v2.CurrencyPair
+}
+var file_connect_oracle_v2_params_proto_depIdxs = []int32{
+	0, // 0: connect.oracle.v2.Params.aggregation_method:type_name -> connect.oracle.v2.AggregationMethod
+	1, // 1: connect.oracle.v2.Params.price_update_events:type_name -> connect.oracle.v2.PriceUpdateEventMode
+	4, // 2: connect.oracle.v2.MarketQuorum.currency_pair:type_name -> connect.types.v2.CurrencyPair
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_connect_oracle_v2_params_proto_init() }
+func file_connect_oracle_v2_params_proto_init() {
+	if File_connect_oracle_v2_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_connect_oracle_v2_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_oracle_v2_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketQuorum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_connect_oracle_v2_params_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_connect_oracle_v2_params_proto_goTypes,
+		DependencyIndexes: file_connect_oracle_v2_params_proto_depIdxs,
+		EnumInfos:         file_connect_oracle_v2_params_proto_enumTypes,
+		MessageInfos:      file_connect_oracle_v2_params_proto_msgTypes,
+	}.Build()
+	File_connect_oracle_v2_params_proto = out.File
+	file_connect_oracle_v2_params_proto_rawDesc = nil
+	file_connect_oracle_v2_params_proto_goTypes = nil
+	file_connect_oracle_v2_params_proto_depIdxs = nil
+}
@@ -22,7 +22,18 @@ const (
 	Query_GetAllCurrencyPairs_FullMethodName    = "/connect.oracle.v2.Query/GetAllCurrencyPairs"
 	Query_GetPrice_FullMethodName               = "/connect.oracle.v2.Query/GetPrice"
 	Query_GetPrices_FullMethodName              = "/connect.oracle.v2.Query/GetPrices"
+	Query_GetPriceHistory_FullMethodName        = "/connect.oracle.v2.Query/GetPriceHistory"
+	Query_GetFreshPrice_FullMethodName          = "/connect.oracle.v2.Query/GetFreshPrice"
+	Query_PriceMaxAges_FullMethodName           = "/connect.oracle.v2.Query/PriceMaxAges"
+	Query_Invariants_FullMethodName             = "/connect.oracle.v2.Query/Invariants"
+	Query_GetTWAP_FullMethodName                = "/connect.oracle.v2.Query/GetTWAP"
 	Query_GetCurrencyPairMapping_FullMethodName = "/connect.oracle.v2.Query/GetCurrencyPairMapping"
+	Query_Params_FullMethodName                 = "/connect.oracle.v2.Query/Params"
+	Query_MarketQuorums_FullMethodName          = "/connect.oracle.v2.Query/MarketQuorums"
+	Query_CircuitBreakers_FullMethodName        = "/connect.oracle.v2.Query/CircuitBreakers"
+	Query_MarketHalts_FullMethodName            = "/connect.oracle.v2.Query/MarketHalts"
+	Query_ValidatorParticipation_FullMethodName = "/connect.oracle.v2.Query/ValidatorParticipation"
+	Query_MarketParticipation_FullMethodName    = "/connect.oracle.v2.Query/MarketParticipation"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,16 +42,49 @@ const (
 //
 // Query is the query service for the x/oracle module.
 type QueryClient interface {
-	// Get all the currency pairs the x/oracle module is tracking price-data for.
+	// Get all the currency pairs the x/oracle module is tracking price-data for,
+	// optionally filtered and paginated.
 	GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error)
 	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
 	// that CurrencyPair.
 	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
 	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
+	// GetPriceHistory returns the historical prices of a CurrencyPair, ordered
+	// by block height, optionally filtered by block height and block time.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// GetFreshPrice returns the price of a CurrencyPair only if it was updated
+	// within a max age of the current block, and fails otherwise.
+	GetFreshPrice(ctx context.Context, in *GetFreshPriceRequest, opts ...grpc.CallOption) (*GetFreshPriceResponse, error)
+	// PriceMaxAges returns the per-currency-pair default price max ages set in
+	// the x/oracle module.
+	PriceMaxAges(ctx context.Context, in *PriceMaxAgesRequest, opts ...grpc.CallOption) (*PriceMaxAgesResponse, error)
+	// Invariants runs the x/oracle invariants, including the consistency checks
+	// between x/oracle and x/marketmap, and returns the result of each.
+	Invariants(ctx context.Context, in *InvariantsRequest, opts ...grpc.CallOption) (*InvariantsResponse, error)
+	// GetTWAP returns the time weighted average price of a CurrencyPair between
+	// two block times.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
 	// Get the mapping of currency pair ID -> currency pair. This is useful for
 	// indexers that have access to the ID of a currency pair, but no way to get
 	// the underlying currency pair from it.
 	GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// MarketQuorums returns the per-currency-pair quorums set in the x/oracle
+	// module. Currency pairs without a quorum use the quorum in Params.
+	MarketQuorums(ctx context.Context, in *MarketQuorumsRequest, opts ...grpc.CallOption) (*MarketQuorumsResponse, error)
+	// CircuitBreakers returns the per-currency-pair circuit breakers set in the
+	// x/oracle module.
+	CircuitBreakers(ctx context.Context, in *CircuitBreakersRequest, opts ...grpc.CallOption) (*CircuitBreakersResponse, error)
+	// MarketHalts returns the currency pairs whose price updates are halted by
+	// their circuit breakers.
+	MarketHalts(ctx context.Context, in *MarketHaltsRequest, opts ...grpc.CallOption) (*MarketHaltsResponse, error)
+	// ValidatorParticipation returns the participation of a validator in the
+	// price updates of each currency pair over its participation window.
+	ValidatorParticipation(ctx context.Context, in *ValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipationResponse, error)
+	// MarketParticipation returns the participation of each validator in the
+	// price updates of a currency pair over their participation windows.
+	MarketParticipation(ctx context.Context, in *MarketParticipationRequest, opts ...grpc.CallOption) (*MarketParticipationResponse, error)
 }
 
 type queryClient struct {
@@ -81,6 +125,56 @@ func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFreshPrice(ctx context.Context, in *GetFreshPriceRequest, opts ...grpc.CallOption) (*GetFreshPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFreshPriceResponse)
+	err := c.cc.Invoke(ctx, Query_GetFreshPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceMaxAges(ctx context.Context, in *PriceMaxAgesRequest, opts ...grpc.CallOption) (*PriceMaxAgesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceMaxAgesResponse)
+	err := c.cc.Invoke(ctx, Query_PriceMaxAges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *InvariantsRequest, opts ...grpc.CallOption) (*InvariantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvariantsResponse)
+	err := c.cc.Invoke(ctx, Query_Invariants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_GetTWAP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCurrencyPairMappingResponse)
@@ -91,22 +185,115 @@ func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrenc
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketQuorums(ctx context.Context, in *MarketQuorumsRequest, opts ...grpc.CallOption) (*MarketQuorumsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketQuorumsResponse)
+	err := c.cc.Invoke(ctx, Query_MarketQuorums_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *CircuitBreakersRequest, opts ...grpc.CallOption) (*CircuitBreakersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitBreakers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketHalts(ctx context.Context, in *MarketHaltsRequest, opts ...grpc.CallOption) (*MarketHaltsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketHaltsResponse)
+	err := c.cc.Invoke(ctx, Query_MarketHalts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorParticipation(ctx context.Context, in *ValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorParticipation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketParticipation(ctx context.Context, in *MarketParticipationRequest, opts ...grpc.CallOption) (*MarketParticipationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketParticipationResponse)
+	err := c.cc.Invoke(ctx, Query_MarketParticipation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
 //
 // Query is the query service for the x/oracle module.
 type QueryServer interface {
-	// Get all the currency pairs the x/oracle module is tracking price-data for.
+	// Get all the currency pairs the x/oracle module is tracking price-data for,
+	// optionally filtered and paginated.
 	GetAllCurrencyPairs(context.Context, *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error)
 	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
 	// that CurrencyPair.
 	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
 	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
+	// GetPriceHistory returns the historical prices of a CurrencyPair, ordered
+	// by block height, optionally filtered by block height and block time.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// GetFreshPrice returns the price of a CurrencyPair only if it was updated
+	// within a max age of the current block, and fails otherwise.
+	GetFreshPrice(context.Context, *GetFreshPriceRequest) (*GetFreshPriceResponse, error)
+	// PriceMaxAges returns the per-currency-pair default price max ages set in
+	// the x/oracle module.
+	PriceMaxAges(context.Context, *PriceMaxAgesRequest) (*PriceMaxAgesResponse, error)
+	// Invariants runs the x/oracle invariants, including the consistency checks
+	// between x/oracle and x/marketmap, and returns the result of each.
+	Invariants(context.Context, *InvariantsRequest) (*InvariantsResponse, error)
+	// GetTWAP returns the time weighted average price of a CurrencyPair between
+	// two block times.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
 	// Get the mapping of currency pair ID -> currency pair. This is useful for
 	// indexers that have access to the ID of a currency pair, but no way to get
 	// the underlying currency pair from it.
 	GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// MarketQuorums returns the per-currency-pair quorums set in the x/oracle
+	// module. Currency pairs without a quorum use the quorum in Params.
+	MarketQuorums(context.Context, *MarketQuorumsRequest) (*MarketQuorumsResponse, error)
+	// CircuitBreakers returns the per-currency-pair circuit breakers set in the
+	// x/oracle module.
+	CircuitBreakers(context.Context, *CircuitBreakersRequest) (*CircuitBreakersResponse, error)
+	// MarketHalts returns the currency pairs whose price updates are halted by
+	// their circuit breakers.
+	MarketHalts(context.Context, *MarketHaltsRequest) (*MarketHaltsResponse, error)
+	// ValidatorParticipation returns the participation of a validator in the
+	// price updates of each currency pair over its participation window.
+	ValidatorParticipation(context.Context, *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error)
+	// MarketParticipation returns the participation of each validator in the
+	// price updates of a currency pair over their participation windows.
+	MarketParticipation(context.Context, *MarketParticipationRequest) (*MarketParticipationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -126,9 +313,42 @@ func (UnimplementedQueryServer) GetPrice(context.Context, *GetPriceRequest) (*Ge
 func (UnimplementedQueryServer) GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
 }
+func (UnimplementedQueryServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) GetFreshPrice(context.Context, *GetFreshPriceRequest) (*GetFreshPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreshPrice not implemented")
+}
+func (UnimplementedQueryServer) PriceMaxAges(context.Context, *PriceMaxAgesRequest) (*PriceMaxAgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceMaxAges not implemented")
+}
+func (UnimplementedQueryServer) Invariants(context.Context, *InvariantsRequest) (*InvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (UnimplementedQueryServer) GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
 func (UnimplementedQueryServer) GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMapping not implemented")
 }
+func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) MarketQuorums(context.Context, *MarketQuorumsRequest) (*MarketQuorumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketQuorums not implemented")
+}
+func (UnimplementedQueryServer) CircuitBreakers(context.Context, *CircuitBreakersRequest) (*CircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
+func (UnimplementedQueryServer) MarketHalts(context.Context, *MarketHaltsRequest) (*MarketHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketHalts not implemented")
+}
+func (UnimplementedQueryServer) ValidatorParticipation(context.Context, *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorParticipation not implemented")
+}
+func (UnimplementedQueryServer) MarketParticipation(context.Context, *MarketParticipationRequest) (*MarketParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketParticipation not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -204,6 +424,96 @@ func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFreshPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreshPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFreshPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetFreshPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFreshPrice(ctx, req.(*GetFreshPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceMaxAges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceMaxAgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceMaxAges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceMaxAges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceMaxAges(ctx, req.(*PriceMaxAgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Invariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*InvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrencyPairMappingRequest)
 	if err := dec(in); err != nil {
@@ -222,6 +532,114 @@ func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketQuorums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketQuorumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketQuorums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketQuorums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketQuorums(ctx, req.(*MarketQuorumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*CircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketHaltsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketHalts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketHalts(ctx, req.(*MarketHaltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorParticipation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorParticipation(ctx, req.(*ValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketParticipation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketParticipation(ctx, req.(*MarketParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,10 +659,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrices",
 			Handler:    _Query_GetPrices_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetFreshPrice",
+			Handler:    _Query_GetFreshPrice_Handler,
+		},
+		{
+			MethodName: "PriceMaxAges",
+			Handler:    _Query_PriceMaxAges_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
 		{
 			MethodName: "GetCurrencyPairMapping",
 			Handler:    _Query_GetCurrencyPairMapping_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "MarketQuorums",
+			Handler:    _Query_MarketQuorums_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
+		{
+			MethodName: "MarketHalts",
+			Handler:    _Query_MarketHalts_Handler,
+		},
+		{
+			MethodName: "ValidatorParticipation",
+			Handler:    _Query_ValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "MarketParticipation",
+			Handler:    _Query_MarketParticipation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/query.proto",
@@ -19,8 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_AddCurrencyPairs_FullMethodName    = "/connect.oracle.v2.Msg/AddCurrencyPairs"
-	Msg_RemoveCurrencyPairs_FullMethodName = "/connect.oracle.v2.Msg/RemoveCurrencyPairs"
+	Msg_AddCurrencyPairs_FullMethodName      = "/connect.oracle.v2.Msg/AddCurrencyPairs"
+	Msg_RemoveCurrencyPairs_FullMethodName   = "/connect.oracle.v2.Msg/RemoveCurrencyPairs"
+	Msg_UpdateParams_FullMethodName          = "/connect.oracle.v2.Msg/UpdateParams"
+	Msg_SetMarketQuorums_FullMethodName      = "/connect.oracle.v2.Msg/SetMarketQuorums"
+	Msg_RemoveMarketQuorums_FullMethodName   = "/connect.oracle.v2.Msg/RemoveMarketQuorums"
+	Msg_SetCircuitBreakers_FullMethodName    = "/connect.oracle.v2.Msg/SetCircuitBreakers"
+	Msg_RemoveCircuitBreakers_FullMethodName = "/connect.oracle.v2.Msg/RemoveCircuitBreakers"
+	Msg_ClearMarketHalts_FullMethodName      = "/connect.oracle.v2.Msg/ClearMarketHalts"
+	Msg_SetPriceMaxAges_FullMethodName       = "/connect.oracle.v2.Msg/SetPriceMaxAges"
+	Msg_RemovePriceMaxAges_FullMethodName    = "/connect.oracle.v2.Msg/RemovePriceMaxAges"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +45,35 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetMarketQuorums will be used only by governance to set the quorum that
+	// validator votes must reach for each of the given currency pairs. Existing
+	// quorums for these currency pairs are overwritten.
+	SetMarketQuorums(ctx context.Context, in *MsgSetMarketQuorums, opts ...grpc.CallOption) (*MsgSetMarketQuorumsResponse, error)
+	// RemoveMarketQuorums will be used only by governance to remove the quorums
+	// set for the given currency pairs, after which they fall back to the
+	// module-wide quorum in Params.
+	RemoveMarketQuorums(ctx context.Context, in *MsgRemoveMarketQuorums, opts ...grpc.CallOption) (*MsgRemoveMarketQuorumsResponse, error)
+	// SetCircuitBreakers will be used only by governance to set the circuit
+	// breaker for each of the given currency pairs. Existing circuit breakers
+	// for these currency pairs are overwritten.
+	SetCircuitBreakers(ctx context.Context, in *MsgSetCircuitBreakers, opts ...grpc.CallOption) (*MsgSetCircuitBreakersResponse, error)
+	// RemoveCircuitBreakers will be used only by governance to remove the
+	// circuit breakers set for the given currency pairs.
+	RemoveCircuitBreakers(ctx context.Context, in *MsgRemoveCircuitBreakers, opts ...grpc.CallOption) (*MsgRemoveCircuitBreakersResponse, error)
+	// ClearMarketHalts will be used by governance or an x/marketmap market
+	// authority to resume price updates for currency pairs halted by their
+	// circuit breakers.
+	ClearMarketHalts(ctx context.Context, in *MsgClearMarketHalts, opts ...grpc.CallOption) (*MsgClearMarketHaltsResponse, error)
+	// SetPriceMaxAges will be used only by governance to set the default max
+	// age of the price of each of the given currency pairs. Existing max ages
+	// for these currency pairs are overwritten.
+	SetPriceMaxAges(ctx context.Context, in *MsgSetPriceMaxAges, opts ...grpc.CallOption) (*MsgSetPriceMaxAgesResponse, error)
+	// RemovePriceMaxAges will be used only by governance to remove the default
+	// max ages set for the given currency pairs.
+	RemovePriceMaxAges(ctx context.Context, in *MsgRemovePriceMaxAges, opts ...grpc.CallOption) (*MsgRemovePriceMaxAgesResponse, error)
 }
 
 type msgClient struct {
@@ -67,6 +104,86 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMarketQuorums(ctx context.Context, in *MsgSetMarketQuorums, opts ...grpc.CallOption) (*MsgSetMarketQuorumsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMarketQuorumsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMarketQuorums_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMarketQuorums(ctx context.Context, in *MsgRemoveMarketQuorums, opts ...grpc.CallOption) (*MsgRemoveMarketQuorumsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveMarketQuorumsResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveMarketQuorums_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCircuitBreakers(ctx context.Context, in *MsgSetCircuitBreakers, opts ...grpc.CallOption) (*MsgSetCircuitBreakersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, Msg_SetCircuitBreakers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveCircuitBreakers(ctx context.Context, in *MsgRemoveCircuitBreakers, opts ...grpc.CallOption) (*MsgRemoveCircuitBreakersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveCircuitBreakers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearMarketHalts(ctx context.Context, in *MsgClearMarketHalts, opts ...grpc.CallOption) (*MsgClearMarketHaltsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClearMarketHaltsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClearMarketHalts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPriceMaxAges(ctx context.Context, in *MsgSetPriceMaxAges, opts ...grpc.CallOption) (*MsgSetPriceMaxAgesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPriceMaxAgesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPriceMaxAges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemovePriceMaxAges(ctx context.Context, in *MsgRemovePriceMaxAges, opts ...grpc.CallOption) (*MsgRemovePriceMaxAgesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemovePriceMaxAgesResponse)
+	err := c.cc.Invoke(ctx, Msg_RemovePriceMaxAges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -81,6 +198,35 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetMarketQuorums will be used only by governance to set the quorum that
+	// validator votes must reach for each of the given currency pairs. Existing
+	// quorums for these currency pairs are overwritten.
+	SetMarketQuorums(context.Context, *MsgSetMarketQuorums) (*MsgSetMarketQuorumsResponse, error)
+	// RemoveMarketQuorums will be used only by governance to remove the quorums
+	// set for the given currency pairs, after which they fall back to the
+	// module-wide quorum in Params.
+	RemoveMarketQuorums(context.Context, *MsgRemoveMarketQuorums) (*MsgRemoveMarketQuorumsResponse, error)
+	// SetCircuitBreakers will be used only by governance to set the circuit
+	// breaker for each of the given currency pairs. Existing circuit breakers
+	// for these currency pairs are overwritten.
+	SetCircuitBreakers(context.Context, *MsgSetCircuitBreakers) (*MsgSetCircuitBreakersResponse, error)
+	// RemoveCircuitBreakers will be used only by governance to remove the
+	// circuit breakers set for the given currency pairs.
+	RemoveCircuitBreakers(context.Context, *MsgRemoveCircuitBreakers) (*MsgRemoveCircuitBreakersResponse, error)
+	// ClearMarketHalts will be used by governance or an x/marketmap market
+	// authority to resume price updates for currency pairs halted by their
+	// circuit breakers.
+	ClearMarketHalts(context.Context, *MsgClearMarketHalts) (*MsgClearMarketHaltsResponse, error)
+	// SetPriceMaxAges will be used only by governance to set the default max
+	// age of the price of each of the given currency pairs. Existing max ages
+	// for these currency pairs are overwritten.
+	SetPriceMaxAges(context.Context, *MsgSetPriceMaxAges) (*MsgSetPriceMaxAgesResponse, error)
+	// RemovePriceMaxAges will be used only by governance to remove the default
+	// max ages set for the given currency pairs.
+	RemovePriceMaxAges(context.Context, *MsgRemovePriceMaxAges) (*MsgRemovePriceMaxAgesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -97,6 +243,30 @@ func (UnimplementedMsgServer) AddCurrencyPairs(context.Context, *MsgAddCurrencyP
 func (UnimplementedMsgServer) RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) SetMarketQuorums(context.Context, *MsgSetMarketQuorums) (*MsgSetMarketQuorumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketQuorums not implemented")
+}
+func (UnimplementedMsgServer) RemoveMarketQuorums(context.Context, *MsgRemoveMarketQuorums) (*MsgRemoveMarketQuorumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketQuorums not implemented")
+}
+func (UnimplementedMsgServer) SetCircuitBreakers(context.Context, *MsgSetCircuitBreakers) (*MsgSetCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCircuitBreakers not implemented")
+}
+func (UnimplementedMsgServer) RemoveCircuitBreakers(context.Context, *MsgRemoveCircuitBreakers) (*MsgRemoveCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCircuitBreakers not implemented")
+}
+func (UnimplementedMsgServer) ClearMarketHalts(context.Context, *MsgClearMarketHalts) (*MsgClearMarketHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearMarketHalts not implemented")
+}
+func (UnimplementedMsgServer) SetPriceMaxAges(context.Context, *MsgSetPriceMaxAges) (*MsgSetPriceMaxAgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceMaxAges not implemented")
+}
+func (UnimplementedMsgServer) RemovePriceMaxAges(context.Context, *MsgRemovePriceMaxAges) (*MsgRemovePriceMaxAgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePriceMaxAges not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -154,6 +324,150 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarketQuorums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarketQuorums)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarketQuorums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMarketQuorums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarketQuorums(ctx, req.(*MsgSetMarketQuorums))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMarketQuorums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarketQuorums)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarketQuorums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMarketQuorums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarketQuorums(ctx, req.(*MsgRemoveMarketQuorums))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetCircuitBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCircuitBreakers(ctx, req.(*MsgSetCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveCircuitBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveCircuitBreakers(ctx, req.(*MsgRemoveCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearMarketHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearMarketHalts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearMarketHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClearMarketHalts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearMarketHalts(ctx, req.(*MsgClearMarketHalts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPriceMaxAges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPriceMaxAges)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPriceMaxAges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPriceMaxAges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPriceMaxAges(ctx, req.(*MsgSetPriceMaxAges))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePriceMaxAges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePriceMaxAges)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePriceMaxAges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemovePriceMaxAges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePriceMaxAges(ctx, req.(*MsgRemovePriceMaxAges))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -169,6 +483,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetMarketQuorums",
+			Handler:    _Msg_SetMarketQuorums_Handler,
+		},
+		{
+			MethodName: "RemoveMarketQuorums",
+			Handler:    _Msg_RemoveMarketQuorums_Handler,
+		},
+		{
+			MethodName: "SetCircuitBreakers",
+			Handler:    _Msg_SetCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "RemoveCircuitBreakers",
+			Handler:    _Msg_RemoveCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "ClearMarketHalts",
+			Handler:    _Msg_ClearMarketHalts_Handler,
+		},
+		{
+			MethodName: "SetPriceMaxAges",
+			Handler:    _Msg_SetPriceMaxAges_Handler,
+		},
+		{
+			MethodName: "RemovePriceMaxAges",
+			Handler:    _Msg_RemovePriceMaxAges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/tx.proto",
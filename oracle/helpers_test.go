@@ -289,10 +289,17 @@ func (n noOpPriceAggregator) UpdateMarketMap(_ mmtypes.MarketMap) {
 func (n noOpPriceAggregator) AggregatePrices() {
 }
 
+func (n noOpPriceAggregator) SetProviderPriceTimestamps(_ string, _ map[string]time.Time) {
+}
+
 func (n noOpPriceAggregator) GetPrices() oracletypes.Prices {
 	return oracletypes.Prices{}
 }
 
+func (n noOpPriceAggregator) GetPriceMetadata() oracletypes.PricesMetadata {
+	return oracletypes.PricesMetadata{}
+}
+
 func (n noOpPriceAggregator) Reset() {
 }
 
@@ -15,6 +15,7 @@ type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
 	GetPrices() types.Prices
+	GetPriceMetadata() types.PricesMetadata
 	GetMarketMap() mmtypes.MarketMap
 	Start(ctx context.Context) error
 	Stop()
@@ -26,9 +27,11 @@ type Oracle interface {
 //go:generate mockery --name PriceAggregator
 type PriceAggregator interface {
 	SetProviderPrices(provider string, prices types.Prices)
+	SetProviderPriceTimestamps(provider string, timestamps map[string]time.Time)
 	UpdateMarketMap(mmtypes.MarketMap)
 	AggregatePrices()
 	GetPrices() types.Prices
+	GetPriceMetadata() types.PricesMetadata
 	Reset()
 }
 
@@ -7,6 +7,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
+
 	types "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
@@ -55,6 +59,53 @@ func (_c *PriceAggregator_AggregatePrices_Call) RunAndReturn(run func()) *PriceA
 	return _c
 }
 
+// GetPriceMetadata provides a mock function with given fields:
+func (_m *PriceAggregator) GetPriceMetadata() map[string]oracletypes.PriceMetadata {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceMetadata")
+	}
+
+	var r0 map[string]oracletypes.PriceMetadata
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.PriceMetadata); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.PriceMetadata)
+		}
+	}
+
+	return r0
+}
+
+// PriceAggregator_GetPriceMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceMetadata'
+type PriceAggregator_GetPriceMetadata_Call struct {
+	*mock.Call
+}
+
+// GetPriceMetadata is a helper method to define mock.On call
+func (_e *PriceAggregator_Expecter) GetPriceMetadata() *PriceAggregator_GetPriceMetadata_Call {
+	return &PriceAggregator_GetPriceMetadata_Call{Call: _e.mock.On("GetPriceMetadata")}
+}
+
+func (_c *PriceAggregator_GetPriceMetadata_Call) Run(run func()) *PriceAggregator_GetPriceMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_GetPriceMetadata_Call) Return(_a0 map[string]oracletypes.PriceMetadata) *PriceAggregator_GetPriceMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceAggregator_GetPriceMetadata_Call) RunAndReturn(run func() map[string]oracletypes.PriceMetadata) *PriceAggregator_GetPriceMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPrices provides a mock function with given fields:
 func (_m *PriceAggregator) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -134,6 +185,40 @@ func (_c *PriceAggregator_Reset_Call) RunAndReturn(run func()) *PriceAggregator_
 	return _c
 }
 
+// SetProviderPriceTimestamps provides a mock function with given fields: provider, timestamps
+func (_m *PriceAggregator) SetProviderPriceTimestamps(provider string, timestamps map[string]time.Time) {
+	_m.Called(provider, timestamps)
+}
+
+// PriceAggregator_SetProviderPriceTimestamps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProviderPriceTimestamps'
+type PriceAggregator_SetProviderPriceTimestamps_Call struct {
+	*mock.Call
+}
+
+// SetProviderPriceTimestamps is a helper method to define mock.On call
+//   - provider string
+//   - timestamps map[string]time.Time
+func (_e *PriceAggregator_Expecter) SetProviderPriceTimestamps(provider interface{}, timestamps interface{}) *PriceAggregator_SetProviderPriceTimestamps_Call {
+	return &PriceAggregator_SetProviderPriceTimestamps_Call{Call: _e.mock.On("SetProviderPriceTimestamps", provider, timestamps)}
+}
+
+func (_c *PriceAggregator_SetProviderPriceTimestamps_Call) Run(run func(provider string, timestamps map[string]time.Time)) *PriceAggregator_SetProviderPriceTimestamps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[string]time.Time))
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_SetProviderPriceTimestamps_Call) Return() *PriceAggregator_SetProviderPriceTimestamps_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceAggregator_SetProviderPriceTimestamps_Call) RunAndReturn(run func(string, map[string]time.Time)) *PriceAggregator_SetProviderPriceTimestamps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetProviderPrices provides a mock function with given fields: provider, prices
 func (_m *PriceAggregator) SetProviderPrices(provider string, prices map[string]*big.Float) {
 	_m.Called(provider, prices)
@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
+
 	time "time"
 
 	types "github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -116,6 +118,53 @@ func (_c *Oracle_GetMarketMap_Call) RunAndReturn(run func() types.MarketMap) *Or
 	return _c
 }
 
+// GetPriceMetadata provides a mock function with given fields:
+func (_m *Oracle) GetPriceMetadata() map[string]oracletypes.PriceMetadata {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceMetadata")
+	}
+
+	var r0 map[string]oracletypes.PriceMetadata
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.PriceMetadata); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.PriceMetadata)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetPriceMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceMetadata'
+type Oracle_GetPriceMetadata_Call struct {
+	*mock.Call
+}
+
+// GetPriceMetadata is a helper method to define mock.On call
+func (_e *Oracle_Expecter) GetPriceMetadata() *Oracle_GetPriceMetadata_Call {
+	return &Oracle_GetPriceMetadata_Call{Call: _e.mock.On("GetPriceMetadata")}
+}
+
+func (_c *Oracle_GetPriceMetadata_Call) Run(run func()) *Oracle_GetPriceMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_GetPriceMetadata_Call) Return(_a0 map[string]oracletypes.PriceMetadata) *Oracle_GetPriceMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetPriceMetadata_Call) RunAndReturn(run func() map[string]oracletypes.PriceMetadata) *Oracle_GetPriceMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPrices provides a mock function with given fields:
 func (_m *Oracle) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
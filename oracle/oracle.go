@@ -151,3 +151,9 @@ func (o *OracleImpl) GetLastSyncTime() time.Time {
 func (o *OracleImpl) GetPrices() types.Prices {
 	return o.aggregator.GetPrices()
 }
+
+// GetPriceMetadata returns the metadata (age and number of providers) of the latest
+// aggregated prices.
+func (o *OracleImpl) GetPriceMetadata() types.PricesMetadata {
+	return o.aggregator.GetPriceMetadata()
+}
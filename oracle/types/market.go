@@ -24,7 +24,8 @@ func ProviderTickersFromMarketMap(
 	// Iterate through every single market and its provider configurations to find the
 	// provider configurations that match the provider name.
 	for _, market := range marketMap.Markets {
-		if !market.Ticker.Enabled {
+		// only markets in a priced lifecycle status, i.e. active or reduce-only, are fetched
+		if !market.Ticker.EffectiveStatus().IsPriced() {
 			continue
 		}
 
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -80,8 +81,21 @@ type (
 
 	// Prices is a type alias for a map of ticker to a price.
 	Prices = map[string]*big.Float
+
+	// PricesMetadata is a type alias for a map of ticker to the metadata of its price.
+	PricesMetadata = map[string]PriceMetadata
 )
 
+// PriceMetadata contains metadata about an aggregated price.
+type PriceMetadata struct {
+	// Timestamp is the timestamp of the oldest provider price used to compute the
+	// aggregated price.
+	Timestamp time.Time
+	// NumProviders is the number of providers whose prices were used to compute the
+	// aggregated price.
+	NumProviders int
+}
+
 var (
 	// NewPriceResult is a function alias for the new price result.
 	NewPriceResult = providertypes.NewResult[*big.Float]
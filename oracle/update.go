@@ -145,6 +145,7 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 	}
 
 	timeFilteredPrices := make(types.Prices)
+	timestamps := make(map[string]time.Time)
 	for pair, result := range prices {
 		// If the price is older than the maxCacheAge, skip it.
 		diff := time.Now().UTC().Sub(result.Timestamp)
@@ -169,6 +170,7 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 			zap.Duration("diff", diff),
 		)
 		timeFilteredPrices[pair.GetOffChainTicker()] = result.Value
+		timestamps[pair.GetOffChainTicker()] = result.Timestamp
 	}
 
 	o.logger.Debug("provider returned prices",
@@ -177,6 +179,7 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		zap.Int("prices", len(prices)),
 	)
 	o.aggregator.SetProviderPrices(provider.Name(), timeFilteredPrices)
+	o.aggregator.SetProviderPriceTimestamps(provider.Name(), timestamps)
 }
 
 func (o *OracleImpl) setLastSyncTime(t time.Time) {
@@ -0,0 +1,278 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+)
+
+// Schema is a JSON schema, restricted to the subset of keywords needed to describe market metadata: type, properties,
+// required, additionalProperties, items, string constraints (minLength, pattern) and numeric bounds (minimum,
+// maximum). Schemas using any other keyword are rejected when parsed, rather than silently ignored.
+type Schema struct {
+	// Schema, Title and Description are annotations, and do not affect validation.
+	Schema      string `json:"$schema,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// Type is the JSON type of the value, one of object, array, string, integer, number, boolean or null. Any type
+	// is permitted if unset.
+	Type string `json:"type,omitempty"`
+
+	// Properties are the schemas of the named properties of an object.
+	Properties map[string]*Schema `json:"properties,omitempty"`
+
+	// Required are the names of the properties an object must have.
+	Required []string `json:"required,omitempty"`
+
+	// AdditionalProperties, if false, rejects object properties that are not in Properties.
+	AdditionalProperties *bool `json:"additionalProperties,omitempty"`
+
+	// Items is the schema of every element of an array.
+	Items *Schema `json:"items,omitempty"`
+
+	// MinLength is the minimum length of a string, in characters.
+	MinLength *int `json:"minLength,omitempty"`
+
+	// Pattern is a regular expression that a string must contain a match of.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Minimum and Maximum are the inclusive bounds of a number.
+	Minimum *json.Number `json:"minimum,omitempty"`
+	Maximum *json.Number `json:"maximum,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+var schemaTypes = map[string]struct{}{
+	"object":  {},
+	"array":   {},
+	"string":  {},
+	"integer": {},
+	"number":  {},
+	"boolean": {},
+	"null":    {},
+}
+
+// ParseSchema parses the given JSON schema. An empty schema string returns a nil schema, which permits any value.
+func ParseSchema(schema string) (*Schema, error) {
+	if len(schema) == 0 {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(schema)))
+	dec.DisallowUnknownFields()
+	dec.UseNumber()
+
+	var s Schema
+	if err := dec.Decode(&s); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal json schema: %w", err)
+	}
+
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// MustParseSchema parses the given JSON schema, and panics if it is invalid.
+func MustParseSchema(schema string) *Schema {
+	s, err := ParseSchema(schema)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// compile checks the keywords of the schema and its subschemas, and compiles their patterns.
+func (s *Schema) compile() error {
+	if s.Type != "" {
+		if _, ok := schemaTypes[s.Type]; !ok {
+			return fmt.Errorf("invalid json schema type %q", s.Type)
+		}
+	}
+
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid json schema pattern %q: %w", s.Pattern, err)
+		}
+		s.pattern = pattern
+	}
+
+	for _, bound := range []*json.Number{s.Minimum, s.Maximum} {
+		if bound == nil {
+			continue
+		}
+
+		if _, ok := new(big.Rat).SetString(bound.String()); !ok {
+			return fmt.Errorf("invalid json schema bound %q", bound.String())
+		}
+	}
+
+	for name, property := range s.Properties {
+		if property == nil {
+			return fmt.Errorf("json schema property %s must not be null", name)
+		}
+
+		if err := property.compile(); err != nil {
+			return fmt.Errorf("property %s: %w", name, err)
+		}
+	}
+
+	if s.Items != nil {
+		if err := s.Items.compile(); err != nil {
+			return fmt.Errorf("items: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the given JSON document conforms to the schema. As with IsValid, an empty document is treated
+// as an empty JSON object. A nil schema permits any valid JSON document.
+func (s *Schema) Validate(jsonBz []byte) error {
+	if len(jsonBz) == 0 {
+		jsonBz = []byte("{}")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(jsonBz))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return fmt.Errorf("unable to unmarshal string to json: %w", err)
+	}
+
+	if s == nil {
+		return nil
+	}
+
+	return s.validate("$", value)
+}
+
+// validate checks the given decoded value, found at the given path, against the schema.
+func (s *Schema) validate(path string, value interface{}) error {
+	if s.Type != "" {
+		if err := checkType(s.Type, value); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return s.validateObject(path, v)
+	case []interface{}:
+		if s.Items == nil {
+			return nil
+		}
+
+		for i, elem := range v {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), elem); err != nil {
+				return err
+			}
+		}
+	case string:
+		if s.MinLength != nil && utf8.RuneCountInString(v) < *s.MinLength {
+			return fmt.Errorf("%s: string must have at least %d characters", path, *s.MinLength)
+		}
+
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			return fmt.Errorf("%s: string %q does not match pattern %q", path, v, s.Pattern)
+		}
+	case json.Number:
+		return s.validateNumber(path, v)
+	}
+
+	return nil
+}
+
+// validateObject checks the properties of the given object, in sorted order so that errors are deterministic.
+func (s *Schema) validateObject(path string, obj map[string]interface{}) error {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			return fmt.Errorf("%s: missing required property %s", path, name)
+		}
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%s: unknown property %s", path, name)
+			}
+
+			continue
+		}
+
+		if err := property.validate(path+"."+name, obj[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateNumber checks the given number against the bounds of the schema. Numbers are compared exactly, so that
+// large integers such as uint64 values are not rounded.
+func (s *Schema) validateNumber(path string, n json.Number) error {
+	value, _ := new(big.Rat).SetString(n.String())
+
+	if s.Minimum != nil {
+		minimum, _ := new(big.Rat).SetString(s.Minimum.String())
+		if value.Cmp(minimum) < 0 {
+			return fmt.Errorf("%s: %s is less than the minimum %s", path, n, s.Minimum)
+		}
+	}
+
+	if s.Maximum != nil {
+		maximum, _ := new(big.Rat).SetString(s.Maximum.String())
+		if value.Cmp(maximum) > 0 {
+			return fmt.Errorf("%s: %s is greater than the maximum %s", path, n, s.Maximum)
+		}
+	}
+
+	return nil
+}
+
+// checkType checks that the given decoded value is of the given JSON schema type.
+func checkType(typ string, value interface{}) error {
+	ok := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		ok = typ == "object"
+	case []interface{}:
+		ok = typ == "array"
+	case string:
+		ok = typ == "string"
+	case bool:
+		ok = typ == "boolean"
+	case nil:
+		ok = typ == "null"
+	case json.Number:
+		switch typ {
+		case "number":
+			ok = true
+		case "integer":
+			_, ok = new(big.Int).SetString(v.String(), 10)
+		}
+	}
+
+	if !ok {
+		return fmt.Errorf("value is not of type %s", typ)
+	}
+
+	return nil
+}
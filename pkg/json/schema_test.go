@@ -0,0 +1,170 @@
+package json_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/pkg/json"
+)
+
+func TestParseSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		schema    string
+		expectErr bool
+	}{
+		{
+			name:      "empty schema",
+			schema:    "",
+			expectErr: false,
+		},
+		{
+			name:      "valid schema",
+			schema:    `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "object", "required": ["a"], "properties": {"a": {"type": "string", "pattern": "^[a-z]+$"}}}`,
+			expectErr: false,
+		},
+		{
+			name:      "invalid json",
+			schema:    `{"type": "object"`,
+			expectErr: true,
+		},
+		{
+			name:      "unsupported keyword",
+			schema:    `{"type": "string", "enum": ["a", "b"]}`,
+			expectErr: true,
+		},
+		{
+			name:      "invalid type",
+			schema:    `{"type": "float"}`,
+			expectErr: true,
+		},
+		{
+			name:      "invalid nested pattern",
+			schema:    `{"type": "object", "properties": {"a": {"type": "string", "pattern": "[a-z"}}}`,
+			expectErr: true,
+		},
+		{
+			name:      "invalid bound",
+			schema:    `{"type": "integer", "minimum": "zero"}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := json.ParseSchema(tc.schema)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	schema := json.MustParseSchema(`{
+		"type": "object",
+		"required": ["id", "name"],
+		"additionalProperties": false,
+		"properties": {
+			"id": {"type": "integer", "minimum": 0, "maximum": 18446744073709551615},
+			"name": {"type": "string", "minLength": 1, "pattern": "^[A-Z]+$"},
+			"price": {"type": "number"},
+			"enabled": {"type": "boolean"},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"parent": {"type": "null"}
+		}
+	}`)
+
+	testCases := []struct {
+		name      string
+		bz        string
+		expectErr bool
+	}{
+		{
+			name:      "valid document",
+			bz:        `{"id": 18446744073709551615, "name": "BTC", "price": 1.5, "enabled": true, "tags": ["a"], "parent": null}`,
+			expectErr: false,
+		},
+		{
+			name:      "empty document is an empty object",
+			bz:        ``,
+			expectErr: true,
+		},
+		{
+			name:      "invalid json",
+			bz:        `{"id": 1`,
+			expectErr: true,
+		},
+		{
+			name:      "wrong top-level type",
+			bz:        `[]`,
+			expectErr: true,
+		},
+		{
+			name:      "missing required property",
+			bz:        `{"id": 1}`,
+			expectErr: true,
+		},
+		{
+			name:      "unknown property",
+			bz:        `{"id": 1, "name": "BTC", "adress": "0x"}`,
+			expectErr: true,
+		},
+		{
+			name:      "integer with a fraction",
+			bz:        `{"id": 1.5, "name": "BTC"}`,
+			expectErr: true,
+		},
+		{
+			name:      "integer below the minimum",
+			bz:        `{"id": -1, "name": "BTC"}`,
+			expectErr: true,
+		},
+		{
+			name:      "integer above the maximum",
+			bz:        `{"id": 18446744073709551616, "name": "BTC"}`,
+			expectErr: true,
+		},
+		{
+			name:      "string shorter than the minimum length",
+			bz:        `{"id": 1, "name": ""}`,
+			expectErr: true,
+		},
+		{
+			name:      "string not matching the pattern",
+			bz:        `{"id": 1, "name": "btc"}`,
+			expectErr: true,
+		},
+		{
+			name:      "invalid array item",
+			bz:        `{"id": 1, "name": "BTC", "tags": ["a", 1]}`,
+			expectErr: true,
+		},
+		{
+			name:      "number given as a string",
+			bz:        `{"id": 1, "name": "BTC", "price": "1.5"}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := schema.Validate([]byte(tc.bz))
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("nil schema permits any valid json", func(t *testing.T) {
+		var nilSchema *json.Schema
+		require.NoError(t, nilSchema.Validate([]byte(`[1, "a"]`)))
+		require.NoError(t, nilSchema.Validate(nil))
+		require.Error(t, nilSchema.Validate([]byte(`{`)))
+	})
+}
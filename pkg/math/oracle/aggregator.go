@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -34,6 +35,11 @@ type IndexPriceAggregator struct {
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// providerTimestamps cache the timestamps of the prices for each provider. These are
+	// indexed by provider -> offChainTicker -> timestamp.
+	providerTimestamps map[string]map[string]time.Time
+	// metadata caches the metadata (age and number of providers) of the scaled prices.
+	metadata types.PricesMetadata
 }
 
 // NewIndexPriceAggregator returns a new Index Price Aggregator.
@@ -52,12 +58,14 @@ func NewIndexPriceAggregator(
 	}
 
 	return &IndexPriceAggregator{
-		logger:         logger.With(zap.String("process", "index_price_aggregator")),
-		cfg:            cfg,
-		metrics:        metrics,
-		indexPrices:    make(types.Prices),
-		scaledPrices:   make(types.Prices),
-		providerPrices: make(map[string]types.Prices),
+		logger:             logger.With(zap.String("process", "index_price_aggregator")),
+		cfg:                cfg,
+		metrics:            metrics,
+		indexPrices:        make(types.Prices),
+		scaledPrices:       make(types.Prices),
+		providerPrices:     make(map[string]types.Prices),
+		providerTimestamps: make(map[string]map[string]time.Time),
+		metadata:           make(types.PricesMetadata),
 	}, nil
 }
 
@@ -76,12 +84,13 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 
 	indexPrices := make(types.Prices)
 	scaledPrices := make(types.Prices)
+	metadata := make(types.PricesMetadata)
 
 	var missingPrices []string
 
 	for ticker, market := range m.cfg.Markets {
-		if !market.Ticker.Enabled {
-			m.logger.Debug("skipping disabled market", zap.Any("market", market))
+		if !market.Ticker.EffectiveStatus().IsPriced() {
+			m.logger.Debug("skipping market that is not priced", zap.Any("market", market))
 			continue
 		}
 
@@ -89,7 +98,7 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		// ex. BTC/USDT * Index USDT/USD = BTC/USD
 		//     BTC/USDC * Index USDC/USD = BTC/USD
 		target := market.Ticker
-		convertedPrices := m.CalculateConvertedPrices(market)
+		convertedPrices, oldest := m.calculateConvertedPrices(market)
 		m.metrics.AddProviderCountForMarket(target.String(), len(convertedPrices))
 
 		// We need to have at least the minimum number of providers to calculate the median.
@@ -113,6 +122,10 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 
 		// Scale the price to the target ticker's decimals.
 		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
+		metadata[target.String()] = types.PriceMetadata{
+			Timestamp:    oldest,
+			NumProviders: len(convertedPrices),
+		}
 
 		m.logger.Debug(
 			"calculated median price",
@@ -135,6 +148,7 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 	}
 	m.indexPrices = indexPrices
 	m.scaledPrices = scaledPrices
+	m.metadata = metadata
 }
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
@@ -143,6 +157,16 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
 ) []*big.Float {
+	convertedPrices, _ := m.calculateConvertedPrices(market)
+	return convertedPrices
+}
+
+// calculateConvertedPrices calculates the converted prices for a given market, along with the
+// timestamp of the oldest provider price that was used. The timestamp is the zero time if no
+// provider timestamps are known.
+func (m *IndexPriceAggregator) calculateConvertedPrices(
+	market mmtypes.Market,
+) ([]*big.Float, time.Time) {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -150,9 +174,10 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			zap.String("target_ticker", market.Ticker.String()),
 		)
 
-		return nil
+		return nil, time.Time{}
 	}
 
+	var oldest time.Time
 	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
 	for _, cfg := range market.ProviderConfigs {
 		// Calculate the converted price.
@@ -170,6 +195,9 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		}
 
 		convertedPrices = append(convertedPrices, adjustedPrice)
+		if ts, ok := m.providerTimestamps[cfg.Name][cfg.OffChainTicker]; ok && (oldest.IsZero() || ts.Before(oldest)) {
+			oldest = ts
+		}
 		m.logger.Debug(
 			"calculated converted price",
 			zap.String("target_ticker", market.Ticker.String()),
@@ -182,7 +210,7 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		m.metrics.UpdatePrice(cfg.Name, market.Ticker.String(), market.Ticker.GetDecimals(), floatPrice)
 	}
 
-	return convertedPrices
+	return convertedPrices, oldest
 }
 
 // CalculateAdjustedPrice calculates an adjusted price for a given set of operations (if applicable).
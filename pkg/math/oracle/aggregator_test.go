@@ -3,6 +3,7 @@ package oracle_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -164,6 +165,40 @@ func TestAggregateData(t *testing.T) {
 	}
 }
 
+func TestAggregateDataMetadata(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	m.SetProviderPrices(coinbase.Name, types.Prices{
+		"USDT-USD": big.NewFloat(1.1),
+	})
+	m.SetProviderPriceTimestamps(coinbase.Name, map[string]time.Time{
+		"USDT-USD": now.Add(-2 * time.Second),
+	})
+	m.SetProviderPrices(binance.Name, types.Prices{
+		"USDTUSD": big.NewFloat(1.2),
+	})
+	m.SetProviderPriceTimestamps(binance.Name, map[string]time.Time{
+		"USDTUSD": now.Add(-time.Second),
+	})
+
+	m.AggregatePrices()
+
+	// the metadata should contain the oldest provider timestamp and the number of providers used.
+	metadata := m.GetPriceMetadata()
+	require.Len(t, metadata, 1)
+	require.Equal(t, types.PriceMetadata{
+		Timestamp:    now.Add(-2 * time.Second),
+		NumProviders: 2,
+	}, metadata[USDT_USD.String()])
+
+	// the timestamps should be cleared on reset.
+	m.Reset()
+	m.AggregatePrices()
+	require.Empty(t, m.GetPriceMetadata())
+}
+
 func TestCalculateConvertedPrices(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"maps"
 	"math/big"
+	"time"
 
 	"github.com/skip-mev/connect/v2/oracle/types"
 	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -102,12 +103,26 @@ func (m *IndexPriceAggregator) SetProviderPrices(provider string, data types.Pri
 	m.providerPrices[provider] = data
 }
 
+// SetProviderPriceTimestamps updates the data aggregator with the timestamps of the given
+// provider's prices, indexed by offChainTicker.
+func (m *IndexPriceAggregator) SetProviderPriceTimestamps(provider string, timestamps map[string]time.Time) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if timestamps == nil {
+		timestamps = make(map[string]time.Time)
+	}
+
+	m.providerTimestamps[provider] = timestamps
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *IndexPriceAggregator) Reset() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.providerTimestamps = make(map[string]map[string]time.Time)
 }
 
 // GetPrices returns the aggregated data the aggregator has. Specifically, the
@@ -122,3 +137,15 @@ func (m *IndexPriceAggregator) GetPrices() types.Prices {
 
 	return cpy
 }
+
+// GetPriceMetadata returns the metadata of the aggregated prices. Specifically, for each
+// ticker, the timestamp of the oldest provider price and the number of providers used.
+func (m *IndexPriceAggregator) GetPriceMetadata() types.PricesMetadata {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.PricesMetadata)
+	maps.Copy(cpy, m.metadata)
+
+	return cpy
+}
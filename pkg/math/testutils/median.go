@@ -3,6 +3,7 @@ package testutils
 import (
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/skip-mev/connect/v2/oracle"
 	"github.com/skip-mev/connect/v2/oracle/types"
@@ -15,15 +16,19 @@ var _ oracle.PriceAggregator = &MedianAggregator{}
 type MedianAggregator struct {
 	mtx sync.Mutex
 
-	providerPrices map[string]types.Prices
-	finalPrices    types.Prices
+	providerPrices     map[string]types.Prices
+	providerTimestamps map[string]map[string]time.Time
+	finalPrices        types.Prices
+	metadata           types.PricesMetadata
 }
 
 // NewMedianAggregator returns a new Median aggregator.
 func NewMedianAggregator() *MedianAggregator {
 	return &MedianAggregator{
-		providerPrices: make(map[string]types.Prices),
-		finalPrices:    make(types.Prices),
+		providerPrices:     make(map[string]types.Prices),
+		providerTimestamps: make(map[string]map[string]time.Time),
+		finalPrices:        make(types.Prices),
+		metadata:           make(types.PricesMetadata),
 	}
 }
 
@@ -39,6 +44,14 @@ func (m *MedianAggregator) SetProviderPrices(provider string, data types.Prices)
 	m.providerPrices[provider] = data
 }
 
+// SetProviderPriceTimestamps updates the data aggregator with the timestamps of the given provider's prices.
+func (m *MedianAggregator) SetProviderPriceTimestamps(provider string, timestamps map[string]time.Time) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.providerTimestamps[provider] = timestamps
+}
+
 func (m *MedianAggregator) UpdateMarketMap(_ mmtypes.MarketMap) {}
 
 // AggregatePrices inputs the aggregated prices from all providers and computes
@@ -49,7 +62,8 @@ func (m *MedianAggregator) AggregatePrices() {
 
 	// Aggregate prices across all providers for each asset.
 	pricesByAsset := make(map[string][]*big.Float)
-	for _, providerPrices := range m.providerPrices {
+	oldestByAsset := make(map[string]time.Time)
+	for provider, providerPrices := range m.providerPrices {
 		for cp, price := range providerPrices {
 			// Only include prices that are not nil
 			if price == nil {
@@ -62,19 +76,30 @@ func (m *MedianAggregator) AggregatePrices() {
 			}
 
 			pricesByAsset[cp] = append(pricesByAsset[cp], price)
+
+			ts, ok := m.providerTimestamps[provider][cp]
+			if oldest, seen := oldestByAsset[cp]; ok && (!seen || ts.Before(oldest)) {
+				oldestByAsset[cp] = ts
+			}
 		}
 	}
 
 	// Iterate through all assets and compute the median price
 	medianPrices := make(types.Prices)
+	metadata := make(types.PricesMetadata)
 	for cp, prices := range pricesByAsset {
 		if len(prices) == 0 {
 			continue
 		}
 
 		medianPrices[cp] = math.CalculateMedian(prices)
+		metadata[cp] = types.PriceMetadata{
+			Timestamp:    oldestByAsset[cp],
+			NumProviders: len(prices),
+		}
 	}
 	m.finalPrices = medianPrices
+	m.metadata = metadata
 }
 
 // GetPrices returns the aggregated data the aggregator has.
@@ -85,10 +110,19 @@ func (m *MedianAggregator) GetPrices() types.Prices {
 	return m.finalPrices
 }
 
+// GetPriceMetadata returns the metadata of the aggregated prices.
+func (m *MedianAggregator) GetPriceMetadata() types.PricesMetadata {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.metadata
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *MedianAggregator) Reset() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.providerTimestamps = make(map[string]map[string]time.Time)
 }
@@ -7,6 +7,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // ValidatorStore defines the interface contract required for calculating stake-weighted median
@@ -26,3 +29,12 @@ type CCValidatorStore interface {
 	GetAllCCValidator(ctx sdk.Context) []types.CrossChainValidator
 	GetCCValidator(ctx sdk.Context, addr []byte) (types.CrossChainValidator, bool)
 }
+
+// ParamsKeeper defines the interface contract required for reading the x/oracle params that select
+// the aggregation function, and the per-currency-pair quorums that override the params' quorum.
+//
+//go:generate mockery --name ParamsKeeper --filename mock_params_keeper.go
+type ParamsKeeper interface {
+	GetParams(ctx context.Context) (oracletypes.Params, error)
+	GetMarketQuorum(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.MarketQuorum, bool)
+}
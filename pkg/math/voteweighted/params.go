@@ -0,0 +1,63 @@
+package voteweighted
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/aggregator"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// FromParams returns an aggregate function that is selected and configured by the x/oracle params at
+// the latest state of the application. This allows a chain to change the aggregation method, power
+// threshold, minimum number of validators, and trim through governance without recompiling. If the
+// params cannot be read, the default params are used. Currency pairs with a MarketQuorum set in
+// x/oracle use that quorum in place of the one in the params.
+func FromParams(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	paramsKeeper ParamsKeeper,
+) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+		params, err := paramsKeeper.GetParams(ctx)
+		if err != nil {
+			logger.Error(
+				"failed to get oracle params; using default params for aggregation",
+				"err", err,
+			)
+
+			params = oracletypes.DefaultParams()
+		}
+
+		quorumFn := MarketQuorum(
+			paramsKeeper,
+			StaticQuorum(params.PowerThreshold(), uint64(params.MinValidators)),
+		)
+
+		switch params.AggregationMethod {
+		case oracletypes.AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN:
+			return TrimmedMean(ctx, logger, validatorStore, quorumFn, oracletypes.BpsToDec(params.TrimBps))
+		default:
+			return Aggregate(ctx, logger, validatorStore, quorumFn, ComputeMedian)
+		}
+	}
+}
+
+// MarketQuorum returns a QuorumFn that applies the MarketQuorum set for a currency pair in x/oracle,
+// and falls back to the given QuorumFn for currency pairs without one.
+func MarketQuorum(paramsKeeper ParamsKeeper, fallback QuorumFn) QuorumFn {
+	return func(ctx sdk.Context, cp connecttypes.CurrencyPair) Quorum {
+		quorum, ok := paramsKeeper.GetMarketQuorum(ctx, cp)
+		if !ok {
+			return fallback(ctx, cp)
+		}
+
+		return Quorum{
+			PowerThreshold: quorum.PowerThreshold(),
+			MinValidators:  uint64(quorum.MinValidators),
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package voteweighted
+
+import (
+	"math/big"
+	"sort"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/aggregator"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// TrimmedMeanFromContext returns a new TrimmedMean aggregate function that is parametrized by the
+// latest state of the application.
+func TrimmedMeanFromContext(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	quorumFn QuorumFn,
+	trim math.LegacyDec,
+) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+		return TrimmedMean(ctx, logger, validatorStore, quorumFn, trim)
+	}
+}
+
+// TrimmedMean returns an aggregation function that computes the stake weighted trimmed mean price as
+// the final deterministic oracle price for any currency pair whose reporting validators meet the quorum
+// returned by quorumFn. The trim is the share of the reporting stake that is discarded from each tail of
+// the sorted prices before the stake weighted mean is computed, and must be in the range [0, 0.5).
+func TrimmedMean(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	quorumFn QuorumFn,
+	trim math.LegacyDec,
+) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return Aggregate(ctx, logger, validatorStore, quorumFn, func(priceInfo PriceInfo) *big.Int {
+		return ComputeTrimmedMean(priceInfo, trim)
+	})
+}
+
+// ComputeTrimmedMean computes the stake weighted trimmed mean price for a given asset. The prices are
+// sorted, the given share of the total weight is trimmed from each tail (partially trimming a price
+// whose weight straddles a cutoff), and the weighted mean of the remaining prices is returned. The
+// result is truncated towards zero.
+func ComputeTrimmedMean(priceInfo PriceInfo, trim math.LegacyDec) *big.Int {
+	if len(priceInfo.Prices) == 0 || !priceInfo.TotalWeight.IsPositive() {
+		return nil
+	}
+
+	// Sort the prices by price.
+	sort.SliceStable(priceInfo.Prices, func(i, j int) bool {
+		return priceInfo.Prices[i].Price.Cmp(priceInfo.Prices[j].Price) < 0
+	})
+
+	// Determine the window of cumulative weight that is retained.
+	trimmed := math.LegacyNewDecFromInt(priceInfo.TotalWeight).Mul(trim).TruncateInt()
+	lower := trimmed
+	upper := priceInfo.TotalWeight.Sub(trimmed)
+	if !upper.GT(lower) {
+		return ComputeMedian(priceInfo)
+	}
+
+	// Compute the weighted sum of the prices within the window.
+	sum := new(big.Int)
+	weight := math.ZeroInt()
+	start := math.ZeroInt()
+	for _, price := range priceInfo.Prices {
+		end := start.Add(price.VoteWeight)
+
+		// Determine the overlap of [start, end) with [lower, upper).
+		overlap := math.MinInt(end, upper).Sub(math.MaxInt(start, lower))
+		if overlap.IsPositive() {
+			sum.Add(sum, new(big.Int).Mul(price.Price, overlap.BigInt()))
+			weight = weight.Add(overlap)
+		}
+
+		start = end
+	}
+
+	return sum.Quo(sum, weight.BigInt())
+}
@@ -0,0 +1,252 @@
+package voteweighted_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/skip-mev/connect/v2/aggregator"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+var btcUSD = connecttypes.NewCurrencyPair("BTC", "USD")
+
+func (s *MathTestSuite) TestComputeTrimmedMean() {
+	cases := []struct {
+		name      string
+		priceInfo voteweighted.PriceInfo
+		trim      sdkmath.LegacyDec
+		expected  *big.Int
+	}{
+		{
+			name: "no prices",
+			priceInfo: voteweighted.PriceInfo{
+				TotalWeight: sdkmath.ZeroInt(),
+			},
+			trim:     sdkmath.LegacyZeroDec(),
+			expected: nil,
+		},
+		{
+			name: "no trim is the stake weighted mean",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{VoteWeight: sdkmath.NewInt(1), Price: big.NewInt(300)},
+					{VoteWeight: sdkmath.NewInt(3), Price: big.NewInt(100)},
+				},
+				TotalWeight: sdkmath.NewInt(4),
+			},
+			trim:     sdkmath.LegacyZeroDec(),
+			expected: big.NewInt(150),
+		},
+		{
+			name: "outliers are trimmed from both tails",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{VoteWeight: sdkmath.NewInt(10), Price: big.NewInt(1_000)},
+					{VoteWeight: sdkmath.NewInt(40), Price: big.NewInt(100)},
+					{VoteWeight: sdkmath.NewInt(40), Price: big.NewInt(200)},
+					{VoteWeight: sdkmath.NewInt(10), Price: big.NewInt(1)},
+				},
+				TotalWeight: sdkmath.NewInt(100),
+			},
+			trim:     sdkmath.LegacyNewDecWithPrec(1, 1),
+			expected: big.NewInt(150),
+		},
+		{
+			name: "prices straddling the cutoff are partially trimmed",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{VoteWeight: sdkmath.NewInt(50), Price: big.NewInt(100)},
+					{VoteWeight: sdkmath.NewInt(50), Price: big.NewInt(200)},
+				},
+				TotalWeight: sdkmath.NewInt(100),
+			},
+			trim:     sdkmath.LegacyNewDecWithPrec(25, 2),
+			expected: big.NewInt(150),
+		},
+		{
+			name: "result is truncated",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{VoteWeight: sdkmath.NewInt(1), Price: big.NewInt(1)},
+					{VoteWeight: sdkmath.NewInt(1), Price: big.NewInt(2)},
+				},
+				TotalWeight: sdkmath.NewInt(2),
+			},
+			trim:     sdkmath.LegacyZeroDec(),
+			expected: big.NewInt(1),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			result := voteweighted.ComputeTrimmedMean(tc.priceInfo, tc.trim)
+			s.Require().Equal(tc.expected, result)
+		})
+	}
+}
+
+func (s *MathTestSuite) TestTrimmedMeanQuorum() {
+	validators := []validator{
+		{stake: sdkmath.NewInt(40), consAddr: validator1},
+		{stake: sdkmath.NewInt(40), consAddr: validator2},
+		{stake: sdkmath.NewInt(20), consAddr: validator3},
+	}
+	providerPrices := aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+		validator1.String(): {btcUSD: big.NewInt(100)},
+		validator2.String(): {btcUSD: big.NewInt(200)},
+	}
+
+	cases := []struct {
+		name     string
+		quorum   voteweighted.QuorumFn
+		expected map[connecttypes.CurrencyPair]*big.Int
+	}{
+		{
+			name:     "stake threshold met with no minimum number of validators",
+			quorum:   voteweighted.StaticQuorum(sdkmath.LegacyNewDecWithPrec(5, 1), 0),
+			expected: map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(150)},
+		},
+		{
+			name:     "stake threshold not met",
+			quorum:   voteweighted.StaticQuorum(sdkmath.LegacyNewDecWithPrec(9, 1), 0),
+			expected: map[connecttypes.CurrencyPair]*big.Int{},
+		},
+		{
+			name:     "stake threshold met but not enough validators",
+			quorum:   voteweighted.StaticQuorum(sdkmath.LegacyNewDecWithPrec(5, 1), 3),
+			expected: map[connecttypes.CurrencyPair]*big.Int{},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			store := s.createMockValidatorStore(validators, sdkmath.NewInt(100))
+			aggregateFn := voteweighted.TrimmedMean(s.ctx, log.NewTestLogger(s.T()), store, tc.quorum, sdkmath.LegacyZeroDec())
+			s.Require().Equal(tc.expected, aggregateFn(providerPrices))
+		})
+	}
+}
+
+func (s *MathTestSuite) TestFromParams() {
+	validators := []validator{
+		{stake: sdkmath.NewInt(30), consAddr: validator1},
+		{stake: sdkmath.NewInt(30), consAddr: validator2},
+		{stake: sdkmath.NewInt(40), consAddr: validator3},
+	}
+	providerPrices := aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+		validator1.String(): {btcUSD: big.NewInt(100)},
+		validator2.String(): {btcUSD: big.NewInt(200)},
+		validator3.String(): {btcUSD: big.NewInt(400)},
+	}
+
+	trimmedMean := oracletypes.DefaultParams()
+	trimmedMean.AggregationMethod = oracletypes.AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN
+	trimmedMean.TrimBps = 2_500
+
+	tooManyValidators := oracletypes.DefaultParams()
+	tooManyValidators.MinValidators = 4
+
+	cases := []struct {
+		name     string
+		params   oracletypes.Params
+		err      error
+		expected map[connecttypes.CurrencyPair]*big.Int
+	}{
+		{
+			name:     "default params use the stake weighted median",
+			params:   oracletypes.DefaultParams(),
+			expected: map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(200)},
+		},
+		{
+			name:     "failing to read params falls back to the default params",
+			err:      fmt.Errorf("no params"),
+			expected: map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(200)},
+		},
+		{
+			// the window [25, 75) covers 5 of validator1, 30 of validator2 and 15 of validator3
+			name:     "trimmed mean",
+			params:   trimmedMean,
+			expected: map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(250)},
+		},
+		{
+			name:     "minimum number of validators",
+			params:   tooManyValidators,
+			expected: map[connecttypes.CurrencyPair]*big.Int{},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			store := s.createMockValidatorStore(validators, sdkmath.NewInt(100))
+			paramsKeeper := mocks.NewParamsKeeper(s.T())
+			paramsKeeper.On("GetParams", s.ctx).Return(tc.params, tc.err).Once()
+			paramsKeeper.On("GetMarketQuorum", s.ctx, btcUSD).Return(oracletypes.MarketQuorum{}, false).Maybe()
+
+			aggregateFn := voteweighted.FromParams(log.NewTestLogger(s.T()), store, paramsKeeper)(s.ctx)
+			s.Require().Equal(tc.expected, aggregateFn(providerPrices))
+		})
+	}
+}
+
+func (s *MathTestSuite) TestMarketQuorum() {
+	validators := []validator{
+		{stake: sdkmath.NewInt(30), consAddr: validator1},
+		{stake: sdkmath.NewInt(30), consAddr: validator2},
+		{stake: sdkmath.NewInt(40), consAddr: validator3},
+	}
+	ethUSD := connecttypes.NewCurrencyPair("ETH", "USD")
+
+	// only validator1 reports a price for ETH/USD, which is below the default power threshold.
+	providerPrices := aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+		validator1.String(): {btcUSD: big.NewInt(100), ethUSD: big.NewInt(10)},
+		validator2.String(): {btcUSD: big.NewInt(200)},
+		validator3.String(): {btcUSD: big.NewInt(400)},
+	}
+
+	cases := []struct {
+		name     string
+		quorums  map[connecttypes.CurrencyPair]oracletypes.MarketQuorum
+		expected map[connecttypes.CurrencyPair]*big.Int
+	}{
+		{
+			name:     "no market quorums use the params quorum",
+			quorums:  map[connecttypes.CurrencyPair]oracletypes.MarketQuorum{},
+			expected: map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(200)},
+		},
+		{
+			name: "market quorum lowers the threshold for a long-tail market",
+			quorums: map[connecttypes.CurrencyPair]oracletypes.MarketQuorum{
+				ethUSD: oracletypes.NewMarketQuorum(ethUSD, 2_500, 1),
+			},
+			expected: map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(200), ethUSD: big.NewInt(10)},
+		},
+		{
+			name: "market quorum raises the minimum number of validators for a major market",
+			quorums: map[connecttypes.CurrencyPair]oracletypes.MarketQuorum{
+				btcUSD: oracletypes.NewMarketQuorum(btcUSD, 5_000, 4),
+			},
+			expected: map[connecttypes.CurrencyPair]*big.Int{},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			store := s.createMockValidatorStore(validators, sdkmath.NewInt(100))
+			paramsKeeper := mocks.NewParamsKeeper(s.T())
+			paramsKeeper.On("GetParams", s.ctx).Return(oracletypes.DefaultParams(), nil).Once()
+			for _, cp := range []connecttypes.CurrencyPair{btcUSD, ethUSD} {
+				quorum, ok := tc.quorums[cp]
+				paramsKeeper.On("GetMarketQuorum", s.ctx, cp).Return(quorum, ok).Maybe()
+			}
+
+			aggregateFn := voteweighted.FromParams(log.NewTestLogger(s.T()), store, paramsKeeper)(s.ctx)
+			s.Require().Equal(tc.expected, aggregateFn(providerPrices))
+		})
+	}
+}
@@ -10,13 +10,14 @@ import (
 
 	"github.com/skip-mev/connect/v2/aggregator"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // DefaultPowerThreshold defines the total voting power % that must be
 // submitted in order for a currency pair to be considered for the
 // final oracle price. We provide a default supermajority threshold
 // of 2/3+.
-var DefaultPowerThreshold = math.LegacyNewDecWithPrec(667, 3)
+var DefaultPowerThreshold = oracletypes.DefaultPowerThreshold
 
 type (
 	// VoteWeightPriceInfo tracks the stake weight(s) + price(s) for a given currency pair.
@@ -30,8 +31,34 @@ type (
 		VoteWeight math.Int
 		Price      *big.Int
 	}
+
+	// Quorum defines the requirements that the validators reporting a price for a currency pair
+	// must meet for the price to be included in the final oracle prices.
+	Quorum struct {
+		// PowerThreshold is the minimum share of the total bonded stake that must report a price.
+		PowerThreshold math.LegacyDec
+		// MinValidators is the minimum number of distinct validators that must report a price.
+		MinValidators uint64
+	}
+
+	// QuorumFn returns the quorum that must be met for a given currency pair.
+	QuorumFn func(ctx sdk.Context, cp connecttypes.CurrencyPair) Quorum
+
+	// ComputeFn computes the final price for a currency pair given the stake weighted prices
+	// reported for it.
+	ComputeFn func(priceInfo PriceInfo) *big.Int
 )
 
+// StaticQuorum returns a QuorumFn that applies the same quorum to every currency pair.
+func StaticQuorum(threshold math.LegacyDec, minValidators uint64) QuorumFn {
+	return func(_ sdk.Context, _ connecttypes.CurrencyPair) Quorum {
+		return Quorum{
+			PowerThreshold: threshold,
+			MinValidators:  minValidators,
+		}
+	}
+}
+
 // MedianFromContext returns a new Median aggregate function that is parametrized by the
 // latest state of the application.
 func MedianFromContext(
@@ -61,6 +88,19 @@ func Median(
 	logger log.Logger,
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
+) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return Aggregate(ctx, logger, validatorStore, StaticQuorum(threshold, 0), ComputeMedian)
+}
+
+// Aggregate returns an aggregation function that computes the final deterministic oracle price for any
+// currency pair whose reporting validators meet the quorum returned by quorumFn. The final price is
+// computed from the stake weighted prices of the reporting validators by computeFn.
+func Aggregate(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	quorumFn QuorumFn,
+	computeFn ComputeFn,
 ) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
 	return func(providers aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
 		priceInfo := make(map[connecttypes.CurrencyPair]PriceInfo)
@@ -125,7 +165,7 @@ func Median(
 			}
 		}
 
-		// Iterate through all prices and compute the median price for each asset.
+		// Iterate through all prices and compute the final price for each asset.
 		prices := make(map[connecttypes.CurrencyPair]*big.Int)
 		totalBondedTokens, err := validatorStore.TotalBondedTokens(ctx)
 		if err != nil {
@@ -134,24 +174,39 @@ func Median(
 		}
 
 		for currencyPair, info := range priceInfo {
+			quorum := quorumFn(ctx, currencyPair)
+
+			// The number of distinct validators that submitted a price update for the given currency pair
+			// must meet the minimum number of validators.
+			if uint64(len(info.Prices)) < quorum.MinValidators {
+				logger.Debug(
+					"not enough validators to compute stake-weighted price for currency pair",
+					"currency_pair", currencyPair.String(),
+					"min_validators", quorum.MinValidators,
+					"num_validators", len(info.Prices),
+				)
+
+				continue
+			}
+
 			// The total voting power % that submitted a price update for the given currency pair must be
 			// greater than the threshold to be included in the final oracle price.
-			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(threshold) {
-				prices[currencyPair] = ComputeMedian(info)
+			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(quorum.PowerThreshold) {
+				prices[currencyPair] = computeFn(info)
 
 				logger.Debug(
-					"computed stake-weighted median price for currency pair",
+					"computed stake-weighted price for currency pair",
 					"currency_pair", currencyPair.String(),
 					"percent_submitted", percentSubmitted.String(),
-					"threshold", threshold.String(),
+					"threshold", quorum.PowerThreshold.String(),
 					"final_price", prices[currencyPair].String(),
 					"num_validators", len(info.Prices),
 				)
 			} else {
 				logger.Debug(
-					"not enough voting power to compute stake-weighted median price price for currency pair",
+					"not enough voting power to compute stake-weighted price for currency pair",
 					"currency_pair", currencyPair.String(),
-					"threshold", threshold.String(),
+					"threshold", quorum.PowerThreshold.String(),
 					"percent_submitted", percentSubmitted.String(),
 					"num_validators", len(info.Prices),
 				)
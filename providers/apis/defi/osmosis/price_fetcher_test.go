@@ -14,6 +14,7 @@ import (
 
 	oracleconfig "github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
+	connectjson "github.com/skip-mev/connect/v2/pkg/json"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/osmosis"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/osmosis/mocks"
 	"github.com/skip-mev/connect/v2/providers/base/api/metrics"
@@ -77,6 +78,25 @@ func TestTickerMetadataValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTickerMetadataSchema(t *testing.T) {
+	schema, err := connectjson.ParseSchema(osmosis.TickerMetadataSchema)
+	require.NoError(t, err)
+
+	t.Run("valid metadata", func(t *testing.T) {
+		bz, err := json.Marshal(osmosis.TickerMetadata{
+			PoolID:          ETHUSDTPoolID,
+			BaseTokenDenom:  ETHTokenDenom,
+			QuoteTokenDenom: USDTTokenDenom,
+		})
+		require.NoError(t, err)
+		require.NoError(t, schema.Validate(bz))
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		require.Error(t, schema.Validate([]byte(`{"pool_id": 1, "base_token_denom": "", "quote_token_denom": "uusdt"}`)))
+	})
+}
+
 // Test Provider init.
 func TestProviderInit(t *testing.T) {
 	t.Run("config fails validate basic", func(t *testing.T) {
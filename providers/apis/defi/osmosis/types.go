@@ -10,6 +10,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
+	connectjson "github.com/skip-mev/connect/v2/pkg/json"
 )
 
 const (
@@ -91,6 +92,22 @@ type TickerMetadata struct {
 	QuoteTokenDenom string `json:"quote_token_denom"`
 }
 
+// TickerMetadataSchema is the JSON schema of the TickerMetadata carried in the metadata JSON of Osmosis provider
+// configs. It can be registered as the metadata schema of the Osmosis provider in x/marketmap, so that malformed
+// metadata is rejected on chain rather than by the sidecar.
+const TickerMetadataSchema = `{
+  "title": "Osmosis ticker metadata",
+  "type": "object",
+  "required": ["pool_id", "base_token_denom", "quote_token_denom"],
+  "properties": {
+    "pool_id": {"type": "integer", "minimum": 0},
+    "base_token_denom": {"type": "string", "minLength": 1},
+    "quote_token_denom": {"type": "string", "minLength": 1}
+  }
+}`
+
+var tickerMetadataSchema = connectjson.MustParseSchema(TickerMetadataSchema)
+
 // ValidateBasic checks that the pool and token information is formatted properly.
 func (metadata TickerMetadata) ValidateBasic() error {
 	if metadata.BaseTokenDenom == "" || metadata.QuoteTokenDenom == "" {
@@ -100,9 +117,13 @@ func (metadata TickerMetadata) ValidateBasic() error {
 	return nil
 }
 
-// unmarshalMetadataJSON unmarshals the given metadata string into a TickerMetadata,
-// this method assumes that the metadata string is valid json, otherwise an error is returned.
+// unmarshalMetadataJSON validates the given metadata string against the TickerMetadataSchema, and unmarshals it into
+// a TickerMetadata.
 func unmarshalMetadataJSON(metadata string) (TickerMetadata, error) {
+	if err := tickerMetadataSchema.Validate([]byte(metadata)); err != nil {
+		return TickerMetadata{}, err
+	}
+
 	// unmarshal the metadata string into a TickerMetadata
 	var tickerMetadata TickerMetadata
 	if err := json.Unmarshal([]byte(metadata), &tickerMetadata); err != nil {
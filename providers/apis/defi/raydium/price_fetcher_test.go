@@ -22,6 +22,7 @@ import (
 
 	oracleconfig "github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
+	connectjson "github.com/skip-mev/connect/v2/pkg/json"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/raydium"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/raydium/mocks"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/raydium/schema"
@@ -136,6 +137,32 @@ func TestTickerMetadataValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTickerMetadataSchema(t *testing.T) {
+	schema, err := connectjson.ParseSchema(raydium.TickerMetadataSchema)
+	require.NoError(t, err)
+
+	t.Run("valid metadata", func(t *testing.T) {
+		bz, err := json.Marshal(raydium.TickerMetadata{
+			BaseTokenVault: raydium.AMMTokenVaultMetadata{
+				TokenVaultAddress: ETHVaultAddress,
+				TokenDecimals:     18,
+			},
+			QuoteTokenVault: raydium.AMMTokenVaultMetadata{
+				TokenVaultAddress: USDTVaultAddress,
+				TokenDecimals:     6,
+			},
+			AMMInfoAddress:    ETHUSDTAMMIDAddress,
+			OpenOrdersAddress: ETHUSDTOpenOrdersAddress,
+		})
+		require.NoError(t, err)
+		require.NoError(t, schema.Validate(bz))
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		require.Error(t, schema.Validate([]byte(`{"base_token_vault": {"token_vault_address": "vault"}, "amm_info_address": "amm"}`)))
+	})
+}
+
 // Test Provider init.
 func TestProviderInit(t *testing.T) {
 	t.Run("config fails validate basic", func(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
+	connectjson "github.com/skip-mev/connect/v2/pkg/json"
 )
 
 const (
@@ -79,6 +80,37 @@ type TickerMetadata struct {
 	OpenOrdersAddress string `json:"open_orders_address"`
 }
 
+// TickerMetadataSchema is the JSON schema of the TickerMetadata carried in the metadata JSON of Raydium provider
+// configs. It can be registered as the metadata schema of the Raydium provider in x/marketmap, so that malformed
+// metadata is rejected on chain rather than by the sidecar.
+const TickerMetadataSchema = `{
+  "title": "Raydium ticker metadata",
+  "type": "object",
+  "required": ["base_token_vault", "quote_token_vault", "amm_info_address", "open_orders_address"],
+  "properties": {
+    "base_token_vault": {
+      "type": "object",
+      "required": ["token_vault_address", "token_decimals"],
+      "properties": {
+        "token_vault_address": {"type": "string", "minLength": 1},
+        "token_decimals": {"type": "integer", "minimum": 0}
+      }
+    },
+    "quote_token_vault": {
+      "type": "object",
+      "required": ["token_vault_address", "token_decimals"],
+      "properties": {
+        "token_vault_address": {"type": "string", "minLength": 1},
+        "token_decimals": {"type": "integer", "minimum": 0}
+      }
+    },
+    "amm_info_address": {"type": "string", "minLength": 1},
+    "open_orders_address": {"type": "string", "minLength": 1}
+  }
+}`
+
+var tickerMetadataSchema = connectjson.MustParseSchema(TickerMetadataSchema)
+
 // ValidateBasic checks that the solana token vault addresses are valid.
 func (metadata TickerMetadata) ValidateBasic() error {
 	if _, err := solana.PublicKeyFromBase58(metadata.BaseTokenVault.TokenVaultAddress); err != nil {
@@ -113,9 +145,13 @@ type AMMTokenVaultMetadata struct {
 	TokenDecimals uint64 `json:"token_decimals"`
 }
 
-// unmarshalMetadataJSON unmarshals the given metadata string into a TickerMetadata,
-// this method assumes that the metadata string is valid json, otherwise an error is returned.
+// unmarshalMetadataJSON validates the given metadata string against the TickerMetadataSchema, and unmarshals it into
+// a TickerMetadata.
 func unmarshalMetadataJSON(metadata string) (TickerMetadata, error) {
+	if err := tickerMetadataSchema.Validate([]byte(metadata)); err != nil {
+		return TickerMetadata{}, err
+	}
+
 	// unmarshal the metadata string into a TickerMetadata
 	var tickerMetadata TickerMetadata
 	if err := json.Unmarshal([]byte(metadata), &tickerMetadata); err != nil {
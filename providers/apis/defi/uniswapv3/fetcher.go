@@ -2,7 +2,6 @@ package uniswapv3
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math/big"
 	"time"
@@ -265,8 +264,8 @@ func (u *PriceFetcher) GetPool(
 		return pool, nil
 	}
 
-	var cfg PoolConfig
-	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+	cfg, err := PoolConfigFromJSON(ticker.GetJSON())
+	if err != nil {
 		return cfg, fmt.Errorf("failed to unmarshal pool config on ticker: %w", err)
 	}
 	if err := cfg.ValidateBasic(); err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/constants"
+	connectjson "github.com/skip-mev/connect/v2/pkg/json"
 )
 
 const (
@@ -62,6 +63,38 @@ type PoolConfig struct {
 	Invert bool `json:"invert"`
 }
 
+// PoolConfigSchema is the JSON schema of the PoolConfig carried in the metadata JSON of Uniswap V3 provider configs.
+// It can be registered as the metadata schema of the Uniswap V3 providers in x/marketmap, so that malformed pool
+// configs are rejected on chain rather than by the sidecar.
+const PoolConfigSchema = `{
+  "title": "Uniswap V3 pool config",
+  "type": "object",
+  "required": ["address", "base_decimals", "quote_decimals"],
+  "properties": {
+    "address": {"type": "string", "pattern": "^(0x|0X)?[0-9a-fA-F]{40}$"},
+    "base_decimals": {"type": "integer", "minimum": 0},
+    "quote_decimals": {"type": "integer", "minimum": 0},
+    "invert": {"type": "boolean"}
+  }
+}`
+
+var poolConfigSchema = connectjson.MustParseSchema(PoolConfigSchema)
+
+// PoolConfigFromJSON validates the given metadata JSON against the PoolConfigSchema, and unmarshals it into a
+// PoolConfig.
+func PoolConfigFromJSON(metadata string) (PoolConfig, error) {
+	if err := poolConfigSchema.Validate([]byte(metadata)); err != nil {
+		return PoolConfig{}, err
+	}
+
+	var cfg PoolConfig
+	if err := json.Unmarshal([]byte(metadata), &cfg); err != nil {
+		return PoolConfig{}, err
+	}
+
+	return cfg, nil
+}
+
 // ValidateBasic validates the pool configuration.
 func (pc *PoolConfig) ValidateBasic() error {
 	if !common.IsHexAddress(pc.Address) {
@@ -50,6 +50,37 @@ func TestPoolConfig(t *testing.T) {
 	})
 }
 
+func TestPoolConfigFromJSON(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		cfg := uniswapv3.PoolConfig{
+			Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+			BaseDecimals:  18,
+			QuoteDecimals: 6,
+			Invert:        true,
+		}
+		got, err := uniswapv3.PoolConfigFromJSON(cfg.MustToJSON())
+		require.NoError(t, err)
+		require.Equal(t, cfg, got)
+	})
+
+	t.Run("missing decimals", func(t *testing.T) {
+		_, err := uniswapv3.PoolConfigFromJSON(`{"address": "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8"}`)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		_, err := uniswapv3.PoolConfigFromJSON(`{"address": "invalid", "base_decimals": 18, "quote_decimals": 6}`)
+		require.Error(t, err)
+	})
+
+	t.Run("negative decimals", func(t *testing.T) {
+		_, err := uniswapv3.PoolConfigFromJSON(
+			`{"address": "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8", "base_decimals": -1, "quote_decimals": 6}`,
+		)
+		require.Error(t, err)
+	})
+}
+
 func TestIsValidProviderName(t *testing.T) {
 	type testcase struct {
 		testName     string
@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"github.com/skip-mev/connect/v2/oracle/types"
+	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
 )
 
 func ToReqPrices(prices types.Prices) map[string]string {
@@ -14,3 +15,16 @@ func ToReqPrices(prices types.Prices) map[string]string {
 
 	return reqPrices
 }
+
+func ToReqPriceMetadata(metadata types.PricesMetadata) map[string]servicetypes.PriceMetadata {
+	reqMetadata := make(map[string]servicetypes.PriceMetadata, len(metadata))
+
+	for cp, md := range metadata {
+		reqMetadata[cp] = servicetypes.PriceMetadata{
+			Timestamp:    md.Timestamp,
+			NumProviders: uint32(md.NumProviders), //nolint:gosec
+		}
+	}
+
+	return reqMetadata
+}
@@ -184,6 +184,9 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 		// get the prices
 		prices := os.o.GetPrices()
 
+		// get the metadata (age and number of providers) of the prices
+		metadata := os.o.GetPriceMetadata()
+
 		// get the latest timestamp of the latest update from the oracle
 		timestamp := os.o.GetLastSyncTime()
 
@@ -191,6 +194,7 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 			Prices:    ToReqPrices(prices),
 			Timestamp: timestamp,
 			Version:   build.Build,
+			Metadata:  ToReqPriceMetadata(metadata),
 		}
 	}()
 
@@ -161,6 +161,9 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	})
 	ts := time.Now()
 	s.mockOracle.On("GetLastSyncTime").Return(ts)
+	s.mockOracle.On("GetPriceMetadata").Return(types.PricesMetadata{
+		cp1.String(): {Timestamp: ts.Add(-time.Second), NumProviders: 3},
+	})
 
 	// call from grpc client
 	resp, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{})
@@ -173,6 +176,11 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 
 	s.Require().Equal(resp.Timestamp, ts.UTC())
 
+	// check metadata
+	s.Require().Len(resp.Metadata, 1)
+	s.Require().Equal(uint32(3), resp.Metadata[cp1.String()].NumProviders)
+	s.Require().Equal(ts.Add(-time.Second).UTC(), resp.Metadata[cp1.String()].Timestamp)
+
 	// call from http client
 	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/connect/oracle/v2/prices", localhost, s.port))
 	s.Require().NoError(err)
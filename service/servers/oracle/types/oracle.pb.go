@@ -80,6 +80,9 @@ type QueryPricesResponse struct {
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 	// Version defines the version of the oracle service that provided the prices.
 	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Metadata defines optional metadata for each of the prices, keyed by the
+	// same currency pair as the prices.
+	Metadata map[string]PriceMetadata `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
@@ -136,6 +139,70 @@ func (m *QueryPricesResponse) GetVersion() string {
 	return ""
 }
 
+func (m *QueryPricesResponse) GetMetadata() map[string]PriceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// PriceMetadata defines metadata about a single aggregated price.
+type PriceMetadata struct {
+	// Timestamp defines the timestamp of the oldest provider price that was used
+	// to compute the aggregated price.
+	Timestamp time.Time `protobuf:"bytes,1,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// NumProviders defines the number of providers whose prices were used to
+	// compute the aggregated price.
+	NumProviders uint32 `protobuf:"varint,2,opt,name=num_providers,json=numProviders,proto3" json:"num_providers,omitempty"`
+}
+
+func (m *PriceMetadata) Reset()         { *m = PriceMetadata{} }
+func (m *PriceMetadata) String() string { return proto.CompactTextString(m) }
+func (*PriceMetadata) ProtoMessage()    {}
+func (*PriceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{2}
+}
+func (m *PriceMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMetadata.Merge(m, src)
+}
+func (m *PriceMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceMetadata proto.InternalMessageInfo
+
+func (m *PriceMetadata) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *PriceMetadata) GetNumProviders() uint32 {
+	if m != nil {
+		return m.NumProviders
+	}
+	return 0
+}
+
 // QueryMarketMapRequest defines the request type for the MarketMap method.
 type QueryMarketMapRequest struct {
 }
@@ -144,7 +211,7 @@ func (m *QueryMarketMapRequest) Reset()         { *m = QueryMarketMapRequest{} }
 func (m *QueryMarketMapRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapRequest) ProtoMessage()    {}
 func (*QueryMarketMapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{2}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{3}
 }
 func (m *QueryMarketMapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +250,7 @@ func (m *QueryMarketMapResponse) Reset()         { *m = QueryMarketMapResponse{}
 func (m *QueryMarketMapResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapResponse) ProtoMessage()    {}
 func (*QueryMarketMapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{3}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{4}
 }
 func (m *QueryMarketMapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +294,7 @@ func (m *QueryVersionRequest) Reset()         { *m = QueryVersionRequest{} }
 func (m *QueryVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionRequest) ProtoMessage()    {}
 func (*QueryVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{4}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{5}
 }
 func (m *QueryVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +333,7 @@ func (m *QueryVersionResponse) Reset()         { *m = QueryVersionResponse{} }
 func (m *QueryVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionResponse) ProtoMessage()    {}
 func (*QueryVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{5}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{6}
 }
 func (m *QueryVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +372,9 @@ func (m *QueryVersionResponse) GetVersion() string {
 func init() {
 	proto.RegisterType((*QueryPricesRequest)(nil), "connect.service.v2.QueryPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "connect.service.v2.QueryPricesResponse")
+	proto.RegisterMapType((map[string]PriceMetadata)(nil), "connect.service.v2.QueryPricesResponse.MetadataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "connect.service.v2.QueryPricesResponse.PricesEntry")
+	proto.RegisterType((*PriceMetadata)(nil), "connect.service.v2.PriceMetadata")
 	proto.RegisterType((*QueryMarketMapRequest)(nil), "connect.service.v2.QueryMarketMapRequest")
 	proto.RegisterType((*QueryMarketMapResponse)(nil), "connect.service.v2.QueryMarketMapResponse")
 	proto.RegisterType((*QueryVersionRequest)(nil), "connect.service.v2.QueryVersionRequest")
@@ -315,42 +384,47 @@ func init() {
 func init() { proto.RegisterFile("connect/service/v2/oracle.proto", fileDescriptor_9b4d2eaa50661ccd) }
 
 var fileDescriptor_9b4d2eaa50661ccd = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x5b, 0xe8, 0xa8, 0x7b, 0x41, 0xa6, 0x83, 0x2e, 0x54, 0x49, 0x17, 0x21, 0x28, 0x48,
-	0x38, 0x28, 0xbb, 0xf0, 0x43, 0xe2, 0x50, 0x89, 0xe3, 0x04, 0x8b, 0x00, 0x21, 0x2e, 0x93, 0x1b,
-	0x99, 0x10, 0xb5, 0x89, 0x4d, 0xec, 0x44, 0xaa, 0xc4, 0x01, 0x38, 0x71, 0x9c, 0x04, 0x7f, 0xd4,
-	0x8e, 0x93, 0xb8, 0x70, 0x02, 0xd4, 0xf2, 0x87, 0xa0, 0xd8, 0x4e, 0xb6, 0x8e, 0x4e, 0xdb, 0xa9,
-	0x7e, 0x7e, 0xdf, 0x7b, 0xef, 0xfb, 0xbe, 0xe7, 0x14, 0x3a, 0x21, 0x4b, 0x53, 0x1a, 0x4a, 0x4f,
-	0xd0, 0xac, 0x88, 0x43, 0xea, 0x15, 0xbe, 0xc7, 0x32, 0x12, 0xce, 0x28, 0xe6, 0x19, 0x93, 0x0c,
-	0x21, 0x03, 0xc0, 0x06, 0x80, 0x0b, 0xdf, 0xea, 0x45, 0x2c, 0x62, 0x2a, 0xed, 0x95, 0x27, 0x8d,
-	0xb4, 0x06, 0x11, 0x63, 0xd1, 0x8c, 0x7a, 0x84, 0xc7, 0x1e, 0x49, 0x53, 0x26, 0x89, 0x8c, 0x59,
-	0x2a, 0x4c, 0xd6, 0x31, 0x59, 0x15, 0x4d, 0xf2, 0x77, 0x9e, 0x8c, 0x13, 0x2a, 0x24, 0x49, 0xb8,
-	0x01, 0x6c, 0x85, 0x4c, 0x24, 0x4c, 0xec, 0xeb, 0xbe, 0x3a, 0x30, 0xa9, 0xed, 0x8a, 0x64, 0x42,
-	0xb2, 0x29, 0x95, 0x09, 0xe1, 0x25, 0x4d, 0x1d, 0x68, 0x88, 0xdb, 0x83, 0x68, 0x2f, 0xa7, 0xd9,
-	0xfc, 0x45, 0x16, 0x87, 0x54, 0x04, 0xf4, 0x43, 0x4e, 0x85, 0x74, 0x3f, 0x37, 0xe1, 0xb5, 0x95,
-	0x6b, 0xc1, 0x59, 0x2a, 0x28, 0xda, 0x83, 0x6d, 0xae, 0x6e, 0xfa, 0x60, 0xd8, 0x1a, 0x75, 0xfd,
-	0x1d, 0xfc, 0xbf, 0x4a, 0xbc, 0xa6, 0x10, 0xeb, 0xf0, 0x59, 0x2a, 0xb3, 0xf9, 0xf8, 0xd2, 0xe1,
-	0x2f, 0xa7, 0x11, 0x98, 0x46, 0x68, 0x0c, 0x3b, 0xb5, 0xa2, 0x7e, 0x73, 0x08, 0x46, 0x5d, 0xdf,
-	0xc2, 0x5a, 0x33, 0xae, 0x34, 0xe3, 0x97, 0x15, 0x62, 0x7c, 0xa5, 0x2c, 0x3e, 0xf8, 0xed, 0x80,
-	0xe0, 0xb8, 0x0c, 0xf5, 0xe1, 0x46, 0x41, 0x33, 0x11, 0xb3, 0xb4, 0xdf, 0x1a, 0x82, 0x51, 0x27,
-	0xa8, 0x42, 0xeb, 0x11, 0xec, 0x9e, 0x18, 0x8d, 0xae, 0xc2, 0xd6, 0x94, 0xce, 0xfb, 0x40, 0x81,
-	0xca, 0x23, 0xea, 0xc1, 0xcb, 0x05, 0x99, 0xe5, 0x54, 0x8d, 0xee, 0x04, 0x3a, 0x78, 0xdc, 0x7c,
-	0x08, 0xdc, 0x1b, 0x70, 0x53, 0x29, 0xd9, 0x55, 0x76, 0xed, 0x12, 0x5e, 0x99, 0xf3, 0x06, 0x5e,
-	0x3f, 0x9d, 0x30, 0xf6, 0x3c, 0x85, 0x50, 0x9b, 0xbb, 0x9f, 0x10, 0xae, 0xa6, 0x74, 0x7d, 0xa7,
-	0xb6, 0xa8, 0x5e, 0x42, 0x69, 0xd2, 0x71, 0x71, 0x27, 0xa9, 0x8e, 0xee, 0xa6, 0x71, 0xfd, 0xb5,
-	0x66, 0x5f, 0x0d, 0x7c, 0x00, 0x7b, 0xab, 0xd7, 0x66, 0xdc, 0x09, 0xd9, 0x60, 0x45, 0xb6, 0xff,
-	0xbd, 0x05, 0xdb, 0xcf, 0xd5, 0x6b, 0x44, 0x1f, 0x61, 0x5b, 0x3b, 0x80, 0x6e, 0x9f, 0xbb, 0x2c,
-	0x35, 0xce, 0xba, 0x73, 0xc1, 0xa5, 0xba, 0xdb, 0x5f, 0x7e, 0xfc, 0xfd, 0xd6, 0xbc, 0x89, 0xb6,
-	0xbc, 0xea, 0x9d, 0xe9, 0x2f, 0xa0, 0x7c, 0x64, 0x66, 0xbb, 0x5f, 0x01, 0xec, 0xd4, 0x52, 0xd1,
-	0xdd, 0x33, 0x3b, 0x9f, 0x36, 0xd9, 0xba, 0x77, 0x11, 0xa8, 0xe1, 0x71, 0x4b, 0xf1, 0xb0, 0xd1,
-	0x60, 0x0d, 0x8f, 0xda, 0x74, 0xf4, 0x09, 0xc0, 0x0d, 0xe3, 0x20, 0x3a, 0x5b, 0xe2, 0xaa, 0xf5,
-	0xd6, 0xe8, 0x7c, 0xa0, 0x21, 0xe1, 0x2a, 0x12, 0x03, 0x64, 0xad, 0x21, 0x61, 0xd6, 0x32, 0x7e,
-	0x75, 0xb8, 0xb0, 0xc1, 0xd1, 0xc2, 0x06, 0x7f, 0x16, 0x36, 0x38, 0x58, 0xda, 0x8d, 0xa3, 0xa5,
-	0xdd, 0xf8, 0xb9, 0xb4, 0x1b, 0x6f, 0x9f, 0x44, 0xb1, 0x7c, 0x9f, 0x4f, 0x70, 0xc8, 0x12, 0x4f,
-	0x4c, 0x63, 0x7e, 0x3f, 0xa1, 0x45, 0xdd, 0xa8, 0xf0, 0xeb, 0x7f, 0x99, 0xf2, 0x97, 0x66, 0xa2,
-	0xea, 0x2d, 0xe7, 0x9c, 0x8a, 0x49, 0x5b, 0x7d, 0x27, 0x3b, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x88, 0x1b, 0xe4, 0x95, 0x94, 0x04, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x34, 0xfd, 0xd2, 0x66, 0xf2, 0x45, 0x42, 0x43, 0x0a, 0xa9, 0xa9, 0x9c, 0xd6, 0x20,
+	0x08, 0x48, 0xd8, 0xc8, 0x15, 0xe2, 0x4f, 0x62, 0x11, 0x89, 0x65, 0x45, 0x6b, 0xf1, 0x27, 0x16,
+	0x54, 0x53, 0x77, 0x08, 0x56, 0x3b, 0x1e, 0xe3, 0x19, 0x5b, 0x44, 0x62, 0x81, 0x58, 0xb1, 0xac,
+	0x04, 0x4f, 0xc3, 0x13, 0x74, 0x59, 0x89, 0x0d, 0x2b, 0x40, 0x2d, 0x0f, 0x82, 0x3c, 0x3f, 0x6e,
+	0x5c, 0x52, 0x35, 0x62, 0xe5, 0xb9, 0x73, 0xef, 0x3d, 0x73, 0xce, 0x9d, 0x33, 0x86, 0xbd, 0x90,
+	0xc5, 0x31, 0x09, 0x85, 0xc7, 0x49, 0x9a, 0x47, 0x21, 0xf1, 0x72, 0xdf, 0x63, 0x29, 0x0e, 0x77,
+	0x89, 0x9b, 0xa4, 0x4c, 0x30, 0x84, 0x74, 0x81, 0xab, 0x0b, 0xdc, 0xdc, 0xb7, 0x3a, 0x43, 0x36,
+	0x64, 0x32, 0xed, 0x15, 0x2b, 0x55, 0x69, 0x2d, 0x0d, 0x19, 0x1b, 0xee, 0x12, 0x0f, 0x27, 0x91,
+	0x87, 0xe3, 0x98, 0x09, 0x2c, 0x22, 0x16, 0x73, 0x9d, 0xed, 0xe9, 0xac, 0x8c, 0xb6, 0xb2, 0xd7,
+	0x9e, 0x88, 0x28, 0xe1, 0x02, 0xd3, 0x44, 0x17, 0x2c, 0x86, 0x8c, 0x53, 0xc6, 0x37, 0x15, 0xae,
+	0x0a, 0x74, 0x6a, 0xc5, 0x90, 0xa4, 0x38, 0xdd, 0x21, 0x82, 0xe2, 0xa4, 0xa0, 0xa9, 0x02, 0x55,
+	0xe2, 0x74, 0x20, 0xda, 0xc8, 0x48, 0x3a, 0x5a, 0x4f, 0xa3, 0x90, 0xf0, 0x80, 0xbc, 0xcd, 0x08,
+	0x17, 0xce, 0xd7, 0x3a, 0x3c, 0x5f, 0xd9, 0xe6, 0x09, 0x8b, 0x39, 0x41, 0x1b, 0xb0, 0x91, 0xc8,
+	0x9d, 0x2e, 0x58, 0xae, 0xf7, 0x5b, 0xfe, 0xaa, 0xfb, 0xb7, 0x4a, 0x77, 0x42, 0xa3, 0xab, 0xc2,
+	0x47, 0xb1, 0x48, 0x47, 0x83, 0xd9, 0xfd, 0x1f, 0xbd, 0x5a, 0xa0, 0x81, 0xd0, 0x00, 0x36, 0x4b,
+	0x45, 0xdd, 0x99, 0x65, 0xd0, 0x6f, 0xf9, 0x96, 0xab, 0x34, 0xbb, 0x46, 0xb3, 0xfb, 0xc4, 0x54,
+	0x0c, 0xe6, 0x8b, 0xe6, 0xbd, 0x9f, 0x3d, 0x10, 0x1c, 0xb7, 0xa1, 0x2e, 0x9c, 0xcb, 0x49, 0xca,
+	0x23, 0x16, 0x77, 0xeb, 0xcb, 0xa0, 0xdf, 0x0c, 0x4c, 0x88, 0x9e, 0xc3, 0x79, 0x4a, 0x04, 0xde,
+	0xc6, 0x02, 0x77, 0x67, 0x25, 0xe5, 0xdb, 0xd3, 0x52, 0x5e, 0xd3, 0x7d, 0xe3, 0xa4, 0x4b, 0x30,
+	0xeb, 0x1e, 0x6c, 0x8d, 0x69, 0x42, 0xe7, 0x60, 0x7d, 0x87, 0x8c, 0xba, 0x40, 0x9e, 0x5e, 0x2c,
+	0x51, 0x07, 0xfe, 0x97, 0xe3, 0xdd, 0x8c, 0x48, 0x4d, 0xcd, 0x40, 0x05, 0xf7, 0x67, 0xee, 0x02,
+	0xeb, 0x15, 0x6c, 0x57, 0xb0, 0x27, 0x34, 0xdf, 0x19, 0x6f, 0x6e, 0xf9, 0x2b, 0x93, 0x38, 0xcb,
+	0xe3, 0x0d, 0xd0, 0x18, 0xbe, 0xf3, 0x0e, 0xb6, 0x2b, 0xb9, 0xea, 0x88, 0xc1, 0xbf, 0x8d, 0xf8,
+	0x32, 0x6c, 0xc7, 0x19, 0x2d, 0x4c, 0x96, 0x47, 0xdb, 0x24, 0xe5, 0x92, 0x59, 0x3b, 0xf8, 0x3f,
+	0xce, 0xe8, 0xba, 0xd9, 0x73, 0x2e, 0xc2, 0x05, 0x39, 0xc9, 0x35, 0xe9, 0xb0, 0x35, 0x9c, 0x18,
+	0x3f, 0xbd, 0x80, 0x17, 0x4e, 0x26, 0xb4, 0xa3, 0x1e, 0x42, 0xa8, 0xfc, 0xb8, 0x49, 0xb1, 0x21,
+	0xd7, 0x2b, 0xe5, 0x96, 0xbe, 0x2d, 0x04, 0x1f, 0x37, 0x37, 0xa9, 0x59, 0x3a, 0x0b, 0xda, 0xa8,
+	0xcf, 0xd4, 0x85, 0x9b, 0x03, 0x6f, 0xc1, 0x4e, 0x75, 0x5b, 0x1f, 0x37, 0xe6, 0x14, 0x50, 0x71,
+	0x8a, 0xff, 0xa5, 0x0e, 0x1b, 0x8f, 0xe5, 0x03, 0x46, 0xef, 0x61, 0x43, 0xdd, 0x2d, 0xba, 0x7a,
+	0xa6, 0x59, 0xe4, 0x71, 0xd6, 0xb5, 0x29, 0x4d, 0xe5, 0xac, 0x7c, 0xfc, 0xf6, 0xfb, 0xf3, 0xcc,
+	0x25, 0xb4, 0xe8, 0x99, 0xa7, 0xa9, 0x7e, 0x1a, 0xc5, 0xbb, 0xd4, 0x0f, 0xe2, 0x13, 0x80, 0xcd,
+	0x52, 0x2a, 0xba, 0x7e, 0x2a, 0xf2, 0xc9, 0x21, 0x5b, 0x37, 0xa6, 0x29, 0xd5, 0x3c, 0xae, 0x48,
+	0x1e, 0x36, 0x5a, 0x9a, 0xc0, 0xa3, 0x1c, 0x3a, 0xfa, 0x00, 0xe0, 0x9c, 0x9e, 0x20, 0x3a, 0x5d,
+	0x62, 0x75, 0xf4, 0x56, 0xff, 0xec, 0x42, 0x4d, 0xc2, 0x91, 0x24, 0x96, 0x90, 0x35, 0x81, 0x84,
+	0xbe, 0x96, 0xc1, 0xd3, 0xfd, 0x43, 0x1b, 0x1c, 0x1c, 0xda, 0xe0, 0xd7, 0xa1, 0x0d, 0xf6, 0x8e,
+	0xec, 0xda, 0xc1, 0x91, 0x5d, 0xfb, 0x7e, 0x64, 0xd7, 0x5e, 0x3e, 0x18, 0x46, 0xe2, 0x4d, 0xb6,
+	0xe5, 0x86, 0x8c, 0x7a, 0x7c, 0x27, 0x4a, 0x6e, 0x52, 0x92, 0x97, 0x40, 0xb9, 0x5f, 0xfe, 0x98,
+	0x8b, 0x2f, 0x49, 0xb9, 0xc1, 0x16, 0xa3, 0x84, 0xf0, 0xad, 0x86, 0xf4, 0xfd, 0xea, 0x9f, 0x01,
+	0x00, 0xa5, 0x4f, 0x40, 0x06, 0xc7, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -559,6 +633,30 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -566,12 +664,12 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Prices) > 0 {
@@ -596,6 +694,42 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumProviders != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.NumProviders))
+		i--
+		dAtA[i] = 0x10
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -747,6 +881,29 @@ func (m *QueryPricesResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PriceMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.NumProviders != 0 {
+		n += 1 + sovOracle(uint64(m.NumProviders))
+	}
 	return n
 }
 
@@ -1071,6 +1228,237 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]PriceMetadata)
+			}
+			var mapkey string
+			mapvalue := &PriceMetadata{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PriceMetadata{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProviders", wireType)
+			}
+			m.NumProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumProviders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
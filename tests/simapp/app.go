@@ -261,6 +261,12 @@ func NewSimApp(
 
 	// set hooks
 	app.MarketMapKeeper.SetHooks(app.OracleKeeper.Hooks())
+	app.MarketMapKeeper.SetOracleKeeper(app.OracleKeeper)
+	app.OracleKeeper.SetParticipationHooks(NewOracleLivenessHooks(
+		app.SlashingKeeper,
+		app.StakingKeeper,
+		DefaultOracleLivenessConfig(),
+	))
 
 	//----------------------------------------------------------------------//
 	//						  ORACLE INITIALIZATION 						//
@@ -330,11 +336,11 @@ func NewSimApp(
 	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
 
 	// Create the aggregation function that will be used to aggregate oracle data
-	// from each validator.
-	aggregatorFn := voteweighted.MedianFromContext(
+	// from each validator. The aggregation method is selected by the x/oracle params.
+	aggregatorFn := voteweighted.FromParams(
 		app.Logger(),
 		app.StakingKeeper,
-		voteweighted.DefaultPowerThreshold,
+		app.OracleKeeper,
 	)
 
 	// Create the pre-finalize block hook that will be used to apply oracle data
@@ -381,6 +387,7 @@ func NewSimApp(
 				// we need a separate price strategy here, so that we can optimistically apply the latest prices
 				// and extend our vote based on these prices
 				currencypair.NewDeltaCurrencyPairStrategy(app.OracleKeeper),
+				aggregator.WithParamsKeeper(app.OracleKeeper),
 			),
 			app.OracleKeeper,
 			veCodec,
@@ -388,6 +395,7 @@ func NewSimApp(
 			app.Logger(),
 		),
 		oracleMetrics,
+		ve.WithVoteExtensionBudget(app.OracleKeeper, app.MarketMapKeeper),
 	)
 	app.SetExtendVoteHandler(voteExtensionsHandler.ExtendVoteHandler())
 	app.SetVerifyVoteExtensionHandler(voteExtensionsHandler.VerifyVoteExtensionHandler())
@@ -0,0 +1,124 @@
+package simapp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// OracleLivenessSlashingKeeper is the subset of the x/slashing keeper used to penalize validators that fail to
+// report oracle prices.
+type OracleLivenessSlashingKeeper interface {
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64) error
+}
+
+// OracleLivenessStakingKeeper is the subset of the x/staking keeper used to look up the validators that fail to
+// report oracle prices.
+type OracleLivenessStakingKeeper interface {
+	ValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error)
+	PowerReduction(ctx context.Context) math.Int
+}
+
+// OracleLivenessConfig configures the penalties applied by the OracleLivenessHooks.
+type OracleLivenessConfig struct {
+	// MaxMissRate is the miss rate, over a participation window, above which a validator is penalized.
+	MaxMissRate math.LegacyDec
+
+	// JailDuration is the duration that a penalized validator is jailed for. A zero duration disables jailing.
+	JailDuration time.Duration
+
+	// SlashFraction is the fraction of a penalized validator's stake that is slashed. A zero fraction disables
+	// slashing.
+	SlashFraction math.LegacyDec
+}
+
+// DefaultOracleLivenessConfig returns an OracleLivenessConfig that jails validators that miss more than half of
+// their oracle reports for ten minutes, without slashing them.
+func DefaultOracleLivenessConfig() OracleLivenessConfig {
+	return OracleLivenessConfig{
+		MaxMissRate:   math.LegacyNewDecWithPrec(5, 1),
+		JailDuration:  10 * time.Minute,
+		SlashFraction: math.LegacyZeroDec(),
+	}
+}
+
+var _ oracletypes.ParticipationHooks = OracleLivenessHooks{}
+
+// OracleLivenessHooks is a reference implementation of the x/oracle ParticipationHooks that slashes and jails
+// validators whose oracle miss rate exceeds a configured threshold at the end of each participation window.
+type OracleLivenessHooks struct {
+	slashingKeeper OracleLivenessSlashingKeeper
+	stakingKeeper  OracleLivenessStakingKeeper
+	config         OracleLivenessConfig
+}
+
+// NewOracleLivenessHooks returns a new OracleLivenessHooks.
+func NewOracleLivenessHooks(
+	slashingKeeper OracleLivenessSlashingKeeper,
+	stakingKeeper OracleLivenessStakingKeeper,
+	config OracleLivenessConfig,
+) OracleLivenessHooks {
+	return OracleLivenessHooks{
+		slashingKeeper: slashingKeeper,
+		stakingKeeper:  stakingKeeper,
+		config:         config,
+	}
+}
+
+// AfterParticipationWindow slashes and jails each bonded validator whose miss rate exceeds the configured maximum.
+// Validators that are unknown to x/staking, or already jailed, are skipped.
+func (h OracleLivenessHooks) AfterParticipationWindow(ctx sdk.Context, missRates []oracletypes.ValidatorMissRate) error {
+	for _, missRate := range missRates {
+		if missRate.MissRate.LTE(h.config.MaxMissRate) {
+			continue
+		}
+
+		validator, err := h.stakingKeeper.ValidatorByConsAddr(ctx, missRate.Validator)
+		if err != nil {
+			if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+				continue
+			}
+
+			return err
+		}
+
+		if validator.IsJailed() || !validator.IsBonded() {
+			continue
+		}
+
+		if h.config.SlashFraction.IsPositive() {
+			// the infraction occurred over the window, so attribute it to the last block whose validator set is known
+			power := validator.GetConsensusPower(h.stakingKeeper.PowerReduction(ctx))
+			distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+			if err := h.slashingKeeper.Slash(ctx, missRate.Validator, h.config.SlashFraction, power, distributionHeight); err != nil {
+				return err
+			}
+		}
+
+		if h.config.JailDuration > 0 {
+			if err := h.slashingKeeper.Jail(ctx, missRate.Validator); err != nil {
+				return err
+			}
+
+			if err := h.slashingKeeper.JailUntil(ctx, missRate.Validator, ctx.BlockTime().Add(h.config.JailDuration)); err != nil {
+				return err
+			}
+		}
+
+		ctx.Logger().Info(
+			"penalized validator for missing oracle reports",
+			"validator", missRate.Validator.String(),
+			"miss_rate", missRate.MissRate.String(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package simapp_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/tests/simapp"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+type slash struct {
+	fraction math.LegacyDec
+	power    int64
+}
+
+// fakeSlashingKeeper records the penalties applied to each validator.
+type fakeSlashingKeeper struct {
+	slashes   map[string]slash
+	jailedTil map[string]time.Time
+}
+
+func (k *fakeSlashingKeeper) Jail(_ context.Context, _ sdk.ConsAddress) error {
+	return nil
+}
+
+func (k *fakeSlashingKeeper) JailUntil(_ context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error {
+	k.jailedTil[consAddr.String()] = jailTime
+	return nil
+}
+
+func (k *fakeSlashingKeeper) Slash(_ context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, _ int64) error {
+	k.slashes[consAddr.String()] = slash{fraction: fraction, power: power}
+	return nil
+}
+
+// fakeStakingKeeper returns the validators it was constructed with.
+type fakeStakingKeeper struct {
+	validators map[string]stakingtypes.Validator
+}
+
+func (k fakeStakingKeeper) ValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	validator, ok := k.validators[consAddr.String()]
+	if !ok {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	return validator, nil
+}
+
+func (k fakeStakingKeeper) PowerReduction(_ context.Context) math.Int {
+	return sdk.DefaultPowerReduction
+}
+
+func TestOracleLivenessHooks(t *testing.T) {
+	live := sdk.ConsAddress("live")
+	offline := sdk.ConsAddress("offline")
+	jailed := sdk.ConsAddress("jailed")
+	unknown := sdk.ConsAddress("unknown")
+
+	bonded := stakingtypes.Validator{Status: stakingtypes.Bonded, Tokens: sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)}
+	stakingKeeper := fakeStakingKeeper{
+		validators: map[string]stakingtypes.Validator{
+			live.String():    bonded,
+			offline.String(): bonded,
+			jailed.String():  {Status: stakingtypes.Bonded, Jailed: true},
+		},
+	}
+
+	missRates := []oracletypes.ValidatorMissRate{
+		oracletypes.NewValidatorMissRate(live, oracletypes.ParticipationCounts{Reported: 9, MissingPrice: 1}),
+		oracletypes.NewValidatorMissRate(offline, oracletypes.ParticipationCounts{Reported: 2, Absent: 6, MissingPrice: 2}),
+		oracletypes.NewValidatorMissRate(jailed, oracletypes.ParticipationCounts{Absent: 10}),
+		oracletypes.NewValidatorMissRate(unknown, oracletypes.ParticipationCounts{Absent: 10}),
+	}
+
+	now := time.Unix(1_000, 0).UTC()
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).
+		WithBlockHeight(100).
+		WithBlockTime(now)
+
+	t.Run("validators above the maximum miss rate are slashed and jailed", func(t *testing.T) {
+		slashingKeeper := &fakeSlashingKeeper{slashes: map[string]slash{}, jailedTil: map[string]time.Time{}}
+		hooks := simapp.NewOracleLivenessHooks(slashingKeeper, stakingKeeper, simapp.OracleLivenessConfig{
+			MaxMissRate:   math.LegacyNewDecWithPrec(5, 1),
+			JailDuration:  time.Hour,
+			SlashFraction: math.LegacyNewDecWithPrec(1, 2),
+		})
+
+		require.NoError(t, hooks.AfterParticipationWindow(ctx, missRates))
+		require.Equal(t, map[string]slash{
+			offline.String(): {fraction: math.LegacyNewDecWithPrec(1, 2), power: 10},
+		}, slashingKeeper.slashes)
+		require.Equal(t, map[string]time.Time{offline.String(): now.Add(time.Hour)}, slashingKeeper.jailedTil)
+	})
+
+	t.Run("the default config jails without slashing", func(t *testing.T) {
+		slashingKeeper := &fakeSlashingKeeper{slashes: map[string]slash{}, jailedTil: map[string]time.Time{}}
+		hooks := simapp.NewOracleLivenessHooks(slashingKeeper, stakingKeeper, simapp.DefaultOracleLivenessConfig())
+
+		require.NoError(t, hooks.AfterParticipationWindow(ctx, missRates))
+		require.Empty(t, slashingKeeper.slashes)
+		require.Equal(t, map[string]time.Time{offline.String(): now.Add(10 * time.Minute)}, slashingKeeper.jailedTil)
+	})
+}
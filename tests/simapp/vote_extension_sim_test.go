@@ -0,0 +1,400 @@
+package simapp_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/tests/simapp"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oraclekeeper "github.com/skip-mev/connect/v2/x/oracle/keeper"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+const (
+	// numVoteExtensionSimValidators is the number of validators that sign vote extensions.
+	numVoteExtensionSimValidators = 4
+
+	// numVoteExtensionSimBlocks is the number of blocks that are simulated.
+	numVoteExtensionSimBlocks = 25
+
+	// voteExtensionsEnableHeight is the height from which validators sign vote extensions, which are first
+	// included in the proposal of the following height.
+	voteExtensionsEnableHeight = 2
+)
+
+// voteExtensionSimCurrencyPairs are the currency pairs that validators report prices for.
+var voteExtensionSimCurrencyPairs = []connecttypes.CurrencyPair{
+	connecttypes.NewCurrencyPair("BTC", "USD"),
+	connecttypes.NewCurrencyPair("ETH", "USD"),
+	connecttypes.NewCurrencyPair("SOL", "USD"),
+	connecttypes.NewCurrencyPair("ATOM", "USD"),
+}
+
+// simValidator is a validator whose private key signs its vote extensions.
+type simValidator struct {
+	privKey cmted25519.PrivKey
+	address []byte
+	power   int64
+}
+
+// TestRandomizedVoteExtensions drives randomized oracle vote extensions through the application's ABCI handlers.
+// At every height each validator reports a random subset of the currency pairs at prices that deviate randomly
+// from a random walk, and may be absent from the commit altogether. The vote extensions are verified with
+// VerifyVoteExtension, and the extended commit is proposed, processed and finalized, after which the prices
+// written to x/oracle are checked against the reported prices. The simulation seed is set with the -Seed flag.
+func TestRandomizedVoteExtensions(t *testing.T) {
+	r := rand.New(rand.NewSource(simcli.FlagSeedValue))
+
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	validators := initVoteExtensionSimChain(t, app)
+
+	prices := make(map[connecttypes.CurrencyPair]*big.Int, len(voteExtensionSimCurrencyPairs))
+	for _, cp := range voteExtensionSimCurrencyPairs {
+		prices[cp] = big.NewInt(int64(simtypes.RandIntBetween(r, 1_000_000, 100_000_000_000)))
+	}
+
+	var (
+		votes    []cometabci.ExtendedVoteInfo
+		reported map[connecttypes.CurrencyPair][]*big.Int
+		proposer = validators[0].address
+	)
+
+	blockTime := time.Now().UTC()
+	for height := int64(1); height <= numVoteExtensionSimBlocks; height++ {
+		blockTime = blockTime.Add(time.Second)
+
+		extCommit := cometabci.ExtendedCommitInfo{Votes: votes}
+		lastCommit := cometabci.CommitInfo{Votes: make([]cometabci.VoteInfo, len(votes))}
+		for i, vote := range votes {
+			lastCommit.Votes[i] = cometabci.VoteInfo{Validator: vote.Validator, BlockIdFlag: vote.BlockIdFlag}
+		}
+
+		prepareRes, err := app.PrepareProposal(&cometabci.RequestPrepareProposal{
+			Height:          height,
+			Time:            blockTime,
+			MaxTxBytes:      1 << 20,
+			LocalLastCommit: extCommit,
+			ProposerAddress: proposer,
+		})
+		require.NoError(t, err)
+
+		processRes, err := app.ProcessProposal(&cometabci.RequestProcessProposal{
+			Height:             height,
+			Time:               blockTime,
+			Txs:                prepareRes.Txs,
+			ProposedLastCommit: lastCommit,
+			ProposerAddress:    proposer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, cometabci.ResponseProcessProposal_ACCEPT, processRes.Status, "proposal rejected at height %d", height)
+
+		_, err = app.FinalizeBlock(&cometabci.RequestFinalizeBlock{
+			Height:            height,
+			Time:              blockTime,
+			Txs:               prepareRes.Txs,
+			DecidedLastCommit: lastCommit,
+			ProposerAddress:   proposer,
+		})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+
+		ctx := app.NewContext(true)
+		checkReportedPrices(t, app, ctx, uint64(height), votes, reported)
+
+		// every currency pair follows a random walk of at most 1% per block
+		for _, cp := range voteExtensionSimCurrencyPairs {
+			price := prices[cp]
+			step := new(big.Int).Div(price, big.NewInt(100))
+			delta := new(big.Int).Rand(r, new(big.Int).Add(new(big.Int).Mul(step, big.NewInt(2)), big.NewInt(1)))
+			price.Add(price, delta.Sub(delta, step))
+		}
+
+		votes, reported = randomVoteExtensions(t, r, app, ctx, height, validators, prices)
+		proposer = validators[r.Intn(len(validators))].address
+	}
+
+	ctx := app.NewContext(true)
+	for _, cp := range voteExtensionSimCurrencyPairs {
+		_, err := app.OracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+		require.NoError(t, err, "no price was written for %s", cp)
+	}
+
+	msg, broken := oraclekeeper.AllInvariants(app.OracleKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+// initVoteExtensionSimChain initializes the chain with a set of validators, markets for each of the simulated
+// currency pairs, and vote extensions enabled.
+func initVoteExtensionSimChain(t *testing.T, app *simapp.SimApp) []simValidator {
+	t.Helper()
+
+	validators := make([]simValidator, numVoteExtensionSimValidators)
+	cmtValidators := make([]*cmttypes.Validator, numVoteExtensionSimValidators)
+	for i := range validators {
+		privKey := cmted25519.GenPrivKey()
+		// the genesis validators are bonded with a single unit of consensus power each
+		validators[i] = simValidator{privKey: privKey, address: privKey.PubKey().Address(), power: 1}
+		cmtValidators[i] = cmttypes.NewValidator(privKey.PubKey(), validators[i].power)
+	}
+
+	// votes in the extended commit are ordered by voting power, and then by address
+	slices.SortFunc(validators, func(a, b simValidator) int {
+		return bytes.Compare(a.address, b.address)
+	})
+
+	acc := authtypes.NewBaseAccount(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), nil, 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100_000_000_000_000))),
+	}
+
+	genesis, err := simtestutil.GenesisStateWithValSet(
+		app.AppCodec(),
+		app.DefaultGenesis(),
+		cmttypes.NewValidatorSet(cmtValidators),
+		[]authtypes.GenesisAccount{acc},
+		balance,
+	)
+	require.NoError(t, err)
+
+	// the bonded pool balance and total supply are only computed correctly for a single validator, so the bonded
+	// pool is funded for every validator and the total supply is derived from the balances instead
+	var bankGenesis banktypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(genesis[banktypes.ModuleName], &bankGenesis)
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	for i, b := range bankGenesis.Balances {
+		if b.Address == bondedPool {
+			bankGenesis.Balances[i].Coins = sdk.NewCoins(sdk.NewCoin(
+				sdk.DefaultBondDenom,
+				sdk.DefaultPowerReduction.MulRaw(numVoteExtensionSimValidators),
+			))
+		}
+	}
+	bankGenesis.Supply = nil
+	genesis[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(&bankGenesis)
+
+	// the genesis validators are not created through x/staking, so their signing infos are set explicitly
+	slashingGenesis := slashingtypes.DefaultGenesisState()
+	for _, validator := range validators {
+		consAddr := sdk.ConsAddress(validator.address)
+		slashingGenesis.SigningInfos = append(slashingGenesis.SigningInfos, slashingtypes.SigningInfo{
+			Address:              consAddr.String(),
+			ValidatorSigningInfo: slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0),
+		})
+	}
+	genesis[slashingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(slashingGenesis)
+
+	oracleGenesis := oracletypes.DefaultGenesisState()
+	marketMapGenesis := marketmaptypes.DefaultGenesisState()
+	for i, cp := range voteExtensionSimCurrencyPairs {
+		oracleGenesis.CurrencyPairGenesis = append(oracleGenesis.CurrencyPairGenesis, oracletypes.CurrencyPairGenesis{
+			CurrencyPair: cp,
+			Id:           uint64(i),
+		})
+
+		marketMapGenesis.MarketMap.Markets[cp.String()] = marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{
+				CurrencyPair:     cp,
+				Decimals:         8,
+				MinProviderCount: 1,
+				Enabled:          true,
+				Status:           marketmaptypes.MarketStatus_MARKET_STATUS_ACTIVE,
+			},
+			ProviderConfigs: []marketmaptypes.ProviderConfig{
+				{Name: "sim_provider", OffChainTicker: cp.Base + "-" + cp.Quote},
+			},
+		}
+	}
+	oracleGenesis.NextId = uint64(len(voteExtensionSimCurrencyPairs))
+	genesis[oracletypes.ModuleName] = app.AppCodec().MustMarshalJSON(oracleGenesis)
+	genesis[marketmaptypes.ModuleName] = app.AppCodec().MustMarshalJSON(marketMapGenesis)
+
+	stateBytes, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	consensusParams := *simtestutil.DefaultConsensusParams
+	consensusParams.Abci = &cmtproto.ABCIParams{VoteExtensionsEnableHeight: voteExtensionsEnableHeight}
+
+	_, err = app.InitChain(&cometabci.RequestInitChain{
+		ChainId:         app.ChainID(),
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   stateBytes,
+		InitialHeight:   1,
+	})
+	require.NoError(t, err)
+
+	return validators
+}
+
+// randomVoteExtensions returns the votes of the validators at the given height, along with the prices that were
+// reported for each currency pair. Each validator is absent with a small probability, as long as more than two
+// thirds of the voting power commits, and otherwise reports a random subset of the currency pairs at prices within
+// 0.5% of the given prices. Vote extensions are only signed from the vote extensions enable height, and each is
+// verified with the application's VerifyVoteExtension handler.
+func randomVoteExtensions(
+	t *testing.T,
+	r *rand.Rand,
+	app *simapp.SimApp,
+	ctx sdk.Context,
+	height int64,
+	validators []simValidator,
+	prices map[connecttypes.CurrencyPair]*big.Int,
+) ([]cometabci.ExtendedVoteInfo, map[connecttypes.CurrencyPair][]*big.Int) {
+	t.Helper()
+
+	// vote extensions are encoded with the same strategy and codec as the application's vote extension handler
+	veCodec := compression.NewCompressionVoteExtensionCodec(
+		compression.NewDefaultVoteExtensionCodec(),
+		compression.NewZLibCompressor(),
+	)
+
+	version, strategy, err := currencypair.CurrentVersion(ctx, currencypair.NewDeltaCurrencyPairStrategy(app.OracleKeeper))
+	require.NoError(t, err)
+
+	var totalPower, absentPower int64
+	for _, validator := range validators {
+		totalPower += validator.power
+	}
+
+	votes := make([]cometabci.ExtendedVoteInfo, len(validators))
+	reported := make(map[connecttypes.CurrencyPair][]*big.Int)
+	for i, validator := range validators {
+		votes[i] = cometabci.ExtendedVoteInfo{
+			Validator:   cometabci.Validator{Address: validator.address, Power: validator.power},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		}
+
+		if r.Intn(10) == 0 && 3*(absentPower+validator.power) < totalPower {
+			absentPower += validator.power
+			votes[i].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+			continue
+		}
+
+		if height < voteExtensionsEnableHeight {
+			continue
+		}
+
+		ve := vetypes.OracleVoteExtension{
+			Prices:  make(map[uint64][]byte),
+			Version: version,
+		}
+		for _, cp := range voteExtensionSimCurrencyPairs {
+			if r.Intn(5) == 0 {
+				continue
+			}
+
+			spread := new(big.Int).Div(prices[cp], big.NewInt(200))
+			price := new(big.Int).Rand(r, new(big.Int).Add(new(big.Int).Mul(spread, big.NewInt(2)), big.NewInt(1)))
+			price.Add(price, prices[cp]).Sub(price, spread)
+
+			id, err := strategy.ID(ctx, cp)
+			require.NoError(t, err)
+			ve.Prices[id], err = strategy.GetEncodedPrice(ctx, cp, price)
+			require.NoError(t, err)
+
+			reported[cp] = append(reported[cp], price)
+		}
+
+		bz, err := veCodec.Encode(ve)
+		require.NoError(t, err)
+
+		verifyRes, err := app.VerifyVoteExtension(&cometabci.RequestVerifyVoteExtension{
+			Height:           height,
+			ValidatorAddress: validator.address,
+			VoteExtension:    bz,
+		})
+		require.NoError(t, err)
+		require.Equal(t, cometabci.ResponseVerifyVoteExtension_ACCEPT, verifyRes.Status)
+
+		votes[i].VoteExtension = bz
+		votes[i].ExtensionSignature = signVoteExtension(t, validator, app.ChainID(), height, bz)
+	}
+
+	return votes, reported
+}
+
+// signVoteExtension signs the canonical vote extension of the validator at the given height.
+func signVoteExtension(t *testing.T, validator simValidator, chainID string, height int64, extension []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, protoio.NewDelimitedWriter(&buf).WriteMsg(&cmtproto.CanonicalVoteExtension{
+		Extension: extension,
+		Height:    height,
+		ChainId:   chainID,
+	}))
+
+	sig, err := validator.privKey.Sign(buf.Bytes())
+	require.NoError(t, err)
+
+	return sig
+}
+
+// checkReportedPrices checks that every price written to x/oracle at the given height is within the range of the
+// prices reported for its currency pair in the given votes, and that the currency pairs reported by every validator
+// that committed a vote extension are updated.
+func checkReportedPrices(
+	t *testing.T,
+	app *simapp.SimApp,
+	ctx sdk.Context,
+	height uint64,
+	votes []cometabci.ExtendedVoteInfo,
+	reported map[connecttypes.CurrencyPair][]*big.Int,
+) {
+	t.Helper()
+
+	reporters := 0
+	for _, vote := range votes {
+		if len(vote.VoteExtension) > 0 {
+			reporters++
+		}
+	}
+
+	for _, cp := range voteExtensionSimCurrencyPairs {
+		reports := reported[cp]
+
+		quote, err := app.OracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+		if err != nil || quote.BlockHeight != height {
+			require.True(t, reporters == 0 || len(reports) < reporters, "price of %s reported by every validator was not updated at height %d", cp, height)
+			continue
+		}
+
+		require.NotEmpty(t, reports, "price of %s updated at height %d without reports", cp, height)
+		minPrice := slices.MinFunc(reports, func(a, b *big.Int) int { return a.Cmp(b) })
+		maxPrice := slices.MaxFunc(reports, func(a, b *big.Int) int { return a.Cmp(b) })
+		require.True(
+			t,
+			quote.Price.BigInt().Cmp(minPrice) >= 0 && quote.Price.BigInt().Cmp(maxPrice) <= 0,
+			"price %s of %s at height %d is outside of the reported range [%s, %s]", quote.Price, cp, height, minPrice, maxPrice,
+		)
+	}
+}
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -9,6 +13,17 @@ import (
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
+const (
+	flagEnabledOnly = "enabled-only"
+	flagProvider    = "provider"
+	flagBase        = "base"
+	flagQuote       = "quote"
+	flagTicker      = "ticker"
+	flagMaxHeight   = "max-height"
+	flagGrantee     = "grantee"
+	flagName        = "name"
+)
+
 // GetQueryCmd returns the parent command for all x/marketmap cli query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +39,12 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryMarketMap(),
 		CmdQueryLastUpdated(),
 		CmdQueryMarket(),
+		CmdQueryValidateMarketMapUpdate(),
+		CmdQueryScheduledMarketChanges(),
+		CmdQueryMarketAuthorityGrants(),
+		CmdQueryMarketHistory(),
+		CmdQueryMarketVersion(),
+		CmdQueryProviders(),
 	)
 
 	return cmd
@@ -66,8 +87,26 @@ func CmdQueryMarketMap() *cobra.Command {
 				return err
 			}
 
+			req := &types.MarketMapRequest{}
+			if req.EnabledOnly, err = cmd.Flags().GetBool(flagEnabledOnly); err != nil {
+				return err
+			}
+			if req.Provider, err = cmd.Flags().GetString(flagProvider); err != nil {
+				return err
+			}
+			if req.Base, err = cmd.Flags().GetString(flagBase); err != nil {
+				return err
+			}
+			if req.Quote, err = cmd.Flags().GetString(flagQuote); err != nil {
+				return err
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.MarketMap(cmd.Context(), &types.MarketMapRequest{})
+			res, err := queryClient.MarketMap(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -76,7 +115,12 @@ func CmdQueryMarketMap() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagEnabledOnly, false, "only return enabled markets")
+	cmd.Flags().String(flagProvider, "", "only return markets with a config for the provider")
+	cmd.Flags().String(flagBase, "", "only return markets with the base asset")
+	cmd.Flags().String(flagQuote, "", "only return markets with the quote asset")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "market-map")
 	return cmd
 }
 
@@ -133,3 +177,220 @@ func CmdQueryLastUpdated() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryValidateMarketMapUpdate returns the command for dry-running a market map update. The update is read from a
+// JSON file of the form {"markets": [...]}.
+func CmdQueryValidateMarketMapUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-update [path/to/markets.json]",
+		Short: "Validate a proposed set of market upserts against the current market map without committing it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read markets file: %w", err)
+			}
+
+			var req types.ValidateMarketMapUpdateRequest
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &req); err != nil {
+				return fmt.Errorf("failed to parse markets file: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidateMarketMapUpdate(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryScheduledMarketChanges returns the command for querying the pending scheduled market changes.
+func CmdQueryScheduledMarketChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-market-changes",
+		Short: "Query the pending scheduled market changes, ordered by the height they are applied at",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.ScheduledMarketChangesRequest{}
+			if req.Ticker, err = cmd.Flags().GetString(flagTicker); err != nil {
+				return err
+			}
+			if req.MaxHeight, err = cmd.Flags().GetUint64(flagMaxHeight); err != nil {
+				return err
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledMarketChanges(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagTicker, "", "only return changes to the market with the ticker, i.e. BTC/USD")
+	cmd.Flags().Uint64(flagMaxHeight, 0, "only return changes applied at or before the height")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-market-changes")
+	return cmd
+}
+
+// CmdQueryMarketAuthorityGrants returns the command for querying the scoped market authority grants.
+func CmdQueryMarketAuthorityGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-authority-grants",
+		Short: "Query the scoped market authority grants",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := cmd.Flags().GetString(flagGrantee)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketAuthorityGrants(cmd.Context(), &types.MarketAuthorityGrantsRequest{
+				Grantee: grantee,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagGrantee, "", "only return the grant of the address")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryMarketHistory returns the command for querying the change log of the markets.
+func CmdQueryMarketHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-history",
+		Short: "Query the change log of the markets, ordered by ticker and then by version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.MarketHistoryRequest{}
+			if req.Ticker, err = cmd.Flags().GetString(flagTicker); err != nil {
+				return err
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagTicker, "", "only return changes to the market with the ticker, i.e. BTC/USD")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "market-history")
+	return cmd
+}
+
+// CmdQueryMarketVersion returns the command for querying a specific version of a market.
+func CmdQueryMarketVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-version [ticker] [version]",
+		Short: "Query the change that produced a version of a market, along with the market at that version",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %s: %w", args[1], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketVersion(cmd.Context(), &types.MarketVersionRequest{
+				Ticker:  args[0],
+				Version: version,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryProviders returns the command for querying the registry of known providers.
+func CmdQueryProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "providers",
+		Short: "Query the registry of known providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, err := cmd.Flags().GetString(flagName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Providers(cmd.Context(), &types.ProvidersRequest{
+				Name: name,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "only return the provider with the name")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
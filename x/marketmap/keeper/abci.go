@@ -0,0 +1,11 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker is called at the beginning of every block. It applies the ScheduledMarketChanges that are due at or
+// before the current block height. It must run after the x/oracle BeginBlocker (see ApplyScheduledMarketChanges).
+func (k *Keeper) BeginBlocker(ctx sdk.Context) error {
+	return k.ApplyScheduledMarketChanges(ctx)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// SetMarketAuthorityGrant sets the scoped market authority grant of the grantee, overwriting any existing grant.
+func (k *Keeper) SetMarketAuthorityGrant(ctx context.Context, grant types.MarketAuthorityGrant) error {
+	return k.marketAuthorityGrants.Set(ctx, grant.Grantee, grant)
+}
+
+// GetMarketAuthorityGrant returns the scoped market authority grant of the given grantee. If no grant exists, return
+// an empty grant, false, if one does, return true and the grant.
+func (k *Keeper) GetMarketAuthorityGrant(ctx context.Context, grantee string) (types.MarketAuthorityGrant, bool) {
+	grant, err := k.marketAuthorityGrants.Get(ctx, grantee)
+	if err != nil {
+		return types.MarketAuthorityGrant{}, false
+	}
+
+	return grant, true
+}
+
+// RemoveMarketAuthorityGrant removes the scoped market authority grant of the given grantee. This is a no-op if no
+// grant exists.
+func (k *Keeper) RemoveMarketAuthorityGrant(ctx context.Context, grantee string) error {
+	return k.marketAuthorityGrants.Remove(ctx, grantee)
+}
+
+// GetAllMarketAuthorityGrants returns all scoped market authority grants, ordered by grantee.
+func (k *Keeper) GetAllMarketAuthorityGrants(ctx context.Context) ([]types.MarketAuthorityGrant, error) {
+	it, err := k.marketAuthorityGrants.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// verifyMarketAuthority verifies that the signer is one of the module market authorities, or holds a market authority
+// grant covering each of the given markets, along with the existing markets that they would update.
+func (k *Keeper) verifyMarketAuthority(ctx context.Context, signer string, markets []types.Market) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if checkMarketAuthority(signer, params) {
+		return nil
+	}
+
+	grant, found := k.GetMarketAuthorityGrant(ctx, signer)
+	if !found {
+		return fmt.Errorf("request signer %s does not match module market authorities", signer)
+	}
+
+	for _, market := range markets {
+		if !grant.Covers(market) {
+			return fmt.Errorf("market %s is not in the scope of the market authority grant of %s", market.Ticker, grant.Grantee)
+		}
+
+		// an update must not move a market out of another authority's scope
+		if err := k.verifyExistingMarketInScope(ctx, grant, market.Ticker.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyExistingMarketInScope verifies that the market with the given ticker is covered by the grant, if it exists.
+func (k *Keeper) verifyExistingMarketInScope(ctx context.Context, grant types.MarketAuthorityGrant, ticker string) error {
+	existing, err := k.GetMarket(ctx, ticker)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if !grant.Covers(existing) {
+		return fmt.Errorf("existing market %s is not in the scope of the market authority grant of %s", ticker, grant.Grantee)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/chaintestutil/sample"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMarketAuthorityGrants() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	grantee := sample.Address(r)
+
+	market := btcusdt
+	market.Ticker.MinProviderCount = 1
+
+	grant := types.NewMarketAuthorityGrant(grantee, nil, []string{"BITCOIN"}, []string{"kucoin"}, false)
+
+	s.Run("grants are set by the module authority or the admin", func() {
+		_, err := msgServer.SetMarketAuthorityGrants(s.ctx, &types.MsgSetMarketAuthorityGrants{
+			Authority: s.marketAuthorities[0],
+			Grants:    []types.MarketAuthorityGrant{grant},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.SetMarketAuthorityGrants(s.ctx, &types.MsgSetMarketAuthorityGrants{
+			Authority: s.admin,
+			Grants:    []types.MarketAuthorityGrant{grant},
+		})
+		s.Require().NoError(err)
+
+		got, found := s.keeper.GetMarketAuthorityGrant(s.ctx, grantee)
+		s.Require().True(found)
+		s.Require().Equal(grant, got)
+	})
+
+	s.Run("grantees may create markets in their scope", func() {
+		outOfScope := usdtusd
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     grantee,
+			CreateMarkets: []types.Market{outOfScope},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     grantee,
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("grantees may not move markets out of their scope", func() {
+		updated := market
+		updated.ProviderConfigs = []types.ProviderConfig{{Name: "okx", OffChainTicker: "BTC-USDT"}}
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     grantee,
+			UpdateMarkets: []types.Market{updated},
+		})
+		s.Require().Error(err)
+
+		updated.ProviderConfigs = []types.ProviderConfig{{Name: "kucoin", OffChainTicker: "BTC-USDT"}}
+		_, err = msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     grantee,
+			UpdateMarkets: []types.Market{updated},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("grantees may remove markets in their scope only if the grant permits it", func() {
+		msg := &types.MsgRemoveMarkets{
+			Admin:   grantee,
+			Markets: []string{market.Ticker.String()},
+		}
+		_, err := msgServer.RemoveMarkets(s.ctx, msg)
+		s.Require().Error(err)
+
+		grant.CanRemove = true
+		s.Require().NoError(s.keeper.SetMarketAuthorityGrant(s.ctx, grant))
+
+		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
+		s.Require().NoError(err)
+		s.Require().Equal([]string{market.Ticker.String()}, resp.DeletedMarkets)
+	})
+
+	s.Run("grants are exported and queried", func() {
+		gs := s.keeper.ExportGenesis(s.ctx)
+		s.Require().Equal([]types.MarketAuthorityGrant{grant}, gs.MarketAuthorityGrants)
+
+		qs := keeper.NewQueryServer(s.keeper)
+		resp, err := qs.MarketAuthorityGrants(s.ctx, &types.MarketAuthorityGrantsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MarketAuthorityGrant{grant}, resp.Grants)
+
+		resp, err = qs.MarketAuthorityGrants(s.ctx, &types.MarketAuthorityGrantsRequest{Grantee: sample.Address(r)})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Grants)
+	})
+
+	s.Run("grants are revoked by the module authority or the admin", func() {
+		_, err := msgServer.RevokeMarketAuthorityGrants(s.ctx, &types.MsgRevokeMarketAuthorityGrants{
+			Authority: grantee,
+			Grantees:  []string{grantee},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.RevokeMarketAuthorityGrants(s.ctx, &types.MsgRevokeMarketAuthorityGrants{
+			Authority: s.authority.String(),
+			Grantees:  []string{grantee},
+		})
+		s.Require().NoError(err)
+
+		_, found := s.keeper.GetMarketAuthorityGrant(s.ctx, grantee)
+		s.Require().False(found)
+
+		_, err = msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     grantee,
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+	})
+}
@@ -30,6 +30,34 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	for _, change := range gs.ScheduledMarketChanges {
+		if err := k.setScheduledMarketChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.nextScheduledMarketChangeID.Set(ctx, gs.NextScheduledMarketChangeId); err != nil {
+		panic(err)
+	}
+
+	for _, grant := range gs.MarketAuthorityGrants {
+		if err := k.SetMarketAuthorityGrant(ctx, grant); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range gs.MarketHistory {
+		if err := k.setMarketChangeRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, provider := range gs.Providers {
+		if err := k.SetProvider(ctx, provider); err != nil {
+			panic(err)
+		}
+	}
+
 	if k.hooks != nil {
 		if err := k.hooks.AfterMarketGenesis(ctx, gs.MarketMap.Markets); err != nil {
 			panic(err)
@@ -54,12 +82,42 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	changes, err := k.GetAllScheduledMarketChanges(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextChangeID, err := k.nextScheduledMarketChangeID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	grants, err := k.GetAllMarketAuthorityGrants(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	history, err := k.GetMarketHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	providers, err := k.GetAllProviders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		MarketMap: types.MarketMap{
 			Markets: markets,
 		},
-		LastUpdated: lastUpdated,
-		Params:      params,
+		LastUpdated:                 lastUpdated,
+		Params:                      params,
+		ScheduledMarketChanges:      changes,
+		NextScheduledMarketChangeId: nextChangeID,
+		MarketAuthorityGrants:       grants,
+		MarketHistory:               history,
+		Providers:                   providers,
 	}
 }
 
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// recordMarketChange increments the version of the market with the given ticker, and appends a MarketChangeRecord
+// of the change from before to after to its history. Before is nil if the market did not exist, and after is nil if
+// the market was removed.
+func (k *Keeper) recordMarketChange(
+	ctx sdk.Context,
+	ticker string,
+	before, after *types.Market,
+	signer string,
+	msg sdk.Msg,
+) error {
+	version, err := k.GetMarketVersion(ctx, ticker)
+	if err != nil {
+		return err
+	}
+
+	record, err := types.NewMarketChangeRecord(
+		ticker,
+		version+1,
+		uint64(ctx.BlockHeight()), //nolint:gosec
+		signer,
+		sdk.MsgTypeURL(msg),
+		before,
+		after,
+	)
+	if err != nil {
+		return err
+	}
+
+	return k.setMarketChangeRecord(ctx, record)
+}
+
+// setMarketChangeRecord stores the given MarketChangeRecord, and sets the version of its market to the record's
+// version if it is greater.
+func (k *Keeper) setMarketChangeRecord(ctx context.Context, record types.MarketChangeRecord) error {
+	version, err := k.GetMarketVersion(ctx, record.Ticker)
+	if err != nil {
+		return err
+	}
+
+	if record.Version > version {
+		if err := k.marketVersions.Set(ctx, record.Ticker, record.Version); err != nil {
+			return err
+		}
+	}
+
+	return k.marketHistory.Set(ctx, collections.Join(record.Ticker, record.Version), record)
+}
+
+// GetMarketVersion returns the current version of the market with the given ticker. Markets that have never been
+// changed since genesis are at version 0.
+func (k *Keeper) GetMarketVersion(ctx context.Context, ticker string) (uint64, error) {
+	version, err := k.marketVersions.Get(ctx, ticker)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+
+	return version, err
+}
+
+// GetMarketChangeRecord returns the MarketChangeRecord that produced the given version of the market with the given
+// ticker.
+func (k *Keeper) GetMarketChangeRecord(ctx context.Context, ticker string, version uint64) (types.MarketChangeRecord, error) {
+	record, err := k.marketHistory.Get(ctx, collections.Join(ticker, version))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.MarketChangeRecord{}, types.NewMarketVersionNotFoundError(ticker, version)
+	}
+
+	return record, err
+}
+
+// GetMarketHistory returns the MarketChangeRecords of every market, ordered by ticker and then by version.
+func (k *Keeper) GetMarketHistory(ctx context.Context) ([]types.MarketChangeRecord, error) {
+	it, err := k.marketHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMarketHistory() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	qs := keeper.NewQueryServer(s.keeper)
+
+	market := btcusdt
+	market.Ticker.MinProviderCount = 1
+	ticker := market.Ticker.String()
+
+	updated := market
+	updated.Ticker.Decimals = 6
+
+	s.Run("every change to a market is recorded", func() {
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+
+		_, err = msgServer.UpsertMarkets(s.ctx, &types.MsgUpsertMarkets{
+			Authority: s.marketAuthorities[1],
+			Markets:   []types.Market{updated},
+		})
+		s.Require().NoError(err)
+
+		version, err := s.keeper.GetMarketVersion(s.ctx, ticker)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), version)
+
+		marketHash, err := types.MarketHash(&market)
+		s.Require().NoError(err)
+		updatedHash, err := types.MarketHash(&updated)
+		s.Require().NoError(err)
+
+		created, err := s.keeper.GetMarketChangeRecord(s.ctx, ticker, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(s.marketAuthorities[0], created.Signer)
+		s.Require().Equal(sdk.MsgTypeURL(&types.MsgCreateMarkets{}), created.MsgType)
+		s.Require().Equal(uint64(s.ctx.BlockHeight()), created.Height) //nolint:gosec
+		s.Require().Empty(created.BeforeHash)
+		s.Require().Equal(marketHash, created.AfterHash)
+		s.Require().Equal(market, created.Market)
+
+		upserted, err := s.keeper.GetMarketChangeRecord(s.ctx, ticker, 2)
+		s.Require().NoError(err)
+		s.Require().Equal(s.marketAuthorities[1], upserted.Signer)
+		s.Require().Equal(sdk.MsgTypeURL(&types.MsgUpsertMarkets{}), upserted.MsgType)
+		s.Require().Equal(marketHash, upserted.BeforeHash)
+		s.Require().Equal(updatedHash, upserted.AfterHash)
+		s.Require().Equal(updated, upserted.Market)
+	})
+
+	s.Run("scheduled changes are recorded with the scheduling authority", func() {
+		ctx := s.ctx
+		_, err := msgServer.ScheduleMarketUpdates(ctx, &types.MsgScheduleMarketUpdates{
+			Authority:     s.marketAuthorities[2],
+			Height:        uint64(ctx.BlockHeight()) + 1, //nolint:gosec
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		record, err := s.keeper.GetMarketChangeRecord(ctx, ticker, 3)
+		s.Require().NoError(err)
+		s.Require().Equal(s.marketAuthorities[2], record.Signer)
+		s.Require().Equal(sdk.MsgTypeURL(&types.MsgScheduleMarketUpdates{}), record.MsgType)
+		s.Require().Equal(uint64(ctx.BlockHeight()), record.Height) //nolint:gosec
+		s.Require().Equal(market, record.Market)
+	})
+
+	s.Run("removals are recorded without a market", func() {
+		_, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Admin:   s.admin,
+			Markets: []string{ticker},
+		})
+		s.Require().NoError(err)
+
+		marketHash, err := types.MarketHash(&market)
+		s.Require().NoError(err)
+
+		removed, err := s.keeper.GetMarketChangeRecord(s.ctx, ticker, 4)
+		s.Require().NoError(err)
+		s.Require().Equal(s.admin, removed.Signer)
+		s.Require().Equal(sdk.MsgTypeURL(&types.MsgRemoveMarkets{}), removed.MsgType)
+		s.Require().True(removed.Removed())
+		s.Require().Equal(marketHash, removed.BeforeHash)
+	})
+
+	s.Run("history is queried by ticker and version", func() {
+		resp, err := qs.MarketHistory(s.ctx, &types.MarketHistoryRequest{Ticker: ticker})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Changes, 4)
+		for i, change := range resp.Changes {
+			s.Require().Equal(uint64(i+1), change.Version) //nolint:gosec
+		}
+
+		resp, err = qs.MarketHistory(s.ctx, &types.MarketHistoryRequest{Ticker: usdtusd.Ticker.String()})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Changes)
+
+		versionResp, err := qs.MarketVersion(s.ctx, &types.MarketVersionRequest{Ticker: ticker, Version: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(updated, versionResp.Change.Market)
+
+		_, err = qs.MarketVersion(s.ctx, &types.MarketVersionRequest{Ticker: ticker, Version: 5})
+		s.Require().ErrorIs(err, types.NewMarketVersionNotFoundError(ticker, 5))
+	})
+
+	s.Run("history is exported and imported", func() {
+		gs := s.keeper.ExportGenesis(s.ctx)
+		s.Require().Len(gs.MarketHistory, 4)
+		s.Require().NoError(gs.ValidateBasic())
+
+		s.SetupTest()
+		s.keeper.InitGenesis(s.ctx, *gs)
+
+		version, err := s.keeper.GetMarketVersion(s.ctx, ticker)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(4), version)
+		s.Require().Equal(gs.MarketHistory, s.keeper.ExportGenesis(s.ctx).MarketHistory)
+	})
+}
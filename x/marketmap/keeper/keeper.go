@@ -35,6 +35,33 @@ type Keeper struct {
 
 	// deleteValidationHooks are called by the keeper before any deletion call is performed.
 	deleteMarketValidationHooks types.MarketValidationHooks
+
+	// scheduledMarketChanges is keyed by the height and ID of each pending ScheduledMarketChange.
+	scheduledMarketChanges collections.Map[collections.Pair[uint64, uint64], types.ScheduledMarketChange]
+
+	// scheduledMarketChangeHeights is keyed by the ID of each pending ScheduledMarketChange, and contains its height.
+	scheduledMarketChangeHeights collections.Map[uint64, uint64]
+
+	// nextScheduledMarketChangeID is the ID of the next ScheduledMarketChange.
+	nextScheduledMarketChangeID collections.Sequence
+
+	// marketAuthorityGrants is keyed by grantee, and contains the scoped market authority grant of each grantee.
+	marketAuthorityGrants collections.Map[string, types.MarketAuthorityGrant]
+
+	// marketVersions is keyed by ticker, and contains the current version of each market that has been changed.
+	marketVersions collections.Map[string, uint64]
+
+	// marketHistory is keyed by the ticker and version of each MarketChangeRecord.
+	marketHistory collections.Map[collections.Pair[string, uint64], types.MarketChangeRecord]
+
+	// providers is keyed by name, and contains the registry of known providers.
+	providers collections.Map[string, types.ProviderInfo]
+
+	// oracleKeeper is used to report the x/oracle currency pair IDs resulting from market map updates. It is optional.
+	oracleKeeper types.OracleKeeper
+
+	// schema is the schema of the module's collections.
+	schema collections.Schema
 }
 
 // NewKeeper initializes the keeper and its backing stores.
@@ -50,13 +77,20 @@ func NewKeeper(ss store.KVStoreService, cdc codec.BinaryCodec, authority sdk.Acc
 	)
 
 	k := &Keeper{
-		cdc:                         cdc,
-		authority:                   authority,
-		markets:                     collections.NewMap(sb, types.MarketsPrefix, "markets", types.TickersCodec, codec.CollValue[types.Market](cdc)),
-		lastUpdated:                 collections.NewItem[uint64](sb, types.LastUpdatedPrefix, "last_updated", types.LastUpdatedCodec),
-		params:                      params,
-		hooks:                       &types.NoopMarketMapHooks{},
-		deleteMarketValidationHooks: types.DefaultDeleteMarketValidationHooks(),
+		cdc:                          cdc,
+		authority:                    authority,
+		markets:                      collections.NewMap(sb, types.MarketsPrefix, "markets", types.TickersCodec, codec.CollValue[types.Market](cdc)),
+		lastUpdated:                  collections.NewItem[uint64](sb, types.LastUpdatedPrefix, "last_updated", types.LastUpdatedCodec),
+		params:                       params,
+		hooks:                        &types.NoopMarketMapHooks{},
+		deleteMarketValidationHooks:  types.DefaultDeleteMarketValidationHooks(),
+		scheduledMarketChanges:       collections.NewMap(sb, types.ScheduledMarketChangesPrefix, "scheduled_market_changes", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.ScheduledMarketChange](cdc)),
+		scheduledMarketChangeHeights: collections.NewMap(sb, types.ScheduledMarketChangeHeightsPrefix, "scheduled_market_change_heights", collections.Uint64Key, collections.Uint64Value),
+		nextScheduledMarketChangeID:  collections.NewSequence(sb, types.NextScheduledMarketChangeIDPrefix, "next_scheduled_market_change_id"),
+		marketAuthorityGrants:        collections.NewMap(sb, types.MarketAuthorityGrantsPrefix, "market_authority_grants", collections.StringKey, codec.CollValue[types.MarketAuthorityGrant](cdc)),
+		marketVersions:               collections.NewMap(sb, types.MarketVersionsPrefix, "market_versions", collections.StringKey, collections.Uint64Value),
+		marketHistory:                collections.NewMap(sb, types.MarketHistoryPrefix, "market_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.MarketChangeRecord](cdc)),
+		providers:                    collections.NewMap(sb, types.ProvidersPrefix, "providers", collections.StringKey, codec.CollValue[types.ProviderInfo](cdc)),
 	}
 
 	// apply options to default initialized keeper
@@ -64,14 +98,32 @@ func NewKeeper(ss store.KVStoreService, cdc codec.BinaryCodec, authority sdk.Acc
 		opt(k)
 	}
 
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.schema = schema
 	return k
 }
 
+// Schema returns the schema of the module's collections.
+func (k *Keeper) Schema() collections.Schema {
+	return k.schema
+}
+
 // SetDeleteMarketValidationHooks sets the MarketValidationHooks for deletion in the keeper.
 func (k *Keeper) SetDeleteMarketValidationHooks(hooks types.MarketValidationHooks) {
 	k.deleteMarketValidationHooks = hooks
 }
 
+// SetOracleKeeper sets the OracleKeeper used to report the x/oracle currency pair IDs resulting from market map
+// updates. In contrast to other receivers, this method must take a pointer due to the x/oracle keeper depending on
+// the x/marketmap keeper.
+func (k *Keeper) SetOracleKeeper(ok types.OracleKeeper) {
+	k.oracleKeeper = ok
+}
+
 // SetLastUpdated sets the lastUpdated field to the current block height.
 func (k *Keeper) SetLastUpdated(ctx context.Context, height uint64) error {
 	return k.lastUpdated.Set(ctx, height)
@@ -92,7 +144,8 @@ func (k *Keeper) setMarket(ctx context.Context, market types.Market) error {
 	return k.markets.Set(ctx, types.TickerString(market.Ticker.String()), market)
 }
 
-// EnableMarket sets the Enabled field of a Market Ticker to true.
+// EnableMarket sets the Enabled field of a Market Ticker to true. If the Ticker has a lifecycle status, it is set to
+// active.
 func (k *Keeper) EnableMarket(ctx context.Context, tickerStr string) error {
 	market, err := k.GetMarket(ctx, tickerStr)
 	if err != nil {
@@ -100,11 +153,15 @@ func (k *Keeper) EnableMarket(ctx context.Context, tickerStr string) error {
 	}
 
 	market.Ticker.Enabled = true
+	if market.Ticker.Status != types.MarketStatus_MARKET_STATUS_UNSPECIFIED {
+		market.Ticker.Status = types.MarketStatus_MARKET_STATUS_ACTIVE
+	}
 
 	return k.setMarket(ctx, market)
 }
 
-// DisableMarket sets the Enabled field of a Market Ticker to false.
+// DisableMarket sets the Enabled field of a Market Ticker to false. If the Ticker has a lifecycle status, it is set to
+// deprecated.
 func (k *Keeper) DisableMarket(ctx context.Context, tickerStr string) error {
 	market, err := k.GetMarket(ctx, tickerStr)
 	if err != nil {
@@ -112,6 +169,9 @@ func (k *Keeper) DisableMarket(ctx context.Context, tickerStr string) error {
 	}
 
 	market.Ticker.Enabled = false
+	if market.Ticker.Status != types.MarketStatus_MARKET_STATUS_UNSPECIFIED {
+		market.Ticker.Status = types.MarketStatus_MARKET_STATUS_DEPRECATED
+	}
 
 	return k.setMarket(ctx, market)
 }
@@ -216,9 +276,25 @@ func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
 	return nil
 }
 
-// IsMarketValid checks if a market is valid by statefully checking if each of the currency pairs
-// specified by its provider configs are valid and in state.
+// IsMarketValid checks if a market is valid by statefully checking if its metadata conforms to the registered
+// schemas, each of its providers is registered, and each of the currency pairs specified by its provider configs are
+// valid and in state.
 func (k *Keeper) IsMarketValid(ctx sdk.Context, market types.Market) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// check that the ticker metadata conforms to the ticker metadata schema
+	if err := params.ValidateTickerMetadata(market.Ticker); err != nil {
+		return err
+	}
+
+	// check that all providers are registered, if the registry is in use
+	if err := k.ValidateMarketProviders(ctx, market); err != nil {
+		return err
+	}
+
 	// check that all markets already exist in the keeper store:
 	for _, providerConfig := range market.ProviderConfigs {
 		if providerConfig.NormalizeByPair != nil {
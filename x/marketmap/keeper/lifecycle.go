@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// applyMarketStatusChange validates the lifecycle status transition of the market with the given ticker from before
+// to after, and emits a status change event if the status changed. Before is nil if the market did not exist, and
+// after is nil if the market was removed. If the registered hooks implement MarketStatusHooks, the
+// AfterMarketStatusChanged hooks are run if the status of an existing market changed.
+func (k *Keeper) applyMarketStatusChange(
+	ctx sdk.Context,
+	ticker string,
+	before, after *types.Market,
+	authority string,
+) error {
+	var from, to types.MarketStatus
+	switch {
+	case before == nil && after == nil:
+		return nil
+	case before == nil:
+		to = after.Ticker.EffectiveStatus()
+		if err := types.ValidateInitialMarketStatus(to); err != nil {
+			return fmt.Errorf("invalid status for market %s: %w", ticker, err)
+		}
+	case after == nil:
+		from, to = before.Ticker.EffectiveStatus(), types.MarketStatus_MARKET_STATUS_REMOVED
+		if err := types.ValidateMarketStatusTransition(from, to); err != nil {
+			return fmt.Errorf("invalid status for market %s: %w", ticker, err)
+		}
+	default:
+		from, to = before.Ticker.EffectiveStatus(), after.Ticker.EffectiveStatus()
+		if err := validateMarketStatusUpdate(before.Ticker, after.Ticker); err != nil {
+			return fmt.Errorf("invalid status for market %s: %w", ticker, err)
+		}
+	}
+
+	if from == to {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMarketStatusChange,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, ticker),
+		sdk.NewAttribute(types.AttributeKeyFromStatus, from.ShortString()),
+		sdk.NewAttribute(types.AttributeKeyToStatus, to.ShortString()),
+		sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+	))
+
+	statusHooks, ok := k.hooks.(types.MarketStatusHooks)
+	if !ok || before == nil || after == nil {
+		return nil
+	}
+
+	if err := statusHooks.AfterMarketStatusChanged(ctx, *after, from); err != nil {
+		return fmt.Errorf("unable to run market status change hook: %w", err)
+	}
+
+	return nil
+}
+
+// validateMarketStatusUpdate validates the status transition of an updated ticker. Tickers that have never had a
+// status set may be enabled and disabled freely, but once set, a status cannot be cleared and only moves along the
+// market lifecycle.
+func validateMarketStatusUpdate(before, after types.Ticker) error {
+	switch {
+	case before.Status == types.MarketStatus_MARKET_STATUS_UNSPECIFIED &&
+		after.Status == types.MarketStatus_MARKET_STATUS_UNSPECIFIED:
+		return nil
+	case after.Status == types.MarketStatus_MARKET_STATUS_UNSPECIFIED:
+		return fmt.Errorf("market status %s cannot be cleared", before.Status.ShortString())
+	case before.Status == types.MarketStatus_MARKET_STATUS_UNSPECIFIED && !before.Enabled &&
+		after.Status == types.MarketStatus_MARKET_STATUS_DEPRECATED:
+		// disabled tickers without a status may have been priced before, and are deprecated directly
+		return nil
+	}
+
+	return types.ValidateMarketStatusTransition(before.EffectiveStatus(), after.Status)
+}
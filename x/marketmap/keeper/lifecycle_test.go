@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+	mmmocks "github.com/skip-mev/connect/v2/x/marketmap/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestMarketLifecycle() {
+	hooks := mmmocks.NewMarketMapHooks(s.T())
+	hooks.On("AfterMarketCreated", mock.Anything, mock.Anything).Return(nil)
+	hooks.On("AfterMarketUpdated", mock.Anything, mock.Anything).Return(nil)
+	hooks.On("AfterMarketRemoved", mock.Anything, mock.Anything).Return(nil)
+	statusHooks := mmmocks.NewMarketStatusHooks(s.T())
+	s.keeper = s.initKeeperWithHooks(types.MultiMarketMapHooks{marketStatusHooks{hooks, statusHooks}})
+
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	withStatus := func(market types.Market, status types.MarketStatus) types.Market {
+		market.Ticker.Status = status
+		market.Ticker.Enabled = status.IsPriced()
+		return market
+	}
+
+	// transition updates the market to the given status, and returns the status change events emitted.
+	transition := func(ctx sdk.Context, status types.MarketStatus) ([]sdk.Event, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.UpdateMarkets(ctx, &types.MsgUpdateMarkets{
+			Authority:     s.marketAuthorities[0],
+			UpdateMarkets: []types.Market{withStatus(btcusdt, status)},
+		})
+		return statusChangeEvents(ctx), err
+	}
+
+	s.Run("markets cannot be created reduce-only or deprecated", func() {
+		for _, status := range []types.MarketStatus{
+			types.MarketStatus_MARKET_STATUS_REDUCE_ONLY,
+			types.MarketStatus_MARKET_STATUS_DEPRECATED,
+		} {
+			cacheCtx, _ := s.ctx.CacheContext()
+			_, err := msgServer.CreateMarkets(cacheCtx, &types.MsgCreateMarkets{
+				Authority:     s.marketAuthorities[0],
+				CreateMarkets: []types.Market{withStatus(btcusdt, status)},
+			})
+			s.Require().Error(err)
+		}
+	})
+
+	s.Run("creating a proposed market emits a status change", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.CreateMarkets(ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{withStatus(btcusdt, types.MarketStatus_MARKET_STATUS_PROPOSED)},
+		})
+		s.Require().NoError(err)
+
+		events := statusChangeEvents(ctx)
+		s.Require().Len(events, 1)
+		s.requireStatusChangeEvent(events[0], "unspecified", "proposed")
+	})
+
+	s.Run("a proposed market is activated", func() {
+		statusHooks.On(
+			"AfterMarketStatusChanged", mock.Anything, mock.Anything, types.MarketStatus_MARKET_STATUS_PROPOSED,
+		).Return(nil).Once()
+
+		events, err := transition(s.ctx, types.MarketStatus_MARKET_STATUS_ACTIVE)
+		s.Require().NoError(err)
+		s.Require().Len(events, 1)
+		s.requireStatusChangeEvent(events[0], "proposed", "active")
+	})
+
+	s.Run("an active market cannot return to proposed or be removed", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := transition(cacheCtx, types.MarketStatus_MARKET_STATUS_PROPOSED)
+		s.Require().Error(err)
+
+		cacheCtx, _ = s.ctx.CacheContext()
+		_, err = msgServer.RemoveMarkets(cacheCtx, &types.MsgRemoveMarkets{
+			Admin:   s.admin,
+			Markets: []string{btcusdt.Ticker.String()},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("updates that keep the status emit no status change", func() {
+		events, err := transition(s.ctx, types.MarketStatus_MARKET_STATUS_ACTIVE)
+		s.Require().NoError(err)
+		s.Require().Empty(events)
+	})
+
+	s.Run("an active market is wound down to deprecated", func() {
+		statusHooks.On(
+			"AfterMarketStatusChanged", mock.Anything, mock.Anything, types.MarketStatus_MARKET_STATUS_ACTIVE,
+		).Return(nil).Once()
+		statusHooks.On(
+			"AfterMarketStatusChanged", mock.Anything, mock.Anything, types.MarketStatus_MARKET_STATUS_REDUCE_ONLY,
+		).Return(nil).Once()
+
+		events, err := transition(s.ctx, types.MarketStatus_MARKET_STATUS_REDUCE_ONLY)
+		s.Require().NoError(err)
+		s.requireStatusChangeEvent(events[0], "active", "reduce_only")
+
+		events, err = transition(s.ctx, types.MarketStatus_MARKET_STATUS_DEPRECATED)
+		s.Require().NoError(err)
+		s.requireStatusChangeEvent(events[0], "reduce_only", "deprecated")
+
+		market, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(market.Ticker.Enabled)
+	})
+
+	s.Run("a status cannot be cleared", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := transition(cacheCtx, types.MarketStatus_MARKET_STATUS_UNSPECIFIED)
+		s.Require().Error(err)
+	})
+
+	s.Run("a deprecated market is removed", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := msgServer.RemoveMarkets(ctx, &types.MsgRemoveMarkets{
+			Admin:   s.admin,
+			Markets: []string{btcusdt.Ticker.String()},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{btcusdt.Ticker.String()}, resp.DeletedMarkets)
+
+		events := statusChangeEvents(ctx)
+		s.Require().Len(events, 1)
+		s.requireStatusChangeEvent(events[0], "deprecated", "removed")
+	})
+}
+
+func (s *KeeperTestSuite) TestMarketLifecycleWithoutStatusHooks() {
+	hooks := mmmocks.NewMarketMapHooks(s.T())
+	hooks.On("AfterMarketCreated", mock.Anything, mock.Anything).Return(nil)
+	hooks.On("AfterMarketUpdated", mock.Anything, mock.Anything).Return(nil)
+	s.keeper = s.initKeeperWithHooks(types.MultiMarketMapHooks{hooks})
+
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	proposed := btcusdt
+	proposed.Ticker.Status = types.MarketStatus_MARKET_STATUS_PROPOSED
+	proposed.Ticker.Enabled = false
+	_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{proposed},
+	})
+	s.Require().NoError(err)
+
+	// status changes are applied without running hooks that do not implement MarketStatusHooks
+	active := btcusdt
+	active.Ticker.Status = types.MarketStatus_MARKET_STATUS_ACTIVE
+	active.Ticker.Enabled = true
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UpdateMarkets(ctx, &types.MsgUpdateMarkets{
+		Authority:     s.marketAuthorities[0],
+		UpdateMarkets: []types.Market{active},
+	})
+	s.Require().NoError(err)
+
+	events := statusChangeEvents(ctx)
+	s.Require().Len(events, 1)
+	s.requireStatusChangeEvent(events[0], "proposed", "active")
+}
+
+// marketStatusHooks are MarketMapHooks that also implement MarketStatusHooks.
+type marketStatusHooks struct {
+	*mmmocks.MarketMapHooks
+	*mmmocks.MarketStatusHooks
+}
+
+// statusChangeEvents returns the market status change events emitted to the given context.
+func statusChangeEvents(ctx sdk.Context) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMarketStatusChange {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func (s *KeeperTestSuite) requireStatusChangeEvent(event sdk.Event, from, to string) {
+	attributes := make(map[string]string)
+	for _, attribute := range event.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+
+	s.Require().Equal(btcusdt.Ticker.String(), attributes[types.AttributeKeyCurrencyPair])
+	s.Require().Equal(from, attributes[types.AttributeKeyFromStatus])
+	s.Require().Equal(to, attributes[types.AttributeKeyToStatus])
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func (s *KeeperTestSuite) TestTickerMetadataSchema() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	s.Run("markets with malformed metadata are created while no schema is set", func() {
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{btcusdt},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("a schema that existing markets violate is rejected", func() {
+		withSchema := params
+		withSchema.TickerMetadataSchema = tickermetadata.DyDxSchema
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := msgServer.UpdateParams(cacheCtx, &types.MsgParams{
+			Authority: s.authority.String(),
+			Params:    withSchema,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("markets must conform to the schema once it is set", func() {
+		bz, err := tickermetadata.MarshalDyDx(tickermetadata.NewDyDx(100, 1000, nil))
+		s.Require().NoError(err)
+
+		updated := btcusdt
+		updated.Ticker.Metadata_JSON = string(bz)
+		_, err = msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     s.marketAuthorities[0],
+			UpdateMarkets: []types.Market{updated},
+		})
+		s.Require().NoError(err)
+
+		withSchema := params
+		withSchema.TickerMetadataSchema = tickermetadata.DyDxSchema
+		_, err = msgServer.UpdateParams(s.ctx, &types.MsgParams{
+			Authority: s.authority.String(),
+			Params:    withSchema,
+		})
+		s.Require().NoError(err)
+
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err = msgServer.CreateMarkets(cacheCtx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{usdtusd},
+		})
+		s.Require().Error(err)
+
+		valid := usdtusd
+		valid.Ticker.Metadata_JSON = string(bz)
+		_, err = msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{valid},
+		})
+		s.Require().NoError(err)
+	})
+}
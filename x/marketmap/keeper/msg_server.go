@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -30,7 +32,7 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	if err := ms.verifyMarketAuthorities(ctx, msg, msg.GetMarkets()); err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
@@ -42,7 +44,10 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 			return nil, err
 		}
 
-		var eventType string
+		var (
+			eventType string
+			before    *types.Market
+		)
 		// if market does not exist, create it
 		if !exists {
 			err = ms.k.CreateMarket(ctx, market)
@@ -57,6 +62,12 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 
 			eventType = types.EventTypeCreateMarket
 		} else {
+			existing, err := ms.k.GetMarket(ctx, market.Ticker.String())
+			if err != nil {
+				return nil, err
+			}
+			before = &existing
+
 			err = ms.k.UpdateMarket(ctx, market)
 			if err != nil {
 				return nil, err
@@ -70,6 +81,14 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 			eventType = types.EventTypeUpdateMarket
 		}
 
+		if err := ms.k.recordMarketChange(ctx, market.Ticker.String(), before, &market, msg.Authority, msg); err != nil {
+			return nil, err
+		}
+
+		if err := ms.k.applyMarketStatusChange(ctx, market.Ticker.String(), before, &market, msg.Authority); err != nil {
+			return nil, err
+		}
+
 		event := sdk.NewEvent(
 			eventType,
 			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
@@ -94,7 +113,7 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	if err := ms.verifyMarketAuthorities(ctx, msg, msg.GetCreateMarkets()); err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
@@ -110,6 +129,14 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 			return nil, fmt.Errorf("unable to run create market hook: %w", err)
 		}
 
+		if err := ms.k.recordMarketChange(ctx, market.Ticker.String(), nil, &market, msg.Authority, msg); err != nil {
+			return nil, err
+		}
+
+		if err := ms.k.applyMarketStatusChange(ctx, market.Ticker.String(), nil, &market, msg.Authority); err != nil {
+			return nil, err
+		}
+
 		event := sdk.NewEvent(
 			types.EventTypeCreateMarket,
 			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
@@ -135,12 +162,17 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	if err := ms.verifyMarketAuthorities(ctx, msg, msg.GetUpdateMarkets()); err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
 	for _, market := range msg.UpdateMarkets {
-		err := ms.k.UpdateMarket(ctx, market)
+		before, err := ms.k.GetMarket(ctx, market.Ticker.String())
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("unable to update market: %w", err)
+		}
+
+		err = ms.k.UpdateMarket(ctx, market)
 		if err != nil {
 			return nil, fmt.Errorf("unable to update market: %w", err)
 		}
@@ -150,6 +182,14 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 			return nil, fmt.Errorf("unable to run update market hook: %w", err)
 		}
 
+		if err := ms.k.recordMarketChange(ctx, market.Ticker.String(), &before, &market, msg.Authority, msg); err != nil {
+			return nil, err
+		}
+
+		if err := ms.k.applyMarketStatusChange(ctx, market.Ticker.String(), &before, &market, msg.Authority); err != nil {
+			return nil, err
+		}
+
 		event := sdk.NewEvent(
 			types.EventTypeUpdateMarket,
 			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
@@ -169,30 +209,169 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	return &types.MsgUpdateMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
 }
 
-// verifyMarketAuthorities verifies that the msg-submitter is a market-authority
-// and returns the context for the msg, this method returns an error if the submitter is not a market
-// authority.
+// verifyMarketAuthorities verifies that the msg-submitter is a market-authority, or holds a market authority
+// grant whose scope covers each of the given markets, and their existing versions. This method returns an error if
+// the submitter is neither.
 func (ms msgServer) verifyMarketAuthorities(ctx sdk.Context, msg interface {
 	GetAuthority() string
-},
+}, markets []types.Market,
 ) error {
 	if msg == nil {
 		return fmt.Errorf("unable to process nil msg")
 	}
 
+	return ms.k.verifyMarketAuthority(ctx, msg.GetAuthority(), markets)
+}
+
+// verifyAuthorityOrAdmin verifies that the signer is the module authority or the admin.
+func (ms msgServer) verifyAuthorityOrAdmin(ctx sdk.Context, signer string) error {
+	if signer == ms.k.authority.String() {
+		return nil
+	}
+
 	params, err := ms.k.GetParams(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to get marketmap params: %w", err)
+		return err
 	}
 
-	found := checkMarketAuthority(msg.GetAuthority(), params)
-	if !found {
-		return fmt.Errorf("request signer %s does not match module market authorities", msg.GetAuthority())
+	if signer != params.Admin {
+		return fmt.Errorf("request signer %s is neither the module authority nor the admin", signer)
 	}
 
 	return nil
 }
 
+// SetMarketAuthorityGrants sets the scoped market authority grants in the message. The signer must be the module
+// authority or the admin.
+func (ms msgServer) SetMarketAuthorityGrants(
+	goCtx context.Context,
+	msg *types.MsgSetMarketAuthorityGrants,
+) (*types.MsgSetMarketAuthorityGrantsResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.verifyAuthorityOrAdmin(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMarketAuthorityGrants(msg.Grants); err != nil {
+		return nil, err
+	}
+
+	for _, grant := range msg.Grants {
+		if err := ms.k.SetMarketAuthorityGrant(ctx, grant); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetMarketAuthorityGrantsResponse{}, nil
+}
+
+// RevokeMarketAuthorityGrants revokes the scoped market authority grants of the grantees in the message. Grantees
+// without a grant are ignored. The signer must be the module authority or the admin.
+func (ms msgServer) RevokeMarketAuthorityGrants(
+	goCtx context.Context,
+	msg *types.MsgRevokeMarketAuthorityGrants,
+) (*types.MsgRevokeMarketAuthorityGrantsResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.verifyAuthorityOrAdmin(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range msg.Grantees {
+		if err := ms.k.RemoveMarketAuthorityGrant(ctx, grantee); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRevokeMarketAuthorityGrantsResponse{}, nil
+}
+
+// UpsertProviders creates or updates the registry entries of the providers in the message. The signer must be the
+// module authority. Every market must remain valid against the resulting registry.
+func (ms msgServer) UpsertProviders(
+	goCtx context.Context,
+	msg *types.MsgUpsertProviders,
+) (*types.MsgUpsertProvidersResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.authority.String() {
+		return nil, fmt.Errorf("request authority %s does not match module keeper authority %s", msg.Authority, ms.k.authority.String())
+	}
+
+	if err := types.ValidateProviders(msg.Providers); err != nil {
+		return nil, err
+	}
+
+	for _, provider := range msg.Providers {
+		if err := ms.k.SetProvider(ctx, provider); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.validateProviderRegistry(ctx); err != nil {
+		return nil, fmt.Errorf("invalid state resulting from provider update: %w", err)
+	}
+
+	return &types.MsgUpsertProvidersResponse{}, nil
+}
+
+// RemoveProviders removes the registry entries of the providers in the message. The signer must be the module
+// authority. Providers that are still used by a market cannot be removed.
+func (ms msgServer) RemoveProviders(
+	goCtx context.Context,
+	msg *types.MsgRemoveProviders,
+) (*types.MsgRemoveProvidersResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.authority.String() {
+		return nil, fmt.Errorf("request authority %s does not match module keeper authority %s", msg.Authority, ms.k.authority.String())
+	}
+
+	for _, name := range msg.Names {
+		if err := ms.k.RemoveProvider(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.validateProviderRegistry(ctx); err != nil {
+		return nil, fmt.Errorf("invalid state resulting from provider removal: %w", err)
+	}
+
+	return &types.MsgRemoveProvidersResponse{}, nil
+}
+
+// validateProviderRegistry checks every market in state against the registry of known providers.
+func (ms msgServer) validateProviderRegistry(ctx sdk.Context) error {
+	registry, err := ms.k.GetProviderRegistry(ctx)
+	if err != nil {
+		return err
+	}
+
+	markets, err := ms.k.GetAllMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	return registry.ValidateMarketMap(types.MarketMap{Markets: markets})
+}
+
 // UpdateParams updates the x/marketmap module's Params.
 func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgParams) (*types.MsgParamsResponse, error) {
 	if msg == nil {
@@ -209,6 +388,24 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgParams) (*
 		return nil, err
 	}
 
+	// every market must conform to the resulting ticker metadata schema
+	markets, err := ms.k.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tickers := make([]string, 0, len(markets))
+	for ticker := range markets {
+		tickers = append(tickers, ticker)
+	}
+	slices.Sort(tickers)
+
+	for _, ticker := range tickers {
+		if err := msg.Params.ValidateTickerMetadata(markets[ticker].Ticker); err != nil {
+			return nil, fmt.Errorf("invalid state resulting from params update: %w", err)
+		}
+	}
+
 	return &types.MsgParamsResponse{}, nil
 }
 
@@ -268,12 +465,27 @@ func (ms msgServer) RemoveMarkets(
 		return nil, err
 	}
 
+	// a grantee may remove the markets in its scope if the grant permits removals
 	if msg.Admin != params.Admin {
-		return nil, fmt.Errorf("request admin %s does not match module admin %s", msg.Admin, params.Admin)
+		grant, found := ms.k.GetMarketAuthorityGrant(ctx, msg.Admin)
+		if !found || !grant.CanRemove {
+			return nil, fmt.Errorf("request admin %s does not match module admin %s", msg.Admin, params.Admin)
+		}
+
+		for _, market := range msg.Markets {
+			if err := ms.k.verifyExistingMarketInScope(ctx, grant, market); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	deletedMarkets := make([]string, 0, len(msg.Markets))
 	for _, market := range msg.Markets {
+		before, err := ms.k.GetMarket(ctx, market)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("unable to delete market: %w", err)
+		}
+
 		deleted, err := ms.k.DeleteMarket(ctx, market)
 		if err != nil {
 			return nil, fmt.Errorf("unable to delete market: %w", err)
@@ -282,6 +494,14 @@ func (ms msgServer) RemoveMarkets(
 		if deleted {
 			ctx.Logger().Info(fmt.Sprintf("deleted market %s", market))
 			deletedMarkets = append(deletedMarkets, market)
+
+			if err := ms.k.recordMarketChange(ctx, market, &before, nil, msg.Admin, msg); err != nil {
+				return nil, err
+			}
+
+			if err := ms.k.applyMarketStatusChange(ctx, market, &before, nil, msg.Admin); err != nil {
+				return nil, err
+			}
 		}
 
 		if err := ms.k.hooks.AfterMarketRemoved(ctx, market); err != nil {
@@ -305,6 +525,148 @@ func (ms msgServer) RemoveMarkets(
 	}, nil
 }
 
+// ScheduleMarketUpdates schedules the markets in the message to be created or updated at the beginning of the block
+// at the message height. The signer must be a market authority.
+func (ms msgServer) ScheduleMarketUpdates(
+	goCtx context.Context,
+	msg *types.MsgScheduleMarketUpdates,
+) (*types.MsgScheduleMarketUpdatesResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	markets := append(append([]types.Market{}, msg.GetCreateMarkets()...), msg.GetUpdateMarkets()...)
+	if err := ms.verifyMarketAuthorities(ctx, msg, markets); err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+	}
+
+	// reject unknown providers now, rather than when the changes are applied
+	for _, market := range markets {
+		if err := ms.k.ValidateMarketProviders(ctx, market); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := make([]types.ScheduledMarketChange, 0, len(msg.CreateMarkets)+len(msg.UpdateMarkets))
+	for _, market := range msg.CreateMarkets {
+		changes = append(changes, types.NewScheduledMarketUpdate(
+			0, msg.Height, types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_CREATE, market, msg.Authority,
+		))
+	}
+	for _, market := range msg.UpdateMarkets {
+		changes = append(changes, types.NewScheduledMarketUpdate(
+			0, msg.Height, types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_UPDATE, market, msg.Authority,
+		))
+	}
+
+	ids, err := ms.scheduleMarketChanges(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleMarketUpdatesResponse{Ids: ids}, nil
+}
+
+// ScheduleMarketRemovals schedules the markets in the message to be removed at the beginning of the block at the
+// message height. The signer must be the admin.
+func (ms msgServer) ScheduleMarketRemovals(
+	goCtx context.Context,
+	msg *types.MsgScheduleMarketRemovals,
+) (*types.MsgScheduleMarketRemovalsResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Admin != params.Admin {
+		return nil, fmt.Errorf("request admin %s does not match module admin %s", msg.Admin, params.Admin)
+	}
+
+	changes := make([]types.ScheduledMarketChange, 0, len(msg.Markets))
+	for _, market := range msg.Markets {
+		changes = append(changes, types.NewScheduledMarketRemoval(0, msg.Height, market, msg.Admin))
+	}
+
+	ids, err := ms.scheduleMarketChanges(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleMarketRemovalsResponse{Ids: ids}, nil
+}
+
+// scheduleMarketChanges stores each of the given changes, and returns their IDs.
+func (ms msgServer) scheduleMarketChanges(ctx sdk.Context, changes []types.ScheduledMarketChange) ([]uint64, error) {
+	ids := make([]uint64, 0, len(changes))
+	for _, change := range changes {
+		id, err := ms.k.ScheduleMarketChange(ctx, change)
+		if err != nil {
+			return nil, fmt.Errorf("unable to schedule market change: %w", err)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeScheduleMarketChange,
+			sdk.NewAttribute(types.AttributeKeyScheduledMarketChangeID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, change.Ticker),
+			sdk.NewAttribute(types.AttributeKeyChangeType, change.ChangeType.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(change.Height, 10)),
+		))
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// CancelScheduledMarketChanges cancels the pending scheduled market changes in the message. The signer must be the
+// module authority, the admin, or the address that scheduled each change.
+func (ms msgServer) CancelScheduledMarketChanges(
+	goCtx context.Context,
+	msg *types.MsgCancelScheduledMarketChanges,
+) (*types.MsgCancelScheduledMarketChangesResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range msg.Ids {
+		change, err := ms.k.GetScheduledMarketChange(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if msg.Authority != ms.k.authority.String() && msg.Authority != params.Admin && msg.Authority != change.Authority {
+			return nil, fmt.Errorf("request signer %s is not authorized to cancel scheduled market change %d", msg.Authority, id)
+		}
+
+		if err := ms.k.CancelScheduledMarketChange(ctx, id); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCancelScheduledMarketChange,
+			sdk.NewAttribute(types.AttributeKeyScheduledMarketChangeID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, change.Ticker),
+		))
+	}
+
+	return &types.MsgCancelScheduledMarketChangesResponse{}, nil
+}
+
 // checkMarketAuthority checks if the given authority is the x/marketmap's list of MarketAuthorities.
 func checkMarketAuthority(authority string, params types.Params) bool {
 	if len(params.MarketAuthorities) == 0 {
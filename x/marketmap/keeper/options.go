@@ -19,3 +19,10 @@ func WithDeleteValidationHooks(hooks []types.MarketValidationHook) Option {
 		k.deleteMarketValidationHooks = hooks
 	}
 }
+
+// WithOracleKeeper sets the keeper oracleKeeper to the given OracleKeeper.
+func WithOracleKeeper(ok types.OracleKeeper) Option {
+	return func(k *Keeper) {
+		k.oracleKeeper = ok
+	}
+}
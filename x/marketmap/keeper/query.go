@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
@@ -18,7 +20,8 @@ func NewQueryServer(k *Keeper) types.QueryServer {
 	return &queryServerImpl{k}
 }
 
-// MarketMap returns the full MarketMap and associated information stored in the x/marketmap module.
+// MarketMap returns the MarketMap and associated information stored in the x/marketmap module. The markets may be
+// filtered, and are paginated if the request sets a pagination.
 func (q queryServerImpl) MarketMap(goCtx context.Context, req *types.MarketMapRequest) (*types.MarketMapResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request cannot be nil")
@@ -27,19 +30,46 @@ func (q queryServerImpl) MarketMap(goCtx context.Context, req *types.MarketMapRe
 	// unwrap the context
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	markets, err := q.k.GetAllMarkets(ctx)
+	// return every matching market if no pagination is requested
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{Limit: query.PaginationMaxLimit}
+	}
+
+	filter := types.NewMarketFilter(req.EnabledOnly, req.Provider, req.Base, req.Quote)
+	markets, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.markets,
+		pageReq,
+		func(_ types.TickerString, market types.Market) (bool, error) {
+			return filter.Matches(market), nil
+		},
+		func(_ types.TickerString, market types.Market) (types.Market, error) {
+			return market, nil
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Pagination == nil {
+		pageRes = nil
+	}
+
+	marketMap := types.MarketMap{
+		Markets: make(map[string]types.Market, len(markets)),
+	}
+	for _, market := range markets {
+		marketMap.Markets[market.Ticker.String()] = market
+	}
+
 	lastUpdated, err := q.k.GetLastUpdated(ctx)
 	return &types.MarketMapResponse{
-			MarketMap: types.MarketMap{
-				Markets: markets,
-			},
+			MarketMap: marketMap,
 
 			LastUpdated: lastUpdated,
 			ChainId:     ctx.ChainID(),
+			Pagination:  pageRes,
 		},
 		err
 }
@@ -91,3 +121,191 @@ func (q queryServerImpl) Params(ctx context.Context, req *types.ParamsRequest) (
 
 	return &types.ParamsResponse{Params: params}, nil
 }
+
+// ValidateMarketMapUpdate returns the result of applying a proposed set of market upserts to the market map, without
+// committing any changes.
+func (q queryServerImpl) ValidateMarketMapUpdate(
+	goCtx context.Context,
+	req *types.ValidateMarketMapUpdateRequest,
+) (*types.ValidateMarketMapUpdateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if len(req.Markets) == 0 {
+		return nil, fmt.Errorf("no markets to validate")
+	}
+
+	results := q.k.ValidateMarketMapUpdate(sdk.UnwrapSDKContext(goCtx), req.Markets)
+
+	valid := true
+	for _, result := range results {
+		if result.Error != "" {
+			valid = false
+			break
+		}
+	}
+
+	return &types.ValidateMarketMapUpdateResponse{
+		Results: results,
+		Valid:   valid,
+	}, nil
+}
+
+// ScheduledMarketChanges returns the pending scheduled market changes stored in the x/marketmap module, ordered by
+// height. The changes may be filtered, and are paginated if the request sets a pagination.
+func (q queryServerImpl) ScheduledMarketChanges(
+	ctx context.Context,
+	req *types.ScheduledMarketChangesRequest,
+) (*types.ScheduledMarketChangesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// return every matching change if no pagination is requested
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{Limit: query.PaginationMaxLimit}
+	}
+
+	changes, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.scheduledMarketChanges,
+		pageReq,
+		func(_ collections.Pair[uint64, uint64], change types.ScheduledMarketChange) (bool, error) {
+			if req.Ticker != "" && req.Ticker != change.Ticker {
+				return false, nil
+			}
+
+			return req.MaxHeight == 0 || change.Height <= req.MaxHeight, nil
+		},
+		func(_ collections.Pair[uint64, uint64], change types.ScheduledMarketChange) (types.ScheduledMarketChange, error) {
+			return change, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Pagination == nil {
+		pageRes = nil
+	}
+
+	return &types.ScheduledMarketChangesResponse{
+		ScheduledMarketChanges: changes,
+		Pagination:             pageRes,
+	}, nil
+}
+
+// MarketAuthorityGrants returns the scoped market authority grants stored in the x/marketmap module, optionally
+// restricted to a single grantee.
+func (q queryServerImpl) MarketAuthorityGrants(
+	ctx context.Context,
+	req *types.MarketAuthorityGrantsRequest,
+) (*types.MarketAuthorityGrantsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if req.Grantee != "" {
+		grant, found := q.k.GetMarketAuthorityGrant(ctx, req.Grantee)
+		if !found {
+			return &types.MarketAuthorityGrantsResponse{}, nil
+		}
+
+		return &types.MarketAuthorityGrantsResponse{Grants: []types.MarketAuthorityGrant{grant}}, nil
+	}
+
+	grants, err := q.k.GetAllMarketAuthorityGrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketAuthorityGrantsResponse{Grants: grants}, nil
+}
+
+// MarketHistory returns the change log of the market with the given ticker, or of every market if no ticker is given,
+// ordered by ticker and then by version. The changes are paginated if the request sets a pagination.
+func (q queryServerImpl) MarketHistory(
+	ctx context.Context,
+	req *types.MarketHistoryRequest,
+) (*types.MarketHistoryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// return every matching change if no pagination is requested
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{Limit: query.PaginationMaxLimit}
+	}
+
+	var opts []func(o *query.CollectionsPaginateOptions[collections.Pair[string, uint64]])
+	if req.Ticker != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, uint64](req.Ticker))
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.marketHistory,
+		pageReq,
+		func(_ collections.Pair[string, uint64], record types.MarketChangeRecord) (types.MarketChangeRecord, error) {
+			return record, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Pagination == nil {
+		pageRes = nil
+	}
+
+	return &types.MarketHistoryResponse{
+		Changes:    changes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MarketVersion returns the recorded change that produced the requested version of a market, along with the market
+// at that version.
+func (q queryServerImpl) MarketVersion(
+	ctx context.Context,
+	req *types.MarketVersionRequest,
+) (*types.MarketVersionResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	record, err := q.k.GetMarketChangeRecord(ctx, req.Ticker, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketVersionResponse{Change: record}, nil
+}
+
+// Providers returns the registry of known providers stored in the x/marketmap module, optionally restricted to a
+// single provider.
+func (q queryServerImpl) Providers(ctx context.Context, req *types.ProvidersRequest) (*types.ProvidersResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if req.Name != "" {
+		provider, found := q.k.GetProvider(ctx, req.Name)
+		if !found {
+			return &types.ProvidersResponse{}, nil
+		}
+
+		return &types.ProvidersResponse{Providers: []types.ProviderInfo{provider}}, nil
+	}
+
+	providers, err := q.k.GetAllProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ProvidersResponse{Providers: providers}, nil
+}
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -139,3 +143,95 @@ func (s *KeeperTestSuite) TestLastUpdated() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestMarketMapFilteredAndPaginated() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	enabledBtc := btcusdt
+	enabledBtc.Ticker.Enabled = true
+
+	binanceEth := ethusdt
+	binanceEth.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:           "binance",
+			OffChainTicker: "ETHUSDT",
+		},
+	}
+
+	for _, market := range []types.Market{enabledBtc, binanceEth, usdcusd, usdtusd} {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, market))
+	}
+
+	tickers := func(resp *types.MarketMapResponse) []string {
+		tickers := make([]string, 0, len(resp.MarketMap.Markets))
+		for ticker := range resp.MarketMap.Markets {
+			tickers = append(tickers, ticker)
+		}
+		sort.Strings(tickers)
+		return tickers
+	}
+
+	tcs := []struct {
+		name     string
+		req      *types.MarketMapRequest
+		expected []string
+	}{
+		{
+			"no filters",
+			&types.MarketMapRequest{},
+			[]string{enabledBtc.Ticker.String(), binanceEth.Ticker.String(), usdcusd.Ticker.String(), usdtusd.Ticker.String()},
+		},
+		{
+			"enabled only",
+			&types.MarketMapRequest{EnabledOnly: true},
+			[]string{enabledBtc.Ticker.String()},
+		},
+		{
+			"provider",
+			&types.MarketMapRequest{Provider: "binance"},
+			[]string{binanceEth.Ticker.String()},
+		},
+		{
+			"base",
+			&types.MarketMapRequest{Base: "usdc"},
+			[]string{usdcusd.Ticker.String()},
+		},
+		{
+			"quote",
+			&types.MarketMapRequest{Quote: "USDT"},
+			[]string{enabledBtc.Ticker.String(), binanceEth.Ticker.String()},
+		},
+		{
+			"combined filters with no matches",
+			&types.MarketMapRequest{Provider: "binance", Quote: "USD"},
+			[]string{},
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			resp, err := qs.MarketMap(s.ctx, tc.req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, tickers(resp))
+			s.Require().Nil(resp.Pagination)
+		})
+	}
+
+	s.Run("markets are paginated", func() {
+		req := &types.MarketMapRequest{Pagination: &query.PageRequest{Limit: 3}}
+		resp, err := qs.MarketMap(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().Len(resp.MarketMap.Markets, 3)
+		s.Require().NotEmpty(resp.Pagination.NextKey)
+
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 3}
+		next, err := qs.MarketMap(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().Len(next.MarketMap.Markets, 1)
+		s.Require().Empty(next.Pagination.NextKey)
+
+		for ticker := range next.MarketMap.Markets {
+			s.Require().NotContains(resp.MarketMap.Markets, ticker)
+		}
+	})
+}
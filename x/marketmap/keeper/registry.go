@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// SetProvider sets the registry entry of the given provider, overwriting any existing entry.
+func (k *Keeper) SetProvider(ctx context.Context, provider types.ProviderInfo) error {
+	return k.providers.Set(ctx, provider.Name, provider)
+}
+
+// GetProvider returns the registry entry of the provider with the given name. If no entry exists, return an empty
+// ProviderInfo, false, if one does, return true and the entry.
+func (k *Keeper) GetProvider(ctx context.Context, name string) (types.ProviderInfo, bool) {
+	provider, err := k.providers.Get(ctx, name)
+	if err != nil {
+		return types.ProviderInfo{}, false
+	}
+
+	return provider, true
+}
+
+// RemoveProvider removes the registry entry of the provider with the given name. This is a no-op if no entry exists.
+func (k *Keeper) RemoveProvider(ctx context.Context, name string) error {
+	return k.providers.Remove(ctx, name)
+}
+
+// GetAllProviders returns every registered provider, ordered by name.
+func (k *Keeper) GetAllProviders(ctx context.Context) ([]types.ProviderInfo, error) {
+	it, err := k.providers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// GetProviderRegistry returns the registry of known providers.
+func (k *Keeper) GetProviderRegistry(ctx context.Context) (types.ProviderRegistry, error) {
+	providers, err := k.GetAllProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewProviderRegistry(providers), nil
+}
+
+// ValidateMarketProviders checks that every provider config of the given market is for a registered provider, and
+// matches its off-chain ticker regex and metadata schema. Every provider is permitted while the registry is empty.
+func (k *Keeper) ValidateMarketProviders(ctx context.Context, market types.Market) error {
+	registry, err := k.GetProviderRegistry(ctx)
+	if err != nil {
+		return err
+	}
+
+	return registry.ValidateMarket(market)
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestProviderRegistry() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	market := btcusdt
+	market.Ticker.MinProviderCount = 1
+
+	kucoin := types.NewProviderInfo("kucoin", "[a-z]+-[a-z]+", "")
+	okx := types.NewProviderInfo("okx", "", "")
+
+	s.Run("every provider is permitted while the registry is empty", func() {
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("providers are registered by the module authority", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := msgServer.UpsertProviders(cacheCtx, &types.MsgUpsertProviders{
+			Authority: s.admin,
+			Providers: []types.ProviderInfo{kucoin, okx},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("the registry must cover every market in state", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := msgServer.UpsertProviders(cacheCtx, &types.MsgUpsertProviders{
+			Authority: s.authority.String(),
+			Providers: []types.ProviderInfo{okx},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.UpsertProviders(s.ctx, &types.MsgUpsertProviders{
+			Authority: s.authority.String(),
+			Providers: []types.ProviderInfo{kucoin, okx},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("markets must use registered providers with matching off-chain tickers", func() {
+		typo := usdtusd
+		typo.ProviderConfigs = []types.ProviderConfig{{Name: "kucion", OffChainTicker: "usdt-usd"}}
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := msgServer.CreateMarkets(cacheCtx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{typo},
+		})
+		s.Require().Error(err)
+
+		malformed := usdtusd
+		malformed.ProviderConfigs = []types.ProviderConfig{{Name: "kucoin", OffChainTicker: "USDT-USD"}}
+		cacheCtx, _ = s.ctx.CacheContext()
+		_, err = msgServer.UpsertMarkets(cacheCtx, &types.MsgUpsertMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []types.Market{malformed},
+		})
+		s.Require().Error(err)
+
+		cacheCtx, _ = s.ctx.CacheContext()
+		_, err = msgServer.ScheduleMarketUpdates(cacheCtx, &types.MsgScheduleMarketUpdates{
+			Authority:     s.marketAuthorities[0],
+			Height:        uint64(s.ctx.BlockHeight()) + 1, //nolint:gosec
+			CreateMarkets: []types.Market{typo},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{usdtusd},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("providers used by a market cannot be removed", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := msgServer.RemoveProviders(cacheCtx, &types.MsgRemoveProviders{
+			Authority: s.authority.String(),
+			Names:     []string{kucoin.Name},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.RemoveProviders(s.ctx, &types.MsgRemoveProviders{
+			Authority: s.authority.String(),
+			Names:     []string{okx.Name},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("providers are exported and queried", func() {
+		gs := s.keeper.ExportGenesis(s.ctx)
+		s.Require().Equal([]types.ProviderInfo{kucoin}, gs.Providers)
+
+		qs := keeper.NewQueryServer(s.keeper)
+		resp, err := qs.Providers(s.ctx, &types.ProvidersRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.ProviderInfo{kucoin}, resp.Providers)
+
+		resp, err = qs.Providers(s.ctx, &types.ProvidersRequest{Name: okx.Name})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Providers)
+	})
+}
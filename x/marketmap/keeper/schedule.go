@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// ScheduleMarketChange assigns the next ID to the given ScheduledMarketChange and stores it, to be applied at the
+// beginning of the block at its height. The height must be greater than the current block height, and fewer than
+// MaxScheduledMarketChangesPerHeight changes may already be pending for it. The ID of the stored change is returned.
+func (k *Keeper) ScheduleMarketChange(ctx sdk.Context, change types.ScheduledMarketChange) (uint64, error) {
+	if change.Height <= uint64(ctx.BlockHeight()) { //nolint:gosec
+		return 0, fmt.Errorf(
+			"scheduled market change height %d must be greater than the current height %d", change.Height, ctx.BlockHeight(),
+		)
+	}
+
+	if err := change.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	pending, err := k.numScheduledMarketChanges(ctx, change.Height)
+	if err != nil {
+		return 0, err
+	}
+
+	if pending >= types.MaxScheduledMarketChangesPerHeight {
+		return 0, fmt.Errorf(
+			"height %d already has the maximum of %d scheduled market changes",
+			change.Height, types.MaxScheduledMarketChangesPerHeight,
+		)
+	}
+
+	id, err := k.nextScheduledMarketChangeID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	change.Id = id
+	return id, k.setScheduledMarketChange(ctx, change)
+}
+
+// numScheduledMarketChanges returns the number of pending ScheduledMarketChanges at the given height, counting at most
+// MaxScheduledMarketChangesPerHeight changes.
+func (k *Keeper) numScheduledMarketChanges(ctx context.Context, height uint64) (int, error) {
+	it, err := k.scheduledMarketChanges.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](height))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	n := 0
+	for ; it.Valid() && n < types.MaxScheduledMarketChangesPerHeight; it.Next() {
+		n++
+	}
+
+	return n, nil
+}
+
+// setScheduledMarketChange stores the given ScheduledMarketChange under its height and ID.
+func (k *Keeper) setScheduledMarketChange(ctx context.Context, change types.ScheduledMarketChange) error {
+	if err := k.scheduledMarketChanges.Set(ctx, collections.Join(change.Height, change.Id), change); err != nil {
+		return err
+	}
+
+	return k.scheduledMarketChangeHeights.Set(ctx, change.Id, change.Height)
+}
+
+// GetScheduledMarketChange returns the pending ScheduledMarketChange with the given ID.
+func (k *Keeper) GetScheduledMarketChange(ctx context.Context, id uint64) (types.ScheduledMarketChange, error) {
+	height, err := k.scheduledMarketChangeHeights.Get(ctx, id)
+	if err != nil {
+		return types.ScheduledMarketChange{}, types.NewScheduledMarketChangeNotFoundError(id)
+	}
+
+	return k.scheduledMarketChanges.Get(ctx, collections.Join(height, id))
+}
+
+// CancelScheduledMarketChange removes the pending ScheduledMarketChange with the given ID, so that it is never
+// applied.
+func (k *Keeper) CancelScheduledMarketChange(ctx context.Context, id uint64) error {
+	change, err := k.GetScheduledMarketChange(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return k.removeScheduledMarketChange(ctx, change)
+}
+
+// removeScheduledMarketChange removes the given ScheduledMarketChange from state.
+func (k *Keeper) removeScheduledMarketChange(ctx context.Context, change types.ScheduledMarketChange) error {
+	if err := k.scheduledMarketChanges.Remove(ctx, collections.Join(change.Height, change.Id)); err != nil {
+		return err
+	}
+
+	return k.scheduledMarketChangeHeights.Remove(ctx, change.Id)
+}
+
+// GetAllScheduledMarketChanges returns all pending ScheduledMarketChanges, ordered by height and then by ID.
+func (k *Keeper) GetAllScheduledMarketChanges(ctx context.Context) ([]types.ScheduledMarketChange, error) {
+	it, err := k.scheduledMarketChanges.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// ApplyScheduledMarketChanges applies every pending ScheduledMarketChange due at or before the current block height,
+// in order of height and then ID. The authority that scheduled each change must still be authorized to make it when it
+// is applied. Each change is applied atomically along with the market map hooks, and must leave the market map in a
+// valid state. Changes that fail are dropped and reported in an event rather than halting the chain. Applied or not,
+// each due change is removed from state.
+//
+// NOTE: The x/oracle BeginBlocker must run before the x/marketmap BeginBlocker. Removing a market removes its
+// currency pair from x/oracle through the market map hooks, and x/oracle records the removed currency pairs until its
+// next BeginBlocker so that vote extensions created before the removal can still be decoded. If x/oracle's BeginBlocker
+// ran after this method, the removals applied in the block would be cleared before they could be used.
+func (k *Keeper) ApplyScheduledMarketChanges(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndInclusive(collections.Join(uint64(ctx.BlockHeight()), uint64(math.MaxUint64))) //nolint:gosec
+	it, err := k.scheduledMarketChanges.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	due, err := it.Values()
+	if err != nil {
+		return err
+	}
+
+	applied := false
+	for _, change := range due {
+		if err := k.removeScheduledMarketChange(ctx, change); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		event := sdk.NewEvent(
+			types.EventTypeApplyScheduledMarketChange,
+			sdk.NewAttribute(types.AttributeKeyScheduledMarketChangeID, strconv.FormatUint(change.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, change.Ticker),
+			sdk.NewAttribute(types.AttributeKeyChangeType, change.ChangeType.String()),
+		)
+
+		if err := k.applyScheduledMarketChange(cacheCtx, change); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to apply scheduled market change %d", change.Id), "err", err)
+			ctx.EventManager().EmitEvent(event.AppendAttributes(
+				sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(false)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+
+		write()
+		applied = true
+		ctx.EventManager().EmitEvent(event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(true)),
+		))
+	}
+
+	if !applied {
+		return nil
+	}
+
+	return k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+}
+
+// applyScheduledMarketChange applies the given ScheduledMarketChange and runs the corresponding market map hooks,
+// then validates the resulting state, as the equivalent market map messages do.
+func (k *Keeper) applyScheduledMarketChange(ctx sdk.Context, change types.ScheduledMarketChange) error {
+	if err := k.verifyScheduledMarketChangeAuthority(ctx, change); err != nil {
+		return err
+	}
+
+	switch change.ChangeType {
+	case types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_CREATE:
+		if err := k.CreateMarket(ctx, change.Market); err != nil {
+			return err
+		}
+
+		if err := k.hooks.AfterMarketCreated(ctx, change.Market); err != nil {
+			return fmt.Errorf("unable to run create market hook: %w", err)
+		}
+
+		if err := k.recordMarketChange(
+			ctx, change.Ticker, nil, &change.Market, change.Authority, &types.MsgScheduleMarketUpdates{},
+		); err != nil {
+			return err
+		}
+
+		if err := k.applyMarketStatusChange(ctx, change.Ticker, nil, &change.Market, change.Authority); err != nil {
+			return err
+		}
+
+		emitMarketEvent(ctx, types.EventTypeCreateMarket, change.Market)
+		return k.ValidateState(ctx, []types.Market{change.Market})
+	case types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_UPDATE:
+		before, err := k.GetMarket(ctx, change.Ticker)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err := k.UpdateMarket(ctx, change.Market); err != nil {
+			return err
+		}
+
+		if err := k.hooks.AfterMarketUpdated(ctx, change.Market); err != nil {
+			return fmt.Errorf("unable to run update market hook: %w", err)
+		}
+
+		if err := k.recordMarketChange(
+			ctx, change.Ticker, &before, &change.Market, change.Authority, &types.MsgScheduleMarketUpdates{},
+		); err != nil {
+			return err
+		}
+
+		if err := k.applyMarketStatusChange(ctx, change.Ticker, &before, &change.Market, change.Authority); err != nil {
+			return err
+		}
+
+		emitMarketEvent(ctx, types.EventTypeUpdateMarket, change.Market)
+		return k.ValidateState(ctx, []types.Market{change.Market})
+	case types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_REMOVE:
+		before, err := k.GetMarket(ctx, change.Ticker)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("unable to delete market: %w", err)
+		}
+
+		deleted, err := k.DeleteMarket(ctx, change.Ticker)
+		if err != nil {
+			return fmt.Errorf("unable to delete market: %w", err)
+		}
+
+		if deleted {
+			if err := k.recordMarketChange(
+				ctx, change.Ticker, &before, nil, change.Authority, &types.MsgScheduleMarketRemovals{},
+			); err != nil {
+				return err
+			}
+
+			if err := k.applyMarketStatusChange(ctx, change.Ticker, &before, nil, change.Authority); err != nil {
+				return err
+			}
+		}
+
+		if err := k.hooks.AfterMarketRemoved(ctx, change.Ticker); err != nil {
+			return fmt.Errorf("unable to run market removal hook: %w", err)
+		}
+
+		// the removed market may be used as a normalization pair
+		allMarkets, err := k.GetAllMarkets(ctx)
+		if err != nil {
+			return err
+		}
+
+		mm := types.MarketMap{Markets: allMarkets}
+		return mm.ValidateBasic()
+	default:
+		return fmt.Errorf("invalid scheduled market change type %s", change.ChangeType)
+	}
+}
+
+// verifyScheduledMarketChangeAuthority verifies that the authority that scheduled the given ScheduledMarketChange is
+// still authorized to make it, as the equivalent schedule message requires: creates and updates must be made by a
+// market authority, or a grantee whose grant covers the market, and removals must be made by the admin.
+func (k *Keeper) verifyScheduledMarketChangeAuthority(ctx sdk.Context, change types.ScheduledMarketChange) error {
+	if change.ChangeType == types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_REMOVE {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		if change.Authority != params.Admin {
+			return fmt.Errorf("scheduled market change %d authority %s is no longer the module admin", change.Id, change.Authority)
+		}
+
+		return nil
+	}
+
+	if err := k.verifyMarketAuthority(ctx, change.Authority, []types.Market{change.Market}); err != nil {
+		return fmt.Errorf("scheduled market change %d authority is no longer authorized: %w", change.Id, err)
+	}
+
+	return nil
+}
+
+// emitMarketEvent emits an event of the given type for the given market.
+func emitMarketEvent(ctx sdk.Context, eventType string, market types.Market) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+		sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
+		sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
+		sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
+	))
+}
@@ -0,0 +1,320 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestScheduledMarketChanges() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	market := btcusdt
+	market.Ticker.MinProviderCount = 1
+
+	s.Run("unable to process nil requests", func() {
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("changes must be scheduled by a market authority for a future height", func() {
+		_, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority:     sdk.AccAddress("invalid").String(),
+			Height:        12,
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority:     s.marketAuthorities[0],
+			Height:        uint64(s.ctx.BlockHeight()), //nolint:gosec
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.ScheduleMarketRemovals(s.ctx, &types.MsgScheduleMarketRemovals{
+			Admin:   s.marketAuthorities[0],
+			Height:  12,
+			Markets: []string{market.Ticker.String()},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scheduled changes are applied at their height", func() {
+		s.SetupTest()
+		msgServer = keeper.NewMsgServer(s.keeper)
+
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority:     s.marketAuthorities[0],
+			Height:        12,
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{0}, resp.Ids)
+
+		// nothing is applied before the height
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockHeight(11)))
+		exists, err := s.keeper.HasMarket(s.ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(exists)
+
+		ctx := s.ctx.WithBlockHeight(12)
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		got, err := s.keeper.GetMarket(ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(market, got)
+		s.Require().True(s.oracleKeeper.HasCurrencyPair(ctx, market.Ticker.CurrencyPair))
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(12), lastUpdated)
+
+		changes, err := s.keeper.GetAllScheduledMarketChanges(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(changes)
+
+		// remove the market
+		removeResp, err := msgServer.ScheduleMarketRemovals(ctx, &types.MsgScheduleMarketRemovals{
+			Admin:   s.admin,
+			Height:  13,
+			Markets: []string{market.Ticker.String()},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{1}, removeResp.Ids)
+
+		ctx = ctx.WithBlockHeight(13)
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+		exists, err = s.keeper.HasMarket(ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(exists)
+	})
+
+	s.Run("failed changes are dropped without failing the block", func() {
+		s.SetupTest()
+		msgServer = keeper.NewMsgServer(s.keeper)
+
+		_, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority:     s.marketAuthorities[0],
+			Height:        12,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+
+		ctx := s.ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		exists, err := s.keeper.HasMarket(ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(exists)
+
+		changes, err := s.keeper.GetAllScheduledMarketChanges(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(changes)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeApplyScheduledMarketChange, events[0].Type)
+		success, ok := events[0].GetAttribute(types.AttributeKeySuccess)
+		s.Require().True(ok)
+		s.Require().Equal("false", success.Value)
+
+		// the last updated height is unchanged
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(10), lastUpdated)
+	})
+
+	s.Run("changes are dropped if their authority was revoked before their height", func() {
+		s.SetupTest()
+		msgServer = keeper.NewMsgServer(s.keeper)
+
+		_, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority:     s.marketAuthorities[0],
+			Height:        12,
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+
+		// the first market authority is removed from the params
+		params, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		params.MarketAuthorities = s.marketAuthorities[1:]
+		s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+		ctx := s.ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		exists, err := s.keeper.HasMarket(ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(exists)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		success, ok := events[0].GetAttribute(types.AttributeKeySuccess)
+		s.Require().True(ok)
+		s.Require().Equal("false", success.Value)
+	})
+
+	s.Run("removals are dropped if their authority is no longer the admin", func() {
+		s.SetupTest()
+
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.NewScheduledMarketRemoval(0, 12, "BTC/USD", s.marketAuthorities[0]))
+		s.Require().NoError(err)
+
+		ctx := s.ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		success, ok := events[0].GetAttribute(types.AttributeKeySuccess)
+		s.Require().True(ok)
+		s.Require().Equal("false", success.Value)
+		errAttr, ok := events[0].GetAttribute(types.AttributeKeyError)
+		s.Require().True(ok)
+		s.Require().Contains(errAttr.Value, "admin")
+	})
+
+	s.Run("at most MaxScheduledMarketChangesPerHeight changes are scheduled per height", func() {
+		s.SetupTest()
+
+		for i := 0; i < types.MaxScheduledMarketChangesPerHeight; i++ {
+			_, err := s.keeper.ScheduleMarketChange(s.ctx, types.NewScheduledMarketRemoval(0, 12, "BTC/USD", s.admin))
+			s.Require().NoError(err)
+		}
+
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.NewScheduledMarketRemoval(0, 12, "BTC/USD", s.admin))
+		s.Require().Error(err)
+
+		// other heights are unaffected
+		_, err = s.keeper.ScheduleMarketChange(s.ctx, types.NewScheduledMarketRemoval(0, 13, "BTC/USD", s.admin))
+		s.Require().NoError(err)
+	})
+
+	s.Run("changes can be cancelled by their authority or the admin", func() {
+		s.SetupTest()
+		msgServer = keeper.NewMsgServer(s.keeper)
+
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority:     s.marketAuthorities[0],
+			Height:        12,
+			CreateMarkets: []types.Market{market, usdtusd},
+		})
+		s.Require().NoError(err)
+
+		_, err = msgServer.CancelScheduledMarketChanges(s.ctx, &types.MsgCancelScheduledMarketChanges{
+			Authority: s.marketAuthorities[1],
+			Ids:       resp.Ids,
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.CancelScheduledMarketChanges(s.ctx, &types.MsgCancelScheduledMarketChanges{
+			Authority: s.marketAuthorities[0],
+			Ids:       []uint64{resp.Ids[0]},
+		})
+		s.Require().NoError(err)
+
+		_, err = msgServer.CancelScheduledMarketChanges(s.ctx, &types.MsgCancelScheduledMarketChanges{
+			Authority: s.admin,
+			Ids:       []uint64{resp.Ids[1]},
+		})
+		s.Require().NoError(err)
+
+		_, err = msgServer.CancelScheduledMarketChanges(s.ctx, &types.MsgCancelScheduledMarketChanges{
+			Authority: s.admin,
+			Ids:       []uint64{resp.Ids[1]},
+		})
+		s.Require().Error(err)
+
+		changes, err := s.keeper.GetAllScheduledMarketChanges(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(changes)
+	})
+
+	s.Run("scheduled changes are round-tripped through genesis", func() {
+		s.SetupTest()
+		msgServer = keeper.NewMsgServer(s.keeper)
+
+		_, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority:     s.marketAuthorities[0],
+			Height:        12,
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+
+		gs := s.keeper.ExportGenesis(s.ctx)
+		s.Require().Len(gs.ScheduledMarketChanges, 1)
+		s.Require().Equal(uint64(1), gs.NextScheduledMarketChangeId)
+
+		s.SetupTest()
+		s.keeper.InitGenesis(s.ctx, *gs)
+
+		change, err := s.keeper.GetScheduledMarketChange(s.ctx, 0)
+		s.Require().NoError(err)
+		s.Require().Equal(gs.ScheduledMarketChanges[0], change)
+
+		id, err := s.keeper.ScheduleMarketChange(s.ctx, types.NewScheduledMarketRemoval(0, 12, "BTC/USD", s.admin))
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), id)
+	})
+}
+
+func (s *KeeperTestSuite) TestScheduledMarketChangesQuery() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	for _, change := range []types.ScheduledMarketChange{
+		types.NewScheduledMarketRemoval(0, 14, "BTC/USD", s.admin),
+		types.NewScheduledMarketRemoval(0, 12, "ETH/USD", s.admin),
+		types.NewScheduledMarketRemoval(0, 13, "BTC/USD", s.admin),
+	} {
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, change)
+		s.Require().NoError(err)
+	}
+
+	heights := func(changes []types.ScheduledMarketChange) []uint64 {
+		out := make([]uint64, 0, len(changes))
+		for _, change := range changes {
+			out = append(out, change.Height)
+		}
+		return out
+	}
+
+	s.Run("invalid for nil request", func() {
+		_, err := qs.ScheduledMarketChanges(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("changes are ordered by height", func() {
+		resp, err := qs.ScheduledMarketChanges(s.ctx, &types.ScheduledMarketChangesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{12, 13, 14}, heights(resp.ScheduledMarketChanges))
+		s.Require().Nil(resp.Pagination)
+	})
+
+	s.Run("changes are filtered", func() {
+		resp, err := qs.ScheduledMarketChanges(s.ctx, &types.ScheduledMarketChangesRequest{
+			Ticker:    "BTC/USD",
+			MaxHeight: 13,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{13}, heights(resp.ScheduledMarketChanges))
+	})
+
+	s.Run("changes are paginated", func() {
+		resp, err := qs.ScheduledMarketChanges(s.ctx, &types.ScheduledMarketChangesRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{12, 13}, heights(resp.ScheduledMarketChanges))
+		s.Require().Equal(uint64(3), resp.Pagination.Total)
+
+		resp, err = qs.ScheduledMarketChanges(s.ctx, &types.ScheduledMarketChangesRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{14}, heights(resp.ScheduledMarketChanges))
+	})
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// ValidateMarketMapUpdate applies the given markets to a cached copy of state as an upsert, and returns the
+// validation result of each market in order. Markets are validated statelessly, applied along with the market map
+// hooks, and then the resulting state is validated, as in UpsertMarkets. No changes are committed to state.
+func (k *Keeper) ValidateMarketMapUpdate(ctx sdk.Context, markets []types.Market) []types.MarketUpdateResult {
+	cacheCtx, _ := ctx.CacheContext()
+
+	results := make([]types.MarketUpdateResult, len(markets))
+	seen := make(map[string]struct{}, len(markets))
+	for i, market := range markets {
+		results[i].Ticker = market.Ticker.String()
+
+		if _, ok := seen[results[i].Ticker]; ok {
+			results[i].Error = fmt.Sprintf("duplicate ticker: %s", results[i].Ticker)
+			continue
+		}
+		seen[results[i].Ticker] = struct{}{}
+
+		created, err := k.applyMarketUpsert(cacheCtx, market)
+		results[i].Created = created
+		if err != nil {
+			results[i].Error = err.Error()
+		}
+	}
+
+	// validate the resulting state of every applied market, and report its resulting currency pair ID
+	for i, market := range markets {
+		if results[i].Error != "" {
+			continue
+		}
+
+		if err := k.IsMarketValid(cacheCtx, market); err != nil {
+			results[i].Error = fmt.Sprintf("invalid state resulting from update: %s", err)
+			continue
+		}
+
+		if k.oracleKeeper != nil {
+			results[i].CurrencyPairId, results[i].HasCurrencyPairId = k.oracleKeeper.GetIDForCurrencyPair(
+				cacheCtx,
+				market.Ticker.CurrencyPair,
+			)
+		}
+	}
+
+	return results
+}
+
+// applyMarketUpsert creates or updates the given market and runs the corresponding hooks. The changes are only
+// written to the given context if every step succeeds. This returns true if the market was created.
+func (k *Keeper) applyMarketUpsert(ctx sdk.Context, market types.Market) (bool, error) {
+	if err := market.ValidateBasic(); err != nil {
+		return false, err
+	}
+
+	exists, err := k.HasMarket(ctx, market.Ticker.String())
+	if err != nil {
+		return false, err
+	}
+
+	marketCtx, write := ctx.CacheContext()
+	if !exists {
+		if err := k.CreateMarket(marketCtx, market); err != nil {
+			return true, err
+		}
+
+		if err := k.hooks.AfterMarketCreated(marketCtx, market); err != nil {
+			return true, fmt.Errorf("unable to run create market hook: %w", err)
+		}
+
+		if err := k.applyMarketStatusChange(marketCtx, market.Ticker.String(), nil, &market, ""); err != nil {
+			return true, err
+		}
+	} else {
+		before, err := k.GetMarket(marketCtx, market.Ticker.String())
+		if err != nil {
+			return false, err
+		}
+
+		if err := k.UpdateMarket(marketCtx, market); err != nil {
+			return false, err
+		}
+
+		if err := k.hooks.AfterMarketUpdated(marketCtx, market); err != nil {
+			return false, fmt.Errorf("unable to run update market hook: %w", err)
+		}
+
+		if err := k.applyMarketStatusChange(marketCtx, market.Ticker.String(), &before, &market, ""); err != nil {
+			return false, err
+		}
+	}
+
+	write()
+	return !exists, nil
+}
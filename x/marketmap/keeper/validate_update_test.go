@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestValidateMarketMapUpdate() {
+	qs := keeper.NewQueryServer(s.keeper)
+	s.keeper.SetOracleKeeper(&s.oracleKeeper)
+
+	normalized := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("ETHEREUM", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "eth-usdt",
+				NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+			},
+		},
+	}
+
+	invalid := btcusdt
+	invalid.ProviderConfigs = nil
+
+	s.Run("invalid for nil request", func() {
+		_, err := qs.ValidateMarketMapUpdate(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid for empty request", func() {
+		_, err := qs.ValidateMarketMapUpdate(s.ctx, &types.ValidateMarketMapUpdateRequest{})
+		s.Require().Error(err)
+	})
+
+	s.Run("valid markets are reported with their currency pair ids without changing state", func() {
+		resp, err := qs.ValidateMarketMapUpdate(s.ctx, &types.ValidateMarketMapUpdateRequest{
+			Markets: []types.Market{normalized, usdtusd},
+		})
+		s.Require().NoError(err)
+		s.Require().True(resp.Valid)
+		s.Require().Len(resp.Results, 2)
+
+		for i, market := range []types.Market{normalized, usdtusd} {
+			result := resp.Results[i]
+			s.Require().Equal(market.Ticker.String(), result.Ticker)
+			s.Require().True(result.Created)
+			s.Require().Empty(result.Error)
+			s.Require().True(result.HasCurrencyPairId)
+		}
+		s.Require().NotEqual(resp.Results[0].CurrencyPairId, resp.Results[1].CurrencyPairId)
+
+		exists, err := s.keeper.HasMarket(s.ctx, normalized.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(exists)
+		s.Require().False(s.oracleKeeper.HasCurrencyPair(s.ctx, normalized.Ticker.CurrencyPair))
+	})
+
+	s.Run("invalid markets are reported per market", func() {
+		resp, err := qs.ValidateMarketMapUpdate(s.ctx, &types.ValidateMarketMapUpdateRequest{
+			Markets: []types.Market{normalized, invalid, ethusdt, ethusdt},
+		})
+		s.Require().NoError(err)
+		s.Require().False(resp.Valid)
+		s.Require().Len(resp.Results, 4)
+
+		// the normalize market does not exist
+		s.Require().NotEmpty(resp.Results[0].Error)
+		s.Require().False(resp.Results[0].HasCurrencyPairId)
+
+		// the market fails stateless validation
+		s.Require().NotEmpty(resp.Results[1].Error)
+
+		// the first instance of a repeated market is valid
+		s.Require().Empty(resp.Results[2].Error)
+		s.Require().True(resp.Results[2].HasCurrencyPairId)
+		s.Require().NotEmpty(resp.Results[3].Error)
+	})
+
+	s.Run("existing markets are updated", func() {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+		s.Require().NoError(s.keeper.Hooks().AfterMarketCreated(s.ctx, btcusdt))
+		id, ok := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, btcusdt.Ticker.CurrencyPair)
+		s.Require().True(ok)
+
+		updated := btcusdt
+		updated.Ticker.Decimals = 10
+		updated.Ticker.MinProviderCount = 1
+
+		resp, err := qs.ValidateMarketMapUpdate(s.ctx, &types.ValidateMarketMapUpdateRequest{
+			Markets: []types.Market{updated},
+		})
+		s.Require().NoError(err)
+		s.Require().True(resp.Valid)
+		s.Require().False(resp.Results[0].Created)
+		s.Require().Equal(id, resp.Results[0].CurrencyPairId)
+
+		market, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(btcusdt, market)
+	})
+}
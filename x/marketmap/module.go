@@ -14,6 +14,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -23,6 +24,7 @@ import (
 	marketmapmodulev1 "github.com/skip-mev/connect/v2/api/connect/marketmap/module/v2"
 	"github.com/skip-mev/connect/v2/x/marketmap/client/cli"
 	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/simulation"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
@@ -36,6 +38,9 @@ var (
 	_ module.AppModuleBasic = AppModule{}
 	_ module.HasServices    = AppModule{}
 
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
+
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
 	_ appmodule.HasEndBlocker   = AppModule{}
@@ -106,11 +111,15 @@ func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 type AppModule struct {
 	AppModuleBasic
 	k *keeper.Keeper
+
+	// ak and bk are only used to simulate the module, and may be nil.
+	ak types.AccountKeeper
+	bk types.BankKeeper
 }
 
-// BeginBlock is a no-op for x/marketmap.
-func (am AppModule) BeginBlock(_ context.Context) error {
-	return nil
+// BeginBlock applies the scheduled market changes that are due in the block.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.k.BeginBlocker(sdk.UnwrapSDKContext(ctx))
 }
 
 // EndBlock is a no-op for x/marketmap.
@@ -155,16 +164,44 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface. It is a no-op.
 func (am AppModule) IsAppModule() {}
 
-// NewAppModule constructs a new application module for the x/marketmap module.
-func NewAppModule(cdc codec.Codec, k *keeper.Keeper) AppModule {
+// NewAppModule constructs a new application module for the x/marketmap module. The account and bank keepers are
+// only used to simulate the module, and may be nil.
+func NewAppModule(cdc codec.Codec, k *keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{
 			cdc: cdc,
 		},
-		k: k,
+		k:  k,
+		ak: ak,
+		bk: bk,
 	}
 }
 
+// GenerateGenesisState creates a randomized GenState of the x/marketmap module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs()
+}
+
+// RegisterStoreDecoder registers a decoder for the x/marketmap module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.k.Schema())
+}
+
+// WeightedOperations returns the x/marketmap module's market upsert and removal operations. No operations are
+// returned if the module was constructed without account and bank keepers.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	if am.ak == nil || am.bk == nil {
+		return nil
+	}
+
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.ak, am.bk, am.k)
+}
+
 /*
 
 	Dep-Inject
@@ -189,6 +226,10 @@ type Inputs struct {
 	Config       *marketmapmodulev1.Module
 	Cdc          codec.Codec
 	StoreService store.KVStoreService
+
+	// keepers used to simulate the module
+	AccountKeeper types.AccountKeeper `optional:"true"`
+	BankKeeper    types.BankKeeper    `optional:"true"`
 }
 
 // Outputs defines the constructor outputs for the module.
@@ -208,7 +249,7 @@ func ProvideModule(in Inputs) Outputs {
 
 	marketmapKeeper := keeper.NewKeeper(in.StoreService, in.Cdc, authority)
 
-	m := NewAppModule(in.Cdc, marketmapKeeper)
+	m := NewAppModule(in.Cdc, marketmapKeeper, in.AccountKeeper, in.BankKeeper)
 
 	return Outputs{
 		MarketMapKeeper: marketmapKeeper,
@@ -249,7 +290,8 @@ func InvokeSetMarketMapHooks(
 			return fmt.Errorf("can't find marketmap hooks for module %s", modName)
 		}
 
-		multiHooks = append(multiHooks, hook)
+		// append the wrapped hooks, so that optional hook interfaces they implement are retained
+		multiHooks = append(multiHooks, hook.MarketMapHooks)
 	}
 
 	keeper.SetHooks(multiHooks)
@@ -0,0 +1,80 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// Simulation parameter constants.
+const (
+	MarketAuthorities = "market_authorities"
+	Admin             = "admin"
+	Providers         = "providers"
+
+	// maxMarketAuthorities is the maximum number of simulation accounts that are market authorities.
+	maxMarketAuthorities = 3
+
+	// maxProviders is the maximum number of providers in the simulated provider registry.
+	maxProviders = 4
+
+	// offChainTickerRegex is the off-chain ticker regex of simulated providers. Off-chain tickers of simulated
+	// markets are of the form BASE-QUOTE.
+	offChainTickerRegex = "[A-Z]+-[A-Z]+"
+)
+
+// RandomizedGenState generates a random GenesisState for the x/marketmap module. The market authorities and admin are
+// simulation accounts, so that the module's weighted operations are able to sign market upserts and removals. No
+// markets are created at genesis, as their currency pairs are created in x/oracle by the market map hooks.
+func RandomizedGenState(simState *module.SimulationState) {
+	var marketAuthorities []string
+	simState.AppParams.GetOrGenerate(MarketAuthorities, &marketAuthorities, simState.Rand, func(r *rand.Rand) {
+		marketAuthorities = RandomMarketAuthorities(r, simState.Accounts)
+	})
+
+	var admin string
+	simState.AppParams.GetOrGenerate(Admin, &admin, simState.Rand, func(r *rand.Rand) {
+		acc, _ := simtypes.RandomAcc(r, simState.Accounts)
+		admin = acc.Address.String()
+	})
+
+	var providers []types.ProviderInfo
+	simState.AppParams.GetOrGenerate(Providers, &providers, simState.Rand, func(r *rand.Rand) {
+		providers = RandomProviders(r)
+	})
+
+	gs := types.DefaultGenesisState()
+	gs.Params.MarketAuthorities = marketAuthorities
+	gs.Params.Admin = admin
+	gs.Providers = providers
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
+}
+
+// RandomMarketAuthorities returns between one and three distinct simulation accounts as market authorities.
+func RandomMarketAuthorities(r *rand.Rand, accs []simtypes.Account) []string {
+	n := simtypes.RandIntBetween(r, 1, min(maxMarketAuthorities, len(accs))+1)
+
+	authorities := make([]string, 0, n)
+	for _, i := range r.Perm(len(accs))[:n] {
+		authorities = append(authorities, accs[i].Address.String())
+	}
+
+	return authorities
+}
+
+// RandomProviders returns a random provider registry. An empty registry permits every provider.
+func RandomProviders(r *rand.Rand) []types.ProviderInfo {
+	n := r.Intn(maxProviders + 1)
+
+	providers := make([]types.ProviderInfo, 0, n)
+	for i := 0; i < n; i++ {
+		providers = append(providers, types.NewProviderInfo(fmt.Sprintf("sim_provider_%d", i), offChainTickerRegex, ""))
+	}
+
+	return providers
+}
@@ -0,0 +1,53 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/simulation"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		accs := simtypes.RandomAccounts(r, 5)
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     accs,
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var gs types.GenesisState
+		cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gs)
+		require.NoError(t, gs.ValidateBasic(), "seed %d", seed)
+		require.Empty(t, gs.MarketMap.Markets)
+
+		// the market authorities and admin are simulation accounts, which sign the module's operations
+		signers := make(map[string]struct{}, len(accs))
+		for _, acc := range accs {
+			signers[acc.Address.String()] = struct{}{}
+		}
+		require.NotEmpty(t, gs.Params.MarketAuthorities)
+		for _, authority := range gs.Params.MarketAuthorities {
+			require.Contains(t, signers, authority)
+		}
+		require.Contains(t, signers, gs.Params.Admin)
+	}
+}
@@ -0,0 +1,357 @@
+package simulation
+
+import (
+	"math/rand"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgUpsertMarkets = "op_weight_msg_upsert_markets"
+	OpWeightMsgRemoveMarkets = "op_weight_msg_remove_markets"
+
+	DefaultWeightMsgUpsertMarkets int = 100
+	DefaultWeightMsgRemoveMarkets int = 25
+
+	// maxMarketsPerMsg is the maximum number of markets upserted or removed by a single message.
+	maxMarketsPerMsg = 3
+
+	// maxMarketDecimals is the maximum number of decimals of a simulated market.
+	maxMarketDecimals = 18
+
+	// maxProviderConfigs is the maximum number of provider configs of a simulated market.
+	maxProviderConfigs = 3
+)
+
+var (
+	// quoteAssets are the quote assets of simulated markets. The set is small, so that upserts regularly update
+	// existing markets.
+	quoteAssets = []string{"USD", "USDT", "ETH"}
+
+	// marketStatuses are the statuses that a simulated market may be upserted with.
+	marketStatuses = []types.MarketStatus{
+		types.MarketStatus_MARKET_STATUS_PROPOSED,
+		types.MarketStatus_MARKET_STATUS_ACTIVE,
+		types.MarketStatus_MARKET_STATUS_REDUCE_ONLY,
+		types.MarketStatus_MARKET_STATUS_DEPRECATED,
+	}
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txConfig client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgUpsertMarkets int
+	appParams.GetOrGenerate(OpWeightMsgUpsertMarkets, &weightMsgUpsertMarkets, nil, func(_ *rand.Rand) {
+		weightMsgUpsertMarkets = DefaultWeightMsgUpsertMarkets
+	})
+
+	var weightMsgRemoveMarkets int
+	appParams.GetOrGenerate(OpWeightMsgRemoveMarkets, &weightMsgRemoveMarkets, nil, func(_ *rand.Rand) {
+		weightMsgRemoveMarkets = DefaultWeightMsgRemoveMarkets
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgUpsertMarkets,
+			SimulateMsgUpsertMarkets(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveMarkets,
+			SimulateMsgRemoveMarkets(txConfig, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgUpsertMarkets generates a MsgUpsertMarkets signed by a market authority, which creates new random markets
+// and updates existing markets. Updated markets move along the market lifecycle.
+func SimulateMsgUpsertMarkets(
+	txConfig client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgUpsertMarkets{})
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get params"), nil, err
+		}
+
+		simAccount, found := randomAccountOf(r, accs, params.MarketAuthorities)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no market authority is a simulation account"), nil, nil
+		}
+
+		markets, err := k.GetAllMarkets(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get markets"), nil, err
+		}
+
+		registry, err := k.GetProviderRegistry(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get provider registry"), nil, err
+		}
+
+		tickers := sortedTickers(markets)
+		n := simtypes.RandIntBetween(r, 1, maxMarketsPerMsg+1)
+		upserts := make([]types.Market, 0, n)
+		seen := make(map[string]struct{}, n)
+		for i := 0; i < n; i++ {
+			var market types.Market
+			if len(tickers) > 0 && r.Intn(2) == 0 {
+				market = randomMarketUpdate(r, markets[tickers[r.Intn(len(tickers))]], registry)
+			} else {
+				market = randomMarket(r, randomCurrencyPair(r), registry)
+				if existing, ok := markets[market.Ticker.String()]; ok {
+					market = randomMarketUpdate(r, existing, registry)
+				}
+			}
+
+			if _, ok := seen[market.Ticker.String()]; ok {
+				continue
+			}
+			seen[market.Ticker.String()] = struct{}{}
+			upserts = append(upserts, market)
+		}
+
+		msg := &types.MsgUpsertMarkets{
+			Authority: simAccount.Address.String(),
+			Markets:   upserts,
+		}
+
+		return deliver(r, app, ctx, txConfig, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgRemoveMarkets generates a MsgRemoveMarkets signed by the admin, which removes random markets that may
+// move to the removed status.
+func SimulateMsgRemoveMarkets(
+	txConfig client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRemoveMarkets{})
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get params"), nil, err
+		}
+
+		simAccount, found := randomAccountOf(r, accs, []string{params.Admin})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin is not a simulation account"), nil, nil
+		}
+
+		markets, err := k.GetAllMarkets(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get markets"), nil, err
+		}
+
+		// markets that normalize other markets cannot be removed
+		normalizing := make(map[string]struct{})
+		for _, market := range markets {
+			for _, pc := range market.ProviderConfigs {
+				if pc.NormalizeByPair != nil {
+					normalizing[pc.NormalizeByPair.String()] = struct{}{}
+				}
+			}
+		}
+
+		var removable []string
+		for _, ticker := range sortedTickers(markets) {
+			market := markets[ticker]
+			if _, ok := normalizing[ticker]; ok || market.Ticker.Enabled {
+				continue
+			}
+
+			if market.Ticker.EffectiveStatus().CanTransitionTo(types.MarketStatus_MARKET_STATUS_REMOVED) {
+				removable = append(removable, ticker)
+			}
+		}
+
+		if len(removable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no removable markets"), nil, nil
+		}
+
+		n := simtypes.RandIntBetween(r, 1, min(maxMarketsPerMsg, len(removable))+1)
+		removals := make([]string, 0, n)
+		for _, i := range r.Perm(len(removable))[:n] {
+			removals = append(removals, removable[i])
+		}
+
+		msg := &types.MsgRemoveMarkets{
+			Admin:   simAccount.Address.String(),
+			Markets: removals,
+		}
+
+		return deliver(r, app, ctx, txConfig, ak, bk, simAccount, msg)
+	}
+}
+
+// deliver generates a transaction with random fees for the given message, signed by the given account, and delivers
+// it.
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txConfig client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txConfig,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randomAccountOf returns a random simulation account whose address is one of the given addresses.
+func randomAccountOf(r *rand.Rand, accs []simtypes.Account, addresses []string) (simtypes.Account, bool) {
+	var candidates []simtypes.Account
+	for _, acc := range accs {
+		if slices.Contains(addresses, acc.Address.String()) {
+			candidates = append(candidates, acc)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return candidates[r.Intn(len(candidates))], true
+}
+
+// randomCurrencyPair returns a random currency pair with a three to five letter base asset, and one of the simulated
+// quote assets.
+func randomCurrencyPair(r *rand.Rand) connecttypes.CurrencyPair {
+	base := make([]byte, simtypes.RandIntBetween(r, 3, 6))
+	for i := range base {
+		base[i] = byte('A' + r.Intn(26))
+	}
+
+	return connecttypes.NewCurrencyPair(string(base), quoteAssets[r.Intn(len(quoteAssets))])
+}
+
+// randomMarket returns a random new market for the given currency pair. The market is created either without a
+// status, or as proposed or active. Its provider configs use registered providers, if the registry is in use.
+func randomMarket(r *rand.Rand, cp connecttypes.CurrencyPair, registry types.ProviderRegistry) types.Market {
+	market := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair: cp,
+			Decimals:     uint64(simtypes.RandIntBetween(r, 1, maxMarketDecimals+1)),
+		},
+		ProviderConfigs: randomProviderConfigs(r, cp, registry),
+	}
+	market.Ticker.MinProviderCount = uint64(simtypes.RandIntBetween(r, 1, len(market.ProviderConfigs)+1))
+
+	switch r.Intn(3) {
+	case 0:
+		market.Ticker.Enabled = r.Intn(2) == 0
+	case 1:
+		market.Ticker.Status = types.MarketStatus_MARKET_STATUS_PROPOSED
+	default:
+		market.Ticker.Status = types.MarketStatus_MARKET_STATUS_ACTIVE
+		market.Ticker.Enabled = true
+	}
+
+	return market
+}
+
+// randomMarketUpdate returns a random update of the given market. The decimals of the market are retained, and its
+// status either remains, or moves to a status it may transition to. Markets without a status may remain without
+// one, and be enabled or disabled freely.
+func randomMarketUpdate(r *rand.Rand, existing types.Market, registry types.ProviderRegistry) types.Market {
+	market := randomMarket(r, existing.Ticker.CurrencyPair, registry)
+	market.Ticker.Decimals = existing.Ticker.Decimals
+
+	if existing.Ticker.Status == types.MarketStatus_MARKET_STATUS_UNSPECIFIED && r.Intn(2) == 0 {
+		market.Ticker.Status = types.MarketStatus_MARKET_STATUS_UNSPECIFIED
+		market.Ticker.Enabled = r.Intn(2) == 0
+		return market
+	}
+
+	from := existing.Ticker.EffectiveStatus()
+	candidates := []types.MarketStatus{from}
+	for _, to := range marketStatuses {
+		if from.CanTransitionTo(to) {
+			candidates = append(candidates, to)
+		}
+	}
+
+	market.Ticker.Status = candidates[r.Intn(len(candidates))]
+	market.Ticker.Enabled = market.Ticker.Status.IsPriced()
+	return market
+}
+
+// randomProviderConfigs returns between one and three provider configs for the given currency pair. If the provider
+// registry is in use, the configs use distinct registered providers.
+func randomProviderConfigs(
+	r *rand.Rand,
+	cp connecttypes.CurrencyPair,
+	registry types.ProviderRegistry,
+) []types.ProviderConfig {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	if len(names) == 0 {
+		names = []string{"sim_provider_a", "sim_provider_b", "sim_provider_c"}
+	}
+
+	n := simtypes.RandIntBetween(r, 1, min(maxProviderConfigs, len(names))+1)
+	configs := make([]types.ProviderConfig, 0, n)
+	for _, i := range r.Perm(len(names))[:n] {
+		configs = append(configs, types.ProviderConfig{
+			Name:           names[i],
+			OffChainTicker: cp.Base + "-" + cp.Quote,
+		})
+	}
+
+	return configs
+}
+
+// sortedTickers returns the tickers of the given markets in sorted order, so that they are chosen deterministically.
+func sortedTickers(markets map[string]types.Market) []string {
+	tickers := make([]string, 0, len(markets))
+	for ticker := range markets {
+		tickers = append(tickers, ticker)
+	}
+	slices.Sort(tickers)
+
+	return tickers
+}
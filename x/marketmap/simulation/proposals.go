@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgParams = "op_weight_msg_params"
+
+	DefaultWeightMsgParams int = 100
+)
+
+// ProposalMsgs defines the module weighted proposals' contents.
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgParams,
+			DefaultWeightMsgParams,
+			SimulateMsgParams,
+		),
+	}
+}
+
+// SimulateMsgParams returns a random MsgParams, which hands the market authorities and admin to random simulation
+// accounts.
+func SimulateMsgParams(r *rand.Rand, _ sdk.Context, accs []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	admin, _ := simtypes.RandomAcc(r, accs)
+
+	params := types.DefaultParams()
+	params.MarketAuthorities = RandomMarketAuthorities(r, accs)
+	params.Admin = admin.Address.String()
+
+	return &types.MsgParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
@@ -0,0 +1,23 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/simulation"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestSimulateMsgParams(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 5)
+
+	for i := 0; i < 50; i++ {
+		msg := simulation.SimulateMsgParams(r, sdk.Context{}, accs).(*types.MsgParams)
+		require.NoError(t, msg.ValidateBasic())
+	}
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMarketAuthorityGrant returns a new MarketAuthorityGrant.
+func NewMarketAuthorityGrant(
+	grantee string,
+	tickers, baseAssets, providers []string,
+	canRemove bool,
+) MarketAuthorityGrant {
+	return MarketAuthorityGrant{
+		Grantee:    grantee,
+		Tickers:    tickers,
+		BaseAssets: baseAssets,
+		Providers:  providers,
+		CanRemove:  canRemove,
+	}
+}
+
+// ValidateBasic performs stateless validation of the MarketAuthorityGrant. This fails if the grantee is not a valid
+// address, if the grant has an empty scope, or if any entry of the scope is empty or repeated.
+func (g *MarketAuthorityGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Grantee); err != nil {
+		return fmt.Errorf("invalid market authority grantee: %w", err)
+	}
+
+	if len(g.Tickers) == 0 && len(g.BaseAssets) == 0 && len(g.Providers) == 0 {
+		return fmt.Errorf("market authority grant for %s must set tickers, base assets or providers", g.Grantee)
+	}
+
+	for _, scope := range [][]string{g.Tickers, g.BaseAssets, g.Providers} {
+		seen := make(map[string]struct{}, len(scope))
+		for _, entry := range scope {
+			if entry == "" {
+				return fmt.Errorf("market authority grant for %s has an empty scope entry", g.Grantee)
+			}
+
+			if _, ok := seen[entry]; ok {
+				return fmt.Errorf("market authority grant for %s has a repeated scope entry %s", g.Grantee, entry)
+			}
+
+			seen[entry] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// Covers returns true if the Market is in the scope of the MarketAuthorityGrant, i.e. it matches every non-empty set
+// of tickers, base assets and providers in the grant.
+func (g *MarketAuthorityGrant) Covers(market Market) bool {
+	if len(g.Tickers) > 0 && !matchesAnyPattern(g.Tickers, market.Ticker.String()) {
+		return false
+	}
+
+	if len(g.BaseAssets) > 0 && !matchesAnyPattern(g.BaseAssets, market.Ticker.CurrencyPair.Base) {
+		return false
+	}
+
+	if len(g.Providers) == 0 {
+		return true
+	}
+
+	// every provider of the market must be granted
+	if len(market.ProviderConfigs) == 0 {
+		return false
+	}
+
+	for _, providerConfig := range market.ProviderConfigs {
+		found := false
+		for _, provider := range g.Providers {
+			if providerConfig.Name == provider {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateMarketAuthorityGrants validates each of the given MarketAuthorityGrants, and checks that no grantee is
+// repeated.
+func ValidateMarketAuthorityGrants(grants []MarketAuthorityGrant) error {
+	seen := make(map[string]struct{}, len(grants))
+	for _, grant := range grants {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[grant.Grantee]; ok {
+			return fmt.Errorf("repeated market authority grant for grantee %s", grant.Grantee)
+		}
+
+		seen[grant.Grantee] = struct{}{}
+	}
+
+	return nil
+}
+
+// matchesAnyPattern returns true if the value matches any of the patterns.
+func matchesAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matchPattern(pattern, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchPattern returns true if the value matches the pattern case-insensitively, where '*' in the pattern matches any
+// sequence of characters.
+func matchPattern(pattern, value string) bool {
+	parts := strings.Split(strings.ToUpper(pattern), "*")
+	value = strings.ToUpper(value)
+
+	// without a wildcard, the pattern must match exactly
+	if len(parts) == 1 {
+		return parts[0] == value
+	}
+
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+
+	// match the middle parts greedily from the left, leaving room for the suffix
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+
+	return strings.HasSuffix(value, last)
+}
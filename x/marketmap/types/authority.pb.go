@@ -0,0 +1,542 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/authority.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketAuthorityGrant delegates market management to an address, restricted
+// to the markets in its scope. A market is in scope if it matches every
+// non-empty set of tickers, base assets and providers in the grant. Ticker and
+// base asset patterns match case-insensitively, and may use '*' to match any
+// sequence of characters.
+type MarketAuthorityGrant struct {
+	// Grantee is the address the grant is made to.
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Tickers are the ticker patterns in scope, i.e. BTC/USD or *,UNISWAP_V3*.
+	Tickers []string `protobuf:"bytes,2,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	// BaseAssets are the base asset patterns in scope, i.e. ETH.
+	BaseAssets []string `protobuf:"bytes,3,rep,name=base_assets,json=baseAssets,proto3" json:"base_assets,omitempty"`
+	// Providers are the provider names in scope. A market is only in scope if
+	// every one of its provider configs is for a provider in the set.
+	Providers []string `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
+	// CanRemove permits the grantee to remove markets in scope.
+	CanRemove bool `protobuf:"varint,5,opt,name=can_remove,json=canRemove,proto3" json:"can_remove,omitempty"`
+}
+
+func (m *MarketAuthorityGrant) Reset()         { *m = MarketAuthorityGrant{} }
+func (m *MarketAuthorityGrant) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityGrant) ProtoMessage()    {}
+func (*MarketAuthorityGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0fe75318a69f1cb, []int{0}
+}
+func (m *MarketAuthorityGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketAuthorityGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityGrant.Merge(m, src)
+}
+func (m *MarketAuthorityGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketAuthorityGrant proto.InternalMessageInfo
+
+func (m *MarketAuthorityGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MarketAuthorityGrant) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+func (m *MarketAuthorityGrant) GetBaseAssets() []string {
+	if m != nil {
+		return m.BaseAssets
+	}
+	return nil
+}
+
+func (m *MarketAuthorityGrant) GetProviders() []string {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *MarketAuthorityGrant) GetCanRemove() bool {
+	if m != nil {
+		return m.CanRemove
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*MarketAuthorityGrant)(nil), "connect.marketmap.v2.MarketAuthorityGrant")
+}
+
+func init() {
+	proto.RegisterFile("connect/marketmap/v2/authority.proto", fileDescriptor_f0fe75318a69f1cb)
+}
+
+var fileDescriptor_f0fe75318a69f1cb = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0x02, 0x31,
+	0x1c, 0xc6, 0xa9, 0xa8, 0x78, 0x75, 0x6b, 0x18, 0xaa, 0xd1, 0x4a, 0x8c, 0x03, 0x0b, 0x57, 0x73,
+	0x3e, 0x01, 0x2c, 0x26, 0x26, 0x2e, 0xe7, 0xe6, 0x42, 0x4a, 0xf9, 0x07, 0x1a, 0xd2, 0xf6, 0xd2,
+	0x96, 0x46, 0xde, 0xc2, 0x87, 0xf1, 0x09, 0x9c, 0x1c, 0x89, 0x93, 0xa3, 0x81, 0x17, 0x31, 0x57,
+	0x38, 0x75, 0xeb, 0xff, 0xf7, 0xfd, 0xbe, 0x0e, 0x1f, 0xbe, 0x91, 0xd6, 0x18, 0x90, 0x81, 0x6b,
+	0xe1, 0x16, 0x10, 0xb4, 0xa8, 0x78, 0x2c, 0xb8, 0x58, 0x86, 0xb9, 0x75, 0x2a, 0xac, 0xf2, 0xca,
+	0xd9, 0x60, 0x49, 0x77, 0x6f, 0xe5, 0xbf, 0x56, 0x1e, 0x8b, 0xf3, 0x33, 0x69, 0xbd, 0xb6, 0x7e,
+	0x9c, 0x1c, 0xbe, 0x3b, 0x76, 0x85, 0xeb, 0x77, 0x84, 0xbb, 0x8f, 0xc9, 0x1d, 0x36, 0x5f, 0xdd,
+	0x3b, 0x61, 0x02, 0x29, 0x70, 0x67, 0x56, 0x3f, 0x00, 0x28, 0xea, 0xa1, 0x7e, 0x36, 0xa2, 0x9f,
+	0x6f, 0x83, 0xee, 0xbe, 0x3b, 0x9c, 0x4e, 0x1d, 0x78, 0xff, 0x14, 0x9c, 0x32, 0xb3, 0xb2, 0x11,
+	0x09, 0xc5, 0x9d, 0xa0, 0xe4, 0x02, 0x9c, 0xa7, 0x07, 0xbd, 0x76, 0x3f, 0x2b, 0x9b, 0x93, 0x5c,
+	0xe1, 0xd3, 0x89, 0xf0, 0x30, 0x16, 0xde, 0x43, 0xf0, 0xb4, 0x9d, 0x52, 0x5c, 0xa3, 0x61, 0x22,
+	0xe4, 0x02, 0x67, 0x95, 0xb3, 0x51, 0x4d, 0xeb, 0xf2, 0x61, 0x8a, 0xff, 0x00, 0xb9, 0xc4, 0x58,
+	0x0a, 0x33, 0x76, 0xa0, 0x6d, 0x04, 0x7a, 0xd4, 0x43, 0xfd, 0x93, 0x32, 0x93, 0xc2, 0x94, 0x09,
+	0x8c, 0x1e, 0x3e, 0x36, 0x0c, 0xad, 0x37, 0x0c, 0x7d, 0x6f, 0x18, 0x7a, 0xdd, 0xb2, 0xd6, 0x7a,
+	0xcb, 0x5a, 0x5f, 0x5b, 0xd6, 0x7a, 0xbe, 0x9d, 0xa9, 0x30, 0x5f, 0x4e, 0x72, 0x69, 0x35, 0xf7,
+	0x0b, 0x55, 0x0d, 0x34, 0x44, 0xde, 0x2c, 0x19, 0x0b, 0xfe, 0xf2, 0x6f, 0xce, 0xb0, 0xaa, 0xc0,
+	0x4f, 0x8e, 0xd3, 0x2e, 0x77, 0x3f, 0x03, 0x00, 0x7c, 0x16, 0x3f, 0xd5, 0x70, 0x01, 0x00, 0x00,
+}
+
+func (m *MarketAuthorityGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanRemove {
+		i--
+		if m.CanRemove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
+			copy(dAtA[i:], m.Providers[iNdEx])
+			i = encodeVarintAuthority(dAtA, i, uint64(len(m.Providers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BaseAssets) > 0 {
+		for iNdEx := len(m.BaseAssets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BaseAssets[iNdEx])
+			copy(dAtA[i:], m.BaseAssets[iNdEx])
+			i = encodeVarintAuthority(dAtA, i, uint64(len(m.BaseAssets[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintAuthority(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintAuthority(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthority(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthority(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketAuthorityGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAuthority(uint64(l))
+	}
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovAuthority(uint64(l))
+		}
+	}
+	if len(m.BaseAssets) > 0 {
+		for _, s := range m.BaseAssets {
+			l = len(s)
+			n += 1 + l + sovAuthority(uint64(l))
+		}
+	}
+	if len(m.Providers) > 0 {
+		for _, s := range m.Providers {
+			l = len(s)
+			n += 1 + l + sovAuthority(uint64(l))
+		}
+	}
+	if m.CanRemove {
+		n += 2
+	}
+	return n
+}
+
+func sovAuthority(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthority(x uint64) (n int) {
+	return sovAuthority(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketAuthorityGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthority
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAssets = append(m.BaseAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanRemove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanRemove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthority(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthority(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthority
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthority
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthority
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthority
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthority        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthority          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthority = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,150 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/skip-mev/chaintestutil/sample"
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestMarketAuthorityGrantValidateBasic(t *testing.T) {
+	grantee := sample.Address(sample.Rand())
+
+	tcs := []struct {
+		name       string
+		grant      types.MarketAuthorityGrant
+		expectPass bool
+	}{
+		{
+			"invalid grantee - fail",
+			types.NewMarketAuthorityGrant("invalid", []string{"BTC/USD"}, nil, nil, false),
+			false,
+		},
+		{
+			"empty scope - fail",
+			types.NewMarketAuthorityGrant(grantee, nil, nil, nil, false),
+			false,
+		},
+		{
+			"empty scope entry - fail",
+			types.NewMarketAuthorityGrant(grantee, nil, []string{""}, nil, false),
+			false,
+		},
+		{
+			"repeated scope entry - fail",
+			types.NewMarketAuthorityGrant(grantee, nil, nil, []string{"kucoin", "kucoin"}, false),
+			false,
+		},
+		{
+			"valid grant - pass",
+			types.NewMarketAuthorityGrant(grantee, []string{"*/USD"}, []string{"ETH"}, []string{"kucoin"}, true),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.grant.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	grant := types.NewMarketAuthorityGrant(grantee, []string{"BTC/USD"}, nil, nil, false)
+	require.Error(t, types.ValidateMarketAuthorityGrants([]types.MarketAuthorityGrant{grant, grant}))
+	require.NoError(t, types.ValidateMarketAuthorityGrants([]types.MarketAuthorityGrant{grant}))
+}
+
+func TestMarketAuthorityGrantCovers(t *testing.T) {
+	defi := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair: connecttypes.NewCurrencyPair("PEPE,UNISWAP_V3,0XABC", "ETH"),
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{Name: "uniswapv3_api-ethereum", OffChainTicker: "0xabc"},
+		},
+	}
+	mixed := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair: connecttypes.NewCurrencyPair("ETH", "USD"),
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{Name: "uniswapv3_api-ethereum", OffChainTicker: "0xdef"},
+			{Name: "kucoin", OffChainTicker: "eth-usd"},
+		},
+	}
+
+	tcs := []struct {
+		name   string
+		grant  types.MarketAuthorityGrant
+		market types.Market
+		covers bool
+	}{
+		{
+			"exact ticker",
+			types.NewMarketAuthorityGrant("", []string{"ETH/USD"}, nil, nil, false),
+			mixed,
+			true,
+		},
+		{
+			"ticker patterns are case-insensitive",
+			types.NewMarketAuthorityGrant("", []string{"*,uniswap_v3*/*"}, nil, nil, false),
+			defi,
+			true,
+		},
+		{
+			"ticker pattern mismatch",
+			types.NewMarketAuthorityGrant("", []string{"*,uniswap_v3*/*"}, nil, nil, false),
+			mixed,
+			false,
+		},
+		{
+			"base asset",
+			types.NewMarketAuthorityGrant("", nil, []string{"BTC", "ETH"}, nil, false),
+			mixed,
+			true,
+		},
+		{
+			"base asset pattern",
+			types.NewMarketAuthorityGrant("", nil, []string{"PEPE,*"}, nil, false),
+			defi,
+			true,
+		},
+		{
+			"every provider is granted",
+			types.NewMarketAuthorityGrant("", nil, nil, []string{"uniswapv3_api-ethereum"}, false),
+			defi,
+			true,
+		},
+		{
+			"some providers are not granted",
+			types.NewMarketAuthorityGrant("", nil, nil, []string{"uniswapv3_api-ethereum"}, false),
+			mixed,
+			false,
+		},
+		{
+			"markets without providers are not covered by a provider scope",
+			types.NewMarketAuthorityGrant("", nil, nil, []string{"kucoin"}, false),
+			types.Market{Ticker: mixed.Ticker},
+			false,
+		},
+		{
+			"every non-empty set must match",
+			types.NewMarketAuthorityGrant("", []string{"ETH/USD"}, []string{"BTC"}, nil, false),
+			mixed,
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.covers, tc.grant.Covers(tc.market))
+		})
+	}
+}
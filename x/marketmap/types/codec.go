@@ -17,6 +17,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "connect/x/marketmap/MsgParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpsertMarkets{}, "connect/x/marketmap/MsgUpsertMarkets")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveMarkets{}, "connect/x/marketmap/MsgRemoveMarkets")
+	// amino names are limited to 39 characters, so the module path is omitted from longer message names
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMarketUpdates{}, "connect/MsgScheduleMarketUpdates")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMarketRemovals{}, "connect/MsgScheduleMarketRemovals")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledMarketChanges{}, "connect/MsgCancelScheduledMarketChanges")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMarketAuthorityGrants{}, "connect/MsgSetMarketAuthorityGrants")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeMarketAuthorityGrants{}, "connect/MsgRevokeMarketAuthorityGrants")
+	legacy.RegisterAminoMsg(cdc, &MsgUpsertProviders{}, "connect/x/marketmap/MsgUpsertProviders")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveProviders{}, "connect/x/marketmap/MsgRemoveProviders")
 }
 
 // RegisterInterfaces registers the x/marketmap messages + message service w/ the InterfaceRegistry (registry).
@@ -29,6 +37,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpsertMarkets{},
 		&MsgRemoveMarkets{},
 		&MsgRemoveMarketAuthorities{},
+		&MsgScheduleMarketUpdates{},
+		&MsgScheduleMarketRemovals{},
+		&MsgCancelScheduledMarketChanges{},
+		&MsgSetMarketAuthorityGrants{},
+		&MsgRevokeMarketAuthorityGrants{},
+		&MsgUpsertProviders{},
+		&MsgRemoveProviders{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
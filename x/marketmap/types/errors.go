@@ -43,3 +43,32 @@ func NewMarketIsEnabledError(ticker TickerString) MarketIsEnabledError {
 func (e MarketIsEnabledError) Error() string {
 	return fmt.Sprintf("market is currently enabled %s", e.ticker)
 }
+
+// ScheduledMarketChangeNotFoundError is an error indicating no pending ScheduledMarketChange exists with the given ID.
+type ScheduledMarketChangeNotFoundError struct {
+	id uint64
+}
+
+func NewScheduledMarketChangeNotFoundError(id uint64) ScheduledMarketChangeNotFoundError {
+	return ScheduledMarketChangeNotFoundError{id: id}
+}
+
+// Error returns the error string for ScheduledMarketChangeNotFoundError.
+func (e ScheduledMarketChangeNotFoundError) Error() string {
+	return fmt.Sprintf("scheduled market change %d not found", e.id)
+}
+
+// MarketVersionNotFoundError is an error indicating no MarketChangeRecord exists for the given market version.
+type MarketVersionNotFoundError struct {
+	ticker  string
+	version uint64
+}
+
+func NewMarketVersionNotFoundError(ticker string, version uint64) MarketVersionNotFoundError {
+	return MarketVersionNotFoundError{ticker: ticker, version: version}
+}
+
+// Error returns the error string for MarketVersionNotFoundError.
+func (e MarketVersionNotFoundError) Error() string {
+	return fmt.Sprintf("version %d of market %s not found", e.version, e.ticker)
+}
@@ -6,8 +6,24 @@ const (
 	EventTypeCreateMarket = "create_market"
 	EventTypeUpdateMarket = "update_market"
 
+	EventTypeScheduleMarketChange        = "schedule_market_change"
+	EventTypeApplyScheduledMarketChange  = "apply_scheduled_market_change"
+	EventTypeCancelScheduledMarketChange = "cancel_scheduled_market_change"
+
+	EventTypeMarketStatusChange = "market_status_change"
+
 	AttributeKeyCurrencyPair     = "currency_pair"
 	AttributeKeyDecimals         = "decimals"
 	AttributeKeyMinProviderCount = "min_provider_count"
 	AttributeKeyMetadata         = "metadata"
+
+	AttributeKeyScheduledMarketChangeID = "scheduled_market_change_id"
+	AttributeKeyHeight                  = "height"
+	AttributeKeyChangeType              = "change_type"
+	AttributeKeySuccess                 = "success"
+	AttributeKeyError                   = "error"
+
+	AttributeKeyFromStatus = "from_status"
+	AttributeKeyToStatus   = "to_status"
+	AttributeKeyAuthority  = "authority"
 )
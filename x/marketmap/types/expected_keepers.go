@@ -0,0 +1,25 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// OracleKeeper is the expected keeper interface for the oracle keeper. It is used to report the currency pair IDs
+// resulting from market map updates.
+type OracleKeeper interface {
+	GetIDForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (uint64, bool)
+}
+
+// AccountKeeper is the expected keeper interface for the x/auth keeper. It is only used to simulate the module.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// BankKeeper is the expected keeper interface for the x/bank keeper. It is only used to simulate the module.
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
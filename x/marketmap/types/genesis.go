@@ -19,7 +19,37 @@ func (gs *GenesisState) ValidateBasic() error {
 		return err
 	}
 
-	return gs.Params.ValidateBasic()
+	if err := ValidateScheduledMarketChanges(gs.ScheduledMarketChanges, gs.NextScheduledMarketChangeId); err != nil {
+		return err
+	}
+
+	if err := ValidateMarketAuthorityGrants(gs.MarketAuthorityGrants); err != nil {
+		return err
+	}
+
+	if err := ValidateMarketHistory(gs.MarketHistory); err != nil {
+		return err
+	}
+
+	if err := ValidateProviders(gs.Providers); err != nil {
+		return err
+	}
+
+	if err := NewProviderRegistry(gs.Providers).ValidateMarketMap(gs.MarketMap); err != nil {
+		return err
+	}
+
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, market := range gs.MarketMap.Markets {
+		if err := gs.Params.ValidateTickerMetadata(market.Ticker); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // DefaultGenesisState returns the default genesis of the marketmap module.
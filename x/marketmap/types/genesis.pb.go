@@ -34,6 +34,19 @@ type GenesisState struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// Params are the parameters for the x/marketmap module.
 	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// ScheduledMarketChanges are the pending market changes scheduled for
+	// future blocks.
+	ScheduledMarketChanges []ScheduledMarketChange `protobuf:"bytes,4,rep,name=scheduled_market_changes,json=scheduledMarketChanges,proto3" json:"scheduled_market_changes"`
+	// NextScheduledMarketChangeId is the identifier of the next scheduled
+	// market change.
+	NextScheduledMarketChangeId uint64 `protobuf:"varint,5,opt,name=next_scheduled_market_change_id,json=nextScheduledMarketChangeId,proto3" json:"next_scheduled_market_change_id,omitempty"`
+	// MarketAuthorityGrants are the scoped market authority grants.
+	MarketAuthorityGrants []MarketAuthorityGrant `protobuf:"bytes,6,rep,name=market_authority_grants,json=marketAuthorityGrants,proto3" json:"market_authority_grants"`
+	// MarketHistory is the change log of every market, ordered by ticker and
+	// then by version.
+	MarketHistory []MarketChangeRecord `protobuf:"bytes,7,rep,name=market_history,json=marketHistory,proto3" json:"market_history"`
+	// Providers is the registry of known providers.
+	Providers []ProviderInfo `protobuf:"bytes,8,rep,name=providers,proto3" json:"providers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +103,41 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetScheduledMarketChanges() []ScheduledMarketChange {
+	if m != nil {
+		return m.ScheduledMarketChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledMarketChangeId() uint64 {
+	if m != nil {
+		return m.NextScheduledMarketChangeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMarketAuthorityGrants() []MarketAuthorityGrant {
+	if m != nil {
+		return m.MarketAuthorityGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketHistory() []MarketChangeRecord {
+	if m != nil {
+		return m.MarketHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviders() []ProviderInfo {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "connect.marketmap.v2.GenesisState")
 }
@@ -99,24 +147,36 @@ func init() {
 }
 
 var fileDescriptor_02569cd74fc8ae65 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33,
-	0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xaa, 0xd1, 0x83, 0xab, 0xd1, 0x2b, 0x33, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xe1, 0xe0, 0x55, 0x52, 0x90, 0x58,
-	0x94, 0x98, 0x0b, 0xb5, 0x51, 0x69, 0x33, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x0d, 0xc1, 0x25, 0x89,
-	0x25, 0xa9, 0x42, 0x2e, 0x5c, 0x5c, 0x10, 0xd5, 0xf1, 0xb9, 0x89, 0x05, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0xdc, 0x46, 0xf2, 0x7a, 0xd8, 0xdc, 0xa5, 0xe7, 0x0b, 0xe6, 0xf8, 0x26, 0x16, 0x38, 0xb1,
-	0x9c, 0xb8, 0x27, 0xcf, 0x10, 0xc4, 0x99, 0x0b, 0x13, 0x10, 0x52, 0xe4, 0xe2, 0xc9, 0x49, 0x2c,
-	0x2e, 0x89, 0x2f, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x4d, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09,
-	0xe2, 0x06, 0x89, 0x85, 0x42, 0x84, 0x84, 0xac, 0xb8, 0xd8, 0x20, 0x2e, 0x91, 0x60, 0x06, 0x5b,
-	0x22, 0x83, 0xdd, 0x92, 0x00, 0xb0, 0x1a, 0xa8, 0x0d, 0x50, 0x1d, 0x4e, 0x5e, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
-	0x9c, 0x9f, 0xab, 0x5f, 0x9c, 0x9d, 0x59, 0xa0, 0x9b, 0x9b, 0x5a, 0xa6, 0x0f, 0x0b, 0x86, 0x32,
-	0x23, 0xfd, 0x0a, 0xa4, 0xb0, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0x84, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x2d, 0x44, 0xf7, 0xa0, 0x01, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x56, 0x0a, 0x73, 0x07, 0x07, 0x6b, 0x80, 0x55, 0x50, 0xda, 0x15, 0x0e, 0x15,
+	0x88, 0x04, 0x85, 0x1b, 0x37, 0xc6, 0xc4, 0x18, 0xd2, 0x24, 0xd4, 0x69, 0x17, 0x2e, 0x91, 0x17,
+	0x9b, 0xc4, 0xea, 0x1c, 0x5b, 0xb6, 0x1b, 0xad, 0x6f, 0xc1, 0x63, 0xed, 0xb8, 0x23, 0x27, 0x84,
+	0xda, 0x07, 0xe0, 0x15, 0x50, 0x6d, 0xa7, 0xe2, 0xe0, 0xf6, 0x96, 0x7c, 0xfe, 0xfd, 0xff, 0xff,
+	0xcf, 0x9f, 0x3f, 0x30, 0x2e, 0x44, 0x5d, 0xd3, 0xc2, 0xa4, 0x1c, 0xab, 0x19, 0x35, 0x1c, 0xcb,
+	0xb4, 0xc9, 0xd2, 0x92, 0xd6, 0x54, 0x33, 0x9d, 0x48, 0x25, 0x8c, 0x80, 0x87, 0x9e, 0x49, 0x36,
+	0x4c, 0xd2, 0x64, 0x83, 0xc3, 0x52, 0x94, 0xc2, 0x02, 0xe9, 0xfa, 0xcb, 0xb1, 0x83, 0xa3, 0xa0,
+	0x9f, 0xfb, 0xd9, 0x89, 0x48, 0xac, 0x30, 0xf7, 0x89, 0x83, 0x97, 0x41, 0x44, 0x17, 0x15, 0x25,
+	0xf3, 0x6b, 0xea, 0xa1, 0x57, 0x41, 0x08, 0xcf, 0x4d, 0x25, 0x14, 0x33, 0x0b, 0x4f, 0x85, 0x2f,
+	0x58, 0x31, 0x6d, 0x84, 0x5a, 0xec, 0x8c, 0x53, 0xb4, 0x64, 0xda, 0xb4, 0xd0, 0xf8, 0x6f, 0x17,
+	0x1c, 0x9c, 0xba, 0xb9, 0x5c, 0x18, 0x6c, 0x28, 0x3c, 0x01, 0xc0, 0xf1, 0x39, 0xc7, 0x12, 0x45,
+	0xa3, 0x68, 0xd2, 0xcf, 0x86, 0x49, 0x68, 0x56, 0xc9, 0xb9, 0xfd, 0x39, 0xc7, 0xf2, 0xb8, 0x7b,
+	0xfb, 0x7b, 0xd8, 0x99, 0xee, 0xf3, 0xb6, 0x00, 0x8f, 0xc0, 0xc1, 0x35, 0xd6, 0x26, 0x9f, 0x4b,
+	0x82, 0x0d, 0x25, 0xe8, 0xde, 0x28, 0x9a, 0x74, 0xa7, 0xfd, 0x75, 0xed, 0xd2, 0x95, 0xe0, 0x07,
+	0xd0, 0x73, 0xd3, 0x41, 0x7b, 0x36, 0xe4, 0x45, 0x38, 0xe4, 0x9b, 0x65, 0x7c, 0x82, 0x57, 0xc0,
+	0x19, 0x40, 0xed, 0xd8, 0x48, 0xee, 0xdb, 0x2d, 0x2a, 0x5c, 0x97, 0x54, 0xa3, 0xee, 0x68, 0x6f,
+	0xd2, 0xcf, 0xde, 0x84, 0xdd, 0x2e, 0x5a, 0x95, 0xeb, 0xfd, 0x93, 0xd5, 0x78, 0xf3, 0xa7, 0x3a,
+	0x74, 0xa8, 0xe1, 0x09, 0x18, 0xd6, 0xf4, 0xc6, 0xe4, 0x5b, 0x12, 0x73, 0x46, 0xd0, 0x7d, 0x7b,
+	0xbd, 0xe7, 0x6b, 0x2c, 0x98, 0x70, 0x46, 0x60, 0x05, 0x9e, 0x79, 0xd9, 0xe6, 0x2d, 0xf3, 0x52,
+	0xe1, 0xda, 0x68, 0xd4, 0xb3, 0x1d, 0xbf, 0xde, 0x35, 0xe4, 0x8f, 0xad, 0xe6, 0x74, 0x2d, 0xf1,
+	0x0d, 0x3f, 0xe1, 0x81, 0x33, 0x0d, 0x2f, 0xc1, 0x63, 0x9f, 0xe4, 0xf7, 0x01, 0x3d, 0xb0, 0x01,
+	0x93, 0x5d, 0x01, 0xae, 0xcf, 0x29, 0x2d, 0x84, 0x22, 0xde, 0xfe, 0x91, 0xc3, 0xbe, 0x38, 0x13,
+	0xf8, 0x19, 0xec, 0x4b, 0x25, 0x1a, 0x46, 0xa8, 0xd2, 0xe8, 0xa1, 0x75, 0x1c, 0x6f, 0x79, 0x32,
+	0x8f, 0x9d, 0xd5, 0x3f, 0x44, 0xbb, 0x1a, 0x1b, 0xe9, 0xf1, 0xd7, 0xdb, 0x65, 0x1c, 0xdd, 0x2d,
+	0xe3, 0xe8, 0xcf, 0x32, 0x8e, 0x7e, 0xae, 0xe2, 0xce, 0xdd, 0x2a, 0xee, 0xfc, 0x5a, 0xc5, 0x9d,
+	0xef, 0xef, 0x4a, 0x66, 0xaa, 0xf9, 0x55, 0x52, 0x08, 0x9e, 0xea, 0x19, 0x93, 0x6f, 0x39, 0x6d,
+	0xd2, 0x76, 0x89, 0x9b, 0x2c, 0xbd, 0xf9, 0x6f, 0x93, 0xcd, 0x42, 0x52, 0x7d, 0xd5, 0xb3, 0x4b,
+	0xfc, 0xfe, 0xdf, 0x00, 0xc1, 0x54, 0x36, 0xd8, 0xf0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +199,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MarketHistory) > 0 {
+		for iNdEx := len(m.MarketHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MarketAuthorityGrants) > 0 {
+		for iNdEx := len(m.MarketAuthorityGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketAuthorityGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextScheduledMarketChangeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledMarketChangeId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduledMarketChanges) > 0 {
+		for iNdEx := len(m.ScheduledMarketChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledMarketChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -191,6 +312,33 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledMarketChanges) > 0 {
+		for _, e := range m.ScheduledMarketChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledMarketChangeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledMarketChangeId))
+	}
+	if len(m.MarketAuthorityGrants) > 0 {
+		for _, e := range m.MarketAuthorityGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketHistory) > 0 {
+		for _, e := range m.MarketHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -314,6 +462,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMarketChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledMarketChanges = append(m.ScheduledMarketChanges, ScheduledMarketChange{})
+			if err := m.ScheduledMarketChanges[len(m.ScheduledMarketChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledMarketChangeId", wireType)
+			}
+			m.NextScheduledMarketChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledMarketChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketAuthorityGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketAuthorityGrants = append(m.MarketAuthorityGrants, MarketAuthorityGrant{})
+			if err := m.MarketAuthorityGrants[len(m.MarketAuthorityGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketHistory = append(m.MarketHistory, MarketChangeRecord{})
+			if err := m.MarketHistory[len(m.MarketHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,9 +3,12 @@ package types_test
 import (
 	"testing"
 
+	"github.com/skip-mev/chaintestutil/sample"
 	"github.com/stretchr/testify/require"
 
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
 )
 
 func TestGenesisState(t *testing.T) {
@@ -30,4 +33,72 @@ func TestGenesisState(t *testing.T) {
 		}
 		require.NoError(t, gs.ValidateBasic())
 	})
+	t.Run("invalid scheduled market changes - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+
+		gs.ScheduledMarketChanges = []types.ScheduledMarketChange{
+			types.NewScheduledMarketRemoval(0, 10, "BTC/USD", ""),
+		}
+		require.Error(t, gs.ValidateBasic())
+
+		gs.NextScheduledMarketChangeId = 1
+		require.NoError(t, gs.ValidateBasic())
+	})
+	t.Run("invalid market authority grants - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+
+		grant := types.NewMarketAuthorityGrant(sample.Address(sample.Rand()), []string{"BTC/USD"}, nil, nil, false)
+		gs.MarketAuthorityGrants = []types.MarketAuthorityGrant{grant, grant}
+		require.Error(t, gs.ValidateBasic())
+
+		gs.MarketAuthorityGrants = []types.MarketAuthorityGrant{grant}
+		require.NoError(t, gs.ValidateBasic())
+	})
+	t.Run("invalid market history - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+
+		gs.MarketHistory = []types.MarketChangeRecord{{Ticker: "BTC/USD", Version: 2, MsgType: "/connect.marketmap.v2.MsgRemoveMarkets"}}
+		require.Error(t, gs.ValidateBasic())
+
+		gs.MarketHistory[0].Version = 1
+		require.NoError(t, gs.ValidateBasic())
+	})
+	t.Run("markets using unregistered providers - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.MarketMap.Markets = map[string]types.Market{
+			"BTC/USD": {
+				Ticker: types.Ticker{
+					CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+					Decimals:         8,
+					MinProviderCount: 1,
+				},
+				ProviderConfigs: []types.ProviderConfig{{Name: "kucoin", OffChainTicker: "BTC-USD"}},
+			},
+		}
+		require.NoError(t, gs.ValidateBasic())
+
+		gs.Providers = []types.ProviderInfo{types.NewProviderInfo("okx", "", "")}
+		require.Error(t, gs.ValidateBasic())
+
+		gs.Providers = append(gs.Providers, types.NewProviderInfo("kucoin", "[A-Z]+-[A-Z]+", ""))
+		require.NoError(t, gs.ValidateBasic())
+	})
+	t.Run("ticker metadata violating the params schema - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.MarketMap.Markets = map[string]types.Market{
+			"BTC/USD": {
+				Ticker: types.Ticker{
+					CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+					Decimals:         8,
+					MinProviderCount: 1,
+					Metadata_JSON:    `{"reference_price": 100}`,
+				},
+				ProviderConfigs: []types.ProviderConfig{{Name: "kucoin", OffChainTicker: "BTC-USD"}},
+			},
+		}
+		require.NoError(t, gs.ValidateBasic())
+
+		gs.Params.TickerMetadataSchema = tickermetadata.DyDxSchema
+		require.Error(t, gs.ValidateBasic())
+	})
 }
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// MarketHash returns the SHA-256 hash of the protobuf encoding of the given market. A nil market hashes to nil.
+func MarketHash(market *Market) ([]byte, error) {
+	if market == nil {
+		return nil, nil
+	}
+
+	bz, err := market.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// NewMarketChangeRecord returns a new MarketChangeRecord of the change to the given market from before to after,
+// either of which may be nil if the market did not exist before, or was removed.
+func NewMarketChangeRecord(
+	ticker string,
+	version, height uint64,
+	signer, msgType string,
+	before, after *Market,
+) (MarketChangeRecord, error) {
+	beforeHash, err := MarketHash(before)
+	if err != nil {
+		return MarketChangeRecord{}, err
+	}
+
+	afterHash, err := MarketHash(after)
+	if err != nil {
+		return MarketChangeRecord{}, err
+	}
+
+	record := MarketChangeRecord{
+		Ticker:     ticker,
+		Version:    version,
+		Height:     height,
+		Signer:     signer,
+		MsgType:    msgType,
+		BeforeHash: beforeHash,
+		AfterHash:  afterHash,
+	}
+	if after != nil {
+		record.Market = *after
+	}
+
+	return record, nil
+}
+
+// Removed returns true if the change removed the market.
+func (r *MarketChangeRecord) Removed() bool {
+	return len(r.AfterHash) == 0
+}
+
+// ValidateBasic performs stateless validation of the MarketChangeRecord. The recorded market must match the ticker
+// and the after hash of the change.
+func (r *MarketChangeRecord) ValidateBasic() error {
+	if _, err := connecttypes.CurrencyPairFromString(r.Ticker); err != nil {
+		return fmt.Errorf("invalid ticker for market change record: %w", err)
+	}
+
+	if r.Version == 0 {
+		return fmt.Errorf("market change record for %s must have a non-zero version", r.Ticker)
+	}
+
+	if r.MsgType == "" {
+		return fmt.Errorf("market change record %s version %d must have a msg type", r.Ticker, r.Version)
+	}
+
+	for _, hash := range [][]byte{r.BeforeHash, r.AfterHash} {
+		if len(hash) != 0 && len(hash) != sha256.Size {
+			return fmt.Errorf("market change record %s version %d has an invalid hash length %d", r.Ticker, r.Version, len(hash))
+		}
+	}
+
+	if r.Removed() {
+		return nil
+	}
+
+	if r.Market.Ticker.String() != r.Ticker {
+		return fmt.Errorf(
+			"market change record ticker %s does not match market %s", r.Ticker, r.Market.Ticker.String(),
+		)
+	}
+
+	hash, err := MarketHash(&r.Market)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(hash, r.AfterHash) {
+		return fmt.Errorf("market change record %s version %d does not match its after hash", r.Ticker, r.Version)
+	}
+
+	return nil
+}
+
+// ValidateMarketHistory validates each of the given MarketChangeRecords, and checks that the versions of each market
+// are contiguous, starting at 1.
+func ValidateMarketHistory(records []MarketChangeRecord) error {
+	versions := make(map[string][]uint64)
+	for _, record := range records {
+		if err := record.ValidateBasic(); err != nil {
+			return err
+		}
+
+		versions[record.Ticker] = append(versions[record.Ticker], record.Version)
+	}
+
+	for ticker, vs := range versions {
+		sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+		for i, v := range vs {
+			if v != uint64(i+1) { //nolint:gosec
+				return fmt.Errorf("market history of %s is missing version %d", ticker, i+1)
+			}
+		}
+	}
+
+	return nil
+}
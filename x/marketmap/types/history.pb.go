@@ -0,0 +1,673 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketChangeRecord is an entry in the append-only change log of a market.
+// Every change made to a market increments its version, and is recorded along
+// with the state of the market at that version.
+type MarketChangeRecord struct {
+	// Ticker is the ticker string of the changed market.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Version is the version of the market resulting from the change. Versions
+	// start at 1 and increase by 1 with every change to the market.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Height is the block height the change was made at.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Signer is the address that made the change.
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// MsgType is the type URL of the message that made the change.
+	MsgType string `protobuf:"bytes,5,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	// BeforeHash is the SHA-256 hash of the market before the change. It is
+	// empty if the market did not exist.
+	BeforeHash []byte `protobuf:"bytes,6,opt,name=before_hash,json=beforeHash,proto3" json:"before_hash,omitempty"`
+	// AfterHash is the SHA-256 hash of the market after the change. It is empty
+	// if the market was removed.
+	AfterHash []byte `protobuf:"bytes,7,opt,name=after_hash,json=afterHash,proto3" json:"after_hash,omitempty"`
+	// Market is the market at this version. It is unset for removals.
+	Market Market `protobuf:"bytes,8,opt,name=market,proto3" json:"market"`
+}
+
+func (m *MarketChangeRecord) Reset()         { *m = MarketChangeRecord{} }
+func (m *MarketChangeRecord) String() string { return proto.CompactTextString(m) }
+func (*MarketChangeRecord) ProtoMessage()    {}
+func (*MarketChangeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8831f20b8738de4, []int{0}
+}
+func (m *MarketChangeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketChangeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketChangeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketChangeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketChangeRecord.Merge(m, src)
+}
+func (m *MarketChangeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketChangeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketChangeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketChangeRecord proto.InternalMessageInfo
+
+func (m *MarketChangeRecord) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *MarketChangeRecord) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MarketChangeRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MarketChangeRecord) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MarketChangeRecord) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *MarketChangeRecord) GetBeforeHash() []byte {
+	if m != nil {
+		return m.BeforeHash
+	}
+	return nil
+}
+
+func (m *MarketChangeRecord) GetAfterHash() []byte {
+	if m != nil {
+		return m.AfterHash
+	}
+	return nil
+}
+
+func (m *MarketChangeRecord) GetMarket() Market {
+	if m != nil {
+		return m.Market
+	}
+	return Market{}
+}
+
+func init() {
+	proto.RegisterType((*MarketChangeRecord)(nil), "connect.marketmap.v2.MarketChangeRecord")
+}
+
+func init() {
+	proto.RegisterFile("connect/marketmap/v2/history.proto", fileDescriptor_e8831f20b8738de4)
+}
+
+var fileDescriptor_e8831f20b8738de4 = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x1c, 0xc4, 0xe3, 0x7e, 0xfd, 0xd2, 0xd6, 0x65, 0x8a, 0x2a, 0x64, 0x2a, 0x48, 0x43, 0xa7, 0x2c,
+	0x24, 0x28, 0x6c, 0x8c, 0x65, 0x41, 0x48, 0x2c, 0x11, 0x13, 0x4b, 0x95, 0x86, 0x7f, 0x6d, 0xab,
+	0x4a, 0x1c, 0xd9, 0x26, 0xa2, 0x0f, 0x81, 0xc4, 0x63, 0x75, 0xec, 0xc8, 0x84, 0x50, 0xfb, 0x22,
+	0x28, 0x76, 0x8a, 0x18, 0xba, 0xf9, 0xee, 0x7e, 0xfe, 0x4b, 0x77, 0x78, 0x9a, 0x8b, 0xb2, 0x84,
+	0x5c, 0xc7, 0x45, 0x26, 0x57, 0xa0, 0x8b, 0xac, 0x8a, 0xeb, 0x24, 0x66, 0x5c, 0x69, 0x21, 0xd7,
+	0x51, 0x25, 0x85, 0x16, 0xde, 0xa8, 0x65, 0xa2, 0x5f, 0x26, 0xaa, 0x93, 0xf1, 0x88, 0x0a, 0x2a,
+	0x0c, 0x10, 0x37, 0x2f, 0xcb, 0x8e, 0x2f, 0x8f, 0xde, 0xb3, 0xc2, 0x22, 0xd3, 0xf7, 0x0e, 0xf6,
+	0x1e, 0x8d, 0x71, 0xc7, 0xb2, 0x92, 0x42, 0x0a, 0xb9, 0x90, 0x2f, 0xde, 0x29, 0x76, 0x35, 0xcf,
+	0x57, 0x20, 0x09, 0x0a, 0x50, 0x38, 0x48, 0x5b, 0xe5, 0x11, 0xdc, 0xab, 0x41, 0x2a, 0x2e, 0x4a,
+	0xd2, 0x09, 0x50, 0xd8, 0x4d, 0x0f, 0xb2, 0xf9, 0xc1, 0x80, 0x53, 0xa6, 0xc9, 0x3f, 0x13, 0xb4,
+	0xaa, 0xf1, 0x15, 0xa7, 0x25, 0x48, 0xd2, 0xb5, 0x97, 0xac, 0xf2, 0xce, 0x70, 0xbf, 0x50, 0x74,
+	0xae, 0xd7, 0x15, 0x90, 0xff, 0x26, 0xe9, 0x15, 0x8a, 0x3e, 0xad, 0x2b, 0xf0, 0x26, 0x78, 0xb8,
+	0x80, 0xa5, 0x90, 0x30, 0x67, 0x99, 0x62, 0xc4, 0x0d, 0x50, 0x78, 0x92, 0x62, 0x6b, 0xdd, 0x67,
+	0x8a, 0x79, 0x17, 0x18, 0x67, 0x4b, 0x0d, 0xd2, 0xe6, 0x3d, 0x93, 0x0f, 0x8c, 0x63, 0xe2, 0x5b,
+	0xec, 0xda, 0x8e, 0xa4, 0x1f, 0xa0, 0x70, 0x98, 0x9c, 0x47, 0xc7, 0x36, 0x8b, 0x6c, 0xed, 0x59,
+	0x77, 0xf3, 0x35, 0x71, 0xd2, 0xf6, 0xc7, 0xec, 0x61, 0xb3, 0xf3, 0xd1, 0x76, 0xe7, 0xa3, 0xef,
+	0x9d, 0x8f, 0x3e, 0xf6, 0xbe, 0xb3, 0xdd, 0xfb, 0xce, 0xe7, 0xde, 0x77, 0x9e, 0xaf, 0x29, 0xd7,
+	0xec, 0x75, 0x11, 0xe5, 0xa2, 0x88, 0xd5, 0x8a, 0x57, 0x57, 0x05, 0xd4, 0xf1, 0x61, 0xe0, 0x3a,
+	0x89, 0xdf, 0xfe, 0xac, 0xdc, 0xb4, 0x52, 0x0b, 0xd7, 0x4c, 0x7c, 0xf3, 0x33, 0x00, 0x63, 0x3d,
+	0x3f, 0x5e, 0xd7, 0x01, 0x00, 0x00,
+}
+
+func (m *MarketChangeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketChangeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketChangeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.AfterHash) > 0 {
+		i -= len(m.AfterHash)
+		copy(dAtA[i:], m.AfterHash)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.AfterHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BeforeHash) > 0 {
+		i -= len(m.BeforeHash)
+		copy(dAtA[i:], m.BeforeHash)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.BeforeHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketChangeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovHistory(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.BeforeHash)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.AfterHash)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = m.Market.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketChangeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketChangeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketChangeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeHash = append(m.BeforeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BeforeHash == nil {
+				m.BeforeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterHash = append(m.AfterHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AfterHash == nil {
+				m.AfterHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestMarketChangeRecord(t *testing.T) {
+	before := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{{Name: "kucoin", OffChainTicker: "btc-usd"}},
+	}
+	after := before
+	after.Ticker.Decimals = 6
+
+	msgType := "/connect.marketmap.v2.MsgUpdateMarkets"
+
+	t.Run("records the hashes of the market before and after the change", func(t *testing.T) {
+		record, err := types.NewMarketChangeRecord("BTC/USD", 2, 10, "signer", msgType, &before, &after)
+		require.NoError(t, err)
+		require.NoError(t, record.ValidateBasic())
+		require.False(t, record.Removed())
+		require.Equal(t, after, record.Market)
+
+		beforeHash, err := types.MarketHash(&before)
+		require.NoError(t, err)
+		require.Equal(t, beforeHash, record.BeforeHash)
+		require.NotEqual(t, record.BeforeHash, record.AfterHash)
+	})
+
+	t.Run("removals have no after hash or market", func(t *testing.T) {
+		record, err := types.NewMarketChangeRecord("BTC/USD", 3, 10, "signer", msgType, &after, nil)
+		require.NoError(t, err)
+		require.NoError(t, record.ValidateBasic())
+		require.True(t, record.Removed())
+		require.Equal(t, types.Market{}, record.Market)
+	})
+
+	t.Run("invalid records - fail", func(t *testing.T) {
+		record, err := types.NewMarketChangeRecord("BTC/USD", 0, 10, "signer", msgType, nil, &after)
+		require.NoError(t, err)
+		require.Error(t, record.ValidateBasic())
+
+		record, err = types.NewMarketChangeRecord("BTC/USD", 1, 10, "signer", "", nil, &after)
+		require.NoError(t, err)
+		require.Error(t, record.ValidateBasic())
+
+		record, err = types.NewMarketChangeRecord("ETH/USD", 1, 10, "signer", msgType, nil, &after)
+		require.NoError(t, err)
+		require.Error(t, record.ValidateBasic())
+
+		record, err = types.NewMarketChangeRecord("BTC/USD", 1, 10, "signer", msgType, nil, &after)
+		require.NoError(t, err)
+		record.Market.Ticker.Decimals = 8
+		require.Error(t, record.ValidateBasic())
+	})
+
+	t.Run("market history versions must be contiguous", func(t *testing.T) {
+		first, err := types.NewMarketChangeRecord("BTC/USD", 1, 10, "signer", msgType, nil, &before)
+		require.NoError(t, err)
+		second, err := types.NewMarketChangeRecord("BTC/USD", 2, 11, "signer", msgType, &before, &after)
+		require.NoError(t, err)
+		third, err := types.NewMarketChangeRecord("BTC/USD", 3, 12, "signer", msgType, &after, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, types.ValidateMarketHistory([]types.MarketChangeRecord{first, second, third}))
+		require.Error(t, types.ValidateMarketHistory([]types.MarketChangeRecord{first, third}))
+		require.Error(t, types.ValidateMarketHistory([]types.MarketChangeRecord{second, third}))
+		require.Error(t, types.ValidateMarketHistory([]types.MarketChangeRecord{first, first, second}))
+	})
+}
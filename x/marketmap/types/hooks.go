@@ -19,7 +19,20 @@ type MarketMapHooks interface {
 	AfterMarketRemoved(ctx sdk.Context, key string) error
 }
 
-var _ MarketMapHooks = &MultiMarketMapHooks{}
+// MarketStatusHooks is an optional interface that MarketMapHooks may implement to be notified of market lifecycle
+// status changes.
+//
+//go:generate mockery --name MarketStatusHooks
+type MarketStatusHooks interface {
+	// AfterMarketStatusChanged is called after the lifecycle status of an existing market changes, after
+	// AfterMarketUpdated. Removals are reported by AfterMarketRemoved instead.
+	AfterMarketStatusChanged(ctx sdk.Context, market Market, from MarketStatus) error
+}
+
+var (
+	_ MarketMapHooks    = &MultiMarketMapHooks{}
+	_ MarketStatusHooks = &MultiMarketMapHooks{}
+)
 
 // MultiMarketMapHooks defines an array of MarketMapHooks which can be executed in sequence.
 type MultiMarketMapHooks []MarketMapHooks
@@ -68,10 +81,30 @@ func (mh MultiMarketMapHooks) AfterMarketRemoved(ctx sdk.Context, key string) er
 	return nil
 }
 
+// AfterMarketStatusChanged calls the AfterMarketStatusChanged hooks of all MarketMapHooks registered to the
+// MultiMarketMapHooks that implement MarketStatusHooks.
+func (mh MultiMarketMapHooks) AfterMarketStatusChanged(ctx sdk.Context, market Market, from MarketStatus) error {
+	for i := range mh {
+		statusHooks, ok := mh[i].(MarketStatusHooks)
+		if !ok {
+			continue
+		}
+
+		if err := statusHooks.AfterMarketStatusChanged(ctx, market, from); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarketMapHooksWrapper is a wrapper for modules to inject MarketMapHooks using depinject.
 type MarketMapHooksWrapper struct{ MarketMapHooks }
 
-var _ MarketMapHooks = &NoopMarketMapHooks{}
+var (
+	_ MarketMapHooks    = &NoopMarketMapHooks{}
+	_ MarketStatusHooks = &NoopMarketMapHooks{}
+)
 
 // NoopMarketMapHooks defines market map hooks that are a no-op.
 type NoopMarketMapHooks struct{}
@@ -91,3 +124,7 @@ func (n *NoopMarketMapHooks) AfterMarketGenesis(_ sdk.Context, _ map[string]Mark
 func (n *NoopMarketMapHooks) AfterMarketRemoved(_ sdk.Context, _ string) error {
 	return nil
 }
+
+func (n *NoopMarketMapHooks) AfterMarketStatusChanged(_ sdk.Context, _ Market, _ MarketStatus) error {
+	return nil
+}
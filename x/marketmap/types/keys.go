@@ -22,6 +22,27 @@ var (
 	// ParamsPrefix is the key prefix of the module Params.
 	ParamsPrefix = collections.NewPrefix(3)
 
+	// ScheduledMarketChangesPrefix is the key prefix for ScheduledMarketChanges, keyed by height and ID.
+	ScheduledMarketChangesPrefix = collections.NewPrefix(4)
+
+	// ScheduledMarketChangeHeightsPrefix is the key prefix for the heights of ScheduledMarketChanges, keyed by ID.
+	ScheduledMarketChangeHeightsPrefix = collections.NewPrefix(5)
+
+	// NextScheduledMarketChangeIDPrefix is the key prefix for the next ScheduledMarketChange ID.
+	NextScheduledMarketChangeIDPrefix = collections.NewPrefix(6)
+
+	// MarketAuthorityGrantsPrefix is the key prefix for MarketAuthorityGrants, keyed by grantee.
+	MarketAuthorityGrantsPrefix = collections.NewPrefix(7)
+
+	// MarketVersionsPrefix is the key prefix for the current version of each market, keyed by ticker.
+	MarketVersionsPrefix = collections.NewPrefix(8)
+
+	// MarketHistoryPrefix is the key prefix for MarketChangeRecords, keyed by ticker and version.
+	MarketHistoryPrefix = collections.NewPrefix(9)
+
+	// ProvidersPrefix is the key prefix for the registry of known providers, keyed by name.
+	ProvidersPrefix = collections.NewPrefix(10)
+
 	// TickersCodec is the collections.KeyCodec value used for the markets map.
 	TickersCodec = codec.NewStringKeyCodec[TickerString]()
 
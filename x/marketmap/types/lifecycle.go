@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// marketStatusTransitions is the set of lifecycle transitions permitted from each MarketStatus.
+var marketStatusTransitions = map[MarketStatus][]MarketStatus{
+	MarketStatus_MARKET_STATUS_PROPOSED:    {MarketStatus_MARKET_STATUS_ACTIVE, MarketStatus_MARKET_STATUS_REMOVED},
+	MarketStatus_MARKET_STATUS_ACTIVE:      {MarketStatus_MARKET_STATUS_REDUCE_ONLY, MarketStatus_MARKET_STATUS_DEPRECATED},
+	MarketStatus_MARKET_STATUS_REDUCE_ONLY: {MarketStatus_MARKET_STATUS_ACTIVE, MarketStatus_MARKET_STATUS_DEPRECATED},
+	MarketStatus_MARKET_STATUS_DEPRECATED:  {MarketStatus_MARKET_STATUS_ACTIVE, MarketStatus_MARKET_STATUS_REMOVED},
+}
+
+// ShortString returns the MarketStatus without its enum prefix in lower case, i.e. reduce_only.
+func (s MarketStatus) ShortString() string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "MARKET_STATUS_"))
+}
+
+// IsPriced returns true if markets in the status are priced by the oracle sidecar, and price updates for them are
+// accepted by x/oracle.
+func (s MarketStatus) IsPriced() bool {
+	return s == MarketStatus_MARKET_STATUS_ACTIVE || s == MarketStatus_MARKET_STATUS_REDUCE_ONLY
+}
+
+// CanTransitionTo returns true if a market may move from the status to the given status.
+func (s MarketStatus) CanTransitionTo(to MarketStatus) bool {
+	for _, allowed := range marketStatusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateMarketStatusTransition returns an error if a market may not move from one status to another. Remaining in
+// the same status is always permitted.
+func ValidateMarketStatusTransition(from, to MarketStatus) error {
+	if from == to || from.CanTransitionTo(to) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid market status transition from %s to %s", from.ShortString(), to.ShortString())
+}
+
+// ValidateInitialMarketStatus returns an error if a market may not be created in the given status. Markets are
+// created either proposed or active.
+func ValidateInitialMarketStatus(status MarketStatus) error {
+	switch status {
+	case MarketStatus_MARKET_STATUS_PROPOSED, MarketStatus_MARKET_STATUS_ACTIVE:
+		return nil
+	default:
+		return fmt.Errorf("markets cannot be created with status %s", status.ShortString())
+	}
+}
+
+// EffectiveStatus returns the lifecycle status of the Ticker. Tickers without a status are active if they are
+// enabled, and proposed otherwise.
+func (t Ticker) EffectiveStatus() MarketStatus {
+	if t.Status != MarketStatus_MARKET_STATUS_UNSPECIFIED {
+		return t.Status
+	}
+
+	if t.Enabled {
+		return MarketStatus_MARKET_STATUS_ACTIVE
+	}
+
+	return MarketStatus_MARKET_STATUS_PROPOSED
+}
+
+// validateStatus checks that the Ticker status is known, may be stored, and agrees with the Enabled flag.
+func (t *Ticker) validateStatus() error {
+	if _, ok := MarketStatus_name[int32(t.Status)]; !ok {
+		return fmt.Errorf("unknown market status %d for %s", t.Status, t.CurrencyPair.String())
+	}
+
+	switch {
+	case t.Status == MarketStatus_MARKET_STATUS_UNSPECIFIED:
+		return nil
+	case t.Status == MarketStatus_MARKET_STATUS_REMOVED:
+		return fmt.Errorf("market status of %s cannot be removed", t.CurrencyPair.String())
+	case t.Enabled != t.Status.IsPriced():
+		return fmt.Errorf("enabled must be %t for %s market %s", t.Status.IsPriced(), t.Status.ShortString(),
+			t.CurrencyPair.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+const (
+	unspecified = types.MarketStatus_MARKET_STATUS_UNSPECIFIED
+	proposed    = types.MarketStatus_MARKET_STATUS_PROPOSED
+	active      = types.MarketStatus_MARKET_STATUS_ACTIVE
+	reduceOnly  = types.MarketStatus_MARKET_STATUS_REDUCE_ONLY
+	deprecated  = types.MarketStatus_MARKET_STATUS_DEPRECATED
+	removed     = types.MarketStatus_MARKET_STATUS_REMOVED
+)
+
+func TestValidateMarketStatusTransition(t *testing.T) {
+	tcs := []struct {
+		from, to   types.MarketStatus
+		expectPass bool
+	}{
+		{proposed, proposed, true},
+		{proposed, active, true},
+		{proposed, removed, true},
+		{proposed, reduceOnly, false},
+		{proposed, deprecated, false},
+		{active, reduceOnly, true},
+		{active, deprecated, true},
+		{active, proposed, false},
+		{active, removed, false},
+		{reduceOnly, active, true},
+		{reduceOnly, deprecated, true},
+		{reduceOnly, removed, false},
+		{deprecated, active, true},
+		{deprecated, removed, true},
+		{deprecated, reduceOnly, false},
+		{removed, active, false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.from.ShortString()+" to "+tc.to.ShortString(), func(t *testing.T) {
+			err := types.ValidateMarketStatusTransition(tc.from, tc.to)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.NoError(t, types.ValidateInitialMarketStatus(proposed))
+	require.NoError(t, types.ValidateInitialMarketStatus(active))
+	require.Error(t, types.ValidateInitialMarketStatus(reduceOnly))
+	require.Error(t, types.ValidateInitialMarketStatus(deprecated))
+}
+
+func TestTickerStatus(t *testing.T) {
+	tcs := []struct {
+		name       string
+		status     types.MarketStatus
+		enabled    bool
+		effective  types.MarketStatus
+		expectPass bool
+	}{
+		{"unspecified enabled", unspecified, true, active, true},
+		{"unspecified disabled", unspecified, false, proposed, true},
+		{"proposed", proposed, false, proposed, true},
+		{"proposed enabled", proposed, true, proposed, false},
+		{"active", active, true, active, true},
+		{"active disabled", active, false, active, false},
+		{"reduce-only", reduceOnly, true, reduceOnly, true},
+		{"deprecated", deprecated, false, deprecated, true},
+		{"deprecated enabled", deprecated, true, deprecated, false},
+		{"removed", removed, false, removed, false},
+		{"unknown", types.MarketStatus(100), false, types.MarketStatus(100), false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ticker := types.Ticker{
+				CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+				Decimals:         8,
+				MinProviderCount: 1,
+				Enabled:          tc.enabled,
+				Status:           tc.status,
+			}
+			require.Equal(t, tc.effective, ticker.EffectiveStatus())
+
+			err := ticker.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.True(t, active.IsPriced())
+	require.True(t, reduceOnly.IsPriced())
+	require.False(t, proposed.IsPriced())
+	require.False(t, deprecated.IsPriced())
+	require.Equal(t, "reduce_only", reduceOnly.ShortString())
+}
@@ -24,6 +24,57 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MarketStatus is the lifecycle status of a Market.
+type MarketStatus int32
+
+const (
+	// MARKET_STATUS_UNSPECIFIED is an unset status. The market is treated as
+	// active if it is enabled, and as proposed otherwise.
+	MarketStatus_MARKET_STATUS_UNSPECIFIED MarketStatus = 0
+	// MARKET_STATUS_PROPOSED markets are assigned a currency pair ID in x/oracle,
+	// but are not priced by the oracle and price updates are rejected.
+	MarketStatus_MARKET_STATUS_PROPOSED MarketStatus = 1
+	// MARKET_STATUS_ACTIVE markets are priced by the oracle.
+	MarketStatus_MARKET_STATUS_ACTIVE MarketStatus = 2
+	// MARKET_STATUS_REDUCE_ONLY markets are priced by the oracle so that
+	// existing positions can be closed, but must not be used to open new
+	// positions by downstream modules.
+	MarketStatus_MARKET_STATUS_REDUCE_ONLY MarketStatus = 3
+	// MARKET_STATUS_DEPRECATED markets are no longer priced by the oracle. Their
+	// currency pair ID and last price are retained in x/oracle, and price
+	// updates are rejected.
+	MarketStatus_MARKET_STATUS_DEPRECATED MarketStatus = 4
+	// MARKET_STATUS_REMOVED markets have been removed from the market map. This
+	// status is never stored, and is only reported in events.
+	MarketStatus_MARKET_STATUS_REMOVED MarketStatus = 5
+)
+
+var MarketStatus_name = map[int32]string{
+	0: "MARKET_STATUS_UNSPECIFIED",
+	1: "MARKET_STATUS_PROPOSED",
+	2: "MARKET_STATUS_ACTIVE",
+	3: "MARKET_STATUS_REDUCE_ONLY",
+	4: "MARKET_STATUS_DEPRECATED",
+	5: "MARKET_STATUS_REMOVED",
+}
+
+var MarketStatus_value = map[string]int32{
+	"MARKET_STATUS_UNSPECIFIED": 0,
+	"MARKET_STATUS_PROPOSED":    1,
+	"MARKET_STATUS_ACTIVE":      2,
+	"MARKET_STATUS_REDUCE_ONLY": 3,
+	"MARKET_STATUS_DEPRECATED":  4,
+	"MARKET_STATUS_REMOVED":     5,
+}
+
+func (x MarketStatus) String() string {
+	return proto.EnumName(MarketStatus_name, int32(x))
+}
+
+func (MarketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_54627e801f077fe4, []int{0}
+}
+
 // Market encapsulates a Ticker and its provider-specific configuration.
 type Market struct {
 	// Ticker represents a price feed for a given asset pair i.e. BTC/USD. The
@@ -98,6 +149,10 @@ type Ticker struct {
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given ticker.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
+	// Status is the lifecycle status of the Ticker. If set, Enabled must be
+	// true iff the status is priced by the oracle. If unset, the status is
+	// derived from Enabled.
+	Status MarketStatus `protobuf:"varint,16,opt,name=status,proto3,enum=connect.marketmap.v2.MarketStatus" json:"status,omitempty"`
 }
 
 func (m *Ticker) Reset()      { *m = Ticker{} }
@@ -167,6 +222,13 @@ func (m *Ticker) GetMetadata_JSON() string {
 	return ""
 }
 
+func (m *Ticker) GetStatus() MarketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
 type ProviderConfig struct {
 	// Name corresponds to the name of the provider for which the configuration is
 	// being set.
@@ -303,6 +365,7 @@ func (m *MarketMap) GetMarkets() map[string]Market {
 }
 
 func init() {
+	proto.RegisterEnum("connect.marketmap.v2.MarketStatus", MarketStatus_name, MarketStatus_value)
 	proto.RegisterType((*Market)(nil), "connect.marketmap.v2.Market")
 	proto.RegisterType((*Ticker)(nil), "connect.marketmap.v2.Ticker")
 	proto.RegisterType((*ProviderConfig)(nil), "connect.marketmap.v2.ProviderConfig")
@@ -313,42 +376,50 @@ func init() {
 func init() { proto.RegisterFile("connect/marketmap/v2/market.proto", fileDescriptor_54627e801f077fe4) }
 
 var fileDescriptor_54627e801f077fe4 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xd2, 0xad, 0x5e, 0xd7, 0x16, 0x6b, 0x42, 0x51, 0x85, 0xb2, 0x52, 0x76, 0xc8,
-	0x61, 0x24, 0x28, 0x5c, 0x50, 0x8f, 0xad, 0x38, 0x50, 0x31, 0x98, 0x02, 0x48, 0x88, 0x4b, 0xe4,
-	0xa6, 0x6e, 0x67, 0xb5, 0xb1, 0x23, 0xc7, 0x8d, 0x28, 0x27, 0x7e, 0x02, 0x47, 0x8e, 0x5c, 0xf8,
-	0x19, 0xdc, 0x77, 0xdc, 0x0d, 0x0e, 0x08, 0xa1, 0x56, 0xe2, 0x77, 0xa0, 0x38, 0x4e, 0xd7, 0x4a,
-	0x45, 0xda, 0xed, 0xbd, 0xe7, 0xcf, 0xdf, 0xf3, 0xf7, 0xf9, 0x3d, 0xf8, 0x20, 0xe4, 0x8c, 0x91,
-	0x50, 0xba, 0x11, 0x16, 0x53, 0x22, 0x23, 0x1c, 0xbb, 0xa9, 0xa7, 0x13, 0x27, 0x16, 0x5c, 0x72,
-	0x74, 0xac, 0x21, 0xce, 0x1a, 0xe2, 0xa4, 0x5e, 0xeb, 0x78, 0xc2, 0x27, 0x5c, 0x01, 0xdc, 0x2c,
-	0xca, 0xb1, 0xad, 0xd3, 0x82, 0x4e, 0x2e, 0x62, 0x92, 0x64, 0x54, 0xe1, 0x5c, 0x08, 0xc2, 0xc2,
-	0x45, 0x10, 0x63, 0x2a, 0x72, 0x54, 0xe7, 0x1b, 0x80, 0x95, 0x73, 0x45, 0x86, 0xba, 0xb0, 0x22,
-	0x69, 0x38, 0x25, 0xc2, 0x04, 0x6d, 0x60, 0x1f, 0x7a, 0xf7, 0x9d, 0x5d, 0xdd, 0x9c, 0x37, 0x0a,
-	0xd3, 0x2b, 0x5f, 0xfd, 0x3e, 0x31, 0x7c, 0x7d, 0x03, 0xbd, 0x85, 0xcd, 0x58, 0xf0, 0x94, 0x8e,
-	0x88, 0x08, 0x42, 0xce, 0xc6, 0x74, 0x92, 0x98, 0x7b, 0xed, 0x92, 0x7d, 0xe8, 0x9d, 0xee, 0x66,
-	0xb9, 0xd0, 0xe8, 0xbe, 0x02, 0x6b, 0xb6, 0x46, 0xbc, 0x55, 0x4d, 0xba, 0x07, 0x5f, 0xbe, 0x9e,
-	0x18, 0x9f, 0x7e, 0xb5, 0x8d, 0xce, 0x5f, 0x00, 0x2b, 0x79, 0x67, 0xf4, 0x1c, 0x1e, 0x6d, 0x29,
-	0xd1, 0xcf, 0xb5, 0xd6, 0x8d, 0x94, 0xe0, 0xac, 0x49, 0x5f, 0xc3, 0x2e, 0x30, 0x2d, 0x1e, 0x5c,
-	0x0b, 0x37, 0x6a, 0xa8, 0x05, 0x0f, 0x46, 0x24, 0xa4, 0x11, 0x9e, 0x65, 0xcf, 0x05, 0x76, 0xd9,
-	0x5f, 0xe7, 0xe8, 0x0c, 0xa2, 0x88, 0xb2, 0x60, 0x43, 0xd6, 0x9c, 0x49, 0xb3, 0xa4, 0x50, 0xcd,
-	0x88, 0xb2, 0x1b, 0x05, 0x73, 0x26, 0x91, 0x09, 0xf7, 0x09, 0xc3, 0xc3, 0x19, 0x19, 0x99, 0xf5,
-	0x36, 0xb0, 0x0f, 0xfc, 0x22, 0x45, 0x0f, 0xe1, 0x51, 0x44, 0x24, 0x1e, 0x61, 0x89, 0x83, 0xc1,
-	0xeb, 0x57, 0x2f, 0xcd, 0x46, 0x1b, 0xd8, 0x55, 0xbf, 0x56, 0x14, 0xb3, 0xda, 0x86, 0xd0, 0x1f,
-	0x00, 0xd6, 0xb7, 0xcd, 0x41, 0x08, 0x96, 0x19, 0x8e, 0x88, 0xd2, 0x59, 0xf5, 0x55, 0x8c, 0x6c,
-	0xd8, 0xe4, 0xe3, 0x71, 0x10, 0x5e, 0x62, 0xca, 0x02, 0xfd, 0x6d, 0x7b, 0xea, 0xbc, 0xce, 0xc7,
-	0xe3, 0x7e, 0x56, 0xd6, 0x76, 0x0d, 0xe0, 0x5d, 0xc6, 0x45, 0x84, 0x67, 0xf4, 0x23, 0x09, 0x86,
-	0xda, 0xb2, 0xd2, 0x6d, 0x2c, 0xf3, 0x1b, 0xeb, 0x8b, 0xbd, 0xdc, 0xaf, 0x7b, 0xb0, 0x42, 0x59,
-	0x4a, 0x84, 0x34, 0xcb, 0x4a, 0xa4, 0xce, 0x6e, 0xa5, 0xb1, 0xf3, 0x1d, 0xc0, 0x6a, 0x3e, 0x6a,
-	0xe7, 0x38, 0x46, 0x2f, 0xe0, 0x7e, 0x3e, 0x10, 0x89, 0x09, 0xd4, 0xa0, 0x9c, 0xed, 0x1e, 0x94,
-	0xf5, 0x0d, 0x1d, 0x25, 0xcf, 0x98, 0x14, 0x0b, 0xfd, 0x9b, 0x05, 0x45, 0xeb, 0x1d, 0xac, 0x6d,
-	0x1e, 0xa3, 0x26, 0x2c, 0x4d, 0xc9, 0x42, 0x3b, 0x96, 0x85, 0xc8, 0x83, 0x77, 0x52, 0x3c, 0x9b,
-	0x13, 0xe5, 0xd2, 0x7f, 0x87, 0x3b, 0x27, 0xf1, 0x73, 0x68, 0x77, 0xef, 0x29, 0xb8, 0xf9, 0x99,
-	0xde, 0xe0, 0x6a, 0x69, 0x81, 0xeb, 0xa5, 0x05, 0xfe, 0x2c, 0x2d, 0xf0, 0x79, 0x65, 0x19, 0xd7,
-	0x2b, 0xcb, 0xf8, 0xb9, 0xb2, 0x8c, 0xf7, 0x8f, 0x27, 0x54, 0x5e, 0xce, 0x87, 0x4e, 0xc8, 0x23,
-	0x37, 0x99, 0xd2, 0xf8, 0x51, 0x44, 0x52, 0xb7, 0x58, 0xbf, 0xd4, 0x73, 0x3f, 0x6c, 0xac, 0xb4,
-	0x72, 0x7a, 0x58, 0x51, 0xdb, 0xf7, 0xe4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xa9, 0x16,
-	0x4f, 0xf4, 0x03, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
+	0x14, 0x66, 0x80, 0x90, 0x64, 0x42, 0x88, 0xef, 0x28, 0x37, 0x72, 0x50, 0x2e, 0xe1, 0x72, 0xb3,
+	0xb0, 0xae, 0x52, 0xa8, 0xdc, 0x4d, 0x95, 0x1d, 0x31, 0xae, 0x44, 0x1a, 0x02, 0x32, 0x10, 0xb5,
+	0xdd, 0x58, 0x83, 0x19, 0x88, 0x05, 0x1e, 0x5b, 0xf6, 0x60, 0x95, 0xae, 0xfa, 0x08, 0x5d, 0x76,
+	0x59, 0x55, 0xea, 0x53, 0x54, 0xdd, 0x67, 0x99, 0x5d, 0xbb, 0xa8, 0xaa, 0x2a, 0x79, 0x91, 0xca,
+	0xe3, 0x81, 0x40, 0x1b, 0x45, 0xd9, 0x9d, 0x9f, 0xef, 0x7c, 0x67, 0xce, 0x77, 0x66, 0x06, 0xfe,
+	0x6b, 0xb9, 0x94, 0x12, 0x8b, 0x55, 0x1c, 0xec, 0x8f, 0x08, 0x73, 0xb0, 0x57, 0x09, 0x55, 0xe1,
+	0x94, 0x3d, 0xdf, 0x65, 0x2e, 0xda, 0x16, 0x90, 0xf2, 0x1c, 0x52, 0x0e, 0xd5, 0xfc, 0xf6, 0xd0,
+	0x1d, 0xba, 0x1c, 0x50, 0x89, 0xac, 0x18, 0x9b, 0x3f, 0x98, 0xd1, 0xb1, 0xa9, 0x47, 0x82, 0x88,
+	0xca, 0x9a, 0xf8, 0x3e, 0xa1, 0xd6, 0xd4, 0xf4, 0xb0, 0xed, 0xc7, 0xa8, 0xd2, 0x27, 0x00, 0x33,
+	0x0d, 0x4e, 0x86, 0x8e, 0x60, 0x86, 0xd9, 0xd6, 0x88, 0xf8, 0x32, 0x28, 0x02, 0x65, 0x43, 0xdd,
+	0x2b, 0xdf, 0xd5, 0xad, 0xdc, 0xe1, 0x98, 0xe3, 0xf4, 0xe5, 0x8f, 0xfd, 0x84, 0x21, 0x2a, 0x50,
+	0x17, 0x4a, 0x9e, 0xef, 0x86, 0x76, 0x9f, 0xf8, 0xa6, 0xe5, 0xd2, 0x81, 0x3d, 0x0c, 0xe4, 0x64,
+	0x31, 0xa5, 0x6c, 0xa8, 0x07, 0x77, 0xb3, 0xb4, 0x04, 0x5a, 0xe3, 0x60, 0xc1, 0xb6, 0xe5, 0x2d,
+	0x45, 0x83, 0xa3, 0xb5, 0xf7, 0x1f, 0xf6, 0x13, 0x6f, 0xbf, 0x17, 0x13, 0xa5, 0x8f, 0x49, 0x98,
+	0x89, 0x3b, 0xa3, 0x3a, 0xdc, 0x5c, 0x9a, 0x44, 0x1c, 0xb7, 0x30, 0x6f, 0xc4, 0x07, 0x8e, 0x9a,
+	0x68, 0x02, 0xd6, 0xc2, 0xf6, 0xec, 0xc0, 0x59, 0x6b, 0x21, 0x86, 0xf2, 0x70, 0xad, 0x4f, 0x2c,
+	0xdb, 0xc1, 0xe3, 0xe8, 0xb8, 0x40, 0x49, 0x1b, 0x73, 0x1f, 0x1d, 0x42, 0xe4, 0xd8, 0xd4, 0x5c,
+	0x18, 0x6b, 0x42, 0x99, 0x9c, 0xe2, 0x28, 0xc9, 0xb1, 0xe9, 0xed, 0x04, 0x13, 0xca, 0x90, 0x0c,
+	0x57, 0x09, 0xc5, 0xbd, 0x31, 0xe9, 0xcb, 0xb9, 0x22, 0x50, 0xd6, 0x8c, 0x99, 0x8b, 0xfe, 0x83,
+	0x9b, 0x0e, 0x61, 0xb8, 0x8f, 0x19, 0x36, 0x4f, 0xda, 0xcd, 0x33, 0x79, 0xab, 0x08, 0x94, 0x75,
+	0x23, 0x3b, 0x0b, 0x46, 0xb1, 0x48, 0xfb, 0x80, 0x61, 0x36, 0x09, 0x64, 0xa9, 0x08, 0x94, 0x9c,
+	0x5a, 0xba, 0x5b, 0xb5, 0x78, 0x53, 0x6d, 0x8e, 0x34, 0x44, 0xc5, 0x82, 0x48, 0x5f, 0x01, 0xcc,
+	0x2d, 0x0b, 0x8b, 0x10, 0x4c, 0x53, 0xec, 0x10, 0xae, 0xd1, 0xba, 0xc1, 0x6d, 0xa4, 0x40, 0xc9,
+	0x1d, 0x0c, 0x4c, 0xeb, 0x02, 0xdb, 0xd4, 0x14, 0x2b, 0x4f, 0xf2, 0x7c, 0xce, 0x1d, 0x0c, 0xb4,
+	0x28, 0x2c, 0xa4, 0x3e, 0x81, 0x7f, 0x51, 0xd7, 0x77, 0xf0, 0xd8, 0x7e, 0x43, 0xcc, 0x9e, 0x90,
+	0x3b, 0xf5, 0x10, 0xb9, 0x8d, 0xad, 0x79, 0xe1, 0x71, 0xac, 0xf5, 0x0e, 0xcc, 0xd8, 0x34, 0x24,
+	0x3e, 0x93, 0xd3, 0x5c, 0x20, 0xe1, 0x3d, 0x48, 0x9f, 0xd2, 0x17, 0x00, 0xd7, 0xe3, 0xe1, 0x1b,
+	0xd8, 0x43, 0xa7, 0x70, 0x35, 0x96, 0x25, 0x90, 0x01, 0xbf, 0x64, 0x87, 0xf7, 0xc9, 0xd5, 0xc0,
+	0x9e, 0xb0, 0x02, 0x9d, 0x32, 0x7f, 0x2a, 0x6e, 0xc2, 0x8c, 0x22, 0xff, 0x02, 0x66, 0x17, 0xd3,
+	0x48, 0x82, 0xa9, 0x11, 0x99, 0x0a, 0xc5, 0x22, 0x13, 0xa9, 0x70, 0x25, 0xc4, 0xe3, 0x09, 0x91,
+	0x93, 0xf7, 0x3d, 0x8c, 0x98, 0xc4, 0x88, 0xa1, 0x47, 0xc9, 0xa7, 0xe0, 0x76, 0x33, 0xff, 0x7f,
+	0x06, 0x30, 0xbb, 0xb8, 0x3c, 0xf4, 0x0f, 0xdc, 0x6d, 0x54, 0x8d, 0xe7, 0x7a, 0xc7, 0x6c, 0x77,
+	0xaa, 0x9d, 0x6e, 0xdb, 0xec, 0x9e, 0xb5, 0x5b, 0xba, 0x56, 0x7f, 0x56, 0xd7, 0x6b, 0x52, 0x02,
+	0xe5, 0xe1, 0xce, 0x72, 0xba, 0x65, 0x34, 0x5b, 0xcd, 0xb6, 0x5e, 0x93, 0x00, 0x92, 0xe1, 0xf6,
+	0x72, 0xae, 0xaa, 0x75, 0xea, 0xe7, 0xba, 0x94, 0xfc, 0x93, 0xd4, 0xd0, 0x6b, 0x5d, 0x4d, 0x37,
+	0x9b, 0x67, 0xa7, 0x2f, 0xa5, 0x14, 0xda, 0x83, 0xf2, 0x72, 0xba, 0xa6, 0xb7, 0x0c, 0x5d, 0xab,
+	0x76, 0xf4, 0x9a, 0x94, 0x46, 0xbb, 0xf0, 0xef, 0xdf, 0x8b, 0x1b, 0xcd, 0x73, 0xbd, 0x26, 0xad,
+	0x1c, 0x9f, 0x5c, 0x5e, 0x17, 0xc0, 0xd5, 0x75, 0x01, 0xfc, 0xbc, 0x2e, 0x80, 0x77, 0x37, 0x85,
+	0xc4, 0xd5, 0x4d, 0x21, 0xf1, 0xed, 0xa6, 0x90, 0x78, 0xf5, 0x78, 0x68, 0xb3, 0x8b, 0x49, 0xaf,
+	0x6c, 0xb9, 0x4e, 0x25, 0x18, 0xd9, 0xde, 0x23, 0x87, 0x84, 0x95, 0xd9, 0xc7, 0x13, 0xaa, 0x95,
+	0xd7, 0x0b, 0x9f, 0x19, 0xbf, 0x27, 0xbd, 0x0c, 0xff, 0x77, 0x9e, 0xfc, 0x1a, 0x00, 0x51, 0xe4,
+	0xa4, 0x97, 0xee, 0x04, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -418,6 +489,13 @@ func (m *Ticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.Metadata_JSON) > 0 {
 		i -= len(m.Metadata_JSON)
 		copy(dAtA[i:], m.Metadata_JSON)
@@ -620,6 +698,9 @@ func (m *Ticker) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 2 + sovMarket(uint64(m.Status))
+	}
 	return n
 }
 
@@ -944,6 +1025,25 @@ func (m *Ticker) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata_JSON = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"strings"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// MarketFilter selects markets by their enabled status, provider configs and assets. Unset fields match every market,
+// and assets are matched case-insensitively.
+type MarketFilter struct {
+	// EnabledOnly matches only enabled markets.
+	EnabledOnly bool
+
+	// Provider matches only markets with a provider config for the named provider.
+	Provider string
+
+	// Base matches only markets with the given base asset.
+	Base string
+
+	// Quote matches only markets with the given quote asset.
+	Quote string
+}
+
+// NewMarketFilter returns a new MarketFilter.
+func NewMarketFilter(enabledOnly bool, provider, base, quote string) MarketFilter {
+	return MarketFilter{
+		EnabledOnly: enabledOnly,
+		Provider:    provider,
+		Base:        base,
+		Quote:       quote,
+	}
+}
+
+// RequiresMarket returns true if the filter can only be evaluated against a Market, and not against a CurrencyPair
+// alone.
+func (f MarketFilter) RequiresMarket() bool {
+	return f.EnabledOnly || f.Provider != ""
+}
+
+// MatchesCurrencyPair returns true if the CurrencyPair matches the base and quote asset filters.
+func (f MarketFilter) MatchesCurrencyPair(cp connecttypes.CurrencyPair) bool {
+	if f.Base != "" && !strings.EqualFold(f.Base, cp.Base) {
+		return false
+	}
+
+	return f.Quote == "" || strings.EqualFold(f.Quote, cp.Quote)
+}
+
+// Matches returns true if the Market matches every filter.
+func (f MarketFilter) Matches(market Market) bool {
+	if !f.MatchesCurrencyPair(market.Ticker.CurrencyPair) {
+		return false
+	}
+
+	if f.EnabledOnly && !market.Ticker.Enabled {
+		return false
+	}
+
+	if f.Provider == "" {
+		return true
+	}
+
+	for _, providerConfig := range market.ProviderConfigs {
+		if providerConfig.Name == f.Provider {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestMarketFilter(t *testing.T) {
+	disabled := btcusdt
+	disabled.Ticker.Enabled = false
+
+	tcs := []struct {
+		name    string
+		filter  types.MarketFilter
+		market  types.Market
+		matches bool
+	}{
+		{
+			"empty filter matches every market",
+			types.MarketFilter{},
+			disabled,
+			true,
+		},
+		{
+			"enabled only matches enabled markets",
+			types.NewMarketFilter(true, "", "", ""),
+			btcusdt,
+			true,
+		},
+		{
+			"enabled only does not match disabled markets",
+			types.NewMarketFilter(true, "", "", ""),
+			disabled,
+			false,
+		},
+		{
+			"provider matches markets with a config for the provider",
+			types.NewMarketFilter(false, "kucoin", "", ""),
+			btcusdt,
+			true,
+		},
+		{
+			"provider does not match markets without a config for the provider",
+			types.NewMarketFilter(false, "binance", "", ""),
+			btcusdt,
+			false,
+		},
+		{
+			"assets are matched case-insensitively",
+			types.NewMarketFilter(false, "", "btc", "usdt"),
+			btcusdt,
+			true,
+		},
+		{
+			"base does not match other base assets",
+			types.NewMarketFilter(false, "", "ETH", ""),
+			btcusdt,
+			false,
+		},
+		{
+			"quote does not match other quote assets",
+			types.NewMarketFilter(false, "", "", "USD"),
+			btcusdt,
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, tc.filter.Matches(tc.market))
+		})
+	}
+
+	require.False(t, types.NewMarketFilter(false, "", "BTC", "USD").RequiresMarket())
+	require.True(t, types.NewMarketFilter(true, "", "", "").RequiresMarket())
+	require.True(t, types.NewMarketFilter(false, "kucoin", "", "").RequiresMarket())
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MarketStatusHooks is an autogenerated mock type for the MarketStatusHooks type
+type MarketStatusHooks struct {
+	mock.Mock
+}
+
+type MarketStatusHooks_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarketStatusHooks) EXPECT() *MarketStatusHooks_Expecter {
+	return &MarketStatusHooks_Expecter{mock: &_m.Mock}
+}
+
+// AfterMarketStatusChanged provides a mock function with given fields: ctx, market, from
+func (_m *MarketStatusHooks) AfterMarketStatusChanged(ctx types.Context, market marketmaptypes.Market, from marketmaptypes.MarketStatus) error {
+	ret := _m.Called(ctx, market, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterMarketStatusChanged")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, marketmaptypes.Market, marketmaptypes.MarketStatus) error); ok {
+		r0 = rf(ctx, market, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarketStatusHooks_AfterMarketStatusChanged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterMarketStatusChanged'
+type MarketStatusHooks_AfterMarketStatusChanged_Call struct {
+	*mock.Call
+}
+
+// AfterMarketStatusChanged is a helper method to define mock.On call
+//   - ctx types.Context
+//   - market marketmaptypes.Market
+//   - from marketmaptypes.MarketStatus
+func (_e *MarketStatusHooks_Expecter) AfterMarketStatusChanged(ctx interface{}, market interface{}, from interface{}) *MarketStatusHooks_AfterMarketStatusChanged_Call {
+	return &MarketStatusHooks_AfterMarketStatusChanged_Call{Call: _e.mock.On("AfterMarketStatusChanged", ctx, market, from)}
+}
+
+func (_c *MarketStatusHooks_AfterMarketStatusChanged_Call) Run(run func(ctx types.Context, market marketmaptypes.Market, from marketmaptypes.MarketStatus)) *MarketStatusHooks_AfterMarketStatusChanged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(marketmaptypes.Market), args[2].(marketmaptypes.MarketStatus))
+	})
+	return _c
+}
+
+func (_c *MarketStatusHooks_AfterMarketStatusChanged_Call) Return(_a0 error) *MarketStatusHooks_AfterMarketStatusChanged_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarketStatusHooks_AfterMarketStatusChanged_Call) RunAndReturn(run func(types.Context, marketmaptypes.Market, marketmaptypes.MarketStatus) error) *MarketStatusHooks_AfterMarketStatusChanged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarketStatusHooks creates a new instance of MarketStatusHooks. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarketStatusHooks(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarketStatusHooks {
+	mock := &MarketStatusHooks{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -83,6 +83,72 @@ func (_m *QueryClient) Market(ctx context.Context, in *types.MarketRequest, opts
 	return r0, r1
 }
 
+// MarketAuthorityGrants provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketAuthorityGrants(ctx context.Context, in *types.MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*types.MarketAuthorityGrantsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.MarketAuthorityGrantsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketAuthorityGrantsRequest, ...grpc.CallOption) (*types.MarketAuthorityGrantsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketAuthorityGrantsRequest, ...grpc.CallOption) *types.MarketAuthorityGrantsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketAuthorityGrantsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketAuthorityGrantsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketHistory provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketHistory(ctx context.Context, in *types.MarketHistoryRequest, opts ...grpc.CallOption) (*types.MarketHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.MarketHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketHistoryRequest, ...grpc.CallOption) (*types.MarketHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketHistoryRequest, ...grpc.CallOption) *types.MarketHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarketMap provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) MarketMap(ctx context.Context, in *types.MarketMapRequest, opts ...grpc.CallOption) (*types.MarketMapResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -116,6 +182,39 @@ func (_m *QueryClient) MarketMap(ctx context.Context, in *types.MarketMapRequest
 	return r0, r1
 }
 
+// MarketVersion provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketVersion(ctx context.Context, in *types.MarketVersionRequest, opts ...grpc.CallOption) (*types.MarketVersionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.MarketVersionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketVersionRequest, ...grpc.CallOption) (*types.MarketVersionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketVersionRequest, ...grpc.CallOption) *types.MarketVersionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketVersionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketVersionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Params(ctx context.Context, in *types.ParamsRequest, opts ...grpc.CallOption) (*types.ParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -149,6 +248,105 @@ func (_m *QueryClient) Params(ctx context.Context, in *types.ParamsRequest, opts
 	return r0, r1
 }
 
+// Providers provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Providers(ctx context.Context, in *types.ProvidersRequest, opts ...grpc.CallOption) (*types.ProvidersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ProvidersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ProvidersRequest, ...grpc.CallOption) (*types.ProvidersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ProvidersRequest, ...grpc.CallOption) *types.ProvidersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ProvidersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ProvidersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduledMarketChanges provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ScheduledMarketChanges(ctx context.Context, in *types.ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*types.ScheduledMarketChangesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ScheduledMarketChangesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ScheduledMarketChangesRequest, ...grpc.CallOption) (*types.ScheduledMarketChangesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ScheduledMarketChangesRequest, ...grpc.CallOption) *types.ScheduledMarketChangesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ScheduledMarketChangesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ScheduledMarketChangesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateMarketMapUpdate provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ValidateMarketMapUpdate(ctx context.Context, in *types.ValidateMarketMapUpdateRequest, opts ...grpc.CallOption) (*types.ValidateMarketMapUpdateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ValidateMarketMapUpdateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ValidateMarketMapUpdateRequest, ...grpc.CallOption) (*types.ValidateMarketMapUpdateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ValidateMarketMapUpdateRequest, ...grpc.CallOption) *types.ValidateMarketMapUpdateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ValidateMarketMapUpdateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ValidateMarketMapUpdateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewQueryClient creates a new instance of QueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueryClient(t interface {
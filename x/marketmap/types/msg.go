@@ -15,6 +15,13 @@ var (
 	_ sdk.Msg = &MsgRemoveMarketAuthorities{}
 	_ sdk.Msg = &MsgUpsertMarkets{}
 	_ sdk.Msg = &MsgRemoveMarkets{}
+	_ sdk.Msg = &MsgScheduleMarketUpdates{}
+	_ sdk.Msg = &MsgScheduleMarketRemovals{}
+	_ sdk.Msg = &MsgCancelScheduledMarketChanges{}
+	_ sdk.Msg = &MsgSetMarketAuthorityGrants{}
+	_ sdk.Msg = &MsgRevokeMarketAuthorityGrants{}
+	_ sdk.Msg = &MsgUpsertProviders{}
+	_ sdk.Msg = &MsgRemoveProviders{}
 )
 
 // ValidateBasic asserts that the authority address in the upsert-markets message is formatted correctly.
@@ -155,3 +162,178 @@ func (m *MsgRemoveMarkets) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, the height is set, and all markets are valid and unique.
+func (m *MsgScheduleMarketUpdates) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if m.Height == 0 {
+		return fmt.Errorf("height must be set")
+	}
+
+	if len(m.CreateMarkets) == 0 && len(m.UpdateMarkets) == 0 {
+		return fmt.Errorf("no markets to schedule")
+	}
+
+	seenTickers := make(map[string]struct{}, len(m.CreateMarkets)+len(m.UpdateMarkets))
+	for _, market := range append(append([]Market{}, m.CreateMarkets...), m.UpdateMarkets...) {
+		ticker := market.Ticker.String()
+		if _, seen := seenTickers[ticker]; seen {
+			return fmt.Errorf("duplicate ticker: %s", ticker)
+		}
+
+		if err := market.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenTickers[ticker] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, the height is set, and all markets are valid and unique.
+func (m *MsgScheduleMarketRemovals) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return err
+	}
+
+	if m.Height == 0 {
+		return fmt.Errorf("height must be set")
+	}
+
+	if len(m.Markets) == 0 {
+		return fmt.Errorf("markets to remove cannot be nil")
+	}
+
+	seenMarkets := make(map[string]struct{}, len(m.Markets))
+	for _, market := range m.Markets {
+		if _, seen := seenMarkets[market]; seen {
+			return fmt.Errorf("duplicate market %s found", market)
+		}
+
+		if _, err := connecttypes.CurrencyPairFromString(market); err != nil {
+			return fmt.Errorf("invalid market pair string: %w", err)
+		}
+
+		seenMarkets[market] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, and the IDs are set and unique.
+func (m *MsgCancelScheduledMarketChanges) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Ids) == 0 {
+		return fmt.Errorf("scheduled market change ids to cancel cannot be nil")
+	}
+
+	seenIDs := make(map[uint64]struct{}, len(m.Ids))
+	for _, id := range m.Ids {
+		if _, seen := seenIDs[id]; seen {
+			return fmt.Errorf("duplicate scheduled market change id %d found", id)
+		}
+
+		seenIDs[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, and the grants are valid and unique.
+func (m *MsgSetMarketAuthorityGrants) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Grants) == 0 {
+		return fmt.Errorf("market authority grants cannot be nil")
+	}
+
+	return ValidateMarketAuthorityGrants(m.Grants)
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer and grantees are valid acc-addresses, and the grantees are unique.
+func (m *MsgRevokeMarketAuthorityGrants) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Grantees) == 0 {
+		return fmt.Errorf("grantees to revoke cannot be nil")
+	}
+
+	seenGrantees := make(map[string]struct{}, len(m.Grantees))
+	for _, grantee := range m.Grantees {
+		if _, seen := seenGrantees[grantee]; seen {
+			return fmt.Errorf("duplicate grantee %s found", grantee)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(grantee); err != nil {
+			return fmt.Errorf("invalid grantee string: %w", err)
+		}
+
+		seenGrantees[grantee] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, and the providers are valid and unique.
+func (m *MsgUpsertProviders) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Providers) == 0 {
+		return fmt.Errorf("providers cannot be nil")
+	}
+
+	return ValidateProviders(m.Providers)
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, and the provider names are valid and unique.
+func (m *MsgRemoveProviders) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Names) == 0 {
+		return fmt.Errorf("providers to remove cannot be nil")
+	}
+
+	seenNames := make(map[string]struct{}, len(m.Names))
+	for _, name := range m.Names {
+		if _, seen := seenNames[name]; seen {
+			return fmt.Errorf("duplicate provider %s found", name)
+		}
+
+		if err := validateProviderName(name); err != nil {
+			return err
+		}
+
+		seenNames[name] = struct{}{}
+	}
+
+	return nil
+}
@@ -577,3 +577,204 @@ func TestValidateBasicMsgRemoveMarkets(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgScheduleMarketUpdates(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgScheduleMarketUpdates
+		expectPass bool
+	}{
+		{
+			"if the Authority is not an acc-address - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:     "invalid",
+				Height:        10,
+				CreateMarkets: []types.Market{btcusdt},
+			},
+			false,
+		},
+		{
+			"if the height is not set - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:     sample.Address(rng),
+				CreateMarkets: []types.Market{btcusdt},
+			},
+			false,
+		},
+		{
+			"if there are no markets - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Height:    10,
+			},
+			false,
+		},
+		{
+			"if a market is both created and updated - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:     sample.Address(rng),
+				Height:        10,
+				CreateMarkets: []types.Market{btcusdt},
+				UpdateMarkets: []types.Market{btcusdt},
+			},
+			false,
+		},
+		{
+			"if a market is invalid - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:     sample.Address(rng),
+				Height:        10,
+				UpdateMarkets: []types.Market{{}},
+			},
+			false,
+		},
+		{
+			"valid message - pass",
+			types.MsgScheduleMarketUpdates{
+				Authority:     sample.Address(rng),
+				Height:        10,
+				CreateMarkets: []types.Market{btcusdt},
+				UpdateMarkets: []types.Market{usdtusd},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgScheduleMarketRemovals(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgScheduleMarketRemovals
+		expectPass bool
+	}{
+		{
+			"if the Admin is not an acc-address - fail",
+			types.MsgScheduleMarketRemovals{
+				Admin:   "invalid",
+				Height:  10,
+				Markets: []string{"USDT/USD"},
+			},
+			false,
+		},
+		{
+			"if the height is not set - fail",
+			types.MsgScheduleMarketRemovals{
+				Admin:   sample.Address(rng),
+				Markets: []string{"USDT/USD"},
+			},
+			false,
+		},
+		{
+			"if a market is repeated - fail",
+			types.MsgScheduleMarketRemovals{
+				Admin:   sample.Address(rng),
+				Height:  10,
+				Markets: []string{"USDT/USD", "USDT/USD"},
+			},
+			false,
+		},
+		{
+			"valid message - pass",
+			types.MsgScheduleMarketRemovals{
+				Admin:   sample.Address(rng),
+				Height:  10,
+				Markets: []string{"USDT/USD", "ETH/USD"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgCancelScheduledMarketChanges(t *testing.T) {
+	rng := sample.Rand()
+
+	msg := types.MsgCancelScheduledMarketChanges{Authority: "invalid", Ids: []uint64{1}}
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.MsgCancelScheduledMarketChanges{Authority: sample.Address(rng)}
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.MsgCancelScheduledMarketChanges{Authority: sample.Address(rng), Ids: []uint64{1, 1}}
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.MsgCancelScheduledMarketChanges{Authority: sample.Address(rng), Ids: []uint64{0, 1}}
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestValidateBasicMsgMarketAuthorityGrants(t *testing.T) {
+	rng := sample.Rand()
+	grant := types.NewMarketAuthorityGrant(sample.Address(rng), []string{"BTC/USD"}, nil, nil, false)
+
+	set := types.MsgSetMarketAuthorityGrants{Authority: "invalid", Grants: []types.MarketAuthorityGrant{grant}}
+	require.Error(t, set.ValidateBasic())
+
+	set = types.MsgSetMarketAuthorityGrants{Authority: sample.Address(rng)}
+	require.Error(t, set.ValidateBasic())
+
+	set = types.MsgSetMarketAuthorityGrants{Authority: sample.Address(rng), Grants: []types.MarketAuthorityGrant{grant, grant}}
+	require.Error(t, set.ValidateBasic())
+
+	set = types.MsgSetMarketAuthorityGrants{Authority: sample.Address(rng), Grants: []types.MarketAuthorityGrant{grant}}
+	require.NoError(t, set.ValidateBasic())
+
+	revoke := types.MsgRevokeMarketAuthorityGrants{Authority: sample.Address(rng), Grantees: []string{"invalid"}}
+	require.Error(t, revoke.ValidateBasic())
+
+	revoke = types.MsgRevokeMarketAuthorityGrants{Authority: sample.Address(rng), Grantees: []string{grant.Grantee, grant.Grantee}}
+	require.Error(t, revoke.ValidateBasic())
+
+	revoke = types.MsgRevokeMarketAuthorityGrants{Authority: sample.Address(rng), Grantees: []string{grant.Grantee}}
+	require.NoError(t, revoke.ValidateBasic())
+}
+
+func TestValidateBasicMsgProviders(t *testing.T) {
+	rng := sample.Rand()
+	provider := types.NewProviderInfo("binance_ws", "[A-Z0-9]+", "")
+
+	upsert := types.MsgUpsertProviders{Authority: "invalid", Providers: []types.ProviderInfo{provider}}
+	require.Error(t, upsert.ValidateBasic())
+
+	upsert = types.MsgUpsertProviders{Authority: sample.Address(rng)}
+	require.Error(t, upsert.ValidateBasic())
+
+	upsert = types.MsgUpsertProviders{Authority: sample.Address(rng), Providers: []types.ProviderInfo{provider, provider}}
+	require.Error(t, upsert.ValidateBasic())
+
+	upsert = types.MsgUpsertProviders{Authority: sample.Address(rng), Providers: []types.ProviderInfo{provider}}
+	require.NoError(t, upsert.ValidateBasic())
+
+	remove := types.MsgRemoveProviders{Authority: sample.Address(rng), Names: []string{"binance_ws "}}
+	require.Error(t, remove.ValidateBasic())
+
+	remove = types.MsgRemoveProviders{Authority: sample.Address(rng), Names: []string{"binance_ws", "binance_ws"}}
+	require.Error(t, remove.ValidateBasic())
+
+	remove = types.MsgRemoveProviders{Authority: sample.Address(rng), Names: []string{"binance_ws"}}
+	require.NoError(t, remove.ValidateBasic())
+}
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/skip-mev/connect/v2/pkg/json"
 )
 
 // DefaultParams returns default marketmap parameters.
@@ -51,5 +53,28 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("invalid marketmap admin string: %w", err)
 	}
 
+	if len(p.TickerMetadataSchema) > MaxMetadataJSONFieldLength {
+		return fmt.Errorf("ticker metadata schema is longer than maximum length of %d", MaxMetadataJSONFieldLength)
+	}
+
+	if _, err := json.ParseSchema(p.TickerMetadataSchema); err != nil {
+		return fmt.Errorf("invalid ticker metadata schema: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateTickerMetadata checks that the metadata JSON of the given ticker conforms to the ticker metadata schema, if
+// one is set.
+func (p *Params) ValidateTickerMetadata(ticker Ticker) error {
+	schema, err := json.ParseSchema(p.TickerMetadataSchema)
+	if err != nil {
+		return fmt.Errorf("invalid ticker metadata schema: %w", err)
+	}
+
+	if err := schema.Validate([]byte(ticker.Metadata_JSON)); err != nil {
+		return fmt.Errorf("invalid metadata json for ticker %s: %w", ticker.String(), err)
+	}
+
 	return nil
 }
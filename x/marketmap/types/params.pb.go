@@ -30,6 +30,9 @@ type Params struct {
 	// Admin is an address that can remove addresses from the MarketAuthorities
 	// list. Only governance can add to the MarketAuthorities or change the Admin.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// TickerMetadataSchema is an optional JSON schema that the metadata JSON of
+	// every ticker must conform to.
+	TickerMetadataSchema string `protobuf:"bytes,3,opt,name=ticker_metadata_schema,json=tickerMetadataSchema,proto3" json:"ticker_metadata_schema,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +82,13 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetTickerMetadataSchema() string {
+	if m != nil {
+		return m.TickerMetadataSchema
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "connect.marketmap.v2.Params")
 }
@@ -86,20 +96,22 @@ func init() {
 func init() { proto.RegisterFile("connect/marketmap/v2/params.proto", fileDescriptor_40e3a88492006139) }
 
 var fileDescriptor_40e3a88492006139 = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
+	// 229 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0xcf, 0xcb,
 	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33,
 	0xd2, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x2a, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x52, 0xf2, 0xe5, 0x62, 0x0b, 0x00, 0xab, 0x12, 0xd2,
-	0xe5, 0x12, 0x82, 0xc8, 0xc4, 0x27, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x64, 0xa6, 0x16,
-	0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x09, 0x42, 0x64, 0x1c, 0x11, 0x12, 0x42, 0x22, 0x5c,
-	0xac, 0x89, 0x29, 0xb9, 0x99, 0x79, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x93,
-	0xd7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa4, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0x67, 0x16, 0xe8, 0xe6, 0xa6, 0x96, 0xe9,
-	0xc3, 0x5c, 0x5d, 0x66, 0xa4, 0x5f, 0x81, 0xe4, 0xf4, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0xbb, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x37, 0x9c, 0xf0, 0x12, 0xdc, 0x00, 0x00,
-	0x00,
+	0x2a, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x52, 0x6a, 0x66, 0xe4, 0x62, 0x0b, 0x00, 0x2b, 0x13,
+	0xd2, 0xe5, 0x12, 0x82, 0x48, 0xc5, 0x27, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x64, 0xa6,
+	0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x09, 0x42, 0x64, 0x1c, 0x11, 0x12, 0x42, 0x22,
+	0x5c, 0xac, 0x89, 0x29, 0xb9, 0x99, 0x79, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e,
+	0x90, 0x09, 0x97, 0x58, 0x49, 0x66, 0x72, 0x76, 0x6a, 0x51, 0x7c, 0x6e, 0x6a, 0x49, 0x62, 0x4a,
+	0x62, 0x49, 0x62, 0x7c, 0x71, 0x72, 0x46, 0x6a, 0x6e, 0xa2, 0x04, 0x33, 0x58, 0x99, 0x08, 0x44,
+	0xd6, 0x17, 0x2a, 0x19, 0x0c, 0x96, 0x73, 0xf2, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
+	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
+	0x39, 0x86, 0x28, 0x83, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2,
+	0xec, 0xcc, 0x02, 0xdd, 0xdc, 0xd4, 0x32, 0x7d, 0x98, 0x67, 0xcb, 0x8c, 0xf4, 0x2b, 0x90, 0x7c,
+	0x5c, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xae, 0x31, 0x60, 0x00, 0xb3, 0xb5, 0x1b,
+	0x22, 0x13, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +134,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TickerMetadataSchema) > 0 {
+		i -= len(m.TickerMetadataSchema)
+		copy(dAtA[i:], m.TickerMetadataSchema)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TickerMetadataSchema)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -168,6 +187,10 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = len(m.TickerMetadataSchema)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -270,6 +293,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickerMetadataSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickerMetadataSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
 )
 
 func TestValidateBasic(t *testing.T) {
@@ -63,6 +64,24 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid ticker metadata schema",
+			params: types.Params{
+				MarketAuthorities:    []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:                authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				TickerMetadataSchema: tickermetadata.DyDxSchema,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid ticker metadata schema",
+			params: types.Params{
+				MarketAuthorities:    []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:                authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				TickerMetadataSchema: `{"type": "object", "unknown": true}`,
+			},
+			expectErr: true,
+		},
 		{
 			name:      "invalid empty params",
 			params:    types.Params{},
@@ -82,3 +101,19 @@ func TestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTickerMetadata(t *testing.T) {
+	params := types.DefaultParams()
+	ticker := types.Ticker{Metadata_JSON: `{"reference_price": -1}`}
+
+	// without a schema any metadata is accepted
+	require.NoError(t, params.ValidateTickerMetadata(ticker))
+
+	params.TickerMetadataSchema = tickermetadata.DyDxSchema
+	require.Error(t, params.ValidateTickerMetadata(ticker))
+
+	bz, err := tickermetadata.MarshalDyDx(tickermetadata.NewDyDx(100, 1000, nil))
+	require.NoError(t, err)
+	ticker.Metadata_JSON = string(bz)
+	require.NoError(t, params.ValidateTickerMetadata(ticker))
+}
@@ -8,8 +8,8 @@ import (
 
 // ValidateBasic performs basic validation on a ProviderConfig.
 func (pc *ProviderConfig) ValidateBasic() error {
-	if len(pc.Name) == 0 {
-		return fmt.Errorf("provider name must not be empty")
+	if err := validateProviderName(pc.Name); err != nil {
+		return err
 	}
 
 	if len(pc.OffChainTicker) == 0 {
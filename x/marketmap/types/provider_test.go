@@ -52,6 +52,13 @@ func TestProviderConfigValidateBasic(t *testing.T) {
 		}
 		require.Error(t, pc.ValidateBasic())
 	})
+	t.Run("name with surrounding whitespace - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "binance_ws ",
+			OffChainTicker: "ticker",
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
 	t.Run("invalid name - fail", func(t *testing.T) {
 		pc := types.ProviderConfig{
 			Name:           "",
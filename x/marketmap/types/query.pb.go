@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,8 +32,19 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MarketMapRequest is the query request for the MarketMap query.
-// It takes no arguments.
 type MarketMapRequest struct {
+	// Pagination defines an optional pagination for the request. If unset, all
+	// matching markets are returned.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// EnabledOnly restricts the results to markets that are enabled.
+	EnabledOnly bool `protobuf:"varint,2,opt,name=enabled_only,json=enabledOnly,proto3" json:"enabled_only,omitempty"`
+	// Provider restricts the results to markets with a provider config for the
+	// named provider.
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Base restricts the results to markets with the given base asset.
+	Base string `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	// Quote restricts the results to markets with the given quote asset.
+	Quote string `protobuf:"bytes,5,opt,name=quote,proto3" json:"quote,omitempty"`
 }
 
 func (m *MarketMapRequest) Reset()         { *m = MarketMapRequest{} }
@@ -68,6 +80,41 @@ func (m *MarketMapRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketMapRequest proto.InternalMessageInfo
 
+func (m *MarketMapRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *MarketMapRequest) GetEnabledOnly() bool {
+	if m != nil {
+		return m.EnabledOnly
+	}
+	return false
+}
+
+func (m *MarketMapRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MarketMapRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MarketMapRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
 // MarketMapResponse is the query response for the MarketMap query.
 type MarketMapResponse struct {
 	// MarketMap defines the global set of market configurations for all providers
@@ -79,6 +126,8 @@ type MarketMapResponse struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// ChainId is the chain identifier for the market map.
 	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *MarketMapResponse) Reset()         { *m = MarketMapResponse{} }
@@ -135,6 +184,13 @@ func (m *MarketMapResponse) GetChainId() string {
 	return ""
 }
 
+func (m *MarketMapResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // MarketRequest is the query request for the Market query.
 // It takes the currency pair of the market as an argument.
 type MarketRequest struct {
@@ -395,608 +451,3941 @@ func (m *LastUpdatedResponse) GetLastUpdated() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MarketMapRequest)(nil), "connect.marketmap.v2.MarketMapRequest")
-	proto.RegisterType((*MarketMapResponse)(nil), "connect.marketmap.v2.MarketMapResponse")
-	proto.RegisterType((*MarketRequest)(nil), "connect.marketmap.v2.MarketRequest")
-	proto.RegisterType((*MarketResponse)(nil), "connect.marketmap.v2.MarketResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "connect.marketmap.v2.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "connect.marketmap.v2.ParamsResponse")
-	proto.RegisterType((*LastUpdatedRequest)(nil), "connect.marketmap.v2.LastUpdatedRequest")
-	proto.RegisterType((*LastUpdatedResponse)(nil), "connect.marketmap.v2.LastUpdatedResponse")
+// ValidateMarketMapUpdateRequest is the request type for the
+// Query/ValidateMarketMapUpdate RPC method.
+type ValidateMarketMapUpdateRequest struct {
+	// Markets is the proposed set of markets to upsert. Markets that do not
+	// exist are created, and existing markets are updated.
+	Markets []Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
 }
 
-func init() { proto.RegisterFile("connect/marketmap/v2/query.proto", fileDescriptor_fc65f1e15c5a0bef) }
-
-var fileDescriptor_fc65f1e15c5a0bef = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x95, 0x10, 0xc8, 0x4b, 0x53, 0xe0, 0xc8, 0x10, 0xa2, 0xc8, 0x49, 0x4c, 0x44, 0x03,
-	0x12, 0x36, 0x32, 0x0b, 0x62, 0x2c, 0x2c, 0x45, 0xa9, 0x54, 0x22, 0xb1, 0x74, 0x89, 0xae, 0xce,
-	0xc9, 0xb5, 0x5a, 0xfb, 0xae, 0xf6, 0x39, 0x22, 0x2b, 0x12, 0x2b, 0x42, 0x42, 0x82, 0xbf, 0xd4,
-	0xb1, 0x12, 0x0b, 0x13, 0x42, 0x09, 0x3f, 0x04, 0xd9, 0x77, 0x76, 0x1d, 0x9a, 0x38, 0xdd, 0x72,
-	0xef, 0xbe, 0xf7, 0x7d, 0xdf, 0x7d, 0xef, 0xc5, 0xd0, 0xb5, 0x99, 0xef, 0x53, 0x5b, 0x98, 0x1e,
-	0x09, 0x4e, 0xa9, 0xf0, 0x08, 0x37, 0xa7, 0x96, 0x79, 0x1e, 0xd1, 0x60, 0x66, 0xf0, 0x80, 0x09,
-	0x86, 0x1b, 0x0a, 0x61, 0x64, 0x08, 0x63, 0x6a, 0xb5, 0x1a, 0x0e, 0x73, 0x58, 0x02, 0x30, 0xe3,
-	0x5f, 0x12, 0xdb, 0x6a, 0x3b, 0x8c, 0x39, 0x67, 0xd4, 0x24, 0xdc, 0x35, 0x89, 0xef, 0x33, 0x41,
-	0x84, 0xcb, 0xfc, 0x50, 0xdd, 0xf6, 0x53, 0x2d, 0x31, 0xe3, 0x34, 0x8c, 0x75, 0xec, 0x28, 0x08,
-	0xa8, 0x6f, 0xcf, 0xc6, 0x9c, 0xb8, 0x81, 0x42, 0xf5, 0x56, 0x3a, 0x92, 0x87, 0x42, 0x08, 0x27,
-	0x01, 0xf1, 0x94, 0x96, 0x8e, 0xe1, 0xfe, 0x41, 0x72, 0x79, 0x40, 0xf8, 0x88, 0x9e, 0x47, 0x34,
-	0x14, 0xfa, 0x0f, 0x04, 0x0f, 0x72, 0xc5, 0x90, 0x33, 0x3f, 0xa4, 0xf8, 0x2d, 0x80, 0xa4, 0x19,
-	0x7b, 0x84, 0x37, 0x51, 0x17, 0x0d, 0x6a, 0x56, 0xc7, 0x58, 0xf5, 0x68, 0x23, 0x6b, 0xde, 0x2b,
-	0x5f, 0xfc, 0xee, 0x94, 0x46, 0x55, 0x2f, 0x2d, 0xe0, 0x1e, 0x6c, 0x9f, 0x91, 0x50, 0x8c, 0x23,
-	0x3e, 0x21, 0x82, 0x4e, 0x9a, 0x5b, 0x5d, 0x34, 0x28, 0x8f, 0x6a, 0x71, 0xed, 0x83, 0x2c, 0xe1,
-	0x47, 0x70, 0xd7, 0x3e, 0x21, 0xae, 0x3f, 0x76, 0x27, 0xcd, 0x5b, 0x5d, 0x34, 0xa8, 0x8e, 0xee,
-	0x24, 0xe7, 0xfd, 0x89, 0x7e, 0x04, 0x75, 0xc9, 0xad, 0xac, 0xe2, 0x7d, 0xa8, 0x2f, 0x65, 0xa3,
-	0x7c, 0x69, 0x99, 0xaf, 0x24, 0xc2, 0xd8, 0xd3, 0x1b, 0x05, 0x3b, 0x24, 0x6e, 0xa0, 0x6c, 0x6d,
-	0xdb, 0xb9, 0x9a, 0x3e, 0x84, 0x9d, 0x94, 0x5b, 0xbd, 0xf8, 0x35, 0x54, 0xa4, 0x71, 0xc5, 0xda,
-	0x2e, 0x7a, 0xad, 0xe2, 0x54, 0x1d, 0xfa, 0x3d, 0xa8, 0x1f, 0x26, 0x39, 0xa7, 0xa1, 0x0e, 0x61,
-	0x27, 0x2d, 0x5c, 0xd1, 0xcb, 0x51, 0x14, 0xd3, 0xcb, 0xae, 0x94, 0x5e, 0x76, 0xe8, 0x0d, 0xc0,
-	0xc3, 0xab, 0xc8, 0x52, 0x8d, 0x57, 0xf0, 0x70, 0xa9, 0xaa, 0x84, 0xfe, 0xcf, 0x1c, 0x5d, 0xcb,
-	0xdc, 0xfa, 0x5e, 0x86, 0xdb, 0xef, 0xe3, 0x65, 0xc6, 0x9f, 0x11, 0x54, 0xb3, 0xf9, 0xe1, 0x27,
-	0x1b, 0x06, 0xac, 0x94, 0x5b, 0xbb, 0x1b, 0x71, 0xd2, 0x8b, 0xbe, 0xfb, 0xe9, 0xe7, 0xdf, 0x6f,
-	0x5b, 0x3d, 0xdc, 0x31, 0x0b, 0xd6, 0xd7, 0x23, 0x1c, 0xcf, 0xa0, 0x22, 0xbb, 0xf1, 0xe3, 0x22,
-	0xee, 0xd4, 0x40, 0xbf, 0x18, 0xa4, 0xd4, 0xfb, 0x89, 0xba, 0x86, 0xdb, 0x45, 0xea, 0xf8, 0x0b,
-	0x82, 0x5a, 0x2e, 0x47, 0x3c, 0x58, 0xcd, 0x7d, 0x7d, 0x00, 0xad, 0xa7, 0x37, 0x40, 0x2a, 0x2b,
-	0xcf, 0x12, 0x2b, 0x7d, 0xac, 0xaf, 0xb6, 0x92, 0x1f, 0x58, 0x9c, 0x85, 0xdc, 0x82, 0x75, 0x59,
-	0x2c, 0xad, 0xda, 0xba, 0x2c, 0x96, 0xd7, 0x6f, 0x53, 0x16, 0x72, 0xd1, 0xf6, 0xde, 0x5d, 0xcc,
-	0x35, 0x74, 0x39, 0xd7, 0xd0, 0x9f, 0xb9, 0x86, 0xbe, 0x2e, 0xb4, 0xd2, 0xe5, 0x42, 0x2b, 0xfd,
-	0x5a, 0x68, 0xa5, 0xa3, 0x17, 0x8e, 0x2b, 0x4e, 0xa2, 0x63, 0xc3, 0x66, 0x9e, 0x19, 0x9e, 0xba,
-	0xfc, 0xb9, 0x47, 0xa7, 0x19, 0xd5, 0xd4, 0x32, 0x3f, 0xe6, 0xf8, 0x92, 0x7f, 0xe1, 0x71, 0x25,
-	0xf9, 0xe4, 0xbc, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x34, 0x1b, 0x3d, 0xb1, 0x4c, 0x05, 0x00,
-	0x00,
+func (m *ValidateMarketMapUpdateRequest) Reset()         { *m = ValidateMarketMapUpdateRequest{} }
+func (m *ValidateMarketMapUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateMarketMapUpdateRequest) ProtoMessage()    {}
+func (*ValidateMarketMapUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{8}
+}
+func (m *ValidateMarketMapUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateMarketMapUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateMarketMapUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateMarketMapUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateMarketMapUpdateRequest.Merge(m, src)
+}
+func (m *ValidateMarketMapUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateMarketMapUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateMarketMapUpdateRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ValidateMarketMapUpdateRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.
-	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
-	// Market returns a market stored in the x/marketmap
-	// module.
-	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
-	// LastUpdated returns the last height the market map was updated at.
-	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
-	// Params returns the current x/marketmap module parameters.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+func (m *ValidateMarketMapUpdateRequest) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// MarketUpdateResult is the result of validating a single market in a
+// proposed market map update.
+type MarketUpdateResult struct {
+	// Ticker is the string representation of the market's ticker.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Created is true if the market would be created, and false if an existing
+	// market would be updated.
+	Created bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Error is the validation error of the market, empty if the market is
+	// valid.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// CurrencyPairId is the ID of the market's currency pair in x/oracle
+	// resulting from the update. It is only set if the market is valid and the
+	// ID is known.
+	CurrencyPairId uint64 `protobuf:"varint,4,opt,name=currency_pair_id,json=currencyPairId,proto3" json:"currency_pair_id,omitempty"`
+	// HasCurrencyPairId is true if CurrencyPairId is set.
+	HasCurrencyPairId bool `protobuf:"varint,5,opt,name=has_currency_pair_id,json=hasCurrencyPairId,proto3" json:"has_currency_pair_id,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *MarketUpdateResult) Reset()         { *m = MarketUpdateResult{} }
+func (m *MarketUpdateResult) String() string { return proto.CompactTextString(m) }
+func (*MarketUpdateResult) ProtoMessage()    {}
+func (*MarketUpdateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{9}
+}
+func (m *MarketUpdateResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketUpdateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketUpdateResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketUpdateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketUpdateResult.Merge(m, src)
+}
+func (m *MarketUpdateResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketUpdateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketUpdateResult.DiscardUnknown(m)
 }
 
-func (c *queryClient) MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error) {
-	out := new(MarketMapResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMap", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketUpdateResult proto.InternalMessageInfo
+
+func (m *MarketUpdateResult) GetTicker() string {
+	if m != nil {
+		return m.Ticker
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error) {
-	out := new(MarketResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Market", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketUpdateResult) GetCreated() bool {
+	if m != nil {
+		return m.Created
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error) {
-	out := new(LastUpdatedResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/LastUpdated", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketUpdateResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketUpdateResult) GetCurrencyPairId() uint64 {
+	if m != nil {
+		return m.CurrencyPairId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.
-	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
-	// Market returns a market stored in the x/marketmap
-	// module.
-	Market(context.Context, *MarketRequest) (*MarketResponse, error)
-	// LastUpdated returns the last height the market map was updated at.
-	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
-	// Params returns the current x/marketmap module parameters.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+func (m *MarketUpdateResult) GetHasCurrencyPairId() bool {
+	if m != nil {
+		return m.HasCurrencyPairId
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// ValidateMarketMapUpdateResponse is the response type for the
+// Query/ValidateMarketMapUpdate RPC method.
+type ValidateMarketMapUpdateResponse struct {
+	// Results are the validation results of each market, in the order of the
+	// request.
+	Results []MarketUpdateResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// Valid is true if every market in the update is valid.
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func (*UnimplementedQueryServer) MarketMap(ctx context.Context, req *MarketMapRequest) (*MarketMapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+func (m *ValidateMarketMapUpdateResponse) Reset()         { *m = ValidateMarketMapUpdateResponse{} }
+func (m *ValidateMarketMapUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateMarketMapUpdateResponse) ProtoMessage()    {}
+func (*ValidateMarketMapUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{10}
 }
-func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest) (*MarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+func (m *ValidateMarketMapUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
+func (m *ValidateMarketMapUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateMarketMapUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *ValidateMarketMapUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateMarketMapUpdateResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *ValidateMarketMapUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateMarketMapUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateMarketMapUpdateResponse.DiscardUnknown(m)
 }
 
-func _Query_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketMapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MarketMap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/MarketMap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MarketMap(ctx, req.(*MarketMapRequest))
+var xxx_messageInfo_ValidateMarketMapUpdateResponse proto.InternalMessageInfo
+
+func (m *ValidateMarketMapUpdateResponse) GetResults() []MarketUpdateResult {
+	if m != nil {
+		return m.Results
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Market(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/Market",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Market(ctx, req.(*MarketRequest))
+func (m *ValidateMarketMapUpdateResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastUpdatedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastUpdated(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/LastUpdated",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastUpdated(ctx, req.(*LastUpdatedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScheduledMarketChangesRequest is the request type for the
+// Query/ScheduledMarketChanges RPC method.
+type ScheduledMarketChangesRequest struct {
+	// Pagination defines an optional pagination for the request. If unset, all
+	// matching changes are returned.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Ticker restricts the results to changes to the given market.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// MaxHeight restricts the results to changes applied at or before the given
+	// height. Zero matches every change.
+	MaxHeight uint64 `protobuf:"varint,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/Params",
+func (m *ScheduledMarketChangesRequest) Reset()         { *m = ScheduledMarketChangesRequest{} }
+func (m *ScheduledMarketChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketChangesRequest) ProtoMessage()    {}
+func (*ScheduledMarketChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{11}
+}
+func (m *ScheduledMarketChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMarketChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+}
+func (m *ScheduledMarketChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketChangesRequest.Merge(m, src)
+}
+func (m *ScheduledMarketChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMarketChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMarketChangesRequest proto.InternalMessageInfo
+
+func (m *ScheduledMarketChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.marketmap.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MarketMap",
-			Handler:    _Query_MarketMap_Handler,
-		},
-		{
-			MethodName: "Market",
-			Handler:    _Query_Market_Handler,
-		},
-		{
-			MethodName: "LastUpdated",
-			Handler:    _Query_LastUpdated_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/marketmap/v2/query.proto",
+func (m *ScheduledMarketChangesRequest) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
 }
 
-func (m *MarketMapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledMarketChangesRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScheduledMarketChangesResponse is the response type for the
+// Query/ScheduledMarketChanges RPC method.
+type ScheduledMarketChangesResponse struct {
+	// ScheduledMarketChanges are the pending changes.
+	ScheduledMarketChanges []ScheduledMarketChange `protobuf:"bytes,1,rep,name=scheduled_market_changes,json=scheduledMarketChanges,proto3" json:"scheduled_market_changes"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ScheduledMarketChangesResponse) Reset()         { *m = ScheduledMarketChangesResponse{} }
+func (m *ScheduledMarketChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketChangesResponse) ProtoMessage()    {}
+func (*ScheduledMarketChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{12}
 }
-
-func (m *MarketMapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledMarketChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMarketChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduledMarketChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketChangesResponse.Merge(m, src)
+}
+func (m *ScheduledMarketChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMarketChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketChangesResponse.DiscardUnknown(m)
 }
 
-func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LastUpdated != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_ScheduledMarketChangesResponse proto.InternalMessageInfo
+
+func (m *ScheduledMarketChangesResponse) GetScheduledMarketChanges() []ScheduledMarketChange {
+	if m != nil {
+		return m.ScheduledMarketChanges
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledMarketChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketAuthorityGrantsRequest is the request type for the
+// Query/MarketAuthorityGrants RPC method.
+type MarketAuthorityGrantsRequest struct {
+	// Grantee restricts the results to the grant of the given address.
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (m *MarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *MarketAuthorityGrantsRequest) Reset()         { *m = MarketAuthorityGrantsRequest{} }
+func (m *MarketAuthorityGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityGrantsRequest) ProtoMessage()    {}
+func (*MarketAuthorityGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{13}
+}
+func (m *MarketAuthorityGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MarketAuthorityGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityGrantsRequest.Merge(m, src)
+}
+func (m *MarketAuthorityGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityGrantsRequest.DiscardUnknown(m)
 }
 
-func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketAuthorityGrantsRequest proto.InternalMessageInfo
+
+func (m *MarketAuthorityGrantsRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketAuthorityGrantsResponse is the response type for the
+// Query/MarketAuthorityGrants RPC method.
+type MarketAuthorityGrantsResponse struct {
+	// Grants are the scoped market authority grants.
+	Grants []MarketAuthorityGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+func (m *MarketAuthorityGrantsResponse) Reset()         { *m = MarketAuthorityGrantsResponse{} }
+func (m *MarketAuthorityGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityGrantsResponse) ProtoMessage()    {}
+func (*MarketAuthorityGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{14}
+}
+func (m *MarketAuthorityGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MarketAuthorityGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityGrantsResponse.Merge(m, src)
 }
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketAuthorityGrantsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarketAuthorityGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityGrantsResponse.DiscardUnknown(m)
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketAuthorityGrantsResponse proto.InternalMessageInfo
+
+func (m *MarketAuthorityGrantsResponse) GetGrants() []MarketAuthorityGrant {
+	if m != nil {
+		return m.Grants
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketHistoryRequest is the request type for the Query/MarketHistory RPC
+// method.
+type MarketHistoryRequest struct {
+	// Pagination defines an optional pagination for the request. If unset, all
+	// matching changes are returned.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Ticker restricts the results to the changes of the given market.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MarketHistoryRequest) Reset()         { *m = MarketHistoryRequest{} }
+func (m *MarketHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketHistoryRequest) ProtoMessage()    {}
+func (*MarketHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{15}
+}
+func (m *MarketHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MarketHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHistoryRequest.Merge(m, src)
+}
+func (m *MarketHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *LastUpdatedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketHistoryRequest proto.InternalMessageInfo
+
+func (m *MarketHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LastUpdatedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketHistoryRequest) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
 }
 
-func (m *LastUpdatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MarketHistoryResponse is the response type for the Query/MarketHistory RPC
+// method.
+type MarketHistoryResponse struct {
+	// Changes are the recorded market changes.
+	Changes []MarketChangeRecord `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *LastUpdatedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketHistoryResponse) Reset()         { *m = MarketHistoryResponse{} }
+func (m *MarketHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketHistoryResponse) ProtoMessage()    {}
+func (*MarketHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{16}
+}
+func (m *MarketHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MarketHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHistoryResponse.Merge(m, src)
+}
+func (m *MarketHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *LastUpdatedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MarketHistoryResponse proto.InternalMessageInfo
+
+func (m *MarketHistoryResponse) GetChanges() []MarketChangeRecord {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
 
-func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdated != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x8
+func (m *MarketHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// MarketVersionRequest is the request type for the Query/MarketVersion RPC
+// method.
+type MarketVersionRequest struct {
+	// Ticker is the ticker string of the market.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Version is the version of the market.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MarketVersionRequest) Reset()         { *m = MarketVersionRequest{} }
+func (m *MarketVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketVersionRequest) ProtoMessage()    {}
+func (*MarketVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{17}
+}
+func (m *MarketVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MarketMapRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MarketVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketVersionRequest.Merge(m, src)
+}
+func (m *MarketVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketVersionRequest proto.InternalMessageInfo
+
+func (m *MarketVersionRequest) GetTicker() string {
+	if m != nil {
+		return m.Ticker
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MarketMapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MarketVersionRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	var l int
-	_ = l
-	l = m.MarketMap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LastUpdated != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdated))
+	return 0
+}
+
+// MarketVersionResponse is the response type for the Query/MarketVersion RPC
+// method.
+type MarketVersionResponse struct {
+	// Change is the recorded change that produced the version.
+	Change MarketChangeRecord `protobuf:"bytes,1,opt,name=change,proto3" json:"change"`
+}
+
+func (m *MarketVersionResponse) Reset()         { *m = MarketVersionResponse{} }
+func (m *MarketVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketVersionResponse) ProtoMessage()    {}
+func (*MarketVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{18}
+}
+func (m *MarketVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *MarketVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketVersionResponse.Merge(m, src)
+}
+func (m *MarketVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketVersionResponse proto.InternalMessageInfo
+
+func (m *MarketVersionResponse) GetChange() MarketChangeRecord {
+	if m != nil {
+		return m.Change
 	}
-	return n
+	return MarketChangeRecord{}
 }
 
-func (m *MarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// ProvidersRequest is the request type for the Query/Providers RPC method.
+type ProvidersRequest struct {
+	// Name restricts the results to the provider with the given name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *ProvidersRequest) Reset()         { *m = ProvidersRequest{} }
+func (m *ProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*ProvidersRequest) ProtoMessage()    {}
+func (*ProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{19}
+}
+func (m *ProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *ProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvidersRequest.Merge(m, src)
+}
+func (m *ProvidersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvidersRequest.DiscardUnknown(m)
 }
 
-func (m *MarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ProvidersRequest proto.InternalMessageInfo
+
+func (m *ProvidersRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// ProvidersResponse is the response type for the Query/Providers RPC method.
+type ProvidersResponse struct {
+	// Providers are the registered providers, ordered by name.
+	Providers []ProviderInfo `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers"`
+}
+
+func (m *ProvidersResponse) Reset()         { *m = ProvidersResponse{} }
+func (m *ProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*ProvidersResponse) ProtoMessage()    {}
+func (*ProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{20}
+}
+func (m *ProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *ProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvidersResponse.Merge(m, src)
+}
+func (m *ProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ProvidersResponse proto.InternalMessageInfo
+
+func (m *ProvidersResponse) GetProviders() []ProviderInfo {
+	if m != nil {
+		return m.Providers
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *LastUpdatedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*MarketMapRequest)(nil), "connect.marketmap.v2.MarketMapRequest")
+	proto.RegisterType((*MarketMapResponse)(nil), "connect.marketmap.v2.MarketMapResponse")
+	proto.RegisterType((*MarketRequest)(nil), "connect.marketmap.v2.MarketRequest")
+	proto.RegisterType((*MarketResponse)(nil), "connect.marketmap.v2.MarketResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "connect.marketmap.v2.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "connect.marketmap.v2.ParamsResponse")
+	proto.RegisterType((*LastUpdatedRequest)(nil), "connect.marketmap.v2.LastUpdatedRequest")
+	proto.RegisterType((*LastUpdatedResponse)(nil), "connect.marketmap.v2.LastUpdatedResponse")
+	proto.RegisterType((*ValidateMarketMapUpdateRequest)(nil), "connect.marketmap.v2.ValidateMarketMapUpdateRequest")
+	proto.RegisterType((*MarketUpdateResult)(nil), "connect.marketmap.v2.MarketUpdateResult")
+	proto.RegisterType((*ValidateMarketMapUpdateResponse)(nil), "connect.marketmap.v2.ValidateMarketMapUpdateResponse")
+	proto.RegisterType((*ScheduledMarketChangesRequest)(nil), "connect.marketmap.v2.ScheduledMarketChangesRequest")
+	proto.RegisterType((*ScheduledMarketChangesResponse)(nil), "connect.marketmap.v2.ScheduledMarketChangesResponse")
+	proto.RegisterType((*MarketAuthorityGrantsRequest)(nil), "connect.marketmap.v2.MarketAuthorityGrantsRequest")
+	proto.RegisterType((*MarketAuthorityGrantsResponse)(nil), "connect.marketmap.v2.MarketAuthorityGrantsResponse")
+	proto.RegisterType((*MarketHistoryRequest)(nil), "connect.marketmap.v2.MarketHistoryRequest")
+	proto.RegisterType((*MarketHistoryResponse)(nil), "connect.marketmap.v2.MarketHistoryResponse")
+	proto.RegisterType((*MarketVersionRequest)(nil), "connect.marketmap.v2.MarketVersionRequest")
+	proto.RegisterType((*MarketVersionResponse)(nil), "connect.marketmap.v2.MarketVersionResponse")
+	proto.RegisterType((*ProvidersRequest)(nil), "connect.marketmap.v2.ProvidersRequest")
+	proto.RegisterType((*ProvidersResponse)(nil), "connect.marketmap.v2.ProvidersResponse")
+}
+
+func init() { proto.RegisterFile("connect/marketmap/v2/query.proto", fileDescriptor_fc65f1e15c5a0bef) }
+
+var fileDescriptor_fc65f1e15c5a0bef = []byte{
+	// 1280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa6, 0x8e, 0x93, 0xbc, 0x34, 0xa5, 0x1d, 0xdc, 0x60, 0xac, 0xc4, 0x49, 0xb6, 0x56,
+	0x12, 0x12, 0xea, 0x4d, 0x9d, 0x06, 0x55, 0x85, 0x0b, 0x0d, 0x6a, 0x13, 0xd4, 0x8a, 0x60, 0x44,
+	0x0f, 0x45, 0x62, 0x35, 0x59, 0x0f, 0xf6, 0x2a, 0xf6, 0xce, 0x66, 0x77, 0x6d, 0xc5, 0x47, 0x90,
+	0xb8, 0x56, 0x48, 0x48, 0x1c, 0xf9, 0x01, 0xfc, 0x81, 0x1e, 0x38, 0xf6, 0xd2, 0x63, 0x25, 0x24,
+	0xc4, 0x09, 0x55, 0x09, 0x3f, 0x04, 0xed, 0xcc, 0x9b, 0xb5, 0xd7, 0x59, 0x6f, 0x6c, 0x54, 0x71,
+	0xdb, 0x99, 0xfd, 0xde, 0x7b, 0xdf, 0xfb, 0xe6, 0xed, 0x7b, 0xb3, 0xb0, 0x62, 0x71, 0xc7, 0x61,
+	0x56, 0x60, 0xb4, 0xa8, 0x77, 0xcc, 0x82, 0x16, 0x75, 0x8d, 0x4e, 0xc5, 0x38, 0x69, 0x33, 0xaf,
+	0x5b, 0x76, 0x3d, 0x1e, 0x70, 0x92, 0x43, 0x44, 0x39, 0x42, 0x94, 0x3b, 0x95, 0x42, 0xae, 0xce,
+	0xeb, 0x5c, 0x00, 0x8c, 0xf0, 0x49, 0x62, 0x0b, 0x8b, 0x75, 0xce, 0xeb, 0x4d, 0x66, 0x50, 0xd7,
+	0x36, 0xa8, 0xe3, 0xf0, 0x80, 0x06, 0x36, 0x77, 0x7c, 0x7c, 0xbb, 0x69, 0x71, 0xbf, 0xc5, 0x7d,
+	0xe3, 0x88, 0xfa, 0x4c, 0x86, 0x30, 0x3a, 0x77, 0x8e, 0x58, 0x40, 0xef, 0x18, 0x2e, 0xad, 0xdb,
+	0x8e, 0x00, 0x23, 0xb6, 0xa4, 0x78, 0x05, 0x5d, 0x97, 0xf9, 0x21, 0x27, 0xab, 0xed, 0x79, 0xcc,
+	0xb1, 0xba, 0xa6, 0x4b, 0x6d, 0x0f, 0x51, 0xab, 0x89, 0xec, 0xe5, 0x22, 0x15, 0xe2, 0x52, 0x8f,
+	0xb6, 0x14, 0xaf, 0x5b, 0x89, 0x10, 0xdf, 0x6a, 0xb0, 0x5a, 0xbb, 0xc9, 0x06, 0x09, 0xc5, 0x40,
+	0xb4, 0x1d, 0x34, 0xb8, 0x67, 0x07, 0x28, 0x56, 0x41, 0x4f, 0x44, 0x35, 0x6c, 0x3f, 0xe0, 0x5e,
+	0x37, 0x35, 0x9c, 0xc7, 0xea, 0xb6, 0x1f, 0x28, 0x90, 0xfe, 0x52, 0x83, 0xeb, 0x4f, 0xc4, 0xfb,
+	0x27, 0xd4, 0xad, 0xb2, 0x93, 0x36, 0xf3, 0x03, 0xf2, 0x10, 0xa0, 0x27, 0x54, 0x5e, 0x5b, 0xd1,
+	0x36, 0xe6, 0x2a, 0x6b, 0x65, 0xa9, 0x6a, 0x39, 0x54, 0xb5, 0x2c, 0x0f, 0x0e, 0x55, 0x2d, 0x1f,
+	0xd2, 0x3a, 0x43, 0xdb, 0x6a, 0x9f, 0x25, 0x59, 0x85, 0xab, 0xcc, 0xa1, 0x47, 0x4d, 0x56, 0x33,
+	0xb9, 0xd3, 0xec, 0xe6, 0x27, 0x57, 0xb4, 0x8d, 0x99, 0xea, 0x1c, 0xee, 0x7d, 0xe1, 0x34, 0xbb,
+	0xa4, 0x00, 0x33, 0xae, 0xc7, 0x3b, 0x76, 0x8d, 0x79, 0xf9, 0x2b, 0x2b, 0xda, 0xc6, 0x6c, 0x35,
+	0x5a, 0x13, 0x02, 0x99, 0x30, 0x58, 0x3e, 0x23, 0xf6, 0xc5, 0x33, 0xc9, 0xc1, 0xd4, 0x49, 0x9b,
+	0x07, 0x2c, 0x3f, 0x25, 0x36, 0xe5, 0x42, 0x7f, 0xa3, 0xc1, 0x8d, 0xbe, 0x2c, 0x7c, 0x97, 0x3b,
+	0x3e, 0x23, 0x9f, 0x01, 0xc8, 0xd4, 0xcd, 0x16, 0x75, 0x31, 0x8d, 0xe5, 0x72, 0x52, 0x99, 0x95,
+	0x23, 0xe3, 0x07, 0x99, 0x57, 0x7f, 0x2f, 0x4f, 0x54, 0x67, 0x5b, 0x6a, 0x23, 0x4c, 0xa2, 0x49,
+	0xfd, 0xc0, 0x6c, 0xbb, 0x35, 0x1a, 0xb0, 0x9a, 0x48, 0x22, 0x53, 0x9d, 0x0b, 0xf7, 0xbe, 0x96,
+	0x5b, 0xe4, 0x7d, 0x98, 0xb1, 0x1a, 0xd4, 0x76, 0x4c, 0xbb, 0x86, 0x49, 0x4c, 0x8b, 0xf5, 0x41,
+	0x8d, 0x3c, 0x8a, 0x49, 0x99, 0x11, 0x1c, 0xd6, 0x2f, 0x95, 0x52, 0x26, 0xd0, 0xaf, 0xa5, 0xfe,
+	0x0c, 0xe6, 0x25, 0x49, 0x75, 0x48, 0x07, 0x30, 0x1f, 0x2b, 0x55, 0x4c, 0xb0, 0x18, 0x25, 0x28,
+	0x2a, 0x3a, 0x4c, 0x6e, 0x0f, 0x61, 0x87, 0xd4, 0xf6, 0x30, 0xbf, 0xab, 0x56, 0xdf, 0x9e, 0xfe,
+	0x18, 0xae, 0x29, 0xdf, 0x28, 0xdd, 0x7d, 0xc8, 0x4a, 0x05, 0xd0, 0xeb, 0x62, 0x9a, 0x6c, 0xe8,
+	0x13, 0x2d, 0xf4, 0x77, 0x60, 0xfe, 0x50, 0x94, 0x3d, 0x32, 0x0d, 0xdd, 0xab, 0x8d, 0x9e, 0x7b,
+	0xf9, 0x65, 0xa4, 0xbb, 0x97, 0x56, 0xca, 0xbd, 0xb4, 0xd0, 0x73, 0x40, 0x1e, 0xf7, 0xb4, 0x57,
+	0x31, 0xee, 0xc1, 0xbb, 0xb1, 0x5d, 0x0c, 0x34, 0x78, 0x78, 0xda, 0x85, 0xc3, 0xd3, 0xbf, 0x85,
+	0xe2, 0x53, 0xda, 0xb4, 0xc3, 0x45, 0x54, 0x05, 0xf2, 0x9d, 0x52, 0xfa, 0x13, 0x98, 0x96, 0xb4,
+	0x42, 0xba, 0x57, 0x46, 0x54, 0x43, 0x99, 0xe8, 0x2f, 0x34, 0x20, 0xf2, 0x8d, 0xf2, 0xea, 0xb7,
+	0x9b, 0x01, 0x59, 0x80, 0x6c, 0x60, 0x5b, 0xc7, 0x4c, 0x9e, 0xdb, 0x6c, 0x15, 0x57, 0x24, 0x0f,
+	0xd3, 0x96, 0xc7, 0xa2, 0x4a, 0x9b, 0xa9, 0xaa, 0x65, 0x58, 0xfa, 0xcc, 0xf3, 0xb8, 0xfa, 0x4e,
+	0xe4, 0x82, 0x6c, 0xc0, 0xf5, 0x58, 0x19, 0x84, 0x35, 0x98, 0x11, 0x59, 0x5e, 0xeb, 0x3f, 0xe3,
+	0x83, 0x1a, 0x31, 0x20, 0xd7, 0xa0, 0xbe, 0x79, 0x01, 0x3d, 0x25, 0xc2, 0xdc, 0x68, 0x50, 0x7f,
+	0x2f, 0x66, 0xa0, 0x7f, 0xaf, 0xc1, 0xf2, 0x50, 0x69, 0x50, 0xe0, 0x7d, 0x98, 0xf6, 0x44, 0x42,
+	0x4a, 0x9b, 0x8d, 0x34, 0x6d, 0xfa, 0x15, 0x50, 0x3a, 0xa1, 0x79, 0x98, 0x5e, 0x27, 0x0c, 0x86,
+	0x69, 0xcb, 0x85, 0xfe, 0xab, 0x06, 0x4b, 0x5f, 0x61, 0x87, 0xac, 0x49, 0x27, 0x7b, 0x0d, 0xea,
+	0xd4, 0x99, 0xff, 0xb6, 0x9b, 0x55, 0xef, 0x40, 0x26, 0x63, 0x07, 0xb2, 0x14, 0x76, 0x91, 0x53,
+	0xb3, 0xc1, 0xec, 0x7a, 0x23, 0x10, 0xda, 0x67, 0xc2, 0xf6, 0x70, 0xba, 0x2f, 0x36, 0xf4, 0x3f,
+	0x35, 0x28, 0x0e, 0x23, 0x88, 0x1a, 0x1d, 0x43, 0x5e, 0x35, 0xf9, 0x9a, 0x89, 0x1d, 0xc9, 0x92,
+	0x18, 0x14, 0x6d, 0x2b, 0x59, 0xb4, 0x44, 0xbf, 0xa8, 0xdb, 0x82, 0x9f, 0x18, 0x74, 0xa0, 0xe1,
+	0x4c, 0xfe, 0xf7, 0x86, 0x73, 0x0f, 0x16, 0xa5, 0xe7, 0x4f, 0xd5, 0xec, 0x79, 0xe4, 0x51, 0x27,
+	0x88, 0x74, 0xcf, 0xc3, 0x74, 0x3d, 0xdc, 0x60, 0x0c, 0x2b, 0x58, 0x2d, 0x75, 0x1b, 0x96, 0x86,
+	0x58, 0x46, 0x45, 0x93, 0x15, 0x58, 0x95, 0xfe, 0x66, 0x5a, 0xcd, 0xc4, 0x9d, 0xa8, 0x66, 0x20,
+	0xed, 0xf5, 0x0e, 0xe4, 0x24, 0x6a, 0x5f, 0x8e, 0xbe, 0xff, 0xa9, 0x28, 0xf4, 0xdf, 0x34, 0xb8,
+	0x39, 0x10, 0xb8, 0xf7, 0x41, 0xc4, 0xcf, 0x36, 0xf5, 0x83, 0x90, 0xa7, 0x56, 0x65, 0x16, 0xf7,
+	0x6a, 0xea, 0x83, 0xb0, 0xde, 0xf6, 0x49, 0xee, 0x2b, 0x91, 0x9e, 0x32, 0xcf, 0xb7, 0xb9, 0xa3,
+	0x44, 0x4a, 0x69, 0x41, 0x1d, 0x89, 0xc4, 0x61, 0xa7, 0x96, 0xba, 0x09, 0x37, 0x07, 0x3c, 0x61,
+	0xd6, 0x0f, 0x21, 0x2b, 0x69, 0xa3, 0xd6, 0xe3, 0x26, 0x8d, 0xd6, 0xfa, 0x1a, 0x5c, 0x3f, 0xc4,
+	0xf1, 0x1f, 0x15, 0x1a, 0x81, 0x8c, 0x43, 0x5b, 0xaa, 0xca, 0xc4, 0xb3, 0xfe, 0x0d, 0xdc, 0xe8,
+	0xc3, 0x45, 0x24, 0x66, 0xd5, 0xdd, 0x41, 0x89, 0xaf, 0x0f, 0x19, 0x2c, 0x08, 0x3b, 0x70, 0xbe,
+	0xe3, 0x6a, 0xe2, 0x47, 0xa6, 0x95, 0xe7, 0x57, 0x61, 0xea, 0xcb, 0x50, 0x5a, 0xf2, 0xa3, 0x06,
+	0xb3, 0x51, 0xe7, 0x23, 0x6b, 0x97, 0xdc, 0x1d, 0x90, 0x70, 0x61, 0xfd, 0x52, 0x9c, 0x24, 0xac,
+	0xaf, 0xff, 0xf0, 0xc7, 0x3f, 0x3f, 0x4f, 0xae, 0x92, 0x65, 0x23, 0xe5, 0x7e, 0xd9, 0xa2, 0x2e,
+	0xe9, 0x42, 0x56, 0x5a, 0x93, 0x5b, 0x69, 0xbe, 0x15, 0x81, 0x52, 0x3a, 0x08, 0xa3, 0x97, 0x44,
+	0xf4, 0x22, 0x59, 0x4c, 0x8b, 0x4e, 0x9e, 0x6b, 0x30, 0xd7, 0x37, 0x59, 0xc9, 0x90, 0x93, 0xbd,
+	0x38, 0x92, 0x0b, 0x1f, 0x8c, 0x80, 0x44, 0x2a, 0x9b, 0x82, 0x4a, 0x89, 0xe8, 0xc9, 0x54, 0xfa,
+	0x47, 0x78, 0xa8, 0x85, 0xbc, 0x17, 0x0c, 0xd3, 0x22, 0x76, 0xf9, 0x28, 0x94, 0xd2, 0x41, 0xa3,
+	0x69, 0x21, 0xaf, 0x1e, 0xe4, 0xa5, 0x06, 0xef, 0x0d, 0x19, 0x88, 0xe4, 0x6e, 0x72, 0x9c, 0xf4,
+	0xab, 0x45, 0x61, 0x77, 0x4c, 0x2b, 0xa4, 0xfb, 0xb1, 0xa0, 0xbb, 0x7b, 0x5f, 0xdb, 0xd4, 0xb7,
+	0x93, 0x19, 0x77, 0xd0, 0x83, 0xd9, 0xbb, 0x01, 0xa3, 0x82, 0xe4, 0x77, 0x0d, 0x16, 0x92, 0x27,
+	0x16, 0xd9, 0x19, 0x63, 0x0e, 0x45, 0x0a, 0xdf, 0x1d, 0xcf, 0x08, 0x53, 0xf8, 0x48, 0xa4, 0xb0,
+	0x4d, 0xca, 0x46, 0xea, 0x5f, 0xd1, 0xe0, 0xc0, 0x24, 0x2f, 0xa2, 0xce, 0x3b, 0x30, 0x5d, 0x48,
+	0x65, 0xf4, 0x29, 0x12, 0x71, 0xdf, 0x19, 0xcb, 0x06, 0xa9, 0xef, 0x0a, 0xea, 0x06, 0xb9, 0x9d,
+	0xf6, 0xe1, 0x98, 0xd1, 0x2f, 0x9b, 0x29, 0x67, 0x15, 0xf9, 0x45, 0x83, 0xf9, 0xd8, 0xcc, 0x20,
+	0xa9, 0x73, 0x2f, 0x3e, 0xd1, 0x0a, 0x5b, 0x23, 0x61, 0x91, 0xe1, 0x87, 0x82, 0xe1, 0x1a, 0x29,
+	0xa5, 0x32, 0xc4, 0xdf, 0xc5, 0x3e, 0x62, 0xd8, 0xd6, 0xd3, 0x89, 0xc5, 0xa7, 0x48, 0x61, 0x6b,
+	0x24, 0xec, 0x58, 0xc4, 0x70, 0xdc, 0x88, 0xf6, 0x1b, 0xb5, 0xf9, 0x61, 0xed, 0x77, 0x70, 0x5e,
+	0x14, 0xd6, 0x2f, 0xc5, 0x8d, 0xd6, 0x7e, 0xa3, 0x81, 0xf0, 0xe0, 0xf3, 0x57, 0x67, 0x45, 0xed,
+	0xf5, 0x59, 0x51, 0x7b, 0x73, 0x56, 0xd4, 0x7e, 0x3a, 0x2f, 0x4e, 0xbc, 0x3e, 0x2f, 0x4e, 0xfc,
+	0x75, 0x5e, 0x9c, 0x78, 0xb6, 0x5d, 0xb7, 0x83, 0x46, 0xfb, 0xa8, 0x6c, 0xf1, 0x96, 0xe1, 0x1f,
+	0xdb, 0xee, 0xed, 0x16, 0xeb, 0x44, 0xde, 0x3a, 0x15, 0xe3, 0xb4, 0xcf, 0xa5, 0xf8, 0x1f, 0x3b,
+	0xca, 0x8a, 0xff, 0xee, 0x9d, 0x7f, 0x07, 0x00, 0x37, 0xbe, 0xe7, 0x52, 0x11, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MarketMap returns the market map stored in the x/marketmap module,
+	// optionally filtered and paginated.
+	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
+	// Market returns a market stored in the x/marketmap
+	// module.
+	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
+	// LastUpdated returns the last height the market map was updated at.
+	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
+	// Params returns the current x/marketmap module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// ValidateMarketMapUpdate applies a proposed set of market upserts to a
+	// cached copy of state, and returns the validation result of each market
+	// without committing any changes.
+	ValidateMarketMapUpdate(ctx context.Context, in *ValidateMarketMapUpdateRequest, opts ...grpc.CallOption) (*ValidateMarketMapUpdateResponse, error)
+	// ScheduledMarketChanges returns the pending scheduled market changes,
+	// ordered by the height they are applied at.
+	ScheduledMarketChanges(ctx context.Context, in *ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*ScheduledMarketChangesResponse, error)
+	// MarketAuthorityGrants returns the scoped market authority grants.
+	MarketAuthorityGrants(ctx context.Context, in *MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*MarketAuthorityGrantsResponse, error)
+	// MarketHistory returns the change log of a market, or of every market,
+	// ordered by ticker and then by version.
+	MarketHistory(ctx context.Context, in *MarketHistoryRequest, opts ...grpc.CallOption) (*MarketHistoryResponse, error)
+	// MarketVersion returns the change that produced a specific version of a
+	// market, along with the market at that version.
+	MarketVersion(ctx context.Context, in *MarketVersionRequest, opts ...grpc.CallOption) (*MarketVersionResponse, error)
+	// Providers returns the registry of known providers.
+	Providers(ctx context.Context, in *ProvidersRequest, opts ...grpc.CallOption) (*ProvidersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error) {
+	out := new(MarketMapResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error) {
+	out := new(MarketResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Market", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error) {
+	out := new(LastUpdatedResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/LastUpdated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateMarketMapUpdate(ctx context.Context, in *ValidateMarketMapUpdateRequest, opts ...grpc.CallOption) (*ValidateMarketMapUpdateResponse, error) {
+	out := new(ValidateMarketMapUpdateResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/ValidateMarketMapUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMarketChanges(ctx context.Context, in *ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*ScheduledMarketChangesResponse, error) {
+	out := new(ScheduledMarketChangesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/ScheduledMarketChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketAuthorityGrants(ctx context.Context, in *MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*MarketAuthorityGrantsResponse, error) {
+	out := new(MarketAuthorityGrantsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketAuthorityGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketHistory(ctx context.Context, in *MarketHistoryRequest, opts ...grpc.CallOption) (*MarketHistoryResponse, error) {
+	out := new(MarketHistoryResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketVersion(ctx context.Context, in *MarketVersionRequest, opts ...grpc.CallOption) (*MarketVersionResponse, error) {
+	out := new(MarketVersionResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Providers(ctx context.Context, in *ProvidersRequest, opts ...grpc.CallOption) (*ProvidersResponse, error) {
+	out := new(ProvidersResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Providers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MarketMap returns the market map stored in the x/marketmap module,
+	// optionally filtered and paginated.
+	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
+	// Market returns a market stored in the x/marketmap
+	// module.
+	Market(context.Context, *MarketRequest) (*MarketResponse, error)
+	// LastUpdated returns the last height the market map was updated at.
+	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
+	// Params returns the current x/marketmap module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// ValidateMarketMapUpdate applies a proposed set of market upserts to a
+	// cached copy of state, and returns the validation result of each market
+	// without committing any changes.
+	ValidateMarketMapUpdate(context.Context, *ValidateMarketMapUpdateRequest) (*ValidateMarketMapUpdateResponse, error)
+	// ScheduledMarketChanges returns the pending scheduled market changes,
+	// ordered by the height they are applied at.
+	ScheduledMarketChanges(context.Context, *ScheduledMarketChangesRequest) (*ScheduledMarketChangesResponse, error)
+	// MarketAuthorityGrants returns the scoped market authority grants.
+	MarketAuthorityGrants(context.Context, *MarketAuthorityGrantsRequest) (*MarketAuthorityGrantsResponse, error)
+	// MarketHistory returns the change log of a market, or of every market,
+	// ordered by ticker and then by version.
+	MarketHistory(context.Context, *MarketHistoryRequest) (*MarketHistoryResponse, error)
+	// MarketVersion returns the change that produced a specific version of a
+	// market, along with the market at that version.
+	MarketVersion(context.Context, *MarketVersionRequest) (*MarketVersionResponse, error)
+	// Providers returns the registry of known providers.
+	Providers(context.Context, *ProvidersRequest) (*ProvidersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MarketMap(ctx context.Context, req *MarketMapRequest) (*MarketMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+}
+func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest) (*MarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+}
+func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidateMarketMapUpdate(ctx context.Context, req *ValidateMarketMapUpdateRequest) (*ValidateMarketMapUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMarketMapUpdate not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledMarketChanges(ctx context.Context, req *ScheduledMarketChangesRequest) (*ScheduledMarketChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMarketChanges not implemented")
+}
+func (*UnimplementedQueryServer) MarketAuthorityGrants(ctx context.Context, req *MarketAuthorityGrantsRequest) (*MarketAuthorityGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketAuthorityGrants not implemented")
+}
+func (*UnimplementedQueryServer) MarketHistory(ctx context.Context, req *MarketHistoryRequest) (*MarketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketHistory not implemented")
+}
+func (*UnimplementedQueryServer) MarketVersion(ctx context.Context, req *MarketVersionRequest) (*MarketVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketVersion not implemented")
+}
+func (*UnimplementedQueryServer) Providers(ctx context.Context, req *ProvidersRequest) (*ProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMap(ctx, req.(*MarketMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Market(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Market",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Market(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/LastUpdated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastUpdated(ctx, req.(*LastUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateMarketMapUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateMarketMapUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateMarketMapUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/ValidateMarketMapUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateMarketMapUpdate(ctx, req.(*ValidateMarketMapUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMarketChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledMarketChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMarketChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/ScheduledMarketChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMarketChanges(ctx, req.(*ScheduledMarketChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketAuthorityGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketAuthorityGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketAuthorityGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketAuthorityGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketAuthorityGrants(ctx, req.(*MarketAuthorityGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketHistory(ctx, req.(*MarketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketVersion(ctx, req.(*MarketVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Providers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Providers(ctx, req.(*ProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.marketmap.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MarketMap",
+			Handler:    _Query_MarketMap_Handler,
+		},
+		{
+			MethodName: "Market",
+			Handler:    _Query_Market_Handler,
+		},
+		{
+			MethodName: "LastUpdated",
+			Handler:    _Query_LastUpdated_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidateMarketMapUpdate",
+			Handler:    _Query_ValidateMarketMapUpdate_Handler,
+		},
+		{
+			MethodName: "ScheduledMarketChanges",
+			Handler:    _Query_ScheduledMarketChanges_Handler,
+		},
+		{
+			MethodName: "MarketAuthorityGrants",
+			Handler:    _Query_MarketAuthorityGrants_Handler,
+		},
+		{
+			MethodName: "MarketHistory",
+			Handler:    _Query_MarketHistory_Handler,
+		},
+		{
+			MethodName: "MarketVersion",
+			Handler:    _Query_MarketVersion_Handler,
+		},
+		{
+			MethodName: "Providers",
+			Handler:    _Query_Providers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/marketmap/v2/query.proto",
+}
+
+func (m *MarketMapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EnabledOnly {
+		i--
+		if m.EnabledOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdatedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdatedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateMarketMapUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateMarketMapUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateMarketMapUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketUpdateResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketUpdateResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketUpdateResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasCurrencyPairId {
+		i--
+		if m.HasCurrencyPairId {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CurrencyPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrencyPairId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Created {
+		i--
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateMarketMapUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateMarketMapUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateMarketMapUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledMarketChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMarketChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMarketChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledMarketChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMarketChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMarketChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledMarketChanges) > 0 {
+		for iNdEx := len(m.ScheduledMarketChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledMarketChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketAuthorityGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketAuthorityGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EnabledOnly {
+		n += 2
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarketMap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LastUpdatedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LastUpdatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	return n
+}
+
+func (m *ValidateMarketMapUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketUpdateResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Created {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CurrencyPairId != 0 {
+		n += 1 + sovQuery(uint64(m.CurrencyPairId))
+	}
+	if m.HasCurrencyPairId {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidateMarketMapUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScheduledMarketChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	return n
+}
+
+func (m *ScheduledMarketChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledMarketChanges) > 0 {
+		for _, e := range m.ScheduledMarketChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketAuthorityGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketAuthorityGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MarketVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Change.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnabledOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateMarketMapUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateMarketMapUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateMarketMapUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketUpdateResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketUpdateResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketUpdateResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairId", wireType)
+			}
+			m.CurrencyPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrencyPairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCurrencyPairId", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasCurrencyPairId = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateMarketMapUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateMarketMapUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateMarketMapUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MarketUpdateResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledMarketChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMarketChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMarketChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledMarketChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMarketChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMarketChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMarketChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledMarketChanges = append(m.ScheduledMarketChanges, ScheduledMarketChange{})
+			if err := m.ScheduledMarketChanges[len(m.ScheduledMarketChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketAuthorityGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *LastUpdatedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastUpdated != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdated))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketAuthorityGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1019,12 +4408,46 @@ func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketAuthorityGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketAuthorityGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, MarketAuthorityGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1046,7 +4469,7 @@ func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1069,15 +4492,15 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1104,32 +4527,16 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
-			}
-			m.LastUpdated = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1157,7 +4564,7 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Ticker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1180,7 +4587,7 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1203,15 +4610,15 @@ func (m *MarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1238,63 +4645,14 @@ func (m *MarketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, MarketChangeRecord{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1321,7 +4679,10 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1346,7 +4707,7 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,12 +4730,63 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1396,7 +4808,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1419,15 +4831,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1454,7 +4866,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1479,7 +4891,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
+func (m *ProvidersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1502,12 +4914,44 @@ func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvidersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1529,7 +4973,7 @@ func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
+func (m *ProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1552,17 +4996,17 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
-			m.LastUpdated = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1572,11 +5016,26 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/skip-mev/connect/v2/pkg/json"
+)
+
+// MaxOffChainTickerRegexLength is the maximum length of the off-chain ticker regex of a ProviderInfo (in bytes).
+const MaxOffChainTickerRegexLength = 512
+
+// NewProviderInfo returns a new ProviderInfo for the provider with the given name.
+func NewProviderInfo(name, offChainTickerRegex, metadataSchema string) ProviderInfo {
+	return ProviderInfo{
+		Name:                name,
+		OffChainTickerRegex: offChainTickerRegex,
+		MetadataSchema:      metadataSchema,
+	}
+}
+
+// ValidateBasic performs stateless validation of the ProviderInfo. The name must be non-empty and free of
+// surrounding whitespace, the off-chain ticker regex must compile, and the metadata schema must be a valid JSON
+// schema.
+func (p *ProviderInfo) ValidateBasic() error {
+	if err := validateProviderName(p.Name); err != nil {
+		return err
+	}
+
+	if len(p.OffChainTickerRegex) > MaxOffChainTickerRegexLength {
+		return fmt.Errorf(
+			"off-chain ticker regex of provider %s is longer than maximum length of %d", p.Name, MaxOffChainTickerRegexLength,
+		)
+	}
+
+	if _, err := p.offChainTickerPattern(); err != nil {
+		return err
+	}
+
+	if len(p.MetadataSchema) > MaxMetadataJSONFieldLength {
+		return fmt.Errorf("metadata schema of provider %s is longer than maximum length of %d", p.Name, MaxMetadataJSONFieldLength)
+	}
+
+	if _, err := json.ParseSchema(p.MetadataSchema); err != nil {
+		return fmt.Errorf("invalid metadata schema for provider %s: %w", p.Name, err)
+	}
+
+	return nil
+}
+
+// ValidateProviderConfig checks that the given ProviderConfig is for the provider, that its off-chain ticker matches
+// the provider's off-chain ticker regex in full, and that its metadata JSON conforms to the provider's metadata
+// schema, if either is set.
+func (p *ProviderInfo) ValidateProviderConfig(pc ProviderConfig) error {
+	if pc.Name != p.Name {
+		return fmt.Errorf("provider config for %s does not match provider %s", pc.Name, p.Name)
+	}
+
+	pattern, err := p.offChainTickerPattern()
+	if err != nil {
+		return err
+	}
+
+	if pattern != nil && !pattern.MatchString(pc.OffChainTicker) {
+		return fmt.Errorf(
+			"off-chain ticker %q does not match pattern %q of provider %s", pc.OffChainTicker, p.OffChainTickerRegex, p.Name,
+		)
+	}
+
+	schema, err := json.ParseSchema(p.MetadataSchema)
+	if err != nil {
+		return fmt.Errorf("invalid metadata schema for provider %s: %w", p.Name, err)
+	}
+
+	if err := schema.Validate([]byte(pc.Metadata_JSON)); err != nil {
+		return fmt.Errorf("invalid metadata json for provider %s: %w", p.Name, err)
+	}
+
+	return nil
+}
+
+// offChainTickerPattern compiles the off-chain ticker regex of the provider, anchored so that it must match the full
+// off-chain ticker. A nil pattern is returned if no regex is set.
+func (p *ProviderInfo) offChainTickerPattern() (*regexp.Regexp, error) {
+	if p.OffChainTickerRegex == "" {
+		return nil, nil
+	}
+
+	pattern, err := regexp.Compile("^(?:" + p.OffChainTickerRegex + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid off-chain ticker regex for provider %s: %w", p.Name, err)
+	}
+
+	return pattern, nil
+}
+
+// validateProviderName checks that the given provider name is non-empty and free of surrounding whitespace.
+func validateProviderName(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("provider name must not be empty")
+	}
+
+	if strings.TrimSpace(name) != name {
+		return fmt.Errorf("provider name %q must not have leading or trailing whitespace", name)
+	}
+
+	return nil
+}
+
+// ProviderRegistry is the registry of known providers, keyed by name. An empty registry permits every provider.
+type ProviderRegistry map[string]ProviderInfo
+
+// NewProviderRegistry returns a ProviderRegistry of the given providers.
+func NewProviderRegistry(providers []ProviderInfo) ProviderRegistry {
+	registry := make(ProviderRegistry, len(providers))
+	for _, provider := range providers {
+		registry[provider.Name] = provider
+	}
+
+	return registry
+}
+
+// ValidateMarket checks that every provider config of the given market is for a registered provider, and matches
+// its off-chain ticker regex and metadata schema. This is a no-op if the registry is empty.
+func (r ProviderRegistry) ValidateMarket(market Market) error {
+	if len(r) == 0 {
+		return nil
+	}
+
+	for _, pc := range market.ProviderConfigs {
+		provider, found := r[pc.Name]
+		if !found {
+			return fmt.Errorf("market %s uses unknown provider %q", market.Ticker.String(), pc.Name)
+		}
+
+		if err := provider.ValidateProviderConfig(pc); err != nil {
+			return fmt.Errorf("invalid provider config for market %s: %w", market.Ticker.String(), err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMarketMap checks every market in the given MarketMap against the registry.
+func (r ProviderRegistry) ValidateMarketMap(mm MarketMap) error {
+	for _, market := range mm.Markets {
+		if err := r.ValidateMarket(market); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateProviders validates each of the given ProviderInfos, and checks that their names are unique.
+func ValidateProviders(providers []ProviderInfo) error {
+	seen := make(map[string]struct{}, len(providers))
+	for _, provider := range providers {
+		if err := provider.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[provider.Name]; ok {
+			return fmt.Errorf("duplicate provider %s", provider.Name)
+		}
+		seen[provider.Name] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/registry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderInfo is an entry in the registry of known providers. Once the
+// registry is non-empty, every provider config in the market map must be for a
+// registered provider, and match its off-chain ticker pattern and metadata
+// schema if either is set.
+type ProviderInfo struct {
+	// Name is the name of the provider, i.e. binance_ws.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// OffChainTickerRegex is an optional regular expression that the off-chain
+	// ticker of every provider config for the provider must match in full.
+	OffChainTickerRegex string `protobuf:"bytes,2,opt,name=off_chain_ticker_regex,json=offChainTickerRegex,proto3" json:"off_chain_ticker_regex,omitempty"`
+	// MetadataSchema is an optional JSON schema that the metadata JSON of every
+	// provider config for the provider must conform to.
+	MetadataSchema string `protobuf:"bytes,3,opt,name=metadata_schema,json=metadataSchema,proto3" json:"metadata_schema,omitempty"`
+}
+
+func (m *ProviderInfo) Reset()         { *m = ProviderInfo{} }
+func (m *ProviderInfo) String() string { return proto.CompactTextString(m) }
+func (*ProviderInfo) ProtoMessage()    {}
+func (*ProviderInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b3690afb7abea44, []int{0}
+}
+func (m *ProviderInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderInfo.Merge(m, src)
+}
+func (m *ProviderInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderInfo proto.InternalMessageInfo
+
+func (m *ProviderInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProviderInfo) GetOffChainTickerRegex() string {
+	if m != nil {
+		return m.OffChainTickerRegex
+	}
+	return ""
+}
+
+func (m *ProviderInfo) GetMetadataSchema() string {
+	if m != nil {
+		return m.MetadataSchema
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ProviderInfo)(nil), "connect.marketmap.v2.ProviderInfo")
+}
+
+func init() {
+	proto.RegisterFile("connect/marketmap/v2/registry.proto", fileDescriptor_3b3690afb7abea44)
+}
+
+var fileDescriptor_3b3690afb7abea44 = []byte{
+	// 238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0xb1, 0x4a, 0xc4, 0x30,
+	0x1c, 0x87, 0x1b, 0x15, 0xc1, 0x20, 0x0a, 0x51, 0xa4, 0x53, 0x10, 0x1d, 0x74, 0xb1, 0x91, 0xde,
+	0x1b, 0xe8, 0xa4, 0x93, 0x9c, 0x4e, 0x2e, 0x25, 0x97, 0xfb, 0xa7, 0x0d, 0x25, 0x49, 0x49, 0xff,
+	0x86, 0xbb, 0xcd, 0x47, 0xf0, 0xb1, 0x1c, 0x6f, 0x74, 0x94, 0xf6, 0x45, 0xe4, 0x82, 0x15, 0xb7,
+	0x1f, 0xbf, 0xef, 0x5b, 0x3e, 0x7a, 0xa9, 0xbc, 0x73, 0xa0, 0x50, 0x58, 0x19, 0x5a, 0x40, 0x2b,
+	0x3b, 0x11, 0x4b, 0x11, 0xa0, 0x36, 0x3d, 0x86, 0x75, 0xd1, 0x05, 0x8f, 0x9e, 0x9d, 0xfe, 0x4a,
+	0xc5, 0x9f, 0x54, 0xc4, 0xf2, 0xe2, 0x9d, 0xd0, 0xc3, 0xa7, 0xe0, 0xa3, 0x59, 0x42, 0x78, 0x70,
+	0xda, 0x33, 0x46, 0xf7, 0x9c, 0xb4, 0x90, 0x93, 0x73, 0x72, 0x7d, 0x30, 0x4f, 0x9b, 0xcd, 0xe8,
+	0x99, 0xd7, 0xba, 0x52, 0x8d, 0x34, 0xae, 0x42, 0xa3, 0x5a, 0x08, 0x55, 0x80, 0x1a, 0x56, 0xf9,
+	0x4e, 0xb2, 0x4e, 0xbc, 0xd6, 0xf7, 0x5b, 0xf8, 0x92, 0xd8, 0x7c, 0x8b, 0xd8, 0x15, 0x3d, 0xb6,
+	0x80, 0x72, 0x29, 0x51, 0x56, 0xbd, 0x6a, 0xc0, 0xca, 0x7c, 0x37, 0xd9, 0x47, 0xd3, 0xfd, 0x9c,
+	0xde, 0xbb, 0xc7, 0xcf, 0x81, 0x93, 0xcd, 0xc0, 0xc9, 0xf7, 0xc0, 0xc9, 0xc7, 0xc8, 0xb3, 0xcd,
+	0xc8, 0xb3, 0xaf, 0x91, 0x67, 0xaf, 0xb7, 0xb5, 0xc1, 0xe6, 0x6d, 0x51, 0x28, 0x6f, 0x45, 0xdf,
+	0x9a, 0xee, 0xc6, 0x42, 0x14, 0x53, 0x6b, 0x2c, 0xc5, 0xea, 0x5f, 0x30, 0xae, 0x3b, 0xe8, 0x17,
+	0xfb, 0xa9, 0x75, 0xf6, 0x33, 0x00, 0xec, 0x76, 0x00, 0x6b, 0x12, 0x01, 0x00, 0x00,
+}
+
+func (m *ProviderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataSchema) > 0 {
+		i -= len(m.MetadataSchema)
+		copy(dAtA[i:], m.MetadataSchema)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.MetadataSchema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OffChainTickerRegex) > 0 {
+		i -= len(m.OffChainTickerRegex)
+		copy(dAtA[i:], m.OffChainTickerRegex)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.OffChainTickerRegex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRegistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRegistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.OffChainTickerRegex)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.MetadataSchema)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func sovRegistry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRegistry(x uint64) (n int) {
+	return sovRegistry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTickerRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTickerRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRegistry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRegistry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRegistry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRegistry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRegistry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRegistry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRegistry = fmt.Errorf("proto: unexpected end of group")
+)
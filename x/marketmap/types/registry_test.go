@@ -0,0 +1,140 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestProviderInfoValidateBasic(t *testing.T) {
+	tcs := []struct {
+		name       string
+		provider   types.ProviderInfo
+		expectPass bool
+	}{
+		{
+			"empty name - fail",
+			types.NewProviderInfo("", "", ""),
+			false,
+		},
+		{
+			"name with surrounding whitespace - fail",
+			types.NewProviderInfo(" binance_ws", "", ""),
+			false,
+		},
+		{
+			"invalid regex - fail",
+			types.NewProviderInfo("binance_ws", "[A-Z", ""),
+			false,
+		},
+		{
+			"invalid metadata schema - fail",
+			types.NewProviderInfo("binance_ws", "", "{"),
+			false,
+		},
+		{
+			"name only - pass",
+			types.NewProviderInfo("binance_ws", "", ""),
+			true,
+		},
+		{
+			"regex and metadata schema - pass",
+			types.NewProviderInfo("binance_ws", "[A-Z0-9]+", `{"type":"object"}`),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.provider.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	provider := types.NewProviderInfo("binance_ws", "", "")
+	require.Error(t, types.ValidateProviders([]types.ProviderInfo{provider, provider}))
+	require.NoError(t, types.ValidateProviders([]types.ProviderInfo{provider}))
+}
+
+func TestProviderRegistryValidateMarket(t *testing.T) {
+	market := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USDT"),
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{Name: "binance_ws", OffChainTicker: "BTCUSDT"},
+			{Name: "kucoin_ws", OffChainTicker: "BTC-USDT"},
+		},
+	}
+
+	tcs := []struct {
+		name       string
+		registry   types.ProviderRegistry
+		expectPass bool
+	}{
+		{
+			"empty registry permits every provider",
+			types.NewProviderRegistry(nil),
+			true,
+		},
+		{
+			"every provider is registered",
+			types.NewProviderRegistry([]types.ProviderInfo{
+				types.NewProviderInfo("binance_ws", "[A-Z0-9]+", ""),
+				types.NewProviderInfo("kucoin_ws", "[A-Z0-9]+-[A-Z0-9]+", ""),
+			}),
+			true,
+		},
+		{
+			"unknown provider",
+			types.NewProviderRegistry([]types.ProviderInfo{
+				types.NewProviderInfo("binance_ws", "", ""),
+			}),
+			false,
+		},
+		{
+			"off-chain ticker does not match",
+			types.NewProviderRegistry([]types.ProviderInfo{
+				types.NewProviderInfo("binance_ws", "[a-z0-9]+", ""),
+				types.NewProviderInfo("kucoin_ws", "", ""),
+			}),
+			false,
+		},
+		{
+			"provider metadata does not match schema",
+			types.NewProviderRegistry([]types.ProviderInfo{
+				types.NewProviderInfo("binance_ws", "", `{"type":"object","required":["pool"]}`),
+				types.NewProviderInfo("kucoin_ws", "", ""),
+			}),
+			false,
+		},
+		{
+			"off-chain ticker must match in full",
+			types.NewProviderRegistry([]types.ProviderInfo{
+				types.NewProviderInfo("binance_ws", "", ""),
+				types.NewProviderInfo("kucoin_ws", "[A-Z]+", ""),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.registry.ValidateMarket(market)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
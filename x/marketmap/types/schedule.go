@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// MaxScheduledMarketChangesPerHeight is the maximum number of ScheduledMarketChanges that may be pending for a single
+// height. This bounds the work done in the BeginBlocker of that height.
+const MaxScheduledMarketChangesPerHeight = 100
+
+// NewScheduledMarketUpdate returns a new ScheduledMarketChange that creates or updates the given market at the given
+// height.
+func NewScheduledMarketUpdate(
+	id, height uint64,
+	changeType ScheduledMarketChangeType,
+	market Market,
+	authority string,
+) ScheduledMarketChange {
+	return ScheduledMarketChange{
+		Id:         id,
+		Height:     height,
+		ChangeType: changeType,
+		Market:     market,
+		Ticker:     market.Ticker.String(),
+		Authority:  authority,
+	}
+}
+
+// NewScheduledMarketRemoval returns a new ScheduledMarketChange that removes the given market at the given height.
+func NewScheduledMarketRemoval(id, height uint64, ticker, authority string) ScheduledMarketChange {
+	return ScheduledMarketChange{
+		Id:         id,
+		Height:     height,
+		ChangeType: ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_REMOVE,
+		Ticker:     ticker,
+		Authority:  authority,
+	}
+}
+
+// ValidateBasic performs stateless validation of the ScheduledMarketChange. Creates and updates must carry a valid
+// market matching the change's ticker, and removals must reference a valid ticker.
+func (c *ScheduledMarketChange) ValidateBasic() error {
+	if c.Height == 0 {
+		return fmt.Errorf("scheduled market change %d must have a non-zero height", c.Id)
+	}
+
+	switch c.ChangeType {
+	case ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_CREATE,
+		ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_UPDATE:
+		if err := c.Market.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid market for scheduled market change %d: %w", c.Id, err)
+		}
+
+		if c.Ticker != c.Market.Ticker.String() {
+			return fmt.Errorf(
+				"scheduled market change %d ticker %s does not match market %s", c.Id, c.Ticker, c.Market.Ticker.String(),
+			)
+		}
+	case ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_REMOVE:
+		if _, err := connecttypes.CurrencyPairFromString(c.Ticker); err != nil {
+			return fmt.Errorf("invalid ticker for scheduled market change %d: %w", c.Id, err)
+		}
+	default:
+		return fmt.Errorf("invalid type %s for scheduled market change %d", c.ChangeType, c.Id)
+	}
+
+	return nil
+}
+
+// ValidateScheduledMarketChanges validates each of the given ScheduledMarketChanges, and checks that their IDs are
+// unique and less than the next scheduled market change ID, and that no more than MaxScheduledMarketChangesPerHeight
+// changes are scheduled for any height.
+func ValidateScheduledMarketChanges(changes []ScheduledMarketChange, nextID uint64) error {
+	seen := make(map[uint64]struct{}, len(changes))
+	perHeight := make(map[uint64]int)
+	for _, change := range changes {
+		if err := change.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[change.Id]; ok {
+			return fmt.Errorf("repeated scheduled market change id: %d", change.Id)
+		}
+
+		if change.Id >= nextID {
+			return fmt.Errorf(
+				"scheduled market change id %d must be less than the next scheduled market change id %d", change.Id, nextID,
+			)
+		}
+
+		seen[change.Id] = struct{}{}
+
+		perHeight[change.Height]++
+		if perHeight[change.Height] > MaxScheduledMarketChangesPerHeight {
+			return fmt.Errorf(
+				"more than %d scheduled market changes at height %d", MaxScheduledMarketChangesPerHeight, change.Height,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,588 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledMarketChangeType defines the kind of change made to the market map
+// by a ScheduledMarketChange.
+type ScheduledMarketChangeType int32
+
+const (
+	// SCHEDULED_MARKET_CHANGE_TYPE_UNSPECIFIED is an invalid change type.
+	ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_UNSPECIFIED ScheduledMarketChangeType = 0
+	// SCHEDULED_MARKET_CHANGE_TYPE_CREATE creates the market.
+	ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_CREATE ScheduledMarketChangeType = 1
+	// SCHEDULED_MARKET_CHANGE_TYPE_UPDATE updates the existing market.
+	ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_UPDATE ScheduledMarketChangeType = 2
+	// SCHEDULED_MARKET_CHANGE_TYPE_REMOVE removes the market.
+	ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_REMOVE ScheduledMarketChangeType = 3
+)
+
+var ScheduledMarketChangeType_name = map[int32]string{
+	0: "SCHEDULED_MARKET_CHANGE_TYPE_UNSPECIFIED",
+	1: "SCHEDULED_MARKET_CHANGE_TYPE_CREATE",
+	2: "SCHEDULED_MARKET_CHANGE_TYPE_UPDATE",
+	3: "SCHEDULED_MARKET_CHANGE_TYPE_REMOVE",
+}
+
+var ScheduledMarketChangeType_value = map[string]int32{
+	"SCHEDULED_MARKET_CHANGE_TYPE_UNSPECIFIED": 0,
+	"SCHEDULED_MARKET_CHANGE_TYPE_CREATE":      1,
+	"SCHEDULED_MARKET_CHANGE_TYPE_UPDATE":      2,
+	"SCHEDULED_MARKET_CHANGE_TYPE_REMOVE":      3,
+}
+
+func (x ScheduledMarketChangeType) String() string {
+	return proto.EnumName(ScheduledMarketChangeType_name, int32(x))
+}
+
+func (ScheduledMarketChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6d78a482c3729980, []int{0}
+}
+
+// ScheduledMarketChange is a change to the market map that is applied at the
+// beginning of a future block.
+type ScheduledMarketChange struct {
+	// Id is the unique identifier of the change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Height is the block height at the beginning of which the change is
+	// applied.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// ChangeType is the kind of change.
+	ChangeType ScheduledMarketChangeType `protobuf:"varint,3,opt,name=change_type,json=changeType,proto3,enum=connect.marketmap.v2.ScheduledMarketChangeType" json:"change_type,omitempty"`
+	// Market is the market to create or update. It is unset for removals.
+	Market Market `protobuf:"bytes,4,opt,name=market,proto3" json:"market"`
+	// Ticker is the ticker string of the market the change applies to.
+	Ticker string `protobuf:"bytes,5,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Authority is the address that scheduled the change.
+	Authority string `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *ScheduledMarketChange) Reset()         { *m = ScheduledMarketChange{} }
+func (m *ScheduledMarketChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketChange) ProtoMessage()    {}
+func (*ScheduledMarketChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d78a482c3729980, []int{0}
+}
+func (m *ScheduledMarketChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMarketChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMarketChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketChange.Merge(m, src)
+}
+func (m *ScheduledMarketChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMarketChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMarketChange proto.InternalMessageInfo
+
+func (m *ScheduledMarketChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledMarketChange) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledMarketChange) GetChangeType() ScheduledMarketChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (m *ScheduledMarketChange) GetMarket() Market {
+	if m != nil {
+		return m.Market
+	}
+	return Market{}
+}
+
+func (m *ScheduledMarketChange) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *ScheduledMarketChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("connect.marketmap.v2.ScheduledMarketChangeType", ScheduledMarketChangeType_name, ScheduledMarketChangeType_value)
+	proto.RegisterType((*ScheduledMarketChange)(nil), "connect.marketmap.v2.ScheduledMarketChange")
+}
+
+func init() {
+	proto.RegisterFile("connect/marketmap/v2/schedule.proto", fileDescriptor_6d78a482c3729980)
+}
+
+var fileDescriptor_6d78a482c3729980 = []byte{
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0xae, 0x93, 0x40,
+	0x14, 0xc6, 0x19, 0x6e, 0x25, 0xb9, 0x73, 0x93, 0xa6, 0x99, 0x54, 0x83, 0x4d, 0x83, 0x68, 0x17,
+	0x12, 0xa3, 0x8c, 0xc1, 0x9d, 0xbb, 0x16, 0x46, 0x5b, 0xb5, 0x95, 0x50, 0x6a, 0xa2, 0x1b, 0x42,
+	0x61, 0x02, 0xa4, 0x52, 0x08, 0x9d, 0x12, 0xbb, 0xf6, 0x05, 0x7c, 0x28, 0x17, 0x5d, 0x76, 0xe9,
+	0xca, 0x98, 0xf6, 0x45, 0x0c, 0x7f, 0xac, 0x2e, 0xf0, 0x76, 0x37, 0xe7, 0x9c, 0xdf, 0xf9, 0xce,
+	0x97, 0xcc, 0x07, 0x07, 0x5e, 0xb2, 0x5e, 0x53, 0x8f, 0xe1, 0xd8, 0xcd, 0x56, 0x94, 0xc5, 0x6e,
+	0x8a, 0x73, 0x0d, 0x6f, 0xbc, 0x90, 0xfa, 0xdb, 0xcf, 0x54, 0x4d, 0xb3, 0x84, 0x25, 0xa8, 0x5b,
+	0x43, 0xea, 0x19, 0x52, 0x73, 0xad, 0xd7, 0x0d, 0x92, 0x20, 0x29, 0x01, 0x5c, 0xbc, 0x2a, 0xb6,
+	0xf7, 0xb0, 0x51, 0xb0, 0x2a, 0x2a, 0xe4, 0xd1, 0x57, 0x1e, 0xde, 0x9d, 0xd7, 0x17, 0xfc, 0x69,
+	0x39, 0xd1, 0x43, 0x77, 0x1d, 0x50, 0xd4, 0x86, 0x7c, 0xe4, 0x8b, 0x40, 0x06, 0x4a, 0xcb, 0xe2,
+	0x23, 0x1f, 0xdd, 0x83, 0x42, 0x48, 0xa3, 0x20, 0x64, 0x22, 0x5f, 0xf6, 0xea, 0x0a, 0x99, 0xf0,
+	0xc6, 0x2b, 0x37, 0x1c, 0xb6, 0x4b, 0xa9, 0x78, 0x25, 0x03, 0xa5, 0xad, 0x61, 0xb5, 0xc9, 0xa6,
+	0xda, 0x78, 0xc9, 0xde, 0xa5, 0xd4, 0x82, 0xde, 0xf9, 0x8d, 0x5e, 0x42, 0xa1, 0xda, 0x12, 0x5b,
+	0x32, 0x50, 0x6e, 0xb4, 0x7e, 0xb3, 0x58, 0xa5, 0x31, 0x6a, 0xed, 0x7f, 0x3e, 0xe0, 0xac, 0x7a,
+	0xa3, 0x70, 0xc9, 0x22, 0x6f, 0x45, 0x33, 0xf1, 0x8e, 0x0c, 0x94, 0x6b, 0xab, 0xae, 0x50, 0x1f,
+	0x5e, 0xbb, 0x5b, 0x16, 0x26, 0x59, 0xc4, 0x76, 0xa2, 0x50, 0x8e, 0xfe, 0x36, 0x9e, 0x7c, 0x07,
+	0xf0, 0xfe, 0x7f, 0xbd, 0xa1, 0xa7, 0x50, 0x99, 0xeb, 0x63, 0x62, 0x2c, 0xde, 0x11, 0xc3, 0x99,
+	0x0e, 0xad, 0xb7, 0xc4, 0x76, 0xf4, 0xf1, 0x70, 0xf6, 0x9a, 0x38, 0xf6, 0x47, 0x93, 0x38, 0x8b,
+	0xd9, 0xdc, 0x24, 0xfa, 0xe4, 0xd5, 0x84, 0x18, 0x1d, 0x0e, 0x3d, 0x86, 0x83, 0x5b, 0x69, 0xdd,
+	0x22, 0x43, 0x9b, 0x74, 0xc0, 0x45, 0x70, 0x61, 0x1a, 0x05, 0xc8, 0x5f, 0x04, 0x2d, 0x32, 0x7d,
+	0xff, 0x81, 0x74, 0xae, 0x46, 0x6f, 0xf6, 0x47, 0x09, 0x1c, 0x8e, 0x12, 0xf8, 0x75, 0x94, 0xc0,
+	0xb7, 0x93, 0xc4, 0x1d, 0x4e, 0x12, 0xf7, 0xe3, 0x24, 0x71, 0x9f, 0x9e, 0x07, 0x11, 0x0b, 0xb7,
+	0x4b, 0xd5, 0x4b, 0x62, 0xbc, 0x59, 0x45, 0xe9, 0xb3, 0x98, 0xe6, 0xf8, 0x4f, 0x3a, 0x72, 0x0d,
+	0x7f, 0xf9, 0x27, 0x22, 0xc5, 0x37, 0x6e, 0x96, 0x42, 0x99, 0x8f, 0x17, 0xbf, 0x07, 0x00, 0xf3,
+	0x70, 0xe1, 0x29, 0x95, 0x02, 0x00, 0x00,
+}
+
+func (m *ScheduledMarketChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMarketChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMarketChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ChangeType != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ChangeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledMarketChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSchedule(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSchedule(uint64(m.Height))
+	}
+	if m.ChangeType != 0 {
+		n += 1 + sovSchedule(uint64(m.ChangeType))
+	}
+	l = m.Market.Size()
+	n += 1 + l + sovSchedule(uint64(l))
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledMarketChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMarketChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMarketChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			m.ChangeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeType |= ScheduledMarketChangeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestScheduledMarketChangeValidateBasic(t *testing.T) {
+	mismatched := types.NewScheduledMarketUpdate(
+		0, 10, types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_CREATE, btcusdt, "",
+	)
+	mismatched.Ticker = usdtusd.Ticker.String()
+
+	tcs := []struct {
+		name       string
+		change     types.ScheduledMarketChange
+		expectPass bool
+	}{
+		{
+			"valid create - pass",
+			types.NewScheduledMarketUpdate(0, 10, types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_CREATE, btcusdt, ""),
+			true,
+		},
+		{
+			"valid update - pass",
+			types.NewScheduledMarketUpdate(0, 10, types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_UPDATE, btcusdt, ""),
+			true,
+		},
+		{
+			"valid removal - pass",
+			types.NewScheduledMarketRemoval(0, 10, "BTC/USD", ""),
+			true,
+		},
+		{
+			"zero height - fail",
+			types.NewScheduledMarketRemoval(0, 0, "BTC/USD", ""),
+			false,
+		},
+		{
+			"unspecified type - fail",
+			types.NewScheduledMarketUpdate(0, 10, types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_UNSPECIFIED, btcusdt, ""),
+			false,
+		},
+		{
+			"invalid market - fail",
+			types.NewScheduledMarketUpdate(0, 10, types.ScheduledMarketChangeType_SCHEDULED_MARKET_CHANGE_TYPE_CREATE, types.Market{}, ""),
+			false,
+		},
+		{
+			"ticker does not match the market - fail",
+			mismatched,
+			false,
+		},
+		{
+			"invalid removal ticker - fail",
+			types.NewScheduledMarketRemoval(0, 10, "BTCUSD", ""),
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateScheduledMarketChanges(t *testing.T) {
+	first := types.NewScheduledMarketRemoval(0, 10, "BTC/USD", "")
+	second := types.NewScheduledMarketRemoval(1, 10, "ETH/USD", "")
+
+	require.NoError(t, types.ValidateScheduledMarketChanges([]types.ScheduledMarketChange{first, second}, 2))
+	require.Error(t, types.ValidateScheduledMarketChanges([]types.ScheduledMarketChange{first, second}, 1))
+	require.Error(t, types.ValidateScheduledMarketChanges([]types.ScheduledMarketChange{first, first}, 2))
+
+	// no more than MaxScheduledMarketChangesPerHeight changes may be scheduled for a height
+	changes := make([]types.ScheduledMarketChange, 0, types.MaxScheduledMarketChangesPerHeight+1)
+	for i := 0; i <= types.MaxScheduledMarketChangesPerHeight; i++ {
+		changes = append(changes, types.NewScheduledMarketRemoval(uint64(i), 10, "BTC/USD", ""))
+	}
+	require.NoError(t, types.ValidateScheduledMarketChanges(changes[:types.MaxScheduledMarketChangesPerHeight], uint64(len(changes))))
+	require.Error(t, types.ValidateScheduledMarketChanges(changes, uint64(len(changes))))
+}
@@ -10,6 +10,16 @@ type AggregatorID struct {
 	ID string `json:"ID"`
 }
 
+// aggregatorIDSchema is the JSON schema of an AggregatorID.
+const aggregatorIDSchema = `{
+  "type": "object",
+  "required": ["venue", "ID"],
+  "properties": {
+    "venue": {"type": "string", "minLength": 1},
+    "ID": {"type": "string", "minLength": 1}
+  }
+}`
+
 // NewAggregatorID returns a new AggregatorID instance.
 func NewAggregatorID(venue, id string) AggregatorID {
 	return AggregatorID{
@@ -9,6 +9,16 @@ type CoreMetadata struct {
 	AggregateIDs []AggregatorID `json:"aggregate_ids"`
 }
 
+// CoreMetadataSchema is the JSON schema of the CoreMetadata Ticker.Metadata_JSON. It can be set as the ticker metadata
+// schema of the x/marketmap module, so that malformed metadata is rejected on chain.
+const CoreMetadataSchema = `{
+  "title": "core ticker metadata",
+  "type": "object",
+  "properties": {
+    "aggregate_ids": {"items": ` + aggregatorIDSchema + `}
+  }
+}`
+
 // NewCoreMetadata returns a new CoreMetadata instance.
 func NewCoreMetadata(aggregateIDs []AggregatorID) CoreMetadata {
 	return CoreMetadata{
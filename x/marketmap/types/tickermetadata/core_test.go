@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/skip-mev/connect/v2/pkg/json"
 	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
 )
 
@@ -49,3 +50,15 @@ func Test_UnmarshalCoreMetadata(t *testing.T) {
 		), elem)
 	})
 }
+
+func Test_CoreMetadataSchema(t *testing.T) {
+	schema := json.MustParseSchema(tickermetadata.CoreMetadataSchema)
+
+	bz, err := tickermetadata.MarshalCoreMetadata(tickermetadata.NewCoreMetadata(
+		[]tickermetadata.AggregatorID{tickermetadata.NewAggregatorID("coingecko", "id")},
+	))
+	require.NoError(t, err)
+	require.NoError(t, schema.Validate(bz))
+
+	require.Error(t, schema.Validate([]byte(`{"aggregate_ids":[{"venue":"coingecko"}]}`)))
+}
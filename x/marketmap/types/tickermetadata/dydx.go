@@ -18,6 +18,19 @@ type DyDx struct {
 	AggregateIDs []AggregatorID `json:"aggregate_ids"`
 }
 
+// DyDxSchema is the JSON schema of the DyDx Ticker.Metadata_JSON. It can be set as the ticker metadata schema of the
+// x/marketmap module, so that malformed metadata is rejected on chain.
+const DyDxSchema = `{
+  "title": "dYdX ticker metadata",
+  "type": "object",
+  "required": ["reference_price", "liquidity"],
+  "properties": {
+    "reference_price": {"type": "integer", "minimum": 0},
+    "liquidity": {"type": "integer", "minimum": 0},
+    "aggregate_ids": {"items": ` + aggregatorIDSchema + `}
+  }
+}`
+
 // NewDyDx returns a new DyDx instance.
 func NewDyDx(referencePrice, liquidity uint64, aggregateIDs []AggregatorID) DyDx {
 	return DyDx{
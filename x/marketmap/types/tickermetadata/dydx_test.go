@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/skip-mev/connect/v2/pkg/json"
 	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
 )
 
@@ -53,3 +54,29 @@ func Test_UnmarshalDyDx(t *testing.T) {
 		), elem)
 	})
 }
+
+func Test_DyDxSchema(t *testing.T) {
+	schema := json.MustParseSchema(tickermetadata.DyDxSchema)
+
+	t.Run("marshalled metadata conforms to the schema", func(t *testing.T) {
+		for _, elem := range []tickermetadata.DyDx{
+			tickermetadata.NewDyDx(100, 1000, []tickermetadata.AggregatorID{tickermetadata.NewAggregatorID("coingecko", "id")}),
+			tickermetadata.NewDyDx(100, 1000, nil),
+		} {
+			bz, err := tickermetadata.MarshalDyDx(elem)
+			require.NoError(t, err)
+			require.NoError(t, schema.Validate(bz))
+		}
+	})
+
+	t.Run("rejects malformed metadata", func(t *testing.T) {
+		for _, elemJSON := range []string{
+			`{"liquidity":1000}`,
+			`{"reference_price":-1,"liquidity":1000}`,
+			`{"reference_price":1.5,"liquidity":1000}`,
+			`{"reference_price":100,"liquidity":1000,"aggregate_ids":[{"venue":"","ID":"id"}]}`,
+		} {
+			require.Error(t, schema.Validate([]byte(elemJSON)), elemJSON)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package tickermetadata
+
+import "encoding/json"
+
+// PriorityMetadata is the priority of a Ticker, read from the top-level "priority" field of its Metadata_JSON. It
+// can be included in any Ticker.Metadata_JSON (e.g. alongside the CoreMetadata or DyDx metadata). When a validator's
+// vote extension exceeds the maximum vote extension size of the x/oracle module, the prices of the lowest priority
+// tickers are dropped first.
+type PriorityMetadata struct {
+	// Priority is the priority of the ticker. Tickers without a priority have a priority of zero.
+	Priority uint32 `json:"priority"`
+}
+
+// NewPriorityMetadata returns a new PriorityMetadata instance.
+func NewPriorityMetadata(priority uint32) PriorityMetadata {
+	return PriorityMetadata{
+		Priority: priority,
+	}
+}
+
+// MarshalPriorityMetadata returns the JSON byte encoding of the PriorityMetadata.
+func MarshalPriorityMetadata(m PriorityMetadata) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// PriorityMetadataFromJSONString returns a PriorityMetadata instance from a JSON string.
+func PriorityMetadataFromJSONString(jsonString string) (PriorityMetadata, error) {
+	var elem PriorityMetadata
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
+
+// TickerPriority returns the priority in the given Ticker.Metadata_JSON. Empty or malformed metadata has a priority
+// of zero.
+func TickerPriority(metadataJSON string) uint32 {
+	if metadataJSON == "" {
+		return 0
+	}
+
+	elem, err := PriorityMetadataFromJSONString(metadataJSON)
+	if err != nil {
+		return 0
+	}
+
+	return elem.Priority
+}
@@ -0,0 +1,35 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func Test_TickerPriority(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewPriorityMetadata(7)
+
+		bz, err := tickermetadata.MarshalPriorityMetadata(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.PriorityMetadataFromJSONString(string(bz))
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+		require.Equal(t, uint32(7), tickermetadata.TickerPriority(string(bz)))
+	})
+
+	t.Run("priority is read alongside other metadata", func(t *testing.T) {
+		metadataJSON := `{"aggregate_ids":[{"venue":"coingecko","ID":"id"}],"priority":3}`
+		require.Equal(t, uint32(3), tickermetadata.TickerPriority(metadataJSON))
+	})
+
+	t.Run("missing, empty or malformed metadata has zero priority", func(t *testing.T) {
+		require.Equal(t, uint32(0), tickermetadata.TickerPriority(""))
+		require.Equal(t, uint32(0), tickermetadata.TickerPriority(`{"aggregate_ids":[]}`))
+		require.Equal(t, uint32(0), tickermetadata.TickerPriority(`{"priority":-1}`))
+		require.Equal(t, uint32(0), tickermetadata.TickerPriority("not json"))
+	})
+}
@@ -620,980 +620,3898 @@ func (m *MsgRemoveMarketsResponse) GetDeletedMarkets() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgUpsertMarkets)(nil), "connect.marketmap.v2.MsgUpsertMarkets")
-	proto.RegisterType((*MsgUpsertMarketsResponse)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse.MarketUpdatesEntry")
-	proto.RegisterType((*MsgCreateMarkets)(nil), "connect.marketmap.v2.MsgCreateMarkets")
-	proto.RegisterType((*MsgCreateMarketsResponse)(nil), "connect.marketmap.v2.MsgCreateMarketsResponse")
-	proto.RegisterType((*MsgUpdateMarkets)(nil), "connect.marketmap.v2.MsgUpdateMarkets")
-	proto.RegisterType((*MsgUpdateMarketsResponse)(nil), "connect.marketmap.v2.MsgUpdateMarketsResponse")
-	proto.RegisterType((*MsgParams)(nil), "connect.marketmap.v2.MsgParams")
-	proto.RegisterType((*MsgParamsResponse)(nil), "connect.marketmap.v2.MsgParamsResponse")
-	proto.RegisterType((*MsgRemoveMarketAuthorities)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthorities")
-	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthoritiesResponse")
-	proto.RegisterType((*MsgRemoveMarkets)(nil), "connect.marketmap.v2.MsgRemoveMarkets")
-	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketsResponse")
+// MsgScheduleMarketUpdates defines the Msg/ScheduleMarketUpdates request type.
+// It contains the markets to create and update at a future block height.
+type MsgScheduleMarketUpdates struct {
+	// Authority is the signer of this transaction. This authority must be a
+	// market authority of the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height is the block height at the beginning of which the changes are
+	// applied. It must be greater than the current block height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// CreateMarkets is the list of markets to create.
+	CreateMarkets []Market `protobuf:"bytes,3,rep,name=create_markets,json=createMarkets,proto3" json:"create_markets"`
+	// UpdateMarkets is the list of markets to update.
+	UpdateMarkets []Market `protobuf:"bytes,4,rep,name=update_markets,json=updateMarkets,proto3" json:"update_markets"`
+}
+
+func (m *MsgScheduleMarketUpdates) Reset()         { *m = MsgScheduleMarketUpdates{} }
+func (m *MsgScheduleMarketUpdates) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketUpdates) ProtoMessage()    {}
+func (*MsgScheduleMarketUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{12}
+}
+func (m *MsgScheduleMarketUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMarketUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketUpdates.Merge(m, src)
+}
+func (m *MsgScheduleMarketUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketUpdates.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("connect/marketmap/v2/tx.proto", fileDescriptor_37df9476ca9a2f81) }
+var xxx_messageInfo_MsgScheduleMarketUpdates proto.InternalMessageInfo
 
-var fileDescriptor_37df9476ca9a2f81 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x14, 0x8a, 0x76, 0xb4, 0x05, 0xc6, 0x26, 0xac, 0x1b, 0x6d, 0x71, 0x43, 0x0a, 0x92,
-	0xb0, 0x8b, 0x35, 0x31, 0xa4, 0x7a, 0xb0, 0x25, 0x1e, 0x34, 0x69, 0x62, 0xd6, 0x90, 0x18, 0x2f,
-	0xcd, 0xd2, 0x9d, 0x2c, 0x1b, 0xd8, 0x3f, 0xd9, 0x99, 0x6e, 0xe0, 0x66, 0x34, 0x5e, 0xbc, 0x68,
-	0x3c, 0x79, 0xd4, 0x6f, 0xc0, 0xc1, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0xf2, 0x64, 0x0c, 0x1c, 0xf0,
-	0x63, 0x98, 0xee, 0x4c, 0x97, 0x1d, 0xe8, 0x96, 0x56, 0xbd, 0xc0, 0xec, 0x7b, 0xbf, 0xf7, 0xde,
-	0xef, 0xfd, 0x99, 0x37, 0x85, 0xb7, 0x3b, 0x9e, 0xeb, 0xe2, 0x0e, 0xd5, 0x1c, 0x23, 0xd8, 0xc6,
-	0xd4, 0x31, 0x7c, 0x2d, 0xac, 0x69, 0x74, 0x57, 0xf5, 0x03, 0x8f, 0x7a, 0xa8, 0xc4, 0xd5, 0x6a,
-	0xac, 0x56, 0xc3, 0x9a, 0x3c, 0xd7, 0xf1, 0x88, 0xe3, 0x11, 0xcd, 0x21, 0x96, 0x16, 0xde, 0xeb,
-	0xfd, 0x63, 0x70, 0xb9, 0x64, 0x79, 0x96, 0x17, 0x1d, 0xb5, 0xde, 0x89, 0x4b, 0x6f, 0x32, 0x78,
-	0x9b, 0x29, 0xd8, 0x07, 0x57, 0xcd, 0x1a, 0x8e, 0xed, 0x7a, 0x5a, 0xf4, 0x97, 0x8b, 0xee, 0x0c,
-	0x64, 0xc4, 0x3e, 0x86, 0x42, 0x7c, 0x23, 0x30, 0x1c, 0xee, 0x58, 0xf9, 0x0e, 0xe0, 0x4c, 0x8b,
-	0x58, 0x1b, 0x3e, 0xc1, 0x01, 0x6d, 0x45, 0x30, 0x82, 0x1e, 0xc0, 0xbc, 0xd1, 0xa5, 0x5b, 0x5e,
-	0x60, 0xd3, 0x3d, 0x09, 0xcc, 0x83, 0xa5, 0x7c, 0x53, 0x3a, 0xfa, 0xb6, 0x52, 0xe2, 0x94, 0x1a,
-	0xa6, 0x19, 0x60, 0x42, 0x5e, 0xd0, 0xc0, 0x76, 0x2d, 0xfd, 0x0c, 0x8a, 0x1e, 0xc1, 0x2b, 0x2c,
-	0x12, 0x91, 0xb2, 0xf3, 0x13, 0x4b, 0xd7, 0x6a, 0xb7, 0xd4, 0x41, 0x75, 0x51, 0x59, 0x9c, 0xe6,
-	0xe4, 0xc1, 0xcf, 0x4a, 0x46, 0xef, 0x9b, 0xd4, 0x1f, 0xfe, 0xfe, 0x52, 0xc9, 0xbc, 0x39, 0xdd,
-	0x5f, 0x3e, 0xf3, 0xf8, 0xfe, 0x74, 0x7f, 0x79, 0xa1, 0x9f, 0xc4, 0x6e, 0x22, 0x8d, 0xf3, 0x94,
-	0x95, 0x43, 0x00, 0xa5, 0xf3, 0x42, 0x1d, 0x13, 0xdf, 0x73, 0x09, 0x46, 0x3b, 0xb0, 0xc8, 0x4c,
-	0xdb, 0x5d, 0xdf, 0x34, 0x28, 0x26, 0x12, 0x88, 0xe8, 0x35, 0x52, 0xe8, 0xa5, 0xf8, 0xe1, 0xbc,
-	0x37, 0x98, 0x8f, 0x27, 0x2e, 0x0d, 0xf6, 0x9a, 0x59, 0x09, 0xe8, 0x05, 0x27, 0x29, 0x97, 0x1f,
-	0x43, 0x74, 0x11, 0x88, 0x66, 0xe0, 0xc4, 0x36, 0xe6, 0xd5, 0xd4, 0x7b, 0x47, 0x54, 0x82, 0xb9,
-	0xd0, 0xd8, 0xe9, 0x62, 0x29, 0x3b, 0x0f, 0x96, 0xae, 0xea, 0xec, 0xa3, 0x9e, 0x5d, 0x03, 0xf5,
-	0xc9, 0xcf, 0x5f, 0x2b, 0x40, 0x39, 0x62, 0xad, 0x59, 0x0f, 0xb0, 0x41, 0xf1, 0xbf, 0xb6, 0xe6,
-	0x29, 0x2c, 0x76, 0x22, 0x47, 0xed, 0xf1, 0x3b, 0x54, 0xe8, 0x24, 0x29, 0x8c, 0xdb, 0x27, 0x81,
-	0xbf, 0x22, 0x47, 0x6d, 0x12, 0x64, 0xfd, 0xf2, 0xf6, 0x13, 0x66, 0x65, 0xfb, 0x0f, 0x09, 0xb3,
-	0x66, 0xff, 0x4d, 0xc2, 0xdd, 0x24, 0x85, 0xf1, 0x07, 0xd3, 0xbc, 0x90, 0xb0, 0x20, 0x8b, 0x13,
-	0xfe, 0x00, 0x60, 0xbe, 0x45, 0xac, 0xe7, 0xd1, 0x85, 0x44, 0x75, 0x38, 0xc5, 0xae, 0x66, 0x94,
-	0x66, 0x2a, 0x53, 0x86, 0xe6, 0x4c, 0xb9, 0x85, 0x58, 0xa5, 0xec, 0xc8, 0x55, 0xaa, 0x17, 0xc5,
-	0xb4, 0x94, 0x1b, 0x70, 0x36, 0x26, 0x14, 0xd3, 0x7c, 0x0b, 0xa0, 0xdc, 0x22, 0x96, 0x8e, 0x1d,
-	0x2f, 0xe4, 0x39, 0x34, 0xb8, 0x85, 0x8d, 0x09, 0xba, 0x0b, 0x67, 0x82, 0x48, 0xd5, 0x36, 0x58,
-	0x18, 0x7e, 0xbf, 0xf2, 0xfa, 0x34, 0x93, 0x37, 0xfa, 0x62, 0xa4, 0xc2, 0x9c, 0x61, 0x3a, 0xb6,
-	0x7b, 0x29, 0x45, 0x06, 0xab, 0xc3, 0x1e, 0x3d, 0x76, 0x56, 0x16, 0xa0, 0x92, 0x4e, 0x22, 0xe6,
-	0xba, 0x15, 0x8d, 0x50, 0x12, 0x95, 0x88, 0x0a, 0x46, 0x8a, 0x8a, 0x24, 0x71, 0x8d, 0xe5, 0xcf,
-	0x56, 0x54, 0x92, 0xcf, 0x7a, 0xd4, 0x58, 0x21, 0x52, 0xbc, 0x70, 0x16, 0xe1, 0xb4, 0x89, 0x77,
-	0x30, 0xc5, 0x66, 0x3c, 0x7d, 0xac, 0x22, 0x45, 0x2e, 0xe6, 0x06, 0xb5, 0x4f, 0x39, 0x38, 0xd1,
-	0x22, 0x16, 0xb2, 0x60, 0x41, 0xbc, 0xe7, 0xd5, 0xd4, 0xd5, 0x24, 0xe0, 0x64, 0x75, 0x34, 0x5c,
-	0xcc, 0xcc, 0x82, 0x05, 0xf1, 0x7e, 0x55, 0x87, 0xec, 0x40, 0x73, 0xa4, 0x40, 0x03, 0x67, 0x1b,
-	0xbd, 0x84, 0xd7, 0x99, 0x82, 0x4f, 0x77, 0x25, 0xd5, 0x9e, 0x01, 0xe4, 0xc5, 0x4b, 0x00, 0xb1,
-	0xe7, 0x77, 0x00, 0xce, 0xa5, 0xcd, 0xe2, 0x6a, 0xaa, 0x93, 0x14, 0x0b, 0x79, 0x6d, 0x5c, 0x0b,
-	0xb1, 0x94, 0xc9, 0x67, 0xb3, 0x3a, 0xda, 0x73, 0x32, 0xb4, 0x94, 0x83, 0x9e, 0x2f, 0x0b, 0x16,
-	0xc4, 0x81, 0xae, 0x8e, 0xc4, 0x79, 0x58, 0xa0, 0x81, 0x63, 0x2b, 0xe7, 0x5e, 0x9f, 0xee, 0x2f,
-	0x83, 0xe6, 0xb3, 0x83, 0xe3, 0x32, 0x38, 0x3c, 0x2e, 0x83, 0x5f, 0xc7, 0x65, 0xf0, 0xf1, 0xa4,
-	0x9c, 0x39, 0x3c, 0x29, 0x67, 0x7e, 0x9c, 0x94, 0x33, 0xaf, 0x56, 0x2d, 0x9b, 0x6e, 0x75, 0x37,
-	0xd5, 0x8e, 0xe7, 0x68, 0x64, 0xdb, 0xf6, 0x57, 0x1c, 0x1c, 0x6a, 0xfd, 0x35, 0x18, 0xd6, 0x84,
-	0x4d, 0x48, 0xf7, 0x7c, 0x4c, 0x36, 0xa7, 0xa2, 0x9f, 0x19, 0xf7, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x17, 0x6d, 0xc6, 0xd8, 0x40, 0x09, 0x00, 0x00,
+func (m *MsgScheduleMarketUpdates) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgScheduleMarketUpdates) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateMarkets creates markets from the given message.
-	CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error)
-	// UpdateMarkets updates markets from the given message.
-	UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error)
-	// UpdateParams defines a method for updating the x/marketmap module
-	// parameters.
-	UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
-	// RemoveMarketAuthorities defines a method for removing market authorities
-	// from the x/marketmap module. the signer must be the admin.
-	RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error)
-	// UpsertMarkets wraps both Create / Update markets into a single message.
-	// Specifically if a market does not exist it will be created, otherwise it
-	// will be updated. The response will be a map between ticker -> updated.
-	UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error)
-	// RemoveMarkets removes the given markets from the marketmap if:
-	// - they exist in the map
-	// - they are disabled
-	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+func (m *MsgScheduleMarketUpdates) GetCreateMarkets() []Market {
+	if m != nil {
+		return m.CreateMarkets
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgScheduleMarketUpdates) GetUpdateMarkets() []Market {
+	if m != nil {
+		return m.UpdateMarkets
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgScheduleMarketUpdatesResponse defines the
+// Msg/ScheduleMarketUpdates response type.
+type MsgScheduleMarketUpdatesResponse struct {
+	// Ids are the identifiers of the scheduled changes, creates first.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (c *msgClient) CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error) {
-	out := new(MsgCreateMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CreateMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMarketUpdatesResponse) Reset()         { *m = MsgScheduleMarketUpdatesResponse{} }
+func (m *MsgScheduleMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketUpdatesResponse) ProtoMessage()    {}
+func (*MsgScheduleMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{13}
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketUpdatesResponse.Merge(m, src)
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketUpdatesResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error) {
-	out := new(MsgUpdateMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleMarketUpdatesResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleMarketUpdatesResponse) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
-	out := new(MsgParamsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgScheduleMarketRemovals defines the Msg/ScheduleMarketRemovals request
+// type. It contains the markets to remove at a future block height.
+type MsgScheduleMarketRemovals struct {
+	// Admin defines the authority that is the x/marketmap
+	// Admin account.  This account is set in the module parameters.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Height is the block height at the beginning of which the removals are
+	// applied. It must be greater than the current block height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Markets is the list of markets to remove.
+	Markets []string `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets,omitempty"`
 }
 
-func (c *msgClient) RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error) {
-	out := new(MsgRemoveMarketAuthoritiesResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarketAuthorities", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMarketRemovals) Reset()         { *m = MsgScheduleMarketRemovals{} }
+func (m *MsgScheduleMarketRemovals) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketRemovals) ProtoMessage()    {}
+func (*MsgScheduleMarketRemovals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{14}
+}
+func (m *MsgScheduleMarketRemovals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketRemovals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketRemovals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgScheduleMarketRemovals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketRemovals.Merge(m, src)
+}
+func (m *MsgScheduleMarketRemovals) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketRemovals) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketRemovals.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error) {
-	out := new(MsgUpsertMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpsertMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleMarketRemovals proto.InternalMessageInfo
+
+func (m *MsgScheduleMarketRemovals) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
-	out := new(MsgRemoveMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMarketRemovals) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateMarkets creates markets from the given message.
-	CreateMarkets(context.Context, *MsgCreateMarkets) (*MsgCreateMarketsResponse, error)
-	// UpdateMarkets updates markets from the given message.
-	UpdateMarkets(context.Context, *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error)
-	// UpdateParams defines a method for updating the x/marketmap module
-	// parameters.
-	UpdateParams(context.Context, *MsgParams) (*MsgParamsResponse, error)
-	// RemoveMarketAuthorities defines a method for removing market authorities
-	// from the x/marketmap module. the signer must be the admin.
-	RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error)
-	// UpsertMarkets wraps both Create / Update markets into a single message.
-	// Specifically if a market does not exist it will be created, otherwise it
-	// will be updated. The response will be a map between ticker -> updated.
-	UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error)
-	// RemoveMarkets removes the given markets from the marketmap if:
-	// - they exist in the map
-	// - they are disabled
-	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+func (m *MsgScheduleMarketRemovals) GetMarkets() []string {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgScheduleMarketRemovalsResponse defines the
+// Msg/ScheduleMarketRemovals response type.
+type MsgScheduleMarketRemovalsResponse struct {
+	// Ids are the identifiers of the scheduled changes.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (*UnimplementedMsgServer) CreateMarkets(ctx context.Context, req *MsgCreateMarkets) (*MsgCreateMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMarkets not implemented")
+func (m *MsgScheduleMarketRemovalsResponse) Reset()         { *m = MsgScheduleMarketRemovalsResponse{} }
+func (m *MsgScheduleMarketRemovalsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketRemovalsResponse) ProtoMessage()    {}
+func (*MsgScheduleMarketRemovalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{15}
 }
-func (*UnimplementedMsgServer) UpdateMarkets(ctx context.Context, req *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarkets not implemented")
+func (m *MsgScheduleMarketRemovalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgScheduleMarketRemovalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketRemovalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveMarketAuthorities(ctx context.Context, req *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketAuthorities not implemented")
+func (m *MsgScheduleMarketRemovalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketRemovalsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpsertMarkets(ctx context.Context, req *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarkets not implemented")
+func (m *MsgScheduleMarketRemovalsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+func (m *MsgScheduleMarketRemovalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketRemovalsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgScheduleMarketRemovalsResponse proto.InternalMessageInfo
 
-func _Msg_CreateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgScheduleMarketRemovalsResponse) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateMarkets(ctx, in)
+	return nil
+}
+
+// MsgCancelScheduledMarketChanges defines the Msg/CancelScheduledMarketChanges
+// request type.
+type MsgCancelScheduledMarketChanges struct {
+	// Authority is the signer of this transaction. This must be the module
+	// authority, the admin, or the address that scheduled each change.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Ids are the identifiers of the scheduled changes to cancel.
+	Ids []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *MsgCancelScheduledMarketChanges) Reset()         { *m = MsgCancelScheduledMarketChanges{} }
+func (m *MsgCancelScheduledMarketChanges) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMarketChanges) ProtoMessage()    {}
+func (*MsgCancelScheduledMarketChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{16}
+}
+func (m *MsgCancelScheduledMarketChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMarketChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMarketChanges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/CreateMarkets",
+}
+func (m *MsgCancelScheduledMarketChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMarketChanges.Merge(m, src)
+}
+func (m *MsgCancelScheduledMarketChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMarketChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMarketChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledMarketChanges proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledMarketChanges) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMarkets(ctx, req.(*MsgCreateMarkets))
+	return ""
+}
+
+func (m *MsgCancelScheduledMarketChanges) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpdateMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMarkets(ctx, req.(*MsgUpdateMarkets))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelScheduledMarketChangesResponse defines the
+// Msg/CancelScheduledMarketChanges response type.
+type MsgCancelScheduledMarketChangesResponse struct {
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgParams))
+func (m *MsgCancelScheduledMarketChangesResponse) Reset() {
+	*m = MsgCancelScheduledMarketChangesResponse{}
+}
+func (m *MsgCancelScheduledMarketChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMarketChangesResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledMarketChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{17}
+}
+func (m *MsgCancelScheduledMarketChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMarketChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMarketChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelScheduledMarketChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMarketChangesResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledMarketChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMarketChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMarketChangesResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemoveMarketAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMarketAuthorities)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMarketAuthorities(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarketAuthorities",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMarketAuthorities(ctx, req.(*MsgRemoveMarketAuthorities))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelScheduledMarketChangesResponse proto.InternalMessageInfo
+
+// MsgSetMarketAuthorityGrants defines the Msg/SetMarketAuthorityGrants request
+// type.
+type MsgSetMarketAuthorityGrants struct {
+	// Authority is the signer of this transaction. This must be the module
+	// authority or the admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Grants are the scoped market authority grants to set.
+	Grants []MarketAuthorityGrant `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants"`
 }
 
-func _Msg_UpsertMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpsertMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpsertMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpsertMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpsertMarkets(ctx, req.(*MsgUpsertMarkets))
+func (m *MsgSetMarketAuthorityGrants) Reset()         { *m = MsgSetMarketAuthorityGrants{} }
+func (m *MsgSetMarketAuthorityGrants) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarketAuthorityGrants) ProtoMessage()    {}
+func (*MsgSetMarketAuthorityGrants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{18}
+}
+func (m *MsgSetMarketAuthorityGrants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarketAuthorityGrants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarketAuthorityGrants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetMarketAuthorityGrants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarketAuthorityGrants.Merge(m, src)
+}
+func (m *MsgSetMarketAuthorityGrants) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarketAuthorityGrants) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarketAuthorityGrants.DiscardUnknown(m)
 }
 
-func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarkets",
+var xxx_messageInfo_MsgSetMarketAuthorityGrants proto.InternalMessageInfo
+
+func (m *MsgSetMarketAuthorityGrants) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	return ""
+}
+
+func (m *MsgSetMarketAuthorityGrants) GetGrants() []MarketAuthorityGrant {
+	if m != nil {
+		return m.Grants
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.marketmap.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateMarkets",
-			Handler:    _Msg_CreateMarkets_Handler,
-		},
-		{
-			MethodName: "UpdateMarkets",
-			Handler:    _Msg_UpdateMarkets_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RemoveMarketAuthorities",
-			Handler:    _Msg_RemoveMarketAuthorities_Handler,
-		},
-		{
-			MethodName: "UpsertMarkets",
-			Handler:    _Msg_UpsertMarkets_Handler,
-		},
-		{
-			MethodName: "RemoveMarkets",
-			Handler:    _Msg_RemoveMarkets_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/marketmap/v2/tx.proto",
+// MsgSetMarketAuthorityGrantsResponse defines the
+// Msg/SetMarketAuthorityGrants response type.
+type MsgSetMarketAuthorityGrantsResponse struct {
 }
 
-func (m *MsgUpsertMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMarketAuthorityGrantsResponse) Reset()         { *m = MsgSetMarketAuthorityGrantsResponse{} }
+func (m *MsgSetMarketAuthorityGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarketAuthorityGrantsResponse) ProtoMessage()    {}
+func (*MsgSetMarketAuthorityGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{19}
+}
+func (m *MsgSetMarketAuthorityGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarketAuthorityGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarketAuthorityGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetMarketAuthorityGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarketAuthorityGrantsResponse.Merge(m, src)
+}
+func (m *MsgSetMarketAuthorityGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarketAuthorityGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarketAuthorityGrantsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpsertMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetMarketAuthorityGrantsResponse proto.InternalMessageInfo
+
+// MsgRevokeMarketAuthorityGrants defines the Msg/RevokeMarketAuthorityGrants
+// request type.
+type MsgRevokeMarketAuthorityGrants struct {
+	// Authority is the signer of this transaction. This must be the module
+	// authority or the admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Grantees are the addresses whose grants are revoked.
+	Grantees []string `protobuf:"bytes,2,rep,name=grantees,proto3" json:"grantees,omitempty"`
 }
 
-func (m *MsgUpsertMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgRevokeMarketAuthorityGrants) Reset()         { *m = MsgRevokeMarketAuthorityGrants{} }
+func (m *MsgRevokeMarketAuthorityGrants) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeMarketAuthorityGrants) ProtoMessage()    {}
+func (*MsgRevokeMarketAuthorityGrants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{20}
+}
+func (m *MsgRevokeMarketAuthorityGrants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeMarketAuthorityGrants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeMarketAuthorityGrants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRevokeMarketAuthorityGrants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeMarketAuthorityGrants.Merge(m, src)
+}
+func (m *MsgRevokeMarketAuthorityGrants) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeMarketAuthorityGrants) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeMarketAuthorityGrants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeMarketAuthorityGrants proto.InternalMessageInfo
+
+func (m *MsgRevokeMarketAuthorityGrants) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpsertMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeMarketAuthorityGrants) GetGrantees() []string {
+	if m != nil {
+		return m.Grantees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpsertMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeMarketAuthorityGrantsResponse defines the
+// Msg/RevokeMarketAuthorityGrants response type.
+type MsgRevokeMarketAuthorityGrantsResponse struct {
 }
 
-func (m *MsgUpsertMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarketUpdates) > 0 {
-		keysForMarketUpdates := make([]string, 0, len(m.MarketUpdates))
-		for k := range m.MarketUpdates {
-			keysForMarketUpdates = append(keysForMarketUpdates, string(k))
-		}
-		github_com_cosmos_gogoproto_sortkeys.Strings(keysForMarketUpdates)
-		for iNdEx := len(keysForMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.MarketUpdates[string(keysForMarketUpdates[iNdEx])]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(keysForMarketUpdates[iNdEx])
-			copy(dAtA[i:], keysForMarketUpdates[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(keysForMarketUpdates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *MsgRevokeMarketAuthorityGrantsResponse) Reset() {
+	*m = MsgRevokeMarketAuthorityGrantsResponse{}
+}
+func (m *MsgRevokeMarketAuthorityGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeMarketAuthorityGrantsResponse) ProtoMessage()    {}
+func (*MsgRevokeMarketAuthorityGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{21}
+}
+func (m *MsgRevokeMarketAuthorityGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeMarketAuthorityGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeMarketAuthorityGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeMarketAuthorityGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeMarketAuthorityGrantsResponse.Merge(m, src)
+}
+func (m *MsgRevokeMarketAuthorityGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeMarketAuthorityGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeMarketAuthorityGrantsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeMarketAuthorityGrantsResponse proto.InternalMessageInfo
+
+// MsgUpsertProviders defines the Msg/UpsertProviders request type.
+type MsgUpsertProviders struct {
+	// Authority is the signer of this transaction. This must be the module
+	// authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Providers are the providers to create or update.
+	Providers []ProviderInfo `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers"`
 }
 
-func (m *MsgCreateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreateMarkets) > 0 {
-		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpsertProviders) Reset()         { *m = MsgUpsertProviders{} }
+func (m *MsgUpsertProviders) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertProviders) ProtoMessage()    {}
+func (*MsgUpsertProviders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{22}
+}
+func (m *MsgUpsertProviders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpsertProviders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpsertProviders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpsertProviders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertProviders.Merge(m, src)
 }
-
-func (m *MsgCreateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpsertProviders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpsertProviders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertProviders.DiscardUnknown(m)
 }
 
-func (m *MsgCreateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpsertProviders proto.InternalMessageInfo
+
+func (m *MsgUpsertProviders) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgUpdateMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpsertProviders) GetProviders() []ProviderInfo {
+	if m != nil {
+		return m.Providers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpsertProvidersResponse defines the Msg/UpsertProviders response type.
+type MsgUpsertProvidersResponse struct {
 }
 
-func (m *MsgUpdateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdateMarkets) > 0 {
-		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpsertProvidersResponse) Reset()         { *m = MsgUpsertProvidersResponse{} }
+func (m *MsgUpsertProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertProvidersResponse) ProtoMessage()    {}
+func (*MsgUpsertProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{23}
+}
+func (m *MsgUpsertProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpsertProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpsertProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpsertProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertProvidersResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpsertProvidersResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpsertProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpsertProvidersResponse proto.InternalMessageInfo
 
-func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveProviders defines the Msg/RemoveProviders request type.
+type MsgRemoveProviders struct {
+	// Authority is the signer of this transaction. This must be the module
+	// authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Names are the names of the providers to remove.
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
 }
 
-func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRemoveProviders) Reset()         { *m = MsgRemoveProviders{} }
+func (m *MsgRemoveProviders) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveProviders) ProtoMessage()    {}
+func (*MsgRemoveProviders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{24}
+}
+func (m *MsgRemoveProviders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveProviders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveProviders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveProviders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveProviders.Merge(m, src)
+}
+func (m *MsgRemoveProviders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveProviders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveProviders.DiscardUnknown(m)
 }
 
-func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveProviders proto.InternalMessageInfo
+
+func (m *MsgRemoveProviders) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveProviders) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
 }
 
-func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRemoveProvidersResponse defines the Msg/RemoveProviders response type.
+type MsgRemoveProvidersResponse struct {
 }
 
-func (m *MsgRemoveMarketAuthorities) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveProvidersResponse) Reset()         { *m = MsgRemoveProvidersResponse{} }
+func (m *MsgRemoveProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveProvidersResponse) ProtoMessage()    {}
+func (*MsgRemoveProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{25}
+}
+func (m *MsgRemoveProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveMarketAuthorities) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveProvidersResponse.Merge(m, src)
 }
-
-func (m *MsgRemoveMarketAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RemoveAddresses) > 0 {
-		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveAddresses[iNdEx])
-			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRemoveProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgRemoveProvidersResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpsertMarkets)(nil), "connect.marketmap.v2.MsgUpsertMarkets")
+	proto.RegisterType((*MsgUpsertMarketsResponse)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse.MarketUpdatesEntry")
+	proto.RegisterType((*MsgCreateMarkets)(nil), "connect.marketmap.v2.MsgCreateMarkets")
+	proto.RegisterType((*MsgCreateMarketsResponse)(nil), "connect.marketmap.v2.MsgCreateMarketsResponse")
+	proto.RegisterType((*MsgUpdateMarkets)(nil), "connect.marketmap.v2.MsgUpdateMarkets")
+	proto.RegisterType((*MsgUpdateMarketsResponse)(nil), "connect.marketmap.v2.MsgUpdateMarketsResponse")
+	proto.RegisterType((*MsgParams)(nil), "connect.marketmap.v2.MsgParams")
+	proto.RegisterType((*MsgParamsResponse)(nil), "connect.marketmap.v2.MsgParamsResponse")
+	proto.RegisterType((*MsgRemoveMarketAuthorities)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthorities")
+	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthoritiesResponse")
+	proto.RegisterType((*MsgRemoveMarkets)(nil), "connect.marketmap.v2.MsgRemoveMarkets")
+	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketsResponse")
+	proto.RegisterType((*MsgScheduleMarketUpdates)(nil), "connect.marketmap.v2.MsgScheduleMarketUpdates")
+	proto.RegisterType((*MsgScheduleMarketUpdatesResponse)(nil), "connect.marketmap.v2.MsgScheduleMarketUpdatesResponse")
+	proto.RegisterType((*MsgScheduleMarketRemovals)(nil), "connect.marketmap.v2.MsgScheduleMarketRemovals")
+	proto.RegisterType((*MsgScheduleMarketRemovalsResponse)(nil), "connect.marketmap.v2.MsgScheduleMarketRemovalsResponse")
+	proto.RegisterType((*MsgCancelScheduledMarketChanges)(nil), "connect.marketmap.v2.MsgCancelScheduledMarketChanges")
+	proto.RegisterType((*MsgCancelScheduledMarketChangesResponse)(nil), "connect.marketmap.v2.MsgCancelScheduledMarketChangesResponse")
+	proto.RegisterType((*MsgSetMarketAuthorityGrants)(nil), "connect.marketmap.v2.MsgSetMarketAuthorityGrants")
+	proto.RegisterType((*MsgSetMarketAuthorityGrantsResponse)(nil), "connect.marketmap.v2.MsgSetMarketAuthorityGrantsResponse")
+	proto.RegisterType((*MsgRevokeMarketAuthorityGrants)(nil), "connect.marketmap.v2.MsgRevokeMarketAuthorityGrants")
+	proto.RegisterType((*MsgRevokeMarketAuthorityGrantsResponse)(nil), "connect.marketmap.v2.MsgRevokeMarketAuthorityGrantsResponse")
+	proto.RegisterType((*MsgUpsertProviders)(nil), "connect.marketmap.v2.MsgUpsertProviders")
+	proto.RegisterType((*MsgUpsertProvidersResponse)(nil), "connect.marketmap.v2.MsgUpsertProvidersResponse")
+	proto.RegisterType((*MsgRemoveProviders)(nil), "connect.marketmap.v2.MsgRemoveProviders")
+	proto.RegisterType((*MsgRemoveProvidersResponse)(nil), "connect.marketmap.v2.MsgRemoveProvidersResponse")
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("connect/marketmap/v2/tx.proto", fileDescriptor_37df9476ca9a2f81) }
+
+var fileDescriptor_37df9476ca9a2f81 = []byte{
+	// 1211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd8, 0x49, 0xa8, 0x1f, 0xcd, 0x47, 0x97, 0xd0, 0x6e, 0xb7, 0xc1, 0x49, 0x36, 0xc5,
+	0x71, 0x23, 0xd5, 0x4e, 0x43, 0x9b, 0xb6, 0xa6, 0x48, 0x24, 0x11, 0x1f, 0x45, 0x8a, 0x54, 0xb9,
+	0xaa, 0x84, 0xb8, 0x44, 0x5b, 0xef, 0xb0, 0x5e, 0xc5, 0xfb, 0xa1, 0x9d, 0xb5, 0xd5, 0x9c, 0x40,
+	0x45, 0x48, 0x88, 0x0b, 0x20, 0x71, 0xe0, 0x82, 0x04, 0x27, 0x2e, 0x48, 0xcd, 0x81, 0x0b, 0x7f,
+	0x00, 0x52, 0x6f, 0x44, 0xbd, 0xc0, 0x09, 0xa1, 0xe4, 0x10, 0xfe, 0x0c, 0xe4, 0x9d, 0xd9, 0xc9,
+	0xae, 0xbd, 0xb3, 0x5e, 0x3b, 0xbd, 0xb4, 0x3b, 0x33, 0xbf, 0xf7, 0xde, 0xef, 0xf7, 0x66, 0xe6,
+	0xcd, 0x73, 0xe0, 0x8d, 0x86, 0x63, 0xdb, 0xb8, 0xe1, 0x57, 0x2d, 0xcd, 0xdb, 0xc3, 0xbe, 0xa5,
+	0xb9, 0xd5, 0xce, 0x7a, 0xd5, 0x7f, 0x52, 0x71, 0x3d, 0xc7, 0x77, 0xa4, 0x39, 0xb6, 0x5c, 0xe1,
+	0xcb, 0x95, 0xce, 0xba, 0x72, 0xa9, 0xe1, 0x10, 0xcb, 0x21, 0x55, 0x8b, 0x18, 0xd5, 0xce, 0x8d,
+	0xee, 0x7f, 0x14, 0xae, 0xcc, 0x19, 0x8e, 0xe1, 0x04, 0x9f, 0xd5, 0xee, 0x17, 0x9b, 0xbd, 0x4c,
+	0xe1, 0xbb, 0x74, 0x81, 0x0e, 0xd8, 0xd2, 0x05, 0xcd, 0x32, 0x6d, 0xa7, 0x1a, 0xfc, 0xcb, 0xa6,
+	0x96, 0x12, 0x19, 0xd1, 0x41, 0x2a, 0xc4, 0xd5, 0x3c, 0xcd, 0x0a, 0x1d, 0x2f, 0x27, 0x42, 0x48,
+	0xa3, 0x89, 0xf5, 0x76, 0x0b, 0x33, 0xd0, 0xd5, 0x44, 0x90, 0xd6, 0xf6, 0x9b, 0x8e, 0x67, 0xfa,
+	0xfb, 0xa9, 0xae, 0x3c, 0x6c, 0x98, 0xc4, 0xf7, 0x18, 0x48, 0xfd, 0x03, 0xc1, 0xec, 0x0e, 0x31,
+	0x1e, 0xb9, 0x04, 0x7b, 0xfe, 0x4e, 0x00, 0x24, 0xd2, 0x06, 0x14, 0xb8, 0x33, 0x19, 0x2d, 0xa2,
+	0x72, 0x61, 0x4b, 0x7e, 0xf1, 0xdb, 0xf5, 0x39, 0x96, 0x82, 0x4d, 0x5d, 0xf7, 0x30, 0x21, 0x0f,
+	0x7d, 0xcf, 0xb4, 0x8d, 0xfa, 0x29, 0x54, 0xba, 0x07, 0xaf, 0xd0, 0x58, 0x44, 0xce, 0x2d, 0xe6,
+	0xcb, 0xaf, 0xae, 0xcf, 0x57, 0x92, 0xf6, 0xa1, 0x42, 0xe3, 0x6c, 0x8d, 0x3f, 0xff, 0x67, 0x61,
+	0xac, 0x1e, 0x9a, 0xd4, 0xde, 0xfe, 0xef, 0xa7, 0x85, 0xb1, 0xa7, 0x27, 0x07, 0xab, 0xa7, 0x1e,
+	0xbf, 0x3e, 0x39, 0x58, 0xe5, 0x62, 0x9f, 0x44, 0x84, 0xf4, 0x52, 0x56, 0x0f, 0x11, 0xc8, 0xbd,
+	0x93, 0x75, 0x4c, 0x5c, 0xc7, 0x26, 0x58, 0x6a, 0xc1, 0x34, 0x35, 0xdd, 0x6d, 0xbb, 0xba, 0xe6,
+	0x63, 0x22, 0xa3, 0x80, 0xde, 0xa6, 0x80, 0x9e, 0xc0, 0x0f, 0xe3, 0xfd, 0x88, 0xfa, 0x78, 0xcf,
+	0xf6, 0xbd, 0xfd, 0xad, 0x9c, 0x8c, 0xea, 0x53, 0x56, 0x74, 0x5e, 0x79, 0x17, 0xa4, 0x7e, 0xa0,
+	0x34, 0x0b, 0xf9, 0x3d, 0xcc, 0xb2, 0x59, 0xef, 0x7e, 0x4a, 0x73, 0x30, 0xd1, 0xd1, 0x5a, 0x6d,
+	0x2c, 0xe7, 0x16, 0x51, 0xf9, 0x5c, 0x9d, 0x0e, 0x6a, 0xb9, 0x3b, 0xa8, 0x36, 0xfe, 0xc3, 0xcf,
+	0x0b, 0x48, 0x7d, 0x41, 0xb7, 0x66, 0xdb, 0xc3, 0x9a, 0x8f, 0xcf, 0xba, 0x35, 0xf7, 0x61, 0xba,
+	0x11, 0x38, 0xda, 0x1d, 0x7e, 0x87, 0xa6, 0x1a, 0x51, 0x0a, 0xc3, 0xee, 0x53, 0x8c, 0xbf, 0xaa,
+	0x80, 0xdc, 0x3b, 0x17, 0xa6, 0x37, 0x14, 0x4c, 0xd3, 0xf6, 0x12, 0x04, 0xd3, 0xcd, 0x1e, 0x45,
+	0x70, 0xdb, 0xd5, 0x47, 0x17, 0x1c, 0xe3, 0xcf, 0x04, 0xc7, 0xe6, 0xb8, 0xe0, 0x6f, 0x10, 0x14,
+	0x76, 0x88, 0xf1, 0x20, 0x28, 0x00, 0x52, 0x0d, 0x26, 0x69, 0x29, 0x08, 0x64, 0x0a, 0x99, 0x52,
+	0x34, 0x63, 0xca, 0x2c, 0xe2, 0x59, 0xca, 0x65, 0xce, 0x52, 0x6d, 0x3a, 0x2e, 0x4b, 0x7d, 0x0d,
+	0x2e, 0x70, 0x42, 0x9c, 0xe6, 0x17, 0x08, 0x94, 0x1d, 0x62, 0xd4, 0xb1, 0xe5, 0x74, 0x98, 0x86,
+	0x4d, 0x66, 0x61, 0x62, 0x22, 0x5d, 0x83, 0x59, 0x2f, 0x58, 0xda, 0xd5, 0x68, 0x18, 0x76, 0xbf,
+	0x0a, 0xf5, 0x19, 0x3a, 0xbf, 0x19, 0x4e, 0x4b, 0x15, 0x98, 0xd0, 0x74, 0xcb, 0xb4, 0x07, 0x52,
+	0xa4, 0xb0, 0x1a, 0x74, 0xe9, 0xd1, 0x6f, 0xf5, 0x2a, 0xa8, 0x62, 0x12, 0x9c, 0x6b, 0x13, 0x66,
+	0x7b, 0x50, 0x91, 0xa8, 0x28, 0x53, 0x54, 0x49, 0x8e, 0x97, 0xb1, 0xc2, 0x69, 0x89, 0x8a, 0xf2,
+	0xd9, 0x06, 0xb9, 0x37, 0x12, 0x2f, 0x38, 0x2b, 0x30, 0xa3, 0xe3, 0x16, 0xf6, 0xb1, 0xce, 0x4f,
+	0x1f, 0xcd, 0xc8, 0x34, 0x9b, 0x0e, 0x4f, 0xc7, 0xef, 0xb9, 0xc0, 0xcb, 0x43, 0x56, 0xdf, 0x63,
+	0x75, 0x63, 0xe4, 0xa3, 0x7f, 0x11, 0x26, 0x9b, 0xd8, 0x34, 0x9a, 0x7e, 0x90, 0xe6, 0xf1, 0x3a,
+	0x1b, 0x25, 0xd4, 0x80, 0xfc, 0x88, 0x35, 0x20, 0xe1, 0x76, 0x8d, 0x8f, 0x7a, 0xbb, 0x6e, 0x27,
+	0xdf, 0xae, 0xc5, 0xf0, 0x76, 0x89, 0xd2, 0xa3, 0xde, 0x84, 0x45, 0xd1, 0x1a, 0xdf, 0x88, 0x59,
+	0xc8, 0x9b, 0x3a, 0x4d, 0xfe, 0x78, 0xbd, 0xfb, 0xa9, 0x3e, 0x43, 0x70, 0xb9, 0xcf, 0x2c, 0xd8,
+	0x45, 0xad, 0x35, 0xfc, 0x51, 0x11, 0xa5, 0x3a, 0x72, 0x84, 0xf2, 0xf1, 0x23, 0xb4, 0x76, 0x7a,
+	0x84, 0xba, 0x32, 0x97, 0x84, 0x32, 0x43, 0x4e, 0xea, 0x2d, 0x58, 0x12, 0x2e, 0xa6, 0x08, 0xfd,
+	0x05, 0xc1, 0x42, 0xb7, 0xd4, 0x6a, 0x76, 0x03, 0xb7, 0x42, 0x6b, 0x76, 0xf0, 0xb6, 0x9b, 0x9a,
+	0x6d, 0x9c, 0xe1, 0x84, 0xb1, 0x68, 0x39, 0x1e, 0xad, 0x56, 0xeb, 0xdf, 0xc1, 0x95, 0x88, 0xb4,
+	0x34, 0x16, 0xea, 0x35, 0x58, 0x19, 0x00, 0xe1, 0xd7, 0xfb, 0x2f, 0x04, 0x57, 0xba, 0xc9, 0xc0,
+	0x7e, 0xbc, 0x04, 0xec, 0x7f, 0xe0, 0x69, 0xf6, 0x19, 0x5e, 0x8b, 0x0f, 0x61, 0xd2, 0x08, 0x3c,
+	0xb0, 0x57, 0x62, 0x35, 0xed, 0x1c, 0xc7, 0x83, 0x86, 0x95, 0x98, 0xda, 0xd7, 0x36, 0xfa, 0x13,
+	0xb1, 0x1c, 0xdd, 0x63, 0x01, 0x73, 0xf5, 0x4d, 0x58, 0x4e, 0x59, 0xe6, 0x09, 0x78, 0x86, 0xa0,
+	0x18, 0x94, 0x9d, 0x8e, 0xb3, 0x87, 0x5f, 0x6e, 0x0e, 0x14, 0x38, 0x17, 0x68, 0xc0, 0x38, 0xac,
+	0x7b, 0x7c, 0x5c, 0xbb, 0xdb, 0xaf, 0xaa, 0x14, 0x51, 0x95, 0x42, 0x47, 0x2d, 0x43, 0x29, 0x1d,
+	0xc1, 0xb5, 0xfd, 0x89, 0x40, 0xe2, 0xbd, 0xd7, 0x03, 0xcf, 0xe9, 0x98, 0x3a, 0xf6, 0x46, 0xd7,
+	0xf3, 0x3e, 0x14, 0xdc, 0xd0, 0x09, 0xdb, 0x56, 0x55, 0xf0, 0xa4, 0x32, 0xd8, 0x7d, 0xfb, 0x53,
+	0x87, 0x6d, 0xe7, 0xa9, 0x69, 0xaa, 0xf6, 0xc4, 0x9e, 0x94, 0x53, 0x57, 0xe7, 0x41, 0xe9, 0x9f,
+	0xe5, 0x7a, 0x7f, 0xa4, 0x7a, 0xe9, 0x13, 0x72, 0x76, 0xbd, 0x73, 0x30, 0x61, 0x6b, 0x16, 0xdf,
+	0x3c, 0x3a, 0x18, 0x86, 0x7d, 0x0f, 0x11, 0xc6, 0xbe, 0x67, 0x36, 0x64, 0xbf, 0xfe, 0xeb, 0x79,
+	0xc8, 0xef, 0x10, 0x43, 0x32, 0x60, 0x2a, 0xde, 0xa2, 0x96, 0x84, 0x5d, 0x75, 0x0c, 0xa7, 0x54,
+	0xb2, 0xe1, 0x78, 0x89, 0x33, 0x60, 0x2a, 0xde, 0x1a, 0x96, 0x52, 0xda, 0x77, 0x3d, 0x53, 0xa0,
+	0xc4, 0xb6, 0x4c, 0xfa, 0x18, 0xce, 0xd3, 0x05, 0xd6, 0x98, 0x2d, 0x08, 0xed, 0x29, 0x40, 0x59,
+	0x19, 0x00, 0xe0, 0x9e, 0xbf, 0x44, 0x70, 0x49, 0xd4, 0x46, 0xad, 0x09, 0x9d, 0x08, 0x2c, 0x94,
+	0x3b, 0xc3, 0x5a, 0xc4, 0x53, 0x19, 0xfd, 0xc5, 0x57, 0xca, 0xf6, 0x4b, 0x48, 0xa9, 0x64, 0xc3,
+	0x45, 0x03, 0xc5, 0x7b, 0xb1, 0x52, 0x26, 0xce, 0x69, 0x81, 0x92, 0x3b, 0xae, 0xcf, 0xe0, 0xf5,
+	0xe4, 0x26, 0x4a, 0xec, 0x28, 0x11, 0xaf, 0x6c, 0x0c, 0x87, 0xe7, 0x04, 0x9e, 0x22, 0xb8, 0x28,
+	0x68, 0x2a, 0xaa, 0x19, 0x5d, 0x86, 0x06, 0xca, 0xed, 0x21, 0x0d, 0x38, 0x89, 0xef, 0x11, 0xcc,
+	0xa7, 0x3e, 0xf8, 0xb7, 0xc4, 0x77, 0x2e, 0xc5, 0x4c, 0x79, 0x67, 0x24, 0x33, 0x4e, 0xeb, 0x2b,
+	0x04, 0xb2, 0xf0, 0xc9, 0xbe, 0x21, 0x16, 0x2b, 0x30, 0x51, 0xee, 0x0e, 0x6d, 0xc2, 0xa9, 0x7c,
+	0x87, 0xe0, 0x4a, 0xda, 0xe3, 0x79, 0x33, 0xe5, 0xdc, 0x09, 0xad, 0x94, 0x7b, 0xa3, 0x58, 0x71,
+	0x4e, 0x16, 0xcc, 0xf4, 0xbe, 0x79, 0xe5, 0x01, 0xf7, 0x8c, 0x23, 0x95, 0xb5, 0xac, 0xc8, 0x68,
+	0xb8, 0xde, 0x27, 0xa7, 0x3c, 0xe0, 0xb6, 0x65, 0x09, 0x27, 0x78, 0x27, 0x94, 0x89, 0xcf, 0x4f,
+	0x0e, 0x56, 0xd1, 0xd6, 0x47, 0xcf, 0x8f, 0x8a, 0xe8, 0xf0, 0xa8, 0x88, 0xfe, 0x3d, 0x2a, 0xa2,
+	0x6f, 0x8f, 0x8b, 0x63, 0x87, 0xc7, 0xc5, 0xb1, 0xbf, 0x8f, 0x8b, 0x63, 0x9f, 0xac, 0x19, 0xa6,
+	0xdf, 0x6c, 0x3f, 0xae, 0x34, 0x1c, 0xab, 0x4a, 0xf6, 0x4c, 0xf7, 0xba, 0x85, 0x3b, 0xd5, 0xf0,
+	0x89, 0xea, 0xac, 0xc7, 0x5e, 0x29, 0x7f, 0xdf, 0xc5, 0xe4, 0xf1, 0x64, 0xf0, 0xb7, 0xab, 0xb7,
+	0xfe, 0x1f, 0x00, 0x91, 0xe1, 0xa2, 0x6f, 0x05, 0x14, 0x00, 0x00,
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateMarkets creates markets from the given message.
+	CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error)
+	// UpdateMarkets updates markets from the given message.
+	UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error)
+	// UpdateParams defines a method for updating the x/marketmap module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// RemoveMarketAuthorities defines a method for removing market authorities
+	// from the x/marketmap module. the signer must be the admin.
+	RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// UpsertMarkets wraps both Create / Update markets into a single message.
+	// Specifically if a market does not exist it will be created, otherwise it
+	// will be updated. The response will be a map between ticker -> updated.
+	UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the marketmap if:
+	// - they exist in the map
+	// - they are disabled
+	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules market creates and updates to be applied
+	// at the beginning of a future block. The signer must be a market authority.
+	ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error)
+	// ScheduleMarketRemovals schedules market removals to be applied at the
+	// beginning of a future block. The signer must be the admin.
+	ScheduleMarketRemovals(ctx context.Context, in *MsgScheduleMarketRemovals, opts ...grpc.CallOption) (*MsgScheduleMarketRemovalsResponse, error)
+	// CancelScheduledMarketChanges cancels pending scheduled market changes. The
+	// signer must be the module authority, the admin, or the address that
+	// scheduled each change.
+	CancelScheduledMarketChanges(ctx context.Context, in *MsgCancelScheduledMarketChanges, opts ...grpc.CallOption) (*MsgCancelScheduledMarketChangesResponse, error)
+	// SetMarketAuthorityGrants sets scoped market authority grants, overwriting
+	// any existing grant to the same grantee. The signer must be the module
+	// authority or the admin.
+	SetMarketAuthorityGrants(ctx context.Context, in *MsgSetMarketAuthorityGrants, opts ...grpc.CallOption) (*MsgSetMarketAuthorityGrantsResponse, error)
+	// RevokeMarketAuthorityGrants revokes the scoped market authority grants of
+	// the given grantees. The signer must be the module authority or the admin.
+	RevokeMarketAuthorityGrants(ctx context.Context, in *MsgRevokeMarketAuthorityGrants, opts ...grpc.CallOption) (*MsgRevokeMarketAuthorityGrantsResponse, error)
+	// UpsertProviders creates or updates entries in the registry of known
+	// providers. The signer must be the module authority.
+	UpsertProviders(ctx context.Context, in *MsgUpsertProviders, opts ...grpc.CallOption) (*MsgUpsertProvidersResponse, error)
+	// RemoveProviders removes entries from the registry of known providers. The
+	// signer must be the module authority.
+	RemoveProviders(ctx context.Context, in *MsgRemoveProviders, opts ...grpc.CallOption) (*MsgRemoveProvidersResponse, error)
 }
 
-func (m *MsgRemoveMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgRemoveMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgRemoveMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Markets[iNdEx])
-			copy(dAtA[i:], m.Markets[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error) {
+	out := new(MsgCreateMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CreateMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error) {
+	out := new(MsgUpdateMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateMarkets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
+	out := new(MsgParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeletedMarkets) > 0 {
-		for iNdEx := len(m.DeletedMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DeletedMarkets[iNdEx])
-			copy(dAtA[i:], m.DeletedMarkets[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedMarkets[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *msgClient) RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error) {
+	out := new(MsgRemoveMarketAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarketAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error) {
+	out := new(MsgUpsertMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpsertMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpsertMarkets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
+	out := new(MsgRemoveMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpsertMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MarketUpdates) > 0 {
-		for k, v := range m.MarketUpdates {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
-		}
+func (c *msgClient) ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error) {
+	out := new(MsgScheduleMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/ScheduleMarketUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateMarkets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) ScheduleMarketRemovals(ctx context.Context, in *MsgScheduleMarketRemovals, opts ...grpc.CallOption) (*MsgScheduleMarketRemovalsResponse, error) {
+	out := new(MsgScheduleMarketRemovalsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/ScheduleMarketRemovals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CreateMarkets) > 0 {
-		for _, e := range m.CreateMarkets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMarketChanges(ctx context.Context, in *MsgCancelScheduledMarketChanges, opts ...grpc.CallOption) (*MsgCancelScheduledMarketChangesResponse, error) {
+	out := new(MsgCancelScheduledMarketChangesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CancelScheduledMarketChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetMarketAuthorityGrants(ctx context.Context, in *MsgSetMarketAuthorityGrants, opts ...grpc.CallOption) (*MsgSetMarketAuthorityGrantsResponse, error) {
+	out := new(MsgSetMarketAuthorityGrantsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/SetMarketAuthorityGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateMarkets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) RevokeMarketAuthorityGrants(ctx context.Context, in *MsgRevokeMarketAuthorityGrants, opts ...grpc.CallOption) (*MsgRevokeMarketAuthorityGrantsResponse, error) {
+	out := new(MsgRevokeMarketAuthorityGrantsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RevokeMarketAuthorityGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UpdateMarkets) > 0 {
-		for _, e := range m.UpdateMarkets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) UpsertProviders(ctx context.Context, in *MsgUpsertProviders, opts ...grpc.CallOption) (*MsgUpsertProvidersResponse, error) {
+	out := new(MsgUpsertProvidersResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpsertProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveProviders(ctx context.Context, in *MsgRemoveProviders, opts ...grpc.CallOption) (*MsgRemoveProvidersResponse, error) {
+	out := new(MsgRemoveProvidersResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgParams) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateMarkets creates markets from the given message.
+	CreateMarkets(context.Context, *MsgCreateMarkets) (*MsgCreateMarketsResponse, error)
+	// UpdateMarkets updates markets from the given message.
+	UpdateMarkets(context.Context, *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error)
+	// UpdateParams defines a method for updating the x/marketmap module
+	// parameters.
+	UpdateParams(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// RemoveMarketAuthorities defines a method for removing market authorities
+	// from the x/marketmap module. the signer must be the admin.
+	RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// UpsertMarkets wraps both Create / Update markets into a single message.
+	// Specifically if a market does not exist it will be created, otherwise it
+	// will be updated. The response will be a map between ticker -> updated.
+	UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the marketmap if:
+	// - they exist in the map
+	// - they are disabled
+	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules market creates and updates to be applied
+	// at the beginning of a future block. The signer must be a market authority.
+	ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error)
+	// ScheduleMarketRemovals schedules market removals to be applied at the
+	// beginning of a future block. The signer must be the admin.
+	ScheduleMarketRemovals(context.Context, *MsgScheduleMarketRemovals) (*MsgScheduleMarketRemovalsResponse, error)
+	// CancelScheduledMarketChanges cancels pending scheduled market changes. The
+	// signer must be the module authority, the admin, or the address that
+	// scheduled each change.
+	CancelScheduledMarketChanges(context.Context, *MsgCancelScheduledMarketChanges) (*MsgCancelScheduledMarketChangesResponse, error)
+	// SetMarketAuthorityGrants sets scoped market authority grants, overwriting
+	// any existing grant to the same grantee. The signer must be the module
+	// authority or the admin.
+	SetMarketAuthorityGrants(context.Context, *MsgSetMarketAuthorityGrants) (*MsgSetMarketAuthorityGrantsResponse, error)
+	// RevokeMarketAuthorityGrants revokes the scoped market authority grants of
+	// the given grantees. The signer must be the module authority or the admin.
+	RevokeMarketAuthorityGrants(context.Context, *MsgRevokeMarketAuthorityGrants) (*MsgRevokeMarketAuthorityGrantsResponse, error)
+	// UpsertProviders creates or updates entries in the registry of known
+	// providers. The signer must be the module authority.
+	UpsertProviders(context.Context, *MsgUpsertProviders) (*MsgUpsertProvidersResponse, error)
+	// RemoveProviders removes entries from the registry of known providers. The
+	// signer must be the module authority.
+	RemoveProviders(context.Context, *MsgRemoveProviders) (*MsgRemoveProvidersResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateMarkets(ctx context.Context, req *MsgCreateMarkets) (*MsgCreateMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMarkets not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMarkets(ctx context.Context, req *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarkets not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMarketAuthorities(ctx context.Context, req *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketAuthorities not implemented")
+}
+func (*UnimplementedMsgServer) UpsertMarkets(ctx context.Context, req *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarkets not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleMarketUpdates(ctx context.Context, req *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketUpdates not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleMarketRemovals(ctx context.Context, req *MsgScheduleMarketRemovals) (*MsgScheduleMarketRemovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketRemovals not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledMarketChanges(ctx context.Context, req *MsgCancelScheduledMarketChanges) (*MsgCancelScheduledMarketChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMarketChanges not implemented")
+}
+func (*UnimplementedMsgServer) SetMarketAuthorityGrants(ctx context.Context, req *MsgSetMarketAuthorityGrants) (*MsgSetMarketAuthorityGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketAuthorityGrants not implemented")
+}
+func (*UnimplementedMsgServer) RevokeMarketAuthorityGrants(ctx context.Context, req *MsgRevokeMarketAuthorityGrants) (*MsgRevokeMarketAuthorityGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMarketAuthorityGrants not implemented")
+}
+func (*UnimplementedMsgServer) UpsertProviders(ctx context.Context, req *MsgUpsertProviders) (*MsgUpsertProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProviders not implemented")
+}
+func (*UnimplementedMsgServer) RemoveProviders(ctx context.Context, req *MsgRemoveProviders) (*MsgRemoveProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProviders not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMarkets(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/CreateMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMarkets(ctx, req.(*MsgCreateMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpdateMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMarkets(ctx, req.(*MsgUpdateMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarketAuthorities) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RemoveAddresses) > 0 {
-		for _, s := range m.RemoveAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpdateParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveMarketAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarketAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarketAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarketAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarketAuthorities(ctx, req.(*MsgRemoveMarketAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarkets) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpsertMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertMarkets(ctx, in)
 	}
-	if len(m.Markets) > 0 {
-		for _, s := range m.Markets {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpsertMarkets",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertMarkets(ctx, req.(*MsgUpsertMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DeletedMarkets) > 0 {
-		for _, s := range m.DeletedMarkets {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarkets(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_ScheduleMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/ScheduleMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, req.(*MsgScheduleMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_ScheduleMarketRemovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketRemovals)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketRemovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/ScheduleMarketRemovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketRemovals(ctx, req.(*MsgScheduleMarketRemovals))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMarketChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMarketChanges)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMarketChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/CancelScheduledMarketChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMarketChanges(ctx, req.(*MsgCancelScheduledMarketChanges))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarketAuthorityGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarketAuthorityGrants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarketAuthorityGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/SetMarketAuthorityGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarketAuthorityGrants(ctx, req.(*MsgSetMarketAuthorityGrants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeMarketAuthorityGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeMarketAuthorityGrants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeMarketAuthorityGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RevokeMarketAuthorityGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeMarketAuthorityGrants(ctx, req.(*MsgRevokeMarketAuthorityGrants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpsertProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpsertProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertProviders(ctx, req.(*MsgUpsertProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RemoveProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveProviders(ctx, req.(*MsgRemoveProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.marketmap.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMarkets",
+			Handler:    _Msg_CreateMarkets_Handler,
+		},
+		{
+			MethodName: "UpdateMarkets",
+			Handler:    _Msg_UpdateMarkets_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RemoveMarketAuthorities",
+			Handler:    _Msg_RemoveMarketAuthorities_Handler,
+		},
+		{
+			MethodName: "UpsertMarkets",
+			Handler:    _Msg_UpsertMarkets_Handler,
+		},
+		{
+			MethodName: "RemoveMarkets",
+			Handler:    _Msg_RemoveMarkets_Handler,
+		},
+		{
+			MethodName: "ScheduleMarketUpdates",
+			Handler:    _Msg_ScheduleMarketUpdates_Handler,
+		},
+		{
+			MethodName: "ScheduleMarketRemovals",
+			Handler:    _Msg_ScheduleMarketRemovals_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMarketChanges",
+			Handler:    _Msg_CancelScheduledMarketChanges_Handler,
+		},
+		{
+			MethodName: "SetMarketAuthorityGrants",
+			Handler:    _Msg_SetMarketAuthorityGrants_Handler,
+		},
+		{
+			MethodName: "RevokeMarketAuthorityGrants",
+			Handler:    _Msg_RevokeMarketAuthorityGrants_Handler,
+		},
+		{
+			MethodName: "UpsertProviders",
+			Handler:    _Msg_UpsertProviders_Handler,
+		},
+		{
+			MethodName: "RemoveProviders",
+			Handler:    _Msg_RemoveProviders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/marketmap/v2/tx.proto",
+}
+
+func (m *MsgUpsertMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketUpdates) > 0 {
+		keysForMarketUpdates := make([]string, 0, len(m.MarketUpdates))
+		for k := range m.MarketUpdates {
+			keysForMarketUpdates = append(keysForMarketUpdates, string(k))
+		}
+		github_com_cosmos_gogoproto_sortkeys.Strings(keysForMarketUpdates)
+		for iNdEx := len(keysForMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MarketUpdates[string(keysForMarketUpdates[iNdEx])]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForMarketUpdates[iNdEx])
+			copy(dAtA[i:], keysForMarketUpdates[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(keysForMarketUpdates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreateMarkets) > 0 {
+		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateMarkets) > 0 {
+		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedMarkets) > 0 {
+		for iNdEx := len(m.DeletedMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeletedMarkets[iNdEx])
+			copy(dAtA[i:], m.DeletedMarkets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedMarkets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateMarkets) > 0 {
+		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CreateMarkets) > 0 {
+		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA3 := make([]byte, len(m.Ids)*10)
+		var j2 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketRemovals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketRemovals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketRemovals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketRemovalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketRemovalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketRemovalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA5 := make([]byte, len(m.Ids)*10)
+		var j4 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMarketChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMarketChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMarketChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA7 := make([]byte, len(m.Ids)*10)
+		var j6 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMarketChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMarketChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMarketChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarketAuthorityGrants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarketAuthorityGrants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarketAuthorityGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarketAuthorityGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarketAuthorityGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarketAuthorityGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeMarketAuthorityGrants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeMarketAuthorityGrants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeMarketAuthorityGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantees) > 0 {
+		for iNdEx := len(m.Grantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Grantees[iNdEx])
+			copy(dAtA[i:], m.Grantees[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Grantees[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeMarketAuthorityGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeMarketAuthorityGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeMarketAuthorityGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertProviders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertProviders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveProviders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveProviders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpsertMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpsertMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketUpdates) > 0 {
+		for k, v := range m.MarketUpdates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CreateMarkets) > 0 {
+		for _, e := range m.CreateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UpdateMarkets) > 0 {
+		for _, e := range m.UpdateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMarketAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeletedMarkets) > 0 {
+		for _, s := range m.DeletedMarkets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleMarketUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.CreateMarkets) > 0 {
+		for _, e := range m.CreateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UpdateMarkets) > 0 {
+		for _, e := range m.UpdateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgScheduleMarketRemovals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleMarketRemovalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMarketChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMarketChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMarketAuthorityGrants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetMarketAuthorityGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeMarketAuthorityGrants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Grantees) > 0 {
+		for _, s := range m.Grantees {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeMarketAuthorityGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpsertProviders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpsertProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveProviders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketUpdates == nil {
+				m.MarketUpdates = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MarketUpdates[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateMarkets = append(m.CreateMarkets, Market{})
+			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
+			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedMarkets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedMarkets = append(m.DeletedMarkets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMarketUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1616,10 +4534,10 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMarketUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMarketUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1655,8 +4573,27 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1683,8 +4620,42 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CreateMarkets = append(m.CreateMarkets, Market{})
+			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
+			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1709,7 +4680,7 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1732,17 +4703,143 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMarketUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleMarketRemovals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMarketRemovals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMarketRemovals: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1752,29 +4849,128 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MarketUpdates == nil {
-				m.MarketUpdates = make(map[string]bool)
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleMarketRemovalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMarketRemovalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMarketRemovalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowTx
@@ -1784,43 +4980,51 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTx
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
 					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTx
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowTx
@@ -1830,29 +5034,16 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
+						v |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.Ids = append(m.Ids, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			m.MarketUpdates[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1874,7 +5065,7 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledMarketChanges) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1897,10 +5088,10 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledMarketChanges: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledMarketChanges: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1936,39 +5127,81 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreateMarkets = append(m.CreateMarkets, Market{})
-			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1990,7 +5223,7 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledMarketChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2013,10 +5246,10 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledMarketChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledMarketChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2040,7 +5273,7 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarketAuthorityGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2063,10 +5296,10 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarketAuthorityGrants: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarketAuthorityGrants: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2103,7 +5336,7 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2130,8 +5363,8 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
-			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, MarketAuthorityGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2156,7 +5389,7 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarketAuthorityGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2179,10 +5412,10 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarketAuthorityGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarketAuthorityGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2206,7 +5439,7 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeMarketAuthorityGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,17 +5462,17 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeMarketAuthorityGrants: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeMarketAuthorityGrants: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2249,28 +5482,27 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2298,7 +5530,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Grantees = append(m.Grantees, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2321,7 +5553,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeMarketAuthorityGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2344,10 +5576,10 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeMarketAuthorityGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeMarketAuthorityGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2371,7 +5603,7 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertProviders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2394,15 +5626,15 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpsertProviders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpsertProviders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2430,13 +5662,13 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2446,23 +5678,25 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Providers = append(m.Providers, ProviderInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2485,7 +5719,7 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2508,10 +5742,10 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpsertProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpsertProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2535,7 +5769,7 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveProviders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2558,15 +5792,15 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveProviders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveProviders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2594,11 +5828,11 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2626,7 +5860,7 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2649,7 +5883,7 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2672,44 +5906,12 @@ func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedMarkets", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeletedMarkets = append(m.DeletedMarkets, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -34,14 +34,14 @@ func DefaultDeleteMarketValidationHooks() MarketValidationHooks {
 
 // DefaultDeleteMarketValidationHook returns the default DeleteMarketValidationHook for x/marketmap.
 // This hook checks:
-// - if the given market is enabled - error
-// - if the given market is disabled - return nil.
+// - if the given market may not move to the removed status, i.e. it is priced - error
+// - if the given market is proposed or deprecated - return nil.
 func DefaultDeleteMarketValidationHook() MarketValidationHook {
 	return func(_ context.Context, market Market) error {
 		if market.Ticker.Enabled {
 			return fmt.Errorf("market is enabled - cannot be deleted")
 		}
 
-		return nil
+		return ValidateMarketStatusTransition(market.Ticker.EffectiveStatus(), MarketStatus_MARKET_STATUS_REMOVED)
 	}
 }
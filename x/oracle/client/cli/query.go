@@ -2,15 +2,30 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
+const (
+	flagStartHeight   = "start-height"
+	flagEndHeight     = "end-height"
+	flagStartTime     = "start-time"
+	flagEndTime       = "end-time"
+	flagEnabledOnly   = "enabled-only"
+	flagProvider      = "provider"
+	flagBase          = "base"
+	flagQuote         = "quote"
+	flagMaxAgeBlocks  = "max-age-blocks"
+	flagMaxAgeSeconds = "max-age-seconds"
+)
+
 // GetQueryCmd returns the parent command for all x/oracle cli query commands. The
 // provided clientCtx should have, at a minimum, a verifier, CometBFT RPC client,
 // and marshaler set.
@@ -26,6 +41,18 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetPriceCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetCurrencyPairMappingCmd(),
+		GetParamsCmd(),
+		GetMarketQuorumsCmd(),
+		GetCircuitBreakersCmd(),
+		GetMarketHaltsCmd(),
+		GetValidatorParticipationCmd(),
+		GetMarketParticipationCmd(),
+		GetPriceHistoryCmd(),
+		GetTWAPCmd(),
+		GetFreshPriceCmd(),
+		GetPriceMaxAgesCmd(),
+		GetInvariantsCmd(),
 	)
 
 	return cmd
@@ -80,11 +107,200 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 				return err
 			}
 
+			// retrieve the filters + pagination from the flags
+			filter, err := readMarketFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			// create a new query client
 			qc := types.NewQueryClient(clientCtx)
 
 			// query for all CurrencyPairs
-			res, err := qc.GetAllCurrencyPairs(cmd.Context(), &types.GetAllCurrencyPairsRequest{})
+			res, err := qc.GetAllCurrencyPairs(cmd.Context(), &types.GetAllCurrencyPairsRequest{
+				Pagination:  pageReq,
+				EnabledOnly: filter.EnabledOnly,
+				Provider:    filter.Provider,
+				Base:        filter.Base,
+				Quote:       filter.Quote,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	addMarketFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "currency-pairs")
+	return cmd
+}
+
+// GetCurrencyPairMappingCmd returns the cli-command that queries the mapping of ID -> CurrencyPair in the module. This is essentially a
+// wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded
+// in the clientCtx.
+func GetCurrencyPairMappingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "currency-pair-mapping",
+		Short: "Query the mapping of ID to currency-pair for the currency-pairs being tracked by the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve the filters + pagination from the flags
+			filter, err := readMarketFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the mapping
+			res, err := qc.GetCurrencyPairMapping(cmd.Context(), &types.GetCurrencyPairMappingRequest{
+				Pagination:  pageReq,
+				EnabledOnly: filter.EnabledOnly,
+				Provider:    filter.Provider,
+				Base:        filter.Base,
+				Quote:       filter.Quote,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	addMarketFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "currency-pair-mapping")
+	return cmd
+}
+
+// GetParamsCmd returns the cli-command that queries the current x/oracle module parameters. This is essentially a wrapper around the module's
+// QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded in the clientCtx.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current oracle module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the module parameters
+			res, err := qc.Params(cmd.Context(), &types.ParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMarketQuorumsCmd returns the cli-command that queries the per-currency-pair quorums set in the x/oracle module. This is essentially a
+// wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded in the clientCtx.
+func GetMarketQuorumsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-quorums",
+		Short: "Query the per-currency-pair quorums that override the module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the market quorums
+			res, err := qc.MarketQuorums(cmd.Context(), &types.MarketQuorumsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCircuitBreakersCmd returns the cli-command that queries the circuit breakers in the x/oracle module. This is essentially a wrapper around the
+// module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded in the clientCtx.
+func GetCircuitBreakersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breakers",
+		Short: "Query the per-currency-pair circuit breakers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the circuit breakers
+			res, err := qc.CircuitBreakers(cmd.Context(), &types.CircuitBreakersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMarketHaltsCmd returns the cli-command that queries the market halts in the x/oracle module. This is essentially a wrapper around the
+// module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded in the clientCtx.
+func GetMarketHaltsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-halts",
+		Short: "Query the currency-pairs whose price updates are halted by their circuit breakers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the market halts
+			res, err := qc.MarketHalts(cmd.Context(), &types.MarketHaltsRequest{})
 			if err != nil {
 				return err
 			}
@@ -95,3 +311,350 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetValidatorParticipationCmd returns the cli-command that queries the participation of a validator in the price updates of each
+// currency-pair. This is essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a
+// query-client served over a grpc-conn embedded in the clientCtx.
+func GetValidatorParticipationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-participation [validator-cons-address]",
+		Short: "Query the participation of a validator in the price updates of each currency-pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the validator's participation
+			res, err := qc.ValidatorParticipation(cmd.Context(), &types.ValidatorParticipationRequest{
+				Validator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMarketParticipationCmd returns the cli-command that queries the participation of each validator in the price updates of a
+// currency-pair. This is essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a
+// query-client served over a grpc-conn embedded in the clientCtx.
+func GetMarketParticipationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-participation [base] [quote]",
+		Short: "Query the participation of each validator in the price updates of a currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := connecttypes.NewCurrencyPair(args[0], args[1])
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the market's participation
+			res, err := qc.MarketParticipation(cmd.Context(), &types.MarketParticipationRequest{
+				CurrencyPair: cp.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPriceHistoryCmd returns the cli-command that queries the historical prices of a currency-pair. This is essentially a wrapper
+// around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded
+// in the clientCtx.
+func GetPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [base] [quote]",
+		Short: "Query the historical prices of a currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := connecttypes.NewCurrencyPair(args[0], args[1])
+
+			req := &types.GetPriceHistoryRequest{
+				CurrencyPair: cp.String(),
+			}
+
+			// retrieve the height + time ranges from the flags
+			if req.StartHeight, err = cmd.Flags().GetUint64(flagStartHeight); err != nil {
+				return err
+			}
+			if req.EndHeight, err = cmd.Flags().GetUint64(flagEndHeight); err != nil {
+				return err
+			}
+			if req.StartTime, err = parseTimeFlag(cmd, flagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = parseTimeFlag(cmd, flagEndTime); err != nil {
+				return err
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the price history
+			res, err := qc.GetPriceHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagStartHeight, 0, "the first height (inclusive) to return prices for")
+	cmd.Flags().Uint64(flagEndHeight, 0, "the last height (inclusive) to return prices for, 0 for no bound")
+	cmd.Flags().String(flagStartTime, "", "the earliest block time (inclusive, RFC3339) to return prices for")
+	cmd.Flags().String(flagEndTime, "", "the latest block time (inclusive, RFC3339) to return prices for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "price-history")
+	return cmd
+}
+
+// GetTWAPCmd returns the cli-command that queries the time weighted average price of a currency-pair. This is essentially a
+// wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn
+// embedded in the clientCtx.
+func GetTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [base] [quote] [start-time]",
+		Short: "Query the time weighted average price of a currency-pair since an RFC3339 start time",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair + interval from arguments and flags
+			cp := connecttypes.NewCurrencyPair(args[0], args[1])
+
+			start, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+
+			end, err := parseTimeFlag(cmd, flagEndTime)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the twap
+			res, err := qc.GetTWAP(cmd.Context(), &types.GetTWAPRequest{
+				CurrencyPair: cp.String(),
+				StartTime:    start,
+				EndTime:      end,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagEndTime, "", "the end (RFC3339) of the averaging interval, defaults to the latest block time")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFreshPriceCmd returns the cli-command that queries the price of a currency-pair, only if it was updated within a max age of the
+// latest block. This is essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client
+// served over a grpc-conn embedded in the clientCtx.
+func GetFreshPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fresh-price [base] [quote]",
+		Short: "Query for the price of a currency-pair, failing if it is older than a max age",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := connecttypes.NewCurrencyPair(args[0], args[1])
+
+			req := &types.GetFreshPriceRequest{
+				CurrencyPair: cp.String(),
+			}
+
+			// retrieve the max age from the flags
+			if req.MaxAgeBlocks, err = cmd.Flags().GetUint64(flagMaxAgeBlocks); err != nil {
+				return err
+			}
+			if req.MaxAgeSeconds, err = cmd.Flags().GetUint64(flagMaxAgeSeconds); err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the fresh price
+			res, err := qc.GetFreshPrice(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagMaxAgeBlocks, 0, "the maximum number of blocks since the price was updated")
+	cmd.Flags().Uint64(flagMaxAgeSeconds, 0, "the maximum number of seconds since the price was updated")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPriceMaxAgesCmd returns the cli-command that queries the default price max ages in the x/oracle module. This is essentially a
+// wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn
+// embedded in the clientCtx.
+func GetPriceMaxAgesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-max-ages",
+		Short: "Query the per-currency-pair default price max ages",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the price max ages
+			res, err := qc.PriceMaxAges(cmd.Context(), &types.PriceMaxAgesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetInvariantsCmd returns the cli-command that checks the consistency invariants of the x/oracle module state. This is
+// essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client
+// served over a grpc-conn embedded in the clientCtx. The command fails if any invariant is broken.
+func GetInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Check the consistency of the currency-pair state of the module, and report any violations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the invariant results
+			res, err := qc.Invariants(cmd.Context(), &types.InvariantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.PrintProto(res); err != nil {
+				return err
+			}
+
+			for _, result := range res.Invariants {
+				if result.Broken {
+					return fmt.Errorf("invariant %s is broken", result.Name)
+				}
+			}
+
+			return nil
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseTimeFlag parses an optional RFC3339 timestamp from the given flag, returning nil if the flag is unset.
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+
+	return &t, nil
+}
+
+// addMarketFilterFlags adds the flags used to filter currency-pairs by their market in the x/marketmap module.
+func addMarketFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagEnabledOnly, false, "only return currency-pairs whose market is enabled")
+	cmd.Flags().String(flagProvider, "", "only return currency-pairs whose market has a config for the provider")
+	cmd.Flags().String(flagBase, "", "only return currency-pairs with the base asset")
+	cmd.Flags().String(flagQuote, "", "only return currency-pairs with the quote asset")
+}
+
+// readMarketFilterFlags reads the flags added by addMarketFilterFlags.
+func readMarketFilterFlags(cmd *cobra.Command) (marketmaptypes.MarketFilter, error) {
+	enabledOnly, err := cmd.Flags().GetBool(flagEnabledOnly)
+	if err != nil {
+		return marketmaptypes.MarketFilter{}, err
+	}
+
+	provider, err := cmd.Flags().GetString(flagProvider)
+	if err != nil {
+		return marketmaptypes.MarketFilter{}, err
+	}
+
+	base, err := cmd.Flags().GetString(flagBase)
+	if err != nil {
+		return marketmaptypes.MarketFilter{}, err
+	}
+
+	quote, err := cmd.Flags().GetString(flagQuote)
+	if err != nil {
+		return marketmaptypes.MarketFilter{}, err
+	}
+
+	return marketmaptypes.NewMarketFilter(enabledOnly, provider, base, quote), nil
+}
@@ -4,8 +4,24 @@ import (
 	"context"
 )
 
-// BeginBlocker is called at the beginning of every block.  It resets the count of
-// removed currency pairs.
+// BeginBlocker is called at the beginning of every block.  It resets the count and
+// set of removed currency pairs, prunes the price history of each currency pair to
+// the configured length, and, if the block ends a participation window, calls the
+// ParticipationHooks and prunes the participation of validators that have left the
+// active set. Participation for the block is recorded by the PreBlocker, so it is
+// included in the window.
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
-	return k.numRemoves.Set(ctx, 0)
+	if err := k.numRemoves.Set(ctx, 0); err != nil {
+		return err
+	}
+
+	if err := k.removedCPs.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	if err := k.prunePriceHistory(ctx); err != nil {
+		return err
+	}
+
+	return k.endParticipationWindow(ctx)
 }
@@ -1,7 +1,15 @@
 package keeper_test
 
 import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
 )
 
 func (s *KeeperTestSuite) TestBeginBlocker() {
@@ -30,12 +38,18 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 		removed, err = s.oracleKeeper.GetNumRemovedCurrencyPairs(s.ctx)
 		s.Require().NoError(err)
 		s.Require().Equal(removed, uint64(1))
+		removedCPs, err := s.oracleKeeper.GetRemovedCurrencyPairs(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(map[uint64]connecttypes.CurrencyPair{0: connecttypes.NewCurrencyPair("TEST", "COIN1")}, removedCPs)
 
-		// Begin blocker should reset the removed count.
+		// Begin blocker should reset the removed count and currency pairs.
 		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
 		removes, err := s.oracleKeeper.GetNumRemovedCurrencyPairs(s.ctx)
 		s.Require().NoError(err)
 		s.Require().Equal(removes, uint64(0))
+		removedCPs, err = s.oracleKeeper.GetRemovedCurrencyPairs(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(removedCPs)
 	})
 
 	s.Run("run with 2 in state - 1 removed", func() {
@@ -53,3 +67,83 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 		s.Require().Equal(cps, uint64(1))
 	})
 }
+
+func (s *KeeperTestSuite) TestBeginBlockerParticipationHooks() {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	live := sdk.ConsAddress("live")
+	offline := sdk.ConsAddress("offline")
+	late := sdk.ConsAddress("late")
+
+	// recordBlock records a block in which live reports a price and offline is absent.
+	recordBlock := func(height int64) sdk.Context {
+		ctx := s.ctx.WithBlockHeight(height)
+		prices := map[connecttypes.CurrencyPair]*big.Int{cp: big.NewInt(1)}
+		s.Require().NoError(s.recordParticipation(ctx, live, false, prices, prices))
+		s.Require().NoError(s.recordParticipation(ctx, offline, true, nil, prices))
+		return ctx
+	}
+
+	s.Run("hooks are only called at the end of each window with full windows", func() {
+		s.SetupTest()
+		hooks := mocks.NewParticipationHooks(s.T())
+		s.oracleKeeper.SetParticipationHooks(hooks)
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.setParticipationParams(2, 0)
+
+		// the window has not ended
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(recordBlock(1)))
+
+		// the window ends, late has only been tracked for one block
+		ctx := recordBlock(2)
+		s.Require().NoError(s.recordParticipation(ctx, late, true, nil, nil))
+		hooks.On("AfterParticipationWindow", ctx, []types.ValidatorMissRate{
+			types.NewValidatorMissRate(live, types.ParticipationCounts{Reported: 2}),
+			types.NewValidatorMissRate(offline, types.ParticipationCounts{Absent: 2}),
+		}).Return(nil).Once()
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(ctx))
+	})
+
+	s.Run("validators that left the active set are pruned at the end of a window", func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.setParticipationParams(2, 0)
+
+		// late is only recorded in the first window
+		s.Require().NoError(s.recordParticipation(recordBlock(1), late, false, nil, nil))
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(recordBlock(2)))
+
+		blocks, _, err := s.oracleKeeper.GetValidatorParticipation(s.ctx, late)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), blocks)
+
+		// late has not been recorded for a full window when the second window ends
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(recordBlock(3)))
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(recordBlock(4)))
+
+		blocks, participation, err := s.oracleKeeper.GetValidatorParticipation(s.ctx, late)
+		s.Require().NoError(err)
+		s.Require().Zero(blocks)
+		s.Require().Empty(participation)
+
+		blocks, participation, err = s.oracleKeeper.GetValidatorParticipation(s.ctx, live)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), blocks)
+		s.Require().Len(participation, 1)
+
+		export, err := s.oracleKeeper.GetAllParticipation(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(export, 2)
+	})
+
+	s.Run("hook errors are returned", func() {
+		s.SetupTest()
+		hooks := mocks.NewParticipationHooks(s.T())
+		s.oracleKeeper.SetParticipationHooks(hooks)
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.setParticipationParams(1, 0)
+
+		ctx := recordBlock(1)
+		hooks.On("AfterParticipationWindow", ctx, mock.Anything).Return(errors.New("slashing failed")).Once()
+		s.Require().Error(s.oracleKeeper.BeginBlocker(ctx))
+	})
+}
@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/maps"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// SetCircuitBreaker sets the circuit breaker for the CircuitBreaker's CurrencyPair, overwriting any existing circuit
+// breaker and restarting its rolling window. This fails if the CurrencyPair does not exist in state.
+func (k *Keeper) SetCircuitBreaker(ctx context.Context, cb types.CircuitBreaker) error {
+	if !k.HasCurrencyPair(ctx, cb.CurrencyPair) {
+		return types.NewCurrencyPairNotExistError(cb.CurrencyPair)
+	}
+
+	if err := k.clearCircuitBreakerState(ctx, cb.CurrencyPair.String()); err != nil {
+		return err
+	}
+
+	return k.circuitBreakers.Set(ctx, cb.CurrencyPair.String(), cb)
+}
+
+// GetCircuitBreaker returns the circuit breaker set for a given CurrencyPair. If no circuit breaker is set, return an
+// empty CircuitBreaker, false, if one is, return true and the circuit breaker.
+func (k *Keeper) GetCircuitBreaker(ctx context.Context, cp connecttypes.CurrencyPair) (types.CircuitBreaker, bool) {
+	cb, err := k.circuitBreakers.Get(ctx, cp.String())
+	if err != nil {
+		return types.CircuitBreaker{}, false
+	}
+
+	return cb, true
+}
+
+// RemoveCircuitBreaker removes the circuit breaker set for a given CurrencyPair, along with its rolling window. This
+// is a no-op if no circuit breaker is set. Any existing MarketHalt for the CurrencyPair is retained.
+func (k *Keeper) RemoveCircuitBreaker(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	if err := k.clearCircuitBreakerState(ctx, cp.String()); err != nil {
+		return err
+	}
+
+	return k.circuitBreakers.Remove(ctx, cp.String())
+}
+
+// GetAllCircuitBreakers returns all CircuitBreakers stored in state, ordered by CurrencyPair.
+func (k *Keeper) GetAllCircuitBreakers(ctx context.Context) ([]types.CircuitBreaker, error) {
+	it, err := k.circuitBreakers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// GetAllCircuitBreakerStates returns the CircuitBreakerState of every CurrencyPair with PriceSamples in its rolling
+// window or a bypass of its circuit breaker pending, ordered by CurrencyPair.
+func (k *Keeper) GetAllCircuitBreakerStates(ctx context.Context) ([]types.CircuitBreakerStateGenesis, error) {
+	states := make(map[string]*types.CircuitBreakerState)
+	state := func(cp string) *types.CircuitBreakerState {
+		if _, ok := states[cp]; !ok {
+			states[cp] = &types.CircuitBreakerState{}
+		}
+
+		return states[cp]
+	}
+
+	// samples are iterated in order of height for each CurrencyPair
+	err := k.circuitBreakerSamples.Walk(ctx, nil, func(key collections.Pair[string, uint64], sample types.PriceSample) (bool, error) {
+		st := state(key.K1())
+		st.Samples = append(st.Samples, sample)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.circuitBreakerBypasses.Walk(ctx, nil, func(cp string) (bool, error) {
+		state(cp).BypassNext = true
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cps := maps.Keys(states)
+	slices.Sort(cps)
+
+	genesis := make([]types.CircuitBreakerStateGenesis, 0, len(cps))
+	for _, cpStr := range cps {
+		cp, err := connecttypes.CurrencyPairFromString(cpStr)
+		if err != nil {
+			return nil, err
+		}
+
+		genesis = append(genesis, types.CircuitBreakerStateGenesis{
+			CurrencyPair: cp,
+			State:        *states[cpStr],
+		})
+	}
+
+	return genesis, nil
+}
+
+// setCircuitBreakerState replaces the rolling window and pending bypass of the circuit breaker of a given CurrencyPair
+// with the given CircuitBreakerState.
+func (k *Keeper) setCircuitBreakerState(ctx context.Context, cp string, state types.CircuitBreakerState) error {
+	if err := k.clearCircuitBreakerState(ctx, cp); err != nil {
+		return err
+	}
+
+	for _, sample := range state.Samples {
+		if err := k.circuitBreakerSamples.Set(ctx, collections.Join(cp, sample.BlockHeight), sample); err != nil {
+			return err
+		}
+	}
+
+	if !state.BypassNext {
+		return nil
+	}
+
+	return k.circuitBreakerBypasses.Set(ctx, cp)
+}
+
+// clearCircuitBreakerState removes the rolling window and any pending bypass of the circuit breaker of a given
+// CurrencyPair.
+func (k *Keeper) clearCircuitBreakerState(ctx context.Context, cp string) error {
+	if err := k.circuitBreakerSamples.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](cp)); err != nil {
+		return err
+	}
+
+	return k.circuitBreakerBypasses.Remove(ctx, cp)
+}
+
+// GetMarketHalt returns the MarketHalt for a given CurrencyPair. If the CurrencyPair is not halted, return an empty
+// MarketHalt, false, if it is, return true and the halt.
+func (k *Keeper) GetMarketHalt(ctx context.Context, cp connecttypes.CurrencyPair) (types.MarketHalt, bool) {
+	halt, err := k.marketHalts.Get(ctx, cp.String())
+	if err != nil {
+		return types.MarketHalt{}, false
+	}
+
+	return halt, true
+}
+
+// IsMarketHalted returns true if price updates for a given CurrencyPair are halted.
+func (k *Keeper) IsMarketHalted(ctx context.Context, cp connecttypes.CurrencyPair) bool {
+	ok, err := k.marketHalts.Has(ctx, cp.String())
+	return err == nil && ok
+}
+
+// GetAllMarketHalts returns all MarketHalts stored in state, ordered by CurrencyPair.
+func (k *Keeper) GetAllMarketHalts(ctx context.Context) ([]types.MarketHalt, error) {
+	it, err := k.marketHalts.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// ClearMarketHalt resumes price updates for a given CurrencyPair. If the CurrencyPair has a circuit breaker, the next
+// price update for the CurrencyPair is written without being checked against it, and re-anchors its rolling window.
+// This is a no-op if the CurrencyPair is not halted.
+func (k *Keeper) ClearMarketHalt(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	if !k.IsMarketHalted(ctx, cp) {
+		return nil
+	}
+
+	if err := k.marketHalts.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+
+	if _, ok := k.GetCircuitBreaker(ctx, cp); ok {
+		if err := k.setCircuitBreakerState(ctx, cp.String(), types.CircuitBreakerState{BypassNext: true}); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMarketHaltCleared,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+	))
+
+	return nil
+}
+
+// ApplyCircuitBreaker checks a price update for a given CurrencyPair against its circuit breaker, and returns the
+// price to be written to state along with whether it should be written at all. Price updates for halted
+// CurrencyPairs are never written. A price update that breaches the circuit breaker emits an event, and is either
+// clamped to the bounds of the circuit breaker or rejected, in which case the CurrencyPair is halted. Any price that
+// is returned to be written is recorded in the circuit breaker's rolling window.
+func (k *Keeper) ApplyCircuitBreaker(ctx context.Context, cp connecttypes.CurrencyPair, price math.Int) (math.Int, bool, error) {
+	if k.IsMarketHalted(ctx, cp) {
+		return math.Int{}, false, nil
+	}
+
+	cb, ok := k.GetCircuitBreaker(ctx, cp)
+	if !ok {
+		return price, true, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec
+
+	bypass, err := k.circuitBreakerBypasses.Has(ctx, cp.String())
+	if err != nil {
+		return math.Int{}, false, err
+	}
+
+	// prune the samples that have left the rolling window
+	if height > cb.WindowBlocks {
+		rng := collections.NewPrefixedPairRange[string, uint64](cp.String()).EndExclusive(height - cb.WindowBlocks)
+		if err := k.circuitBreakerSamples.Clear(ctx, rng); err != nil {
+			return math.Int{}, false, err
+		}
+	}
+
+	applied := price
+	if !bypass {
+		lower, upper, bounded, err := k.circuitBreakerBounds(ctx, cb)
+		if err != nil {
+			return math.Int{}, false, err
+		}
+
+		if bounded && (price.LT(lower) || price.GT(upper)) {
+			switch cb.Action {
+			case types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP:
+				applied = math.MinInt(math.MaxInt(price, lower), upper)
+			default:
+				applied = math.Int{}
+			}
+
+			attrs := []sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+				sdk.NewAttribute(types.AttributeKeyLowerBound, lower.String()),
+				sdk.NewAttribute(types.AttributeKeyUpperBound, upper.String()),
+				sdk.NewAttribute(types.AttributeKeyAction, cb.Action.String()),
+			}
+			if !applied.IsNil() {
+				attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyAppliedPrice, applied.String()))
+			}
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCircuitBreakerTripped, attrs...))
+
+			if applied.IsNil() {
+				halt := types.MarketHalt{
+					CurrencyPair: cp,
+					BlockHeight:  height,
+					Price:        price,
+					LowerBound:   lower,
+					UpperBound:   upper,
+				}
+
+				return math.Int{}, false, k.marketHalts.Set(ctx, cp.String(), halt)
+			}
+		}
+	}
+
+	// only the rolling window needs to be tracked across blocks
+	if cb.MaxWindowMoveBps == 0 {
+		return applied, true, k.clearCircuitBreakerState(ctx, cp.String())
+	}
+
+	if err := k.circuitBreakerBypasses.Remove(ctx, cp.String()); err != nil {
+		return math.Int{}, false, err
+	}
+
+	sample := types.PriceSample{
+		BlockHeight: height,
+		Price:       applied,
+	}
+
+	return applied, true, k.circuitBreakerSamples.Set(ctx, collections.Join(cp.String(), height), sample)
+}
+
+// circuitBreakerBounds returns the lowest and highest prices allowed by a circuit breaker, and whether the circuit
+// breaker bounds the price at all. The block move is bounded around the latest price in state, and the window move
+// around the oldest price in the rolling window. If the two bounds do not overlap, the window bounds are used.
+func (k *Keeper) circuitBreakerBounds(
+	ctx context.Context,
+	cb types.CircuitBreaker,
+) (lower, upper math.Int, bounded bool, err error) {
+	if cb.MaxBlockMoveBps != 0 {
+		qp, err := k.GetPriceForCurrencyPair(ctx, cb.CurrencyPair)
+		switch {
+		case err == nil:
+			lower, upper = types.PriceBand(qp.Price, cb.MaxBlockMoveBps)
+			bounded = true
+		case !errors.As(err, &types.QuotePriceNotExistError{}):
+			return lower, upper, false, err
+		}
+	}
+
+	if cb.MaxWindowMoveBps == 0 {
+		return lower, upper, bounded, nil
+	}
+
+	oldest, ok, err := k.oldestCircuitBreakerSample(ctx, cb.CurrencyPair)
+	if err != nil {
+		return lower, upper, false, err
+	}
+
+	if !ok {
+		return lower, upper, bounded, nil
+	}
+
+	windowLower, windowUpper := types.PriceBand(oldest.Price, cb.MaxWindowMoveBps)
+	if !bounded {
+		return windowLower, windowUpper, true, nil
+	}
+
+	lower, upper = math.MaxInt(lower, windowLower), math.MinInt(upper, windowUpper)
+	if lower.GT(upper) {
+		lower, upper = windowLower, windowUpper
+	}
+
+	return lower, upper, true, nil
+}
+
+// oldestCircuitBreakerSample returns the oldest PriceSample in the rolling window of the circuit breaker of a given
+// CurrencyPair, and whether the rolling window has any PriceSamples.
+func (k *Keeper) oldestCircuitBreakerSample(ctx context.Context, cp connecttypes.CurrencyPair) (types.PriceSample, bool, error) {
+	it, err := k.circuitBreakerSamples.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String()))
+	if err != nil {
+		return types.PriceSample{}, false, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return types.PriceSample{}, false, nil
+	}
+
+	sample, err := it.Value()
+	if err != nil {
+		return types.PriceSample{}, false, err
+	}
+
+	return sample, true, nil
+}
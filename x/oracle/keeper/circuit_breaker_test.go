@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// writePrice applies the circuit breaker for a price update of an enabled market at the given height, and writes the
+// resulting price to state as the price applier would.
+func (s *KeeperTestSuite) writePrice(height int64, cp connecttypes.CurrencyPair, price int64) (sdkmath.Int, bool) {
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8, Enabled: true},
+	}, nil).Maybe()
+
+	ctx := s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	applied, ok, err := s.oracleKeeper.ApplyCircuitBreaker(ctx, cp, sdkmath.NewInt(price))
+	s.Require().NoError(err)
+
+	if ok {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, cp, types.QuotePrice{Price: applied}))
+	}
+
+	return applied, ok
+}
+
+func (s *KeeperTestSuite) TestApplyCircuitBreaker() {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+
+	s.Run("prices for currency pairs without a circuit breaker are passed through", func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+		applied, ok := s.writePrice(1, cp, 100)
+		s.Require().True(ok)
+		s.Require().Equal(sdkmath.NewInt(100), applied)
+
+		applied, ok = s.writePrice(2, cp, 1_000)
+		s.Require().True(ok)
+		s.Require().Equal(sdkmath.NewInt(1_000), applied)
+	})
+
+	s.Run("a breach of the block move halts the market until it is cleared", func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, types.NewCircuitBreaker(
+			cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT,
+		)))
+
+		// the first price is never bounded
+		_, ok := s.writePrice(1, cp, 100)
+		s.Require().True(ok)
+
+		// a 10% move is allowed
+		_, ok = s.writePrice(2, cp, 110)
+		s.Require().True(ok)
+
+		// an 11% move is rejected, and the market is halted
+		ctx := s.ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+		_, ok, err := s.oracleKeeper.ApplyCircuitBreaker(ctx, cp, sdkmath.NewInt(123))
+		s.Require().NoError(err)
+		s.Require().False(ok)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeCircuitBreakerTripped, events[0].Type)
+
+		halt, halted := s.oracleKeeper.GetMarketHalt(s.ctx, cp)
+		s.Require().True(halted)
+		s.Require().Equal(types.MarketHalt{
+			CurrencyPair: cp,
+			BlockHeight:  3,
+			Price:        sdkmath.NewInt(123),
+			LowerBound:   sdkmath.NewInt(99),
+			UpperBound:   sdkmath.NewInt(121),
+		}, halt)
+
+		// prices are not written while the market is halted, even within bounds
+		_, ok = s.writePrice(4, cp, 111)
+		s.Require().False(ok)
+
+		// once the halt is cleared, the next price re-anchors the circuit breaker
+		s.Require().NoError(s.oracleKeeper.ClearMarketHalt(s.ctx, cp))
+		s.Require().False(s.oracleKeeper.IsMarketHalted(s.ctx, cp))
+
+		applied, ok := s.writePrice(5, cp, 200)
+		s.Require().True(ok)
+		s.Require().Equal(sdkmath.NewInt(200), applied)
+
+		// and subsequent prices are bounded around the new price
+		_, ok = s.writePrice(6, cp, 100)
+		s.Require().False(ok)
+	})
+
+	s.Run("a breach is clamped to the bounds of the circuit breaker", func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, types.NewCircuitBreaker(
+			cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP,
+		)))
+
+		_, ok := s.writePrice(1, cp, 100)
+		s.Require().True(ok)
+
+		applied, ok := s.writePrice(2, cp, 200)
+		s.Require().True(ok)
+		s.Require().Equal(sdkmath.NewInt(110), applied)
+
+		applied, ok = s.writePrice(3, cp, 1)
+		s.Require().True(ok)
+		s.Require().Equal(sdkmath.NewInt(99), applied)
+
+		s.Require().False(s.oracleKeeper.IsMarketHalted(s.ctx, cp))
+	})
+
+	s.Run("the window move is bounded around the oldest price in the rolling window", func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, types.NewCircuitBreaker(
+			cp, 1_000, 1_500, 3, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP,
+		)))
+
+		_, ok := s.writePrice(1, cp, 100)
+		s.Require().True(ok)
+
+		// within the block move, and the window move from 100
+		applied, _ := s.writePrice(2, cp, 110)
+		s.Require().Equal(sdkmath.NewInt(110), applied)
+
+		// within the block move from 110, but clamped to the window move from 100
+		applied, _ = s.writePrice(3, cp, 120)
+		s.Require().Equal(sdkmath.NewInt(115), applied)
+
+		// the price at height 1 has left the window, so the window is bounded around 110
+		applied, _ = s.writePrice(5, cp, 126)
+		s.Require().Equal(sdkmath.NewInt(126), applied)
+
+		// the samples that have left the window are pruned
+		states, err := s.oracleKeeper.GetAllCircuitBreakerStates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.CircuitBreakerStateGenesis{{
+			CurrencyPair: cp,
+			State: types.CircuitBreakerState{Samples: []types.PriceSample{
+				{BlockHeight: 2, Price: sdkmath.NewInt(110)},
+				{BlockHeight: 3, Price: sdkmath.NewInt(115)},
+				{BlockHeight: 5, Price: sdkmath.NewInt(126)},
+			}},
+		}}, states)
+	})
+
+	s.Run("prices are not written for halted markets without a circuit breaker", func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, types.NewCircuitBreaker(
+			cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT,
+		)))
+
+		_, ok := s.writePrice(1, cp, 100)
+		s.Require().True(ok)
+		_, ok = s.writePrice(2, cp, 200)
+		s.Require().False(ok)
+
+		// removing the circuit breaker retains the halt
+		s.Require().NoError(s.oracleKeeper.RemoveCircuitBreaker(s.ctx, cp))
+		_, ok = s.writePrice(3, cp, 200)
+		s.Require().False(ok)
+
+		// removing the currency pair removes the halt
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+		s.Require().False(s.oracleKeeper.IsMarketHalted(s.ctx, cp))
+	})
+
+	s.Run("clearing the halt of a market without a circuit breaker leaves no circuit breaker state", func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, types.NewCircuitBreaker(
+			cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT,
+		)))
+
+		_, ok := s.writePrice(1, cp, 100)
+		s.Require().True(ok)
+		_, ok = s.writePrice(2, cp, 200)
+		s.Require().False(ok)
+
+		s.Require().NoError(s.oracleKeeper.RemoveCircuitBreaker(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.ClearMarketHalt(s.ctx, cp))
+
+		states, err := s.oracleKeeper.GetAllCircuitBreakerStates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(states)
+	})
+}
+
+func (s *KeeperTestSuite) TestAcceptsPrices() {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+
+	tcs := []struct {
+		name     string
+		status   marketmaptypes.MarketStatus
+		enabled  bool
+		accepted bool
+	}{
+		{"legacy enabled market", marketmaptypes.MarketStatus_MARKET_STATUS_UNSPECIFIED, true, true},
+		{"legacy disabled market", marketmaptypes.MarketStatus_MARKET_STATUS_UNSPECIFIED, false, false},
+		{"proposed market", marketmaptypes.MarketStatus_MARKET_STATUS_PROPOSED, false, false},
+		{"active market", marketmaptypes.MarketStatus_MARKET_STATUS_ACTIVE, true, true},
+		{"reduce-only market", marketmaptypes.MarketStatus_MARKET_STATUS_REDUCE_ONLY, true, true},
+		{"deprecated market", marketmaptypes.MarketStatus_MARKET_STATUS_DEPRECATED, false, false},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+				Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8, Enabled: tc.enabled, Status: tc.status},
+			}, nil)
+
+			ok, err := s.oracleKeeper.AcceptsPrices(s.ctx, cp)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.accepted, ok)
+		})
+	}
+
+	s.Run("prices are accepted for currency pairs without a market", func() {
+		s.SetupTest()
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{}, collections.ErrNotFound)
+
+		ok, err := s.oracleKeeper.AcceptsPrices(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().True(ok)
+	})
+
+	s.Run("prices are accepted without a market map", func() {
+		s.SetupWithNoMMKeeper()
+
+		ok, err := s.oracleKeeper.AcceptsPrices(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().True(ok)
+	})
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// EmitPriceUpdateEvents emits events for the prices of the given CurrencyPairs written to state in the current block,
+// in accordance with the PriceUpdateEvents mode in the Params. numValidators is the number of validators that reported
+// a price for each CurrencyPair, and may be nil if unknown. This fails if any of the CurrencyPairs has no price.
+func (k *Keeper) EmitPriceUpdateEvents(
+	ctx context.Context,
+	cps []connecttypes.CurrencyPair,
+	numValidators map[connecttypes.CurrencyPair]uint32,
+) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PriceUpdateEvents == types.PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_DISABLED || len(cps) == 0 {
+		return nil
+	}
+
+	updates := make([]types.EventPriceUpdate, 0, len(cps))
+	for _, cp := range cps {
+		cps, err := k.currencyPairs.Get(ctx, cp.String())
+		if err != nil {
+			return err
+		}
+
+		if cps.Price == nil {
+			return types.NewQuotePriceNotExistError(cp)
+		}
+
+		updates = append(updates, types.EventPriceUpdate{
+			CurrencyPair:  cp.String(),
+			Id:            cps.Id,
+			Price:         cps.Price.Price,
+			BlockHeight:   cps.Price.BlockHeight,
+			Nonce:         cps.Nonce,
+			NumValidators: numValidators[cp],
+		})
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if params.PriceUpdateEvents == types.PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_AGGREGATED {
+		return sdkCtx.EventManager().EmitTypedEvent(&types.EventPriceUpdates{
+			BlockHeight:  uint64(sdkCtx.BlockHeight()), //nolint:gosec
+			PriceUpdates: updates,
+		})
+	}
+
+	for i := range updates {
+		if err := sdkCtx.EventManager().EmitTypedEvent(&updates[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
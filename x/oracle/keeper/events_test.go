@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestEmitPriceUpdateEvents() {
+	btc := connecttypes.NewCurrencyPair("BTC", "USD")
+	eth := connecttypes.NewCurrencyPair("ETH", "USD")
+	numValidators := map[connecttypes.CurrencyPair]uint32{btc: 3}
+
+	expected := []types.EventPriceUpdate{
+		{CurrencyPair: btc.String(), Id: 0, Price: sdkmath.NewInt(100), BlockHeight: 10, Nonce: 1, NumValidators: 3},
+		{CurrencyPair: eth.String(), Id: 1, Price: sdkmath.NewInt(200), BlockHeight: 10, Nonce: 1},
+	}
+
+	// setup creates both currency pairs and writes a price for each, returning a context with a fresh event manager.
+	setup := func(mode types.PriceUpdateEventMode) sdk.Context {
+		s.SetupTest()
+		params := types.DefaultParams()
+		params.PriceUpdateEvents = mode
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		for i, cp := range []connecttypes.CurrencyPair{btc, eth} {
+			s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+			s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+				Price:       sdkmath.NewInt(int64(100 * (i + 1))),
+				BlockHeight: 10,
+			}))
+		}
+
+		return s.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	}
+
+	s.Run("no events are emitted when disabled", func() {
+		ctx := setup(types.PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_DISABLED)
+		s.Require().NoError(s.oracleKeeper.EmitPriceUpdateEvents(ctx, []connecttypes.CurrencyPair{btc, eth}, numValidators))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("an event is emitted per price", func() {
+		ctx := setup(types.PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_PER_PRICE)
+		s.Require().NoError(s.oracleKeeper.EmitPriceUpdateEvents(ctx, []connecttypes.CurrencyPair{btc, eth}, numValidators))
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 2)
+		for i, event := range events {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			s.Require().NoError(err)
+			s.Require().Equal(&expected[i], msg)
+		}
+	})
+
+	s.Run("a single event is emitted per block when aggregated", func() {
+		ctx := setup(types.PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_AGGREGATED)
+		s.Require().NoError(s.oracleKeeper.EmitPriceUpdateEvents(ctx, []connecttypes.CurrencyPair{btc, eth}, numValidators))
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+		s.Require().NoError(err)
+		s.Require().Equal(&types.EventPriceUpdates{BlockHeight: 10, PriceUpdates: expected}, msg)
+	})
+
+	s.Run("no event is emitted when no prices are updated", func() {
+		ctx := setup(types.PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_AGGREGATED)
+		s.Require().NoError(s.oracleKeeper.EmitPriceUpdateEvents(ctx, nil, nil))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("currency pairs without a price fail", func() {
+		ctx := setup(types.PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_PER_PRICE)
+		cp := connecttypes.NewCurrencyPair("AA", "BB")
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(ctx, cp))
+		s.Require().Error(s.oracleKeeper.EmitPriceUpdateEvents(ctx, []connecttypes.CurrencyPair{cp}, nil))
+	})
+}
@@ -38,6 +38,68 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	if err := k.numRemoves.Set(ctx, 0); err != nil {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
+
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	// initialize all per-currency-pair quorums
+	for _, quorum := range gs.MarketQuorums {
+		if err := k.SetMarketQuorum(ctx, quorum); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize all circuit breakers
+	for _, cb := range gs.CircuitBreakers {
+		if err := k.SetCircuitBreaker(ctx, cb); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize the rolling windows of the circuit breakers, after the circuit breakers as setting a circuit
+	// breaker restarts its rolling window
+	for _, st := range gs.CircuitBreakerStates {
+		if err := k.setCircuitBreakerState(ctx, st.CurrencyPair.String(), st.State); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize all market halts
+	for _, halt := range gs.MarketHalts {
+		if err := k.marketHalts.Set(ctx, halt.CurrencyPair.String(), halt); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize all per-currency-pair price max ages
+	for _, maxAge := range gs.PriceMaxAges {
+		if err := k.SetPriceMaxAge(ctx, maxAge); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize the price history of each currency pair, which is pruned to the price history length in the
+	// BeginBlocker
+	for _, e := range gs.PriceHistory {
+		if err := k.setPriceHistoryEntry(ctx, e); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize the latest price accumulator of each currency pair
+	for _, acc := range gs.PriceAccumulators {
+		if err := k.priceAccumulators.Set(ctx, acc.CurrencyPair.String(), acc.Accumulator); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize the participation windows of each validator
+	for _, p := range gs.Participation {
+		if err := k.setParticipation(ctx, p); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
 }
 
 // ExportGenesis retrieve all CurrencyPairs + QuotePrices set for the module, and return them as a genesis state.
@@ -49,10 +111,64 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	quorums, err := k.GetAllMarketQuorums(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	cbs, err := k.GetAllCircuitBreakers(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	cbStates, err := k.GetAllCircuitBreakerStates(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	halts, err := k.GetAllMarketHalts(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	maxAges, err := k.GetAllPriceMaxAges(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	history, err := k.GetAllPriceHistory(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	accs, err := k.GetAllPriceAccumulators(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	participation, err := k.GetAllParticipation(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	// instantiate genesis-state w/ empty array
 	gs := &types.GenesisState{
-		CurrencyPairGenesis: make([]types.CurrencyPairGenesis, 0),
-		NextId:              id,
+		CurrencyPairGenesis:  make([]types.CurrencyPairGenesis, 0),
+		NextId:               id,
+		Params:               params,
+		MarketQuorums:        quorums,
+		CircuitBreakers:      cbs,
+		MarketHalts:          halts,
+		PriceMaxAges:         maxAges,
+		PriceHistory:         history,
+		PriceAccumulators:    accs,
+		CircuitBreakerStates: cbStates,
+		Participation:        participation,
 	}
 
 	// next, iterate over NonceKey to retrieve any CurrencyPairs that have not yet been traversed (CurrencyPairs w/ no Price info)
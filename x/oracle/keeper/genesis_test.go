@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -255,4 +257,151 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 			s.Require().Equal(id, cpg.Id)
 		}
 	})
+
+	s.Run("ExportGenesis round-trips market quorums, circuit breakers and halts", func() {
+		cp := connecttypes.NewCurrencyPair("AA", "BB")
+		gs := types.GenesisState{
+			CurrencyPairGenesis: []types.CurrencyPairGenesis{
+				{
+					CurrencyPair: cp,
+					Id:           0,
+				},
+			},
+			NextId:        1,
+			Params:        types.DefaultParams(),
+			MarketQuorums: []types.MarketQuorum{types.NewMarketQuorum(cp, 5_000, 2)},
+			CircuitBreakers: []types.CircuitBreaker{
+				types.NewCircuitBreaker(cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT),
+			},
+			MarketHalts: []types.MarketHalt{
+				{
+					CurrencyPair: cp,
+					BlockHeight:  1,
+					Price:        sdkmath.NewInt(200),
+					LowerBound:   sdkmath.NewInt(90),
+					UpperBound:   sdkmath.NewInt(110),
+				},
+			},
+		}
+		s.oracleKeeper.InitGenesis(s.ctx, gs)
+
+		quorum, ok := s.oracleKeeper.GetMarketQuorum(s.ctx, cp)
+		s.Require().True(ok)
+		s.Require().Equal(gs.MarketQuorums[0], quorum)
+		s.Require().True(s.oracleKeeper.IsMarketHalted(s.ctx, cp))
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Equal(gs.MarketQuorums, egs.MarketQuorums)
+		s.Require().Equal(gs.CircuitBreakers, egs.CircuitBreakers)
+		s.Require().Equal(gs.MarketHalts, egs.MarketHalts)
+	})
+	s.Run("ExportGenesis round-trips the price history and price accumulators", func() {
+		s.SetupTest()
+		s.ctx = s.ctx.WithBlockTime(time.Unix(10, 0).UTC())
+		btc := connecttypes.NewCurrencyPair("BTC", "USD")
+		eth := connecttypes.NewCurrencyPair("ETH", "USD")
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btc))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, eth))
+		s.setPriceHistoryLength(3)
+
+		btcPrices := s.writeHistoricalPrices(btc, 1, 2, 3)
+		ethPrices := s.writeHistoricalPrices(eth, 2, 3)
+
+		twap, err := s.oracleKeeper.GetTWAP(s.ctx, btc, time.Unix(1, 0).UTC(), time.Unix(10, 0).UTC())
+		s.Require().NoError(err)
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Len(egs.PriceHistory, 5)
+		s.Require().Len(egs.PriceAccumulators, 2)
+
+		// import the exported genesis into a fresh keeper
+		s.SetupTest()
+		s.ctx = s.ctx.WithBlockTime(time.Unix(10, 0).UTC())
+		s.oracleKeeper.InitGenesis(s.ctx, *egs)
+
+		imported, err := s.oracleKeeper.GetTWAP(s.ctx, btc, time.Unix(1, 0).UTC(), time.Unix(10, 0).UTC())
+		s.Require().NoError(err)
+		s.Require().Equal(twap, imported)
+		s.Require().Equal(egs.PriceAccumulators, s.oracleKeeper.ExportGenesis(s.ctx).PriceAccumulators)
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Equal(btcPrices, prices)
+
+		prices, err = s.oracleKeeper.GetPriceHistory(s.ctx, eth, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Equal(ethPrices, prices)
+		s.Require().Equal(egs.PriceHistory, s.oracleKeeper.ExportGenesis(s.ctx).PriceHistory)
+
+		// the imported history is pruned to the price history length
+		s.writeHistoricalPrices(btc, 4)
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+
+		prices, err = s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 3)
+		s.Require().Equal(uint64(2), prices[0].BlockHeight)
+	})
+	s.Run("ExportGenesis round-trips the rolling windows of circuit breakers", func() {
+		s.SetupTest()
+		cp := connecttypes.NewCurrencyPair("AA", "BB")
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, types.NewCircuitBreaker(
+			cp, 1_000, 1_500, 3, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP,
+		)))
+
+		s.writePrice(1, cp, 100)
+		s.writePrice(2, cp, 110)
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Len(egs.CircuitBreakerStates, 1)
+		s.Require().Len(egs.CircuitBreakerStates[0].State.Samples, 2)
+
+		// import the exported genesis into a fresh keeper
+		s.SetupTest()
+		s.oracleKeeper.InitGenesis(s.ctx, *egs)
+		s.Require().Equal(egs.CircuitBreakerStates, s.oracleKeeper.ExportGenesis(s.ctx).CircuitBreakerStates)
+
+		// the window move is still bounded around the price written at height 1
+		applied, _ := s.writePrice(3, cp, 120)
+		s.Require().Equal(sdkmath.NewInt(115), applied)
+	})
+	s.Run("ExportGenesis round-trips the participation windows of validators", func() {
+		s.SetupTest()
+		btc := connecttypes.NewCurrencyPair("BTC", "USD")
+		eth := connecttypes.NewCurrencyPair("ETH", "USD")
+		val := sdk.ConsAddress("val1")
+		final := map[connecttypes.CurrencyPair]*big.Int{btc: big.NewInt(100), eth: big.NewInt(10)}
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btc))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, eth))
+		s.setParticipationParams(3, 0)
+
+		s.Require().NoError(s.recordParticipation(s.ctx, val, true, nil, final))
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, map[connecttypes.CurrencyPair]*big.Int{btc: big.NewInt(100)}, final))
+
+		blocks, participation, err := s.oracleKeeper.GetValidatorParticipation(s.ctx, val)
+		s.Require().NoError(err)
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Len(egs.Participation, 1)
+		s.Require().Len(egs.Participation[0].Windows, 2)
+
+		// import the exported genesis into a fresh keeper
+		s.SetupTest()
+		s.oracleKeeper.InitGenesis(s.ctx, *egs)
+		s.Require().Equal(egs.Participation, s.oracleKeeper.ExportGenesis(s.ctx).Participation)
+
+		importedBlocks, imported, err := s.oracleKeeper.GetValidatorParticipation(s.ctx, val)
+		s.Require().NoError(err)
+		s.Require().Equal(blocks, importedBlocks)
+		s.Require().Equal(participation, imported)
+
+		// the window continues from the imported slot, so the absent block is the next to be evicted
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, final, final))
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, final, final))
+
+		participation, err = s.oracleKeeper.GetMarketParticipation(s.ctx, eth)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ParticipationCounts{Reported: 2, MissingPrice: 1}, participation[0].Counts)
+	})
 }
@@ -2,21 +2,25 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // queryServer is the default implementation of the x/oracle QueryService.
 type queryServer struct {
-	k Keeper
+	k *Keeper
 }
 
 // NewQueryServer returns an implementation of the x/oracle QueryServer.
-func NewQueryServer(k Keeper) types.QueryServer {
+func NewQueryServer(k *Keeper) types.QueryServer {
 	return queryServer{
 		k,
 	}
@@ -24,13 +28,31 @@ func NewQueryServer(k Keeper) types.QueryServer {
 
 var _ types.QueryServer = queryServer{}
 
-// GetAllCurrencyPairs returns the set of all currency pairs that the module is tracking QuotePrices for.
-// It returns an error to the caller if there are no CurrencyPairs being tracked by the module.
-func (q queryServer) GetAllCurrencyPairs(ctx context.Context, _ *types.GetAllCurrencyPairsRequest) (*types.GetAllCurrencyPairsResponse, error) {
-	// get all currency pairs from state
-	cps := q.k.GetAllCurrencyPairs(sdk.UnwrapSDKContext(ctx))
+// GetAllCurrencyPairs returns the set of all currency pairs that the module is tracking QuotePrices for. The currency
+// pairs may be filtered, and are paginated if the request sets a pagination.
+func (q queryServer) GetAllCurrencyPairs(ctx context.Context, req *types.GetAllCurrencyPairsRequest) (*types.GetAllCurrencyPairsResponse, error) {
+	// nil requests are treated as unfiltered + unpaginated, as this query took no arguments previously
+	if req == nil {
+		req = &types.GetAllCurrencyPairsRequest{}
+	}
+
+	filter := marketmaptypes.NewMarketFilter(req.EnabledOnly, req.Provider, req.Base, req.Quote)
+	cps, pageRes, err := paginateCurrencyPairs(
+		ctx,
+		q.k,
+		req.Pagination,
+		filter,
+		func(cp connecttypes.CurrencyPair, _ types.CurrencyPairState) connecttypes.CurrencyPair {
+			return cp
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GetAllCurrencyPairsResponse{
 		CurrencyPairs: cps,
+		Pagination:    pageRes,
 	}, nil
 }
 
@@ -122,10 +144,372 @@ func (q queryServer) GetPrices(ctx context.Context, req *types.GetPricesRequest)
 	}, nil
 }
 
-func (q queryServer) GetCurrencyPairMapping(ctx context.Context, _ *types.GetCurrencyPairMappingRequest) (*types.GetCurrencyPairMappingResponse, error) {
-	pairs, err := q.k.GetCurrencyPairMapping(ctx)
+// GetCurrencyPairMapping returns the mapping of ID -> CurrencyPair for the currency pairs that the module is tracking.
+// The currency pairs may be filtered, and are paginated if the request sets a pagination.
+func (q queryServer) GetCurrencyPairMapping(ctx context.Context, req *types.GetCurrencyPairMappingRequest) (*types.GetCurrencyPairMappingResponse, error) {
+	// nil requests are treated as unfiltered + unpaginated, as this query took no arguments previously
+	if req == nil {
+		req = &types.GetCurrencyPairMappingRequest{}
+	}
+
+	filter := marketmaptypes.NewMarketFilter(req.EnabledOnly, req.Provider, req.Base, req.Quote)
+	states, pageRes, err := paginateCurrencyPairs(
+		ctx,
+		q.k,
+		req.Pagination,
+		filter,
+		func(cp connecttypes.CurrencyPair, cps types.CurrencyPairState) types.CurrencyPairGenesis {
+			return types.CurrencyPairGenesis{CurrencyPair: cp, Id: cps.Id}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make(map[uint64]connecttypes.CurrencyPair, len(states))
+	for _, state := range states {
+		pairs[state.Id] = state.CurrencyPair
+	}
+
+	return &types.GetCurrencyPairMappingResponse{CurrencyPairMapping: pairs, Pagination: pageRes}, nil
+}
+
+// paginateCurrencyPairs returns the currency pairs matching the filter, transformed by the given function. All
+// matching currency pairs are returned if pageReq is nil, in which case the returned PageResponse is also nil. Filters
+// on the enabled status and providers of a currency pair are evaluated against its market in the x/marketmap module,
+// and currency pairs without a market never match them.
+func paginateCurrencyPairs[T any](
+	ctx context.Context,
+	k *Keeper,
+	pageReq *query.PageRequest,
+	filter marketmaptypes.MarketFilter,
+	transform func(cp connecttypes.CurrencyPair, cps types.CurrencyPairState) T,
+) ([]T, *query.PageResponse, error) {
+	req := pageReq
+	if req == nil {
+		req = &query.PageRequest{Limit: query.PaginationMaxLimit}
+	}
+
+	results, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.currencyPairs,
+		req,
+		func(cpStr string, _ types.CurrencyPairState) (bool, error) {
+			cp, err := connecttypes.CurrencyPairFromString(cpStr)
+			if err != nil {
+				return false, err
+			}
+
+			if !filter.MatchesCurrencyPair(cp) {
+				return false, nil
+			}
+
+			if !filter.RequiresMarket() {
+				return true, nil
+			}
+
+			if k.mmKeeper == nil {
+				return false, nil
+			}
+
+			market, err := k.mmKeeper.GetMarket(ctx, cpStr)
+			if errors.Is(err, collections.ErrNotFound) {
+				return false, nil
+			} else if err != nil {
+				return false, err
+			}
+
+			return filter.Matches(market), nil
+		},
+		func(cpStr string, cps types.CurrencyPairState) (T, error) {
+			cp, err := connecttypes.CurrencyPairFromString(cpStr)
+			if err != nil {
+				var zero T
+				return zero, err
+			}
+
+			return transform(cp, cps), nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pageReq == nil {
+		pageRes = nil
+	}
+
+	return results, pageRes, nil
+}
+
+// Params returns the parameters stored in the x/oracle module.
+func (q queryServer) Params(ctx context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ParamsResponse{Params: params}, nil
+}
+
+// MarketQuorums returns the per-currency-pair quorums stored in the x/oracle module.
+func (q queryServer) MarketQuorums(ctx context.Context, req *types.MarketQuorumsRequest) (*types.MarketQuorumsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	quorums, err := q.k.GetAllMarketQuorums(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketQuorumsResponse{MarketQuorums: quorums}, nil
+}
+
+// CircuitBreakers returns the per-currency-pair circuit breakers stored in the x/oracle module.
+func (q queryServer) CircuitBreakers(ctx context.Context, req *types.CircuitBreakersRequest) (*types.CircuitBreakersResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cbs, err := q.k.GetAllCircuitBreakers(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &types.GetCurrencyPairMappingResponse{CurrencyPairMapping: pairs}, nil
+
+	return &types.CircuitBreakersResponse{CircuitBreakers: cbs}, nil
+}
+
+// MarketHalts returns the currency pairs whose price updates are halted by their circuit breakers.
+func (q queryServer) MarketHalts(ctx context.Context, req *types.MarketHaltsRequest) (*types.MarketHaltsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	halts, err := q.k.GetAllMarketHalts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketHaltsResponse{MarketHalts: halts}, nil
+}
+
+// ValidatorParticipation returns the participation of a validator in the price updates of each currency pair over
+// its participation window.
+func (q queryServer) ValidatorParticipation(
+	ctx context.Context,
+	req *types.ValidatorParticipationRequest,
+) (*types.ValidatorParticipationResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator consensus address %s: %w", req.Validator, err)
+	}
+
+	blocks, participation, err := q.k.GetValidatorParticipation(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidatorParticipationResponse{BlocksInWindow: blocks, Participation: participation}, nil
+}
+
+// MarketParticipation returns the participation of each validator in the price updates of a currency pair over
+// their participation windows.
+func (q queryServer) MarketParticipation(
+	ctx context.Context,
+	req *types.MarketParticipationRequest,
+) (*types.MarketParticipationResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(req.CurrencyPair)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing currency pair: %w", err)
+	}
+
+	if !q.k.HasCurrencyPair(ctx, cp) {
+		return nil, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	participation, err := q.k.GetMarketParticipation(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketParticipationResponse{Participation: participation}, nil
+}
+
+// GetPriceHistory returns the historical prices of a CurrencyPair, ordered by height. The prices may be bounded by
+// an inclusive range of heights and an inclusive range of block timestamps, and are paginated.
+func (q queryServer) GetPriceHistory(
+	ctx context.Context,
+	req *types.GetPriceHistoryRequest,
+) (*types.GetPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(req.CurrencyPair)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing currency pair: %w", err)
+	}
+
+	if !q.k.HasCurrencyPair(ctx, cp) {
+		return nil, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	if req.EndHeight != 0 && req.StartHeight > req.EndHeight {
+		return nil, fmt.Errorf("start height %d is after end height %d", req.StartHeight, req.EndHeight)
+	}
+
+	if req.StartTime != nil && req.EndTime != nil && req.StartTime.After(*req.EndTime) {
+		return nil, fmt.Errorf("start time %s is after end time %s", req.StartTime, req.EndTime)
+	}
+
+	prices, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.priceHistory,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], qp types.QuotePrice) (bool, error) {
+			if key.K2() < req.StartHeight || (req.EndHeight != 0 && key.K2() > req.EndHeight) {
+				return false, nil
+			}
+			if req.StartTime != nil && qp.BlockTimestamp.Before(*req.StartTime) {
+				return false, nil
+			}
+			if req.EndTime != nil && qp.BlockTimestamp.After(*req.EndTime) {
+				return false, nil
+			}
+
+			return true, nil
+		},
+		func(_ collections.Pair[string, uint64], qp types.QuotePrice) (types.QuotePrice, error) {
+			return qp, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](cp.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetPriceHistoryResponse{Prices: prices, Pagination: pageRes}, nil
+}
+
+// GetTWAP returns the time weighted average price of a CurrencyPair between a start time and an end time, which
+// defaults to the current block time.
+func (q queryServer) GetTWAP(ctx context.Context, req *types.GetTWAPRequest) (*types.GetTWAPResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(req.CurrencyPair)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing currency pair: %w", err)
+	}
+
+	if !q.k.HasCurrencyPair(ctx, cp) {
+		return nil, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	end := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if req.EndTime != nil {
+		end = *req.EndTime
+	}
+
+	price, err := q.k.GetTWAP(ctx, cp, req.StartTime, end)
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTWAPResponse{Price: price, Decimals: decimals}, nil
+}
+
+// GetFreshPrice returns the price of a CurrencyPair only if it was updated within a max age of the current block. The
+// max age in the request is enforced if set, otherwise the CurrencyPair's default PriceMaxAge is.
+func (q queryServer) GetFreshPrice(ctx context.Context, req *types.GetFreshPriceRequest) (*types.GetFreshPriceResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(req.CurrencyPair)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing currency pair: %w", err)
+	}
+
+	if !q.k.HasCurrencyPair(ctx, cp) {
+		return nil, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	maxAge := types.NewPriceMaxAge(cp, req.MaxAgeBlocks, req.MaxAgeSeconds)
+	if req.MaxAgeBlocks == 0 && req.MaxAgeSeconds == 0 {
+		var ok bool
+		if maxAge, ok = q.k.GetPriceMaxAge(ctx, cp); !ok {
+			return nil, types.NewPriceMaxAgeNotExistError(cp)
+		}
+	}
+
+	qpn, err := q.k.GetPriceWithMaxAge(ctx, maxAge)
+	if err != nil {
+		return nil, err
+	}
+
+	id, ok := q.k.GetIDForCurrencyPair(ctx, cp)
+	if !ok {
+		return nil, fmt.Errorf("no ID found for CurrencyPair: %s", cp.String())
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetFreshPriceResponse{
+		Price:    qpn.QuotePrice,
+		Nonce:    qpn.Nonce(),
+		Decimals: decimals,
+		Id:       id,
+		MaxAge:   maxAge,
+	}, nil
+}
+
+// PriceMaxAges returns the per-currency-pair default price max ages stored in the x/oracle module.
+func (q queryServer) PriceMaxAges(ctx context.Context, req *types.PriceMaxAgesRequest) (*types.PriceMaxAgesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	maxAges, err := q.k.GetAllPriceMaxAges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PriceMaxAgesResponse{PriceMaxAges: maxAges}, nil
+}
+
+// Invariants checks the consistency invariants of the x/oracle module state, and returns the result of each.
+func (q queryServer) Invariants(ctx context.Context, req *types.InvariantsRequest) (*types.InvariantsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	results, err := q.k.CheckInvariants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.InvariantsResponse{Invariants: results}, nil
 }
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/mock"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -11,7 +14,7 @@ import (
 )
 
 func (s *KeeperTestSuite) TestGetAllCurrencyPairs() {
-	qs := keeper.NewQueryServer(s.oracleKeeper)
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
 
 	// test that an error is returned if no CurrencyPairs have been registered in the module
 	s.Run("an error is returned if no CurrencyPairs have been registered in the module", func() {
@@ -56,6 +59,107 @@ func (s *KeeperTestSuite) TestGetAllCurrencyPairs() {
 	})
 }
 
+func (s *KeeperTestSuite) TestCurrencyPairsFilteredAndPaginated() {
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
+
+	btc := connecttypes.NewCurrencyPair("BTC", "USD")
+	eth := connecttypes.NewCurrencyPair("ETH", "USD")
+	sol := connecttypes.NewCurrencyPair("SOL", "USDT")
+	for _, cp := range []connecttypes.CurrencyPair{btc, eth, sol} {
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	}
+
+	// BTC/USD is enabled with a binance config, ETH/USD is disabled, and SOL/USDT has no market
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btc.String()).Return(marketmaptypes.Market{
+		Ticker:          marketmaptypes.Ticker{CurrencyPair: btc, Enabled: true},
+		ProviderConfigs: []marketmaptypes.ProviderConfig{{Name: "binance"}},
+	}, nil).Maybe()
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, eth.String()).Return(marketmaptypes.Market{
+		Ticker:          marketmaptypes.Ticker{CurrencyPair: eth},
+		ProviderConfigs: []marketmaptypes.ProviderConfig{{Name: "kucoin"}},
+	}, nil).Maybe()
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, sol.String()).Return(marketmaptypes.Market{}, collections.ErrNotFound).Maybe()
+
+	tcs := []struct {
+		name     string
+		req      *types.GetAllCurrencyPairsRequest
+		expected []connecttypes.CurrencyPair
+	}{
+		{
+			"no filters",
+			&types.GetAllCurrencyPairsRequest{},
+			[]connecttypes.CurrencyPair{btc, eth, sol},
+		},
+		{
+			"enabled only",
+			&types.GetAllCurrencyPairsRequest{EnabledOnly: true},
+			[]connecttypes.CurrencyPair{btc},
+		},
+		{
+			"provider",
+			&types.GetAllCurrencyPairsRequest{Provider: "kucoin"},
+			[]connecttypes.CurrencyPair{eth},
+		},
+		{
+			"base + quote",
+			&types.GetAllCurrencyPairsRequest{Base: "sol", Quote: "usdt"},
+			[]connecttypes.CurrencyPair{sol},
+		},
+		{
+			"quote",
+			&types.GetAllCurrencyPairsRequest{Quote: "USD"},
+			[]connecttypes.CurrencyPair{btc, eth},
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			res, err := qs.GetAllCurrencyPairs(s.ctx, tc.req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res.CurrencyPairs)
+			s.Require().Nil(res.Pagination)
+
+			mapping, err := qs.GetCurrencyPairMapping(s.ctx, &types.GetCurrencyPairMappingRequest{
+				EnabledOnly: tc.req.EnabledOnly,
+				Provider:    tc.req.Provider,
+				Base:        tc.req.Base,
+				Quote:       tc.req.Quote,
+			})
+			s.Require().NoError(err)
+			s.Require().Len(mapping.CurrencyPairMapping, len(tc.expected))
+			for _, cp := range tc.expected {
+				id, ok := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, cp)
+				s.Require().True(ok)
+				s.Require().Equal(cp, mapping.CurrencyPairMapping[id])
+			}
+		})
+	}
+
+	s.Run("currency pairs are paginated", func() {
+		res, err := qs.GetAllCurrencyPairs(s.ctx, &types.GetAllCurrencyPairsRequest{
+			Pagination: &query.PageRequest{Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]connecttypes.CurrencyPair{btc, eth}, res.CurrencyPairs)
+		s.Require().NotEmpty(res.Pagination.NextKey)
+
+		res, err = qs.GetAllCurrencyPairs(s.ctx, &types.GetAllCurrencyPairsRequest{
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]connecttypes.CurrencyPair{sol}, res.CurrencyPairs)
+		s.Require().Empty(res.Pagination.NextKey)
+
+		mapping, err := qs.GetCurrencyPairMapping(s.ctx, &types.GetCurrencyPairMappingRequest{
+			Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(mapping.CurrencyPairMapping, 1)
+		id, _ := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, eth)
+		s.Require().Equal(eth, mapping.CurrencyPairMapping[id])
+	})
+}
+
 func (s *KeeperTestSuite) TestGetPrice() {
 	// set CPs on genesis for testing
 	cpg := []types.CurrencyPairGenesis{
@@ -173,7 +277,7 @@ func (s *KeeperTestSuite) TestGetPrice() {
 		},
 	}
 
-	qs := keeper.NewQueryServer(s.oracleKeeper)
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
 
 	for _, tc := range tcs {
 		s.Run(tc.name, func() {
@@ -207,7 +311,7 @@ func (s *KeeperTestSuite) TestGetPrice() {
 }
 
 func (s *KeeperTestSuite) TestGetCurrencyPairMappingGRPC() {
-	qs := keeper.NewQueryServer(s.oracleKeeper)
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
 	// test that after CurrencyPairs are registered, all of them are returned from the query
 	s.Run("after CurrencyPairs are registered, all of them are returned from the query", func() {
 		currencyPairs := []connecttypes.CurrencyPair{
@@ -233,3 +337,127 @@ func (s *KeeperTestSuite) TestGetCurrencyPairMappingGRPC() {
 		}
 	})
 }
+
+func (s *KeeperTestSuite) TestParamsGRPC() {
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
+
+	s.Run("a nil request returns an error", func() {
+		_, err := qs.Params(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("params set in state are returned from the query", func() {
+		params := types.NewParams(1, 0, 100)
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		res, err := qs.Params(s.ctx, &types.ParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(params, res.Params)
+	})
+}
+
+func (s *KeeperTestSuite) TestMarketQuorumsGRPC() {
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
+
+	s.Run("a nil request returns an error", func() {
+		_, err := qs.MarketQuorums(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("no quorums set returns an empty response", func() {
+		res, err := qs.MarketQuorums(s.ctx, &types.MarketQuorumsRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(res.MarketQuorums)
+	})
+
+	s.Run("quorums set in state are returned from the query", func() {
+		cp := connecttypes.NewCurrencyPair("AA", "BB")
+		quorum := types.NewMarketQuorum(cp, 5_000, 2)
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetMarketQuorum(s.ctx, quorum))
+
+		res, err := qs.MarketQuorums(s.ctx, &types.MarketQuorumsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MarketQuorum{quorum}, res.MarketQuorums)
+	})
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakersGRPC() {
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+
+	s.Run("nil requests return an error", func() {
+		_, err := qs.CircuitBreakers(s.ctx, nil)
+		s.Require().Error(err)
+
+		_, err = qs.MarketHalts(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("circuit breakers and halts set in state are returned from the queries", func() {
+		cb := types.NewCircuitBreaker(cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT)
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, cb))
+
+		res, err := qs.CircuitBreakers(s.ctx, &types.CircuitBreakersRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.CircuitBreaker{cb}, res.CircuitBreakers)
+
+		halts, err := qs.MarketHalts(s.ctx, &types.MarketHaltsRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(halts.MarketHalts)
+
+		s.writePrice(1, cp, 100)
+		s.writePrice(2, cp, 200)
+
+		halts, err = qs.MarketHalts(s.ctx, &types.MarketHaltsRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(halts.MarketHalts, 1)
+		s.Require().Equal(cp, halts.MarketHalts[0].CurrencyPair)
+	})
+}
+
+func (s *KeeperTestSuite) TestParticipationGRPC() {
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	val := sdk.ConsAddress("val1")
+
+	s.Run("nil requests return an error", func() {
+		_, err := qs.ValidatorParticipation(s.ctx, nil)
+		s.Require().Error(err)
+
+		_, err = qs.MarketParticipation(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid requests return an error", func() {
+		_, err := qs.ValidatorParticipation(s.ctx, &types.ValidatorParticipationRequest{Validator: "invalid"})
+		s.Require().Error(err)
+
+		_, err = qs.MarketParticipation(s.ctx, &types.MarketParticipationRequest{CurrencyPair: "CC/DD"})
+		s.Require().Error(err)
+	})
+
+	s.Run("recorded participation is returned from the queries", func() {
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.setParticipationParams(10, 0)
+		s.Require().NoError(s.recordParticipation(s.ctx, val, true, nil, nil))
+
+		expected := []types.MarketParticipation{
+			{
+				Validator:    val.String(),
+				CurrencyPair: cp,
+				Counts:       types.ParticipationCounts{Absent: 1},
+			},
+		}
+
+		res, err := qs.ValidatorParticipation(s.ctx, &types.ValidatorParticipationRequest{Validator: val.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), res.BlocksInWindow)
+		s.Require().Equal(expected, res.Participation)
+
+		market, err := qs.MarketParticipation(s.ctx, &types.MarketParticipationRequest{CurrencyPair: cp.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(expected, market.Participation)
+	})
+}
@@ -13,7 +13,10 @@ type Hooks struct {
 	k *Keeper
 }
 
-var _ marketmaptypes.MarketMapHooks = Hooks{}
+var (
+	_ marketmaptypes.MarketMapHooks    = Hooks{}
+	_ marketmaptypes.MarketStatusHooks = Hooks{}
+)
 
 // Hooks returns registered hooks for x/oracle.
 func (k *Keeper) Hooks() Hooks {
@@ -54,3 +57,17 @@ func (h Hooks) AfterMarketRemoved(ctx sdk.Context, key string) error {
 
 	return nil
 }
+
+// AfterMarketStatusChanged is the marketmap hook for x/oracle that is run after the lifecycle status of a market
+// changes. The currency pair ID and last price of the market are retained in every status, and price updates are
+// only accepted while the market is priced.
+func (h Hooks) AfterMarketStatusChanged(ctx sdk.Context, market marketmaptypes.Market, from marketmaptypes.MarketStatus) error {
+	ctx.Logger().Info(fmt.Sprintf(
+		"market %s status changed from %s to %s",
+		market.Ticker.String(),
+		from.ShortString(),
+		market.Ticker.EffectiveStatus().ShortString(),
+	))
+
+	return nil
+}
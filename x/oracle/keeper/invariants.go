@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// invariantCheck is a named x/oracle invariant. Check returns a description of each violation of the invariant.
+type invariantCheck struct {
+	name  string
+	check func(ctx context.Context, k *Keeper) ([]string, error)
+}
+
+// invariantChecks are the x/oracle invariants, in registration order.
+var invariantChecks = []invariantCheck{
+	{"market-currency-pairs", marketCurrencyPairViolations},
+	{"currency-pair-ids", currencyPairIDViolations},
+	{"num-currency-pairs", numCurrencyPairsViolations},
+	{"orphaned-currency-pair-state", orphanedCurrencyPairStateViolations},
+}
+
+// RegisterInvariants registers all x/oracle invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	for _, ic := range invariantChecks {
+		ir.RegisterRoute(types.ModuleName, ic.name, invariant(k, ic))
+	}
+}
+
+// AllInvariants runs all x/oracle invariants, and returns the first broken invariant, if any.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, ic := range invariantChecks {
+			if msg, broken := invariant(k, ic)(ctx); broken {
+				return msg, broken
+			}
+		}
+
+		return "", false
+	}
+}
+
+// invariant returns the sdk.Invariant for the given invariant check. The invariant is broken if the check fails.
+func invariant(k *Keeper, ic invariantCheck) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		violations, err := ic.check(ctx, k)
+		if err != nil {
+			violations = []string{fmt.Sprintf("unable to check invariant: %v", err)}
+		}
+
+		msg := fmt.Sprintf("%d violations found\n%s", len(violations), strings.Join(violations, "\n"))
+		return sdk.FormatInvariant(types.ModuleName, ic.name, msg), len(violations) > 0
+	}
+}
+
+// CheckInvariants runs all x/oracle invariants, and returns the result of each.
+func (k *Keeper) CheckInvariants(ctx context.Context) ([]types.InvariantResult, error) {
+	results := make([]types.InvariantResult, 0, len(invariantChecks))
+	for _, ic := range invariantChecks {
+		violations, err := ic.check(ctx, k)
+		if err != nil {
+			return nil, fmt.Errorf("unable to check invariant %s: %w", ic.name, err)
+		}
+
+		results = append(results, types.InvariantResult{
+			Name:       ic.name,
+			Broken:     len(violations) > 0,
+			Violations: violations,
+		})
+	}
+
+	return results, nil
+}
+
+// marketCurrencyPairViolations checks that every market in x/marketmap has a currency pair in x/oracle. Currency
+// pairs without a market are not violations, as the state of removed markets is retained.
+func marketCurrencyPairViolations(ctx context.Context, k *Keeper) ([]string, error) {
+	if k.mmKeeper == nil {
+		return nil, nil
+	}
+
+	markets, err := k.mmKeeper.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	for ticker, market := range markets {
+		if !k.HasCurrencyPair(ctx, market.Ticker.CurrencyPair) {
+			violations = append(violations, fmt.Sprintf("market %s has no currency pair", ticker))
+		}
+	}
+
+	return sortedViolations(violations), nil
+}
+
+// currencyPairIDViolations checks that the ID of every currency pair is unique and below the next currency pair ID,
+// and that the ID indexes contain exactly the IDs of the stored currency pairs.
+func currencyPairIDViolations(ctx context.Context, k *Keeper) ([]string, error) {
+	nextID, err := k.nextCurrencyPairID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	ids := make(map[uint64]string)
+	states := make(map[string]uint64)
+	err = k.currencyPairs.Walk(ctx, nil, func(cp string, state types.CurrencyPairState) (bool, error) {
+		states[cp] = state.Id
+		if other, ok := ids[state.Id]; ok {
+			violations = append(violations, fmt.Sprintf("currency pairs %s and %s share id %d", other, cp, state.Id))
+		}
+		ids[state.Id] = cp
+
+		if state.Id >= nextID {
+			violations = append(violations, fmt.Sprintf("currency pair %s has id %d, not below the next id %d", cp,
+				state.Id, nextID))
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// every index entry must reference a stored currency pair with the indexed ID, and every currency pair must be
+	// indexed by each index.
+	for _, index := range []struct {
+		name    string
+		entries func() ([]collections.Pair[uint64, string], error)
+	}{
+		{"unique", func() ([]collections.Pair[uint64, string], error) {
+			it, err := k.currencyPairs.Indexes.idUnique.Iterate(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+			return it.FullKeys()
+		}},
+		{"multi", func() ([]collections.Pair[uint64, string], error) {
+			it, err := k.idIndex.Iterate(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+			return it.FullKeys()
+		}},
+	} {
+		name := index.name
+		entries, err := index.entries()
+		if err != nil {
+			return nil, err
+		}
+
+		indexed := make(map[string]struct{}, len(entries))
+		for _, entry := range entries {
+			id, cp := entry.K1(), entry.K2()
+			indexed[cp] = struct{}{}
+
+			stateID, ok := states[cp]
+			switch {
+			case !ok:
+				violations = append(violations, fmt.Sprintf("%s id index entry %d references missing currency pair %s",
+					name, id, cp))
+			case stateID != id:
+				violations = append(violations, fmt.Sprintf("%s id index entry %d references currency pair %s with id %d",
+					name, id, cp, stateID))
+			}
+		}
+
+		for cp := range states {
+			if _, ok := indexed[cp]; !ok {
+				violations = append(violations, fmt.Sprintf("currency pair %s is missing from the %s id index", cp, name))
+			}
+		}
+	}
+
+	return sortedViolations(violations), nil
+}
+
+// numCurrencyPairsViolations checks that the currency pair counter matches the number of stored currency pairs.
+func numCurrencyPairsViolations(ctx context.Context, k *Keeper) ([]string, error) {
+	numCPs, err := k.GetNumCurrencyPairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var count uint64
+	err = k.currencyPairs.Walk(ctx, nil, func(_ string, _ types.CurrencyPairState) (bool, error) {
+		count++
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if numCPs != count {
+		return []string{fmt.Sprintf("currency pair count is %d, but %d currency pairs are stored", numCPs, count)}, nil
+	}
+
+	return nil, nil
+}
+
+// orphanedCurrencyPairStateViolations checks that no per-currency-pair state remains for currency pairs that are
+// not stored, i.e. that removing a currency pair removed all of its state.
+func orphanedCurrencyPairStateViolations(ctx context.Context, k *Keeper) ([]string, error) {
+	var violations []string
+	check := func(name string, walk func(func(cp string) error) error) error {
+		return walk(func(cp string) error {
+			ok, err := k.currencyPairs.Has(ctx, cp)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				violations = append(violations, fmt.Sprintf("%s of missing currency pair %s", name, cp))
+			}
+
+			return nil
+		})
+	}
+
+	for _, c := range []struct {
+		name string
+		walk func(func(cp string) error) error
+	}{
+		{"market quorum", walkKeys(ctx, k.marketQuorums)},
+		{"circuit breaker", walkKeys(ctx, k.circuitBreakers)},
+		{"circuit breaker sample", walkPairKeys(ctx, k.circuitBreakerSamples)},
+		{"circuit breaker bypass", walkKeySet(ctx, k.circuitBreakerBypasses)},
+		{"market halt", walkKeys(ctx, k.marketHalts)},
+		{"price history count", walkKeys(ctx, k.priceHistoryCounts)},
+		{"price accumulator", walkKeys(ctx, k.priceAccumulators)},
+		{"price max age", walkKeys(ctx, k.priceMaxAges)},
+	} {
+		if err := check(c.name, c.walk); err != nil {
+			return nil, err
+		}
+	}
+
+	return sortedViolations(violations), nil
+}
+
+// walkKeys returns a function that calls the given callback with each key of the map.
+func walkKeys[V any](ctx context.Context, m collections.Map[string, V]) func(func(string) error) error {
+	return func(cb func(string) error) error {
+		return m.Walk(ctx, nil, func(key string, _ V) (bool, error) {
+			return false, cb(key)
+		})
+	}
+}
+
+// walkPairKeys returns a function that calls the given callback with the first part of each key of the map.
+func walkPairKeys[V any](ctx context.Context, m collections.Map[collections.Pair[string, uint64], V]) func(func(string) error) error {
+	return func(cb func(string) error) error {
+		return m.Walk(ctx, nil, func(key collections.Pair[string, uint64], _ V) (bool, error) {
+			return false, cb(key.K1())
+		})
+	}
+}
+
+// walkKeySet returns a function that calls the given callback with each key of the set.
+func walkKeySet(ctx context.Context, ks collections.KeySet[string]) func(func(string) error) error {
+	return func(cb func(string) error) error {
+		return ks.Walk(ctx, nil, func(key string) (bool, error) {
+			return false, cb(key)
+		})
+	}
+}
+
+// sortedViolations sorts the given violations, so that they are reported deterministically.
+func sortedViolations(violations []string) []string {
+	slices.Sort(violations)
+	return violations
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/mock"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestInvariants() {
+	// set up a keeper whose store is also written to directly, to corrupt its state
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ss := runtime.NewKVStoreService(key)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	s.oracleKeeper = keeper.NewKeeper(ss, encCfg.Codec, s.mockMarketMapKeeper, moduleAuthAddr)
+	s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_key"))
+	s.oracleKeeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+
+	sb := collections.NewSchemaBuilder(ss)
+	numCPs := collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec)
+	marketQuorums := collections.NewMap(sb, types.MarketQuorumKeyPrefix, "market_quorums", collections.StringKey,
+		codec.CollValue[types.MarketQuorum](encCfg.Codec))
+
+	btcusd := connecttypes.NewCurrencyPair("BTC", "USD")
+	ethusd := connecttypes.NewCurrencyPair("ETH", "USD")
+	solusd := connecttypes.NewCurrencyPair("SOL", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcusd))
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, ethusd))
+
+	markets := func(cps ...connecttypes.CurrencyPair) map[string]marketmaptypes.Market {
+		m := make(map[string]marketmaptypes.Market, len(cps))
+		for _, cp := range cps {
+			m[cp.String()] = marketmaptypes.Market{Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8}}
+		}
+		return m
+	}
+
+	// broken returns the violations of each broken invariant.
+	broken := func() map[string][]string {
+		results, err := s.oracleKeeper.CheckInvariants(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(results, 4)
+
+		violations := make(map[string][]string)
+		for _, result := range results {
+			s.Require().Equal(len(result.Violations) > 0, result.Broken)
+			if result.Broken {
+				violations[result.Name] = result.Violations
+			}
+		}
+
+		return violations
+	}
+
+	s.Run("consistent state passes all invariants", func() {
+		s.mockMarketMapKeeper.On("GetAllMarkets", mock.Anything).Return(markets(btcusd, ethusd), nil).Twice()
+		s.Require().Empty(broken())
+
+		msg, isBroken := keeper.AllInvariants(&s.oracleKeeper)(s.ctx)
+		s.Require().False(isBroken, msg)
+	})
+
+	s.Run("currency pairs of removed markets are retained", func() {
+		s.mockMarketMapKeeper.On("GetAllMarkets", mock.Anything).Return(markets(btcusd), nil).Once()
+		s.Require().Empty(broken())
+	})
+
+	s.Run("a market without a currency pair breaks an invariant", func() {
+		s.mockMarketMapKeeper.On("GetAllMarkets", mock.Anything).Return(markets(btcusd, ethusd, solusd), nil).Once()
+		s.Require().Equal(map[string][]string{
+			"market-currency-pairs": {"market SOL/USD has no currency pair"},
+		}, broken())
+	})
+
+	s.Run("a corrupted currency pair count breaks an invariant", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		s.Require().NoError(numCPs.Set(cacheCtx, 5))
+
+		s.mockMarketMapKeeper.On("GetAllMarkets", mock.Anything).Return(markets(btcusd, ethusd), nil).Twice()
+		results, err := s.oracleKeeper.CheckInvariants(cacheCtx)
+		s.Require().NoError(err)
+		s.Require().Equal("num-currency-pairs", results[2].Name)
+		s.Require().True(results[2].Broken)
+		s.Require().Equal([]string{"currency pair count is 5, but 2 currency pairs are stored"}, results[2].Violations)
+
+		msg, isBroken := keeper.AllInvariants(&s.oracleKeeper)(cacheCtx)
+		s.Require().True(isBroken)
+		s.Require().Contains(msg, "num-currency-pairs")
+	})
+
+	s.Run("state of a missing currency pair breaks an invariant", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		s.Require().NoError(marketQuorums.Set(cacheCtx, solusd.String(), types.NewMarketQuorum(solusd, 5_000, 2)))
+
+		s.mockMarketMapKeeper.On("GetAllMarkets", mock.Anything).Return(markets(btcusd, ethusd), nil).Once()
+		results, err := s.oracleKeeper.CheckInvariants(cacheCtx)
+		s.Require().NoError(err)
+		s.Require().Equal("orphaned-currency-pair-state", results[3].Name)
+		s.Require().Equal([]string{"market quorum of missing currency pair SOL/USD"}, results[3].Violations)
+	})
+
+	s.Run("removing a currency pair leaves no orphaned state", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		s.Require().NoError(s.oracleKeeper.SetMarketQuorum(cacheCtx, types.NewMarketQuorum(ethusd, 5_000, 2)))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(cacheCtx, ethusd))
+
+		s.mockMarketMapKeeper.On("GetAllMarkets", mock.Anything).Return(markets(btcusd), nil).Once()
+		results, err := s.oracleKeeper.CheckInvariants(cacheCtx)
+		s.Require().NoError(err)
+		for _, result := range results {
+			s.Require().False(result.Broken, result.Violations)
+		}
+	})
+
+	s.Run("invariants query", func() {
+		qs := keeper.NewQueryServer(&s.oracleKeeper)
+
+		_, err := qs.Invariants(s.ctx, nil)
+		s.Require().Error(err)
+
+		s.mockMarketMapKeeper.On("GetAllMarkets", mock.Anything).Return(markets(btcusd, ethusd, solusd), nil).Once()
+		res, err := qs.Invariants(s.ctx, &types.InvariantsRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(res.Invariants, 4)
+		s.Require().Equal("market-currency-pairs", res.Invariants[0].Name)
+		s.Require().True(res.Invariants[0].Broken)
+		for _, result := range res.Invariants[1:] {
+			s.Require().False(result.Broken)
+		}
+	})
+}
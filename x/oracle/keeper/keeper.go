@@ -65,9 +65,66 @@ type Keeper struct {
 	// numRemoves is the number of CPs removed in the previous block.
 	numRemoves collections.Item[uint64]
 
+	// removedCPs are the CPs removed in the previous block, keyed by their ID before removal.
+	removedCPs collections.Map[uint64, string]
+
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// params is the module's parameters.
+	params collections.Item[types.Params]
+
+	// marketQuorums are the per-currency-pair quorums, keyed by CurrencyPair.String().
+	marketQuorums collections.Map[string, types.MarketQuorum]
+
+	// circuitBreakers are the per-currency-pair circuit breakers, keyed by CurrencyPair.String().
+	circuitBreakers collections.Map[string, types.CircuitBreaker]
+
+	// circuitBreakerSamples are the PriceSamples in the rolling window of each circuit breaker, keyed by
+	// CurrencyPair.String() and block height.
+	circuitBreakerSamples collections.Map[collections.Pair[string, uint64], types.PriceSample]
+
+	// circuitBreakerBypasses are the currency-pairs whose next price update bypasses their circuit breaker, keyed by
+	// CurrencyPair.String().
+	circuitBreakerBypasses collections.KeySet[string]
+
+	// marketHalts are the currency-pairs halted by their circuit breakers, keyed by CurrencyPair.String().
+	marketHalts collections.Map[string, types.MarketHalt]
+
+	// participationInfo is the participation tracking state of each validator, keyed by consensus address.
+	participationInfo collections.Map[[]byte, types.ValidatorParticipationInfo]
+
+	// participationCounts are the participation counts of each validator over its participation window, keyed by
+	// consensus address.
+	participationCounts collections.Map[[]byte, types.ValidatorParticipationCounts]
+
+	// participationSlots are the ParticipationRecords of each validator for each block in its participation window,
+	// keyed by consensus address and slot index.
+	participationSlots collections.Map[collections.Pair[[]byte, uint64], types.ParticipationRecord]
+
+	// priceHistory are the historical prices of each currency-pair, keyed by CurrencyPair.String() and block height.
+	priceHistory collections.Map[collections.Pair[string, uint64], types.QuotePrice]
+
+	// priceHistoryCounts are the number of historical prices stored for each currency-pair, keyed by
+	// CurrencyPair.String().
+	priceHistoryCounts collections.Map[string, uint64]
+
+	// priceAccumulators are the latest PriceAccumulators of each currency-pair, keyed by CurrencyPair.String().
+	priceAccumulators collections.Map[string, types.PriceAccumulator]
+
+	// priceAccumulatorHistory are the historical PriceAccumulators of each currency-pair, keyed by
+	// CurrencyPair.String() and block height. These are retained alongside the price history.
+	priceAccumulatorHistory collections.Map[collections.Pair[string, uint64], types.PriceAccumulator]
+
+	// priceMaxAges are the per-currency-pair default PriceMaxAges, keyed by CurrencyPair.String().
+	priceMaxAges collections.Map[string, types.PriceMaxAge]
+
+	// participationHooks are called at the end of each participation window.
+	participationHooks types.ParticipationHooks
+
+	// priceUpdateHooks are called by the price applier after each price it writes to state.
+	priceUpdateHooks types.PriceUpdateHooks
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -90,15 +147,30 @@ func NewKeeper(
 	}
 
 	k := Keeper{
-		storeService:       ss,
-		cdc:                cdc,
-		authority:          authority,
-		mmKeeper:           mmKeeper,
-		numRemoves:         collections.NewItem[uint64](sb, types.NumRemovesKeyPrefix, "removed_cps", types.CounterCodec),
-		numCPs:             collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec),
-		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
-		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
-		idIndex:            idMulti,
+		storeService:            ss,
+		cdc:                     cdc,
+		authority:               authority,
+		mmKeeper:                mmKeeper,
+		numRemoves:              collections.NewItem[uint64](sb, types.NumRemovesKeyPrefix, "removed_cps", types.CounterCodec),
+		removedCPs:              collections.NewMap(sb, types.RemovedCurrencyPairKeyPrefix, "removed_currency_pairs", collections.Uint64Key, collections.StringValue),
+		numCPs:                  collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec),
+		params:                  collections.NewItem[types.Params](sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
+		marketQuorums:           collections.NewMap(sb, types.MarketQuorumKeyPrefix, "market_quorums", collections.StringKey, codec.CollValue[types.MarketQuorum](cdc)),
+		circuitBreakers:         collections.NewMap(sb, types.CircuitBreakerKeyPrefix, "circuit_breakers", collections.StringKey, codec.CollValue[types.CircuitBreaker](cdc)),
+		circuitBreakerSamples:   collections.NewMap(sb, types.CircuitBreakerSampleKeyPrefix, "circuit_breaker_samples", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.PriceSample](cdc)),
+		circuitBreakerBypasses:  collections.NewKeySet(sb, types.CircuitBreakerBypassKeyPrefix, "circuit_breaker_bypasses", collections.StringKey),
+		marketHalts:             collections.NewMap(sb, types.MarketHaltKeyPrefix, "market_halts", collections.StringKey, codec.CollValue[types.MarketHalt](cdc)),
+		participationInfo:       collections.NewMap(sb, types.ParticipationInfoKeyPrefix, "participation_info", collections.BytesKey, codec.CollValue[types.ValidatorParticipationInfo](cdc)),
+		participationCounts:     collections.NewMap(sb, types.ParticipationCountsKeyPrefix, "participation_counts", collections.BytesKey, codec.CollValue[types.ValidatorParticipationCounts](cdc)),
+		participationSlots:      collections.NewMap(sb, types.ParticipationSlotKeyPrefix, "participation_slots", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key), codec.CollValue[types.ParticipationRecord](cdc)),
+		priceHistory:            collections.NewMap(sb, types.PriceHistoryKeyPrefix, "price_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.QuotePrice](cdc)),
+		priceHistoryCounts:      collections.NewMap(sb, types.PriceHistoryCountKeyPrefix, "price_history_counts", collections.StringKey, collections.Uint64Value),
+		priceAccumulators:       collections.NewMap(sb, types.PriceAccumulatorKeyPrefix, "price_accumulators", collections.StringKey, codec.CollValue[types.PriceAccumulator](cdc)),
+		priceAccumulatorHistory: collections.NewMap(sb, types.PriceAccumulatorHistoryKeyPrefix, "price_accumulator_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.PriceAccumulator](cdc)),
+		priceMaxAges:            collections.NewMap(sb, types.PriceMaxAgeKeyPrefix, "price_max_ages", collections.StringKey, codec.CollValue[types.PriceMaxAge](cdc)),
+		nextCurrencyPairID:      collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
+		currencyPairs:           collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
+		idIndex:                 idMulti,
 	}
 
 	// create the schema
@@ -111,16 +183,53 @@ func NewKeeper(
 	return k
 }
 
-// RemoveCurrencyPair removes a given CurrencyPair from state, i.e. removes its nonce + QuotePrice from the module's store.
+// Schema returns the schema of the module's collections.
+func (k *Keeper) Schema() collections.Schema {
+	return k.schema
+}
+
+// RemoveCurrencyPair removes a given CurrencyPair from state, i.e. removes its nonce + QuotePrice + MarketQuorum +
+// CircuitBreaker + MarketHalt + validator participation + price history + PriceAccumulator + PriceMaxAge from the
+// module's store.
 func (k *Keeper) RemoveCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) error {
 	// check if the currency pair exists.
 	if !k.HasCurrencyPair(ctx, cp) {
 		return types.NewCurrencyPairNotExistError(cp)
 	}
 
+	// record the removed currency pair, so that the IDs used before its removal can be resolved until the next block
+	state, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return err
+	}
+	if err := k.removedCPs.Set(ctx, state.Id, cp.String()); err != nil {
+		return err
+	}
+
 	if err := k.currencyPairs.Remove(ctx, cp.String()); err != nil {
 		return err
 	}
+	if err := k.marketQuorums.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+	if err := k.RemoveCircuitBreaker(ctx, cp); err != nil {
+		return err
+	}
+	if err := k.marketHalts.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+	if err := k.removeParticipation(ctx, state.Id); err != nil {
+		return err
+	}
+	if err := k.removePriceHistory(ctx, cp); err != nil {
+		return err
+	}
+	if err := k.priceAccumulators.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+	if err := k.RemovePriceMaxAge(ctx, cp); err != nil {
+		return err
+	}
 	if err := k.incrementRemovedCPCounter(ctx); err != nil {
 		return err
 	}
@@ -193,7 +302,7 @@ func (k *Keeper) GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.Cu
 
 // SetPriceForCurrencyPair sets the given QuotePrice for a given CurrencyPair, and updates the CurrencyPair's nonce. Note, no validation is performed on
 // either the CurrencyPair or the QuotePrice (it is expected the caller performs this validation). If the CurrencyPair does not exist, create the currency-pair
-// and set its nonce to 0.
+// and set its nonce to 0. The CurrencyPair's PriceAccumulator is advanced and the price is added to its price history.
 func (k *Keeper) SetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair, qp types.QuotePrice) error {
 	// get the current state for the currency-pair, fail if it does not exist
 	cps, err := k.currencyPairs.Get(ctx, cp.String())
@@ -212,7 +321,16 @@ func (k *Keeper) SetPriceForCurrencyPair(ctx context.Context, cp connecttypes.Cu
 	}
 
 	// set the updated state
-	return k.currencyPairs.Set(ctx, cp.String(), cps)
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	acc, err := k.updatePriceAccumulator(ctx, cp, qp)
+	if err != nil {
+		return err
+	}
+
+	return k.recordPriceHistory(ctx, cp, qp, acc)
 }
 
 // CreateCurrencyPair creates a CurrencyPair in state, and sets its ID to the next available ID. If the CurrencyPair already exists, return an error.
@@ -332,6 +450,21 @@ func (k *Keeper) IterateCurrencyPairs(ctx context.Context, cb func(cp connecttyp
 	return nil
 }
 
+// PriceUpdateHooks returns the PriceUpdateHooks registered with the keeper.
+func (k *Keeper) PriceUpdateHooks() types.PriceUpdateHooks {
+	if k.priceUpdateHooks == nil {
+		// return a no-op implementation if no hooks are set
+		return &types.NoopPriceUpdateHooks{}
+	}
+
+	return k.priceUpdateHooks
+}
+
+// SetPriceUpdateHooks sets the PriceUpdateHooks registered with the keeper.
+func (k *Keeper) SetPriceUpdateHooks(hooks types.PriceUpdateHooks) {
+	k.priceUpdateHooks = hooks
+}
+
 // GetDecimalsForCurrencyPair gets the decimals used for the given currency pair.  If the market map is not enabled
 // with the x/oracle module, the legacy Decimals function is used.
 func (k *Keeper) GetDecimalsForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (decimals uint64, err error) {
@@ -351,6 +484,26 @@ func (k *Keeper) GetDecimalsForCurrencyPair(ctx context.Context, cp connecttypes
 	return market.Ticker.Decimals, nil
 }
 
+// AcceptsPrices returns true if price updates for the given currency pair are accepted under the lifecycle status
+// of its market. Prices are accepted for every currency pair if the market map is not enabled with the x/oracle
+// module, and for currency pairs without a market in the market map (as with GetDecimalsForCurrencyPair).
+func (k *Keeper) AcceptsPrices(ctx context.Context, cp connecttypes.CurrencyPair) (bool, error) {
+	if k.mmKeeper == nil {
+		return true, nil
+	}
+
+	market, err := k.mmKeeper.GetMarket(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return market.Ticker.EffectiveStatus().IsPriced(), nil
+}
+
 // IncrementRemovedCPCounter increments the counter of removed currency pairs.
 func (k *Keeper) incrementRemovedCPCounter(ctx context.Context) error {
 	val, err := k.numRemoves.Get(ctx)
@@ -367,6 +520,32 @@ func (k *Keeper) GetNumRemovedCurrencyPairs(ctx context.Context) (uint64, error)
 	return k.numRemoves.Get(ctx)
 }
 
+// GetRemovedCurrencyPairs returns the currency pairs removed in the previous block, keyed by their ID before removal.
+func (k *Keeper) GetRemovedCurrencyPairs(ctx context.Context) (map[uint64]connecttypes.CurrencyPair, error) {
+	it, err := k.removedCPs.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	removed := make(map[uint64]connecttypes.CurrencyPair)
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		cp, err := connecttypes.CurrencyPairFromString(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		removed[kv.Key] = cp
+	}
+
+	return removed, nil
+}
+
 // IncrementCPCounter increments the counter of currency pairs.
 func (k *Keeper) incrementCPCounter(ctx context.Context) error {
 	val, err := k.numCPs.Get(ctx)
@@ -393,3 +572,49 @@ func (k *Keeper) decrementCPCounter(ctx context.Context) error {
 func (k *Keeper) GetNumCurrencyPairs(ctx context.Context) (uint64, error) {
 	return k.numCPs.Get(ctx)
 }
+
+// SetParams sets the x/oracle module's parameters.
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.params.Set(ctx, params)
+}
+
+// GetParams returns the x/oracle module's parameters.
+func (k *Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.params.Get(ctx)
+}
+
+// SetMarketQuorum sets the quorum for the MarketQuorum's CurrencyPair, overwriting any existing quorum. This fails
+// if the CurrencyPair does not exist in state.
+func (k *Keeper) SetMarketQuorum(ctx context.Context, quorum types.MarketQuorum) error {
+	if !k.HasCurrencyPair(ctx, quorum.CurrencyPair) {
+		return types.NewCurrencyPairNotExistError(quorum.CurrencyPair)
+	}
+
+	return k.marketQuorums.Set(ctx, quorum.CurrencyPair.String(), quorum)
+}
+
+// GetMarketQuorum returns the quorum set for a given CurrencyPair. If no quorum is set, return an empty
+// MarketQuorum, false, if one is, return true and the quorum.
+func (k *Keeper) GetMarketQuorum(ctx context.Context, cp connecttypes.CurrencyPair) (types.MarketQuorum, bool) {
+	quorum, err := k.marketQuorums.Get(ctx, cp.String())
+	if err != nil {
+		return types.MarketQuorum{}, false
+	}
+
+	return quorum, true
+}
+
+// RemoveMarketQuorum removes the quorum set for a given CurrencyPair. This is a no-op if no quorum is set.
+func (k *Keeper) RemoveMarketQuorum(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	return k.marketQuorums.Remove(ctx, cp.String())
+}
+
+// GetAllMarketQuorums returns all MarketQuorums stored in state, ordered by CurrencyPair.
+func (k *Keeper) GetAllMarketQuorums(ctx context.Context) ([]types.MarketQuorum, error) {
+	it, err := k.marketQuorums.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
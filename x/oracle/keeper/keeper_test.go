@@ -364,3 +364,46 @@ func (s *KeeperTestSuite) TestGetNumCurrencyPairs() {
 		s.Require().Equal(cps, uint64(2))
 	})
 }
+
+func (s *KeeperTestSuite) TestMarketQuorums() {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	quorum := types.NewMarketQuorum(cp, 5_000, 3)
+
+	s.Run("setting a quorum for a currency pair not in state fails", func() {
+		s.Require().Error(s.oracleKeeper.SetMarketQuorum(s.ctx, quorum))
+
+		_, ok := s.oracleKeeper.GetMarketQuorum(s.ctx, cp)
+		s.Require().False(ok)
+	})
+
+	s.Run("a quorum can be set, overwritten and removed", func() {
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetMarketQuorum(s.ctx, quorum))
+
+		got, ok := s.oracleKeeper.GetMarketQuorum(s.ctx, cp)
+		s.Require().True(ok)
+		s.Require().Equal(quorum, got)
+
+		updated := types.NewMarketQuorum(cp, 3_000, 1)
+		s.Require().NoError(s.oracleKeeper.SetMarketQuorum(s.ctx, updated))
+
+		quorums, err := s.oracleKeeper.GetAllMarketQuorums(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MarketQuorum{updated}, quorums)
+
+		s.Require().NoError(s.oracleKeeper.RemoveMarketQuorum(s.ctx, cp))
+		_, ok = s.oracleKeeper.GetMarketQuorum(s.ctx, cp)
+		s.Require().False(ok)
+
+		// removing a quorum that is not set is a no-op
+		s.Require().NoError(s.oracleKeeper.RemoveMarketQuorum(s.ctx, cp))
+	})
+
+	s.Run("removing a currency pair removes its quorum", func() {
+		s.Require().NoError(s.oracleKeeper.SetMarketQuorum(s.ctx, quorum))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+
+		_, ok := s.oracleKeeper.GetMarketQuorum(s.ctx, cp)
+		s.Require().False(ok)
+	})
+}
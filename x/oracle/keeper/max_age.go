@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// SetPriceMaxAge sets the default max age of the price of the PriceMaxAge's CurrencyPair, overwriting any existing
+// max age. This fails if the CurrencyPair does not exist in state.
+func (k *Keeper) SetPriceMaxAge(ctx context.Context, maxAge types.PriceMaxAge) error {
+	if !k.HasCurrencyPair(ctx, maxAge.CurrencyPair) {
+		return types.NewCurrencyPairNotExistError(maxAge.CurrencyPair)
+	}
+
+	return k.priceMaxAges.Set(ctx, maxAge.CurrencyPair.String(), maxAge)
+}
+
+// GetPriceMaxAge returns the default max age of the price of a given CurrencyPair. If no max age is set, return an
+// empty PriceMaxAge, false, if one is, return true and the max age.
+func (k *Keeper) GetPriceMaxAge(ctx context.Context, cp connecttypes.CurrencyPair) (types.PriceMaxAge, bool) {
+	maxAge, err := k.priceMaxAges.Get(ctx, cp.String())
+	if err != nil {
+		return types.PriceMaxAge{}, false
+	}
+
+	return maxAge, true
+}
+
+// RemovePriceMaxAge removes the default max age set for a given CurrencyPair. This is a no-op if no max age is set.
+func (k *Keeper) RemovePriceMaxAge(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	return k.priceMaxAges.Remove(ctx, cp.String())
+}
+
+// GetAllPriceMaxAges returns all PriceMaxAges stored in state, ordered by CurrencyPair.
+func (k *Keeper) GetAllPriceMaxAges(ctx context.Context) ([]types.PriceMaxAge, error) {
+	it, err := k.priceMaxAges.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// GetFreshPriceForCurrencyPair returns the price + nonce of a CurrencyPair, enforcing the CurrencyPair's default
+// PriceMaxAge relative to the current block. This returns a PriceMaxAgeNotExistError if no max age is set for the
+// CurrencyPair, and a StalePriceError if the price is older than the max age.
+func (k *Keeper) GetFreshPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (types.QuotePriceWithNonce, error) {
+	maxAge, ok := k.GetPriceMaxAge(ctx, cp)
+	if !ok {
+		return types.QuotePriceWithNonce{}, types.NewPriceMaxAgeNotExistError(cp)
+	}
+
+	return k.GetPriceWithMaxAge(ctx, maxAge)
+}
+
+// GetPriceWithMaxAge returns the price + nonce of the PriceMaxAge's CurrencyPair, if it was updated within the max age
+// of the current block. This returns a StalePriceError if the price is older than the max age.
+func (k *Keeper) GetPriceWithMaxAge(ctx context.Context, maxAge types.PriceMaxAge) (types.QuotePriceWithNonce, error) {
+	// a currency pair without a price is never fresh
+	qp, err := k.GetPriceForCurrencyPair(ctx, maxAge.CurrencyPair)
+	if err != nil {
+		return types.QuotePriceWithNonce{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := maxAge.CheckPrice(qp, sdkCtx.BlockHeight(), sdkCtx.BlockTime()); err != nil {
+		return types.QuotePriceWithNonce{}, err
+	}
+
+	nonce, err := k.GetNonceForCurrencyPair(ctx, maxAge.CurrencyPair)
+	if err != nil {
+		return types.QuotePriceWithNonce{}, err
+	}
+
+	return types.NewQuotePriceWithNonce(qp, nonce), nil
+}
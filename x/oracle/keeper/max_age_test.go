@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestPriceMaxAges() {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+
+	// a price of 10 is written at height 1, with a block time of 1 second
+	setup := func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.writeHistoricalPrices(cp, 1)
+	}
+
+	s.Run("max ages cannot be set for unknown currency pairs", func() {
+		setup()
+
+		err := s.oracleKeeper.SetPriceMaxAge(s.ctx, types.NewPriceMaxAge(connecttypes.NewCurrencyPair("CC", "DD"), 1, 0))
+		s.Require().Error(err)
+	})
+
+	s.Run("fresh prices require a max age", func() {
+		setup()
+
+		_, err := s.oracleKeeper.GetFreshPriceForCurrencyPair(s.ctx, cp)
+		s.Require().ErrorIs(err, types.NewPriceMaxAgeNotExistError(cp))
+	})
+
+	s.Run("prices within the max age are fresh", func() {
+		setup()
+		maxAge := types.NewPriceMaxAge(cp, 5, 60)
+		s.Require().NoError(s.oracleKeeper.SetPriceMaxAge(s.ctx, maxAge))
+
+		got, ok := s.oracleKeeper.GetPriceMaxAge(s.ctx, cp)
+		s.Require().True(ok)
+		s.Require().Equal(maxAge, got)
+
+		ctx := s.ctx.WithBlockHeight(6).WithBlockTime(time.Unix(61, 0).UTC())
+		qpn, err := s.oracleKeeper.GetFreshPriceForCurrencyPair(ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(10), qpn.Price.Int64())
+	})
+
+	s.Run("prices beyond the max age are stale", func() {
+		setup()
+		s.Require().NoError(s.oracleKeeper.SetPriceMaxAge(s.ctx, types.NewPriceMaxAge(cp, 5, 0)))
+
+		ctx := s.ctx.WithBlockHeight(7)
+		_, err := s.oracleKeeper.GetFreshPriceForCurrencyPair(ctx, cp)
+
+		var stale types.StalePriceError
+		s.Require().True(errors.As(err, &stale))
+		s.Require().Equal(uint64(6), stale.AgeBlocks)
+	})
+
+	s.Run("currency pairs without a price are never fresh", func() {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.SetPriceMaxAge(s.ctx, types.NewPriceMaxAge(cp, 5, 0)))
+
+		_, err := s.oracleKeeper.GetFreshPriceForCurrencyPair(s.ctx, cp)
+		s.Require().Error(err)
+	})
+
+	s.Run("removing the currency pair removes its max age", func() {
+		setup()
+		s.Require().NoError(s.oracleKeeper.SetPriceMaxAge(s.ctx, types.NewPriceMaxAge(cp, 5, 0)))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+
+		_, ok := s.oracleKeeper.GetPriceMaxAge(s.ctx, cp)
+		s.Require().False(ok)
+	})
+
+	s.Run("max ages are round-tripped through genesis", func() {
+		setup()
+		maxAge := types.NewPriceMaxAge(cp, 5, 60)
+		s.Require().NoError(s.oracleKeeper.SetPriceMaxAge(s.ctx, maxAge))
+
+		gs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Equal([]types.PriceMaxAge{maxAge}, gs.PriceMaxAges)
+
+		s.SetupTest()
+		s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+		maxAges, err := s.oracleKeeper.GetAllPriceMaxAges(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PriceMaxAge{maxAge}, maxAges)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgSetPriceMaxAges() {
+	ms := keeper.NewMsgServer(&s.oracleKeeper)
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	maxAge := types.NewPriceMaxAge(cp, 5, 0)
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	s.Run("if the signer is not the authority - fail", func() {
+		_, err := ms.SetPriceMaxAges(s.ctx, &types.MsgSetPriceMaxAges{
+			Authority:    sdk.AccAddress("not-authority").String(),
+			PriceMaxAges: []types.PriceMaxAge{maxAge},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("if the currency pair does not exist - fail", func() {
+		_, err := ms.SetPriceMaxAges(s.ctx, &types.MsgSetPriceMaxAges{
+			Authority:    moduleAuthAddr.String(),
+			PriceMaxAges: []types.PriceMaxAge{types.NewPriceMaxAge(connecttypes.NewCurrencyPair("CC", "DD"), 5, 0)},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("if the signer is the authority - pass", func() {
+		_, err := ms.SetPriceMaxAges(s.ctx, &types.MsgSetPriceMaxAges{
+			Authority:    moduleAuthAddr.String(),
+			PriceMaxAges: []types.PriceMaxAge{maxAge},
+		})
+		s.Require().NoError(err)
+
+		got, ok := s.oracleKeeper.GetPriceMaxAge(s.ctx, cp)
+		s.Require().True(ok)
+		s.Require().Equal(maxAge, got)
+	})
+
+	s.Run("removing max ages requires the authority", func() {
+		_, err := ms.RemovePriceMaxAges(s.ctx, &types.MsgRemovePriceMaxAges{
+			Authority:       sdk.AccAddress("not-authority").String(),
+			CurrencyPairIds: []string{cp.String()},
+		})
+		s.Require().Error(err)
+
+		_, err = ms.RemovePriceMaxAges(s.ctx, &types.MsgRemovePriceMaxAges{
+			Authority:       moduleAuthAddr.String(),
+			CurrencyPairIds: []string{cp.String()},
+		})
+		s.Require().NoError(err)
+
+		_, ok := s.oracleKeeper.GetPriceMaxAge(s.ctx, cp)
+		s.Require().False(ok)
+	})
+}
+
+func (s *KeeperTestSuite) TestFreshPriceGRPC() {
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	noDefault := connecttypes.NewCurrencyPair("CC", "DD")
+
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, noDefault))
+	s.writeHistoricalPrices(cp, 1)
+	s.writeHistoricalPrices(noDefault, 1)
+	s.Require().NoError(s.oracleKeeper.SetPriceMaxAge(s.ctx, types.NewPriceMaxAge(cp, 5, 0)))
+	for _, pair := range []connecttypes.CurrencyPair{cp, noDefault} {
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, pair.String()).Return(marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{CurrencyPair: pair, Decimals: 8},
+		}, nil).Maybe()
+	}
+	ctx := s.ctx.WithBlockHeight(4)
+
+	tcs := []struct {
+		name     string
+		req      *types.GetFreshPriceRequest
+		expected types.PriceMaxAge
+		expErr   bool
+	}{
+		{
+			"nil request - fail",
+			nil,
+			types.PriceMaxAge{},
+			true,
+		},
+		{
+			"invalid currency pair - fail",
+			&types.GetFreshPriceRequest{CurrencyPair: "invalid"},
+			types.PriceMaxAge{},
+			true,
+		},
+		{
+			"unknown currency pair - fail",
+			&types.GetFreshPriceRequest{CurrencyPair: "EE/FF"},
+			types.PriceMaxAge{},
+			true,
+		},
+		{
+			"no max age in the request or state - fail",
+			&types.GetFreshPriceRequest{CurrencyPair: noDefault.String()},
+			types.PriceMaxAge{},
+			true,
+		},
+		{
+			"default max age - pass",
+			&types.GetFreshPriceRequest{CurrencyPair: cp.String()},
+			types.NewPriceMaxAge(cp, 5, 0),
+			false,
+		},
+		{
+			"request max age overrides the default - fail",
+			&types.GetFreshPriceRequest{CurrencyPair: cp.String(), MaxAgeBlocks: 2},
+			types.PriceMaxAge{},
+			true,
+		},
+		{
+			"request max age without a default - pass",
+			&types.GetFreshPriceRequest{CurrencyPair: noDefault.String(), MaxAgeBlocks: 3},
+			types.NewPriceMaxAge(noDefault, 3, 0),
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			res, err := qs.GetFreshPrice(ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res.MaxAge)
+			s.Require().Equal(int64(10), res.Price.Price.Int64())
+			s.Require().Equal(uint64(8), res.Decimals)
+		})
+	}
+
+	res, err := qs.PriceMaxAges(s.ctx, &types.PriceMaxAgesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PriceMaxAge{types.NewPriceMaxAge(cp, 5, 0)}, res.PriceMaxAges)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// Migrator is responsible for performing in-place store migrations of the x/oracle module.
+type Migrator struct {
+	k *Keeper
+}
+
+// NewMigrator returns a new Migrator for the x/oracle module.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the x/oracle module from consensus version 1 to 2. Version 2 introduces
+// the module parameters, which are initialized to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.k.SetParams(ctx, types.DefaultParams())
+}
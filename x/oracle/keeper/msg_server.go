@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,11 +13,11 @@ import (
 
 // msgServer is the default implementation of the x/oracle MsgService.
 type msgServer struct {
-	k Keeper
+	k *Keeper
 }
 
 // NewMsgServer returns the default implementation of the x/oracle message service.
-func NewMsgServer(k Keeper) types.MsgServer {
+func NewMsgServer(k *Keeper) types.MsgServer {
 	return &msgServer{k}
 }
 
@@ -92,3 +93,250 @@ func (m *msgServer) RemoveCurrencyPairs(goCtx context.Context, req *types.MsgRem
 
 	return nil, nil
 }
+
+// UpdateParams updates the x/oracle module's Params. This method fails if the message is invalid, or if the signer
+// is not the authority account of the module. Changing the participation window resets all tracked validator
+// participation.
+func (m *msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	if err := req.Params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := m.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	// participation counts are only meaningful over a fixed window, so changing the window restarts tracking
+	if params.ParticipationWindowBlocks != req.Params.ParticipationWindowBlocks {
+		if err := m.k.ResetParticipation(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetMarketQuorums sets the quorum for each of the currency pairs in the message. This method fails if the message is invalid,
+// if the signer is not the authority account of the module, or if any of the currency pairs do not exist in state.
+func (m *msgServer) SetMarketQuorums(goCtx context.Context, req *types.MsgSetMarketQuorums) (*types.MsgSetMarketQuorumsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	if err := types.ValidateMarketQuorums(req.MarketQuorums); err != nil {
+		return nil, fmt.Errorf("invalid market quorums: %w", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, quorum := range req.MarketQuorums {
+		if err := m.k.SetMarketQuorum(ctx, quorum); err != nil {
+			return nil, fmt.Errorf("error setting market quorum: %w", err)
+		}
+	}
+
+	return &types.MsgSetMarketQuorumsResponse{}, nil
+}
+
+// RemoveMarketQuorums removes the quorums set for each of the currency pairs in the message, after which they fall back to the
+// quorum in the module's Params. Currency pairs without a quorum are skipped.
+func (m *msgServer) RemoveMarketQuorums(goCtx context.Context, req *types.MsgRemoveMarketQuorums) (*types.MsgRemoveMarketQuorumsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, id := range req.CurrencyPairIds {
+		// get cp from identifier string
+		cp, err := connecttypes.CurrencyPairFromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving CurrencyPair from request: %w", err)
+		}
+
+		if err := m.k.RemoveMarketQuorum(ctx, cp); err != nil {
+			return nil, fmt.Errorf("error removing market quorum from state: %w", err)
+		}
+	}
+
+	return &types.MsgRemoveMarketQuorumsResponse{}, nil
+}
+
+// SetCircuitBreakers sets the circuit breaker for each of the currency pairs in the message. This method fails if the message is
+// invalid, if the signer is not the authority account of the module, or if any of the currency pairs do not exist in state.
+func (m *msgServer) SetCircuitBreakers(goCtx context.Context, req *types.MsgSetCircuitBreakers) (*types.MsgSetCircuitBreakersResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	if err := types.ValidateCircuitBreakers(req.CircuitBreakers); err != nil {
+		return nil, fmt.Errorf("invalid circuit breakers: %w", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, cb := range req.CircuitBreakers {
+		if err := m.k.SetCircuitBreaker(ctx, cb); err != nil {
+			return nil, fmt.Errorf("error setting circuit breaker: %w", err)
+		}
+	}
+
+	return &types.MsgSetCircuitBreakersResponse{}, nil
+}
+
+// RemoveCircuitBreakers removes the circuit breakers set for each of the currency pairs in the message. Currency pairs without a
+// circuit breaker are skipped.
+func (m *msgServer) RemoveCircuitBreakers(goCtx context.Context, req *types.MsgRemoveCircuitBreakers) (*types.MsgRemoveCircuitBreakersResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, id := range req.CurrencyPairIds {
+		// get cp from identifier string
+		cp, err := connecttypes.CurrencyPairFromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving CurrencyPair from request: %w", err)
+		}
+
+		if err := m.k.RemoveCircuitBreaker(ctx, cp); err != nil {
+			return nil, fmt.Errorf("error removing circuit breaker from state: %w", err)
+		}
+	}
+
+	return &types.MsgRemoveCircuitBreakersResponse{}, nil
+}
+
+// ClearMarketHalts resumes price updates for each of the currency pairs in the message. The signer must be the authority account of
+// the module, or one of the x/marketmap market authorities. Currency pairs that are not halted are skipped.
+func (m *msgServer) ClearMarketHalts(goCtx context.Context, req *types.MsgClearMarketHalts) (*types.MsgClearMarketHaltsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.verifyAuthorityOrMarketAuthority(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, id := range req.CurrencyPairIds {
+		// get cp from identifier string
+		cp, err := connecttypes.CurrencyPairFromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving CurrencyPair from request: %w", err)
+		}
+
+		if err := m.k.ClearMarketHalt(ctx, cp); err != nil {
+			return nil, fmt.Errorf("error clearing market halt: %w", err)
+		}
+	}
+
+	return &types.MsgClearMarketHaltsResponse{}, nil
+}
+
+// SetPriceMaxAges sets the default price max age for each of the currency pairs in the message. This method fails if the message
+// is invalid, if the signer is not the authority account of the module, or if any of the currency pairs do not exist in state.
+func (m *msgServer) SetPriceMaxAges(goCtx context.Context, req *types.MsgSetPriceMaxAges) (*types.MsgSetPriceMaxAgesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	if err := types.ValidatePriceMaxAges(req.PriceMaxAges); err != nil {
+		return nil, fmt.Errorf("invalid price max ages: %w", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, maxAge := range req.PriceMaxAges {
+		if err := m.k.SetPriceMaxAge(ctx, maxAge); err != nil {
+			return nil, fmt.Errorf("error setting price max age: %w", err)
+		}
+	}
+
+	return &types.MsgSetPriceMaxAgesResponse{}, nil
+}
+
+// RemovePriceMaxAges removes the default price max ages set for each of the currency pairs in the message. Currency pairs
+// without a max age are skipped.
+func (m *msgServer) RemovePriceMaxAges(goCtx context.Context, req *types.MsgRemovePriceMaxAges) (*types.MsgRemovePriceMaxAgesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, id := range req.CurrencyPairIds {
+		// get cp from identifier string
+		cp, err := connecttypes.CurrencyPairFromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving CurrencyPair from request: %w", err)
+		}
+
+		if err := m.k.RemovePriceMaxAge(ctx, cp); err != nil {
+			return nil, fmt.Errorf("error removing price max age from state: %w", err)
+		}
+	}
+
+	return &types.MsgRemovePriceMaxAgesResponse{}, nil
+}
+
+// verifyAuthorityOrMarketAuthority returns an error if the signer is neither the authority account of the module, nor one of the
+// x/marketmap market authorities.
+func (m *msgServer) verifyAuthorityOrMarketAuthority(ctx sdk.Context, signer string) error {
+	if signer == m.k.authority.String() {
+		return nil
+	}
+
+	if m.k.mmKeeper != nil {
+		params, err := m.k.mmKeeper.GetParams(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to get marketmap params: %w", err)
+		}
+
+		if slices.Contains(params.MarketAuthorities, signer) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("message validation failed: signer %s is neither the module authority %s nor a market authority", signer, m.k.authority)
+}
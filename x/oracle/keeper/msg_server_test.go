@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/keeper"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
 )
@@ -107,7 +108,7 @@ func (s *KeeperTestSuite) TestMsgAddCurrencyPairs() {
 	s.oracleKeeper.InitGenesis(s.ctx, gs)
 
 	// construct message server + wrap context
-	ms := keeper.NewMsgServer(s.oracleKeeper)
+	ms := keeper.NewMsgServer(&s.oracleKeeper)
 	for _, tc := range tcs {
 		s.Run(tc.name, func() {
 			// execute message
@@ -228,7 +229,7 @@ func (s *KeeperTestSuite) TestMsgRemoveCurrencyPairs() {
 		},
 	}
 
-	ms := keeper.NewMsgServer(s.oracleKeeper)
+	ms := keeper.NewMsgServer(&s.oracleKeeper)
 	for _, tc := range tcs {
 		s.T().Run(tc.name, func(t *testing.T) {
 			// execute message
@@ -254,3 +255,357 @@ func (s *KeeperTestSuite) TestMsgRemoveCurrencyPairs() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	ms := keeper.NewMsgServer(&s.oracleKeeper)
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the authority of the module - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress("not-authority").String(),
+				Params:    types.NewParams(1, 0, 100),
+			},
+			false,
+		},
+		{
+			"if the params are invalid - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				Params:    types.NewParams(1, 1, 100),
+			},
+			false,
+		},
+		{
+			"if the authority is correct and the params are valid - pass",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				Params:    types.NewParams(1, 0, 100),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.UpdateParams(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			params, err := s.oracleKeeper.GetParams(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Params, params)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSetMarketQuorums() {
+	ms := keeper.NewMsgServer(&s.oracleKeeper)
+
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgSetMarketQuorums
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the authority of the module - fail",
+			&types.MsgSetMarketQuorums{
+				Authority:     sdk.AccAddress("not-authority").String(),
+				MarketQuorums: []types.MarketQuorum{types.NewMarketQuorum(cp, 5_000, 1)},
+			},
+			false,
+		},
+		{
+			"if a quorum is invalid - fail",
+			&types.MsgSetMarketQuorums{
+				Authority:     sdk.AccAddress(moduleAuth).String(),
+				MarketQuorums: []types.MarketQuorum{types.NewMarketQuorum(cp, 0, 1)},
+			},
+			false,
+		},
+		{
+			"if a currency pair does not exist - fail",
+			&types.MsgSetMarketQuorums{
+				Authority:     sdk.AccAddress(moduleAuth).String(),
+				MarketQuorums: []types.MarketQuorum{types.NewMarketQuorum(connecttypes.NewCurrencyPair("CC", "DD"), 5_000, 1)},
+			},
+			false,
+		},
+		{
+			"if the authority is correct and the quorums are valid - pass",
+			&types.MsgSetMarketQuorums{
+				Authority:     sdk.AccAddress(moduleAuth).String(),
+				MarketQuorums: []types.MarketQuorum{types.NewMarketQuorum(cp, 5_000, 1)},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.SetMarketQuorums(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			for _, expected := range tc.req.MarketQuorums {
+				quorum, ok := s.oracleKeeper.GetMarketQuorum(s.ctx, expected.CurrencyPair)
+				s.Require().True(ok)
+				s.Require().Equal(expected, quorum)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveMarketQuorums() {
+	ms := keeper.NewMsgServer(&s.oracleKeeper)
+
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.Require().NoError(s.oracleKeeper.SetMarketQuorum(s.ctx, types.NewMarketQuorum(cp, 5_000, 1)))
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgRemoveMarketQuorums
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the authority of the module - fail",
+			&types.MsgRemoveMarketQuorums{
+				Authority:       sdk.AccAddress("not-authority").String(),
+				CurrencyPairIds: []string{cp.String()},
+			},
+			false,
+		},
+		{
+			"if a currency pair id is invalid - fail",
+			&types.MsgRemoveMarketQuorums{
+				Authority:       sdk.AccAddress(moduleAuth).String(),
+				CurrencyPairIds: []string{"AABB"},
+			},
+			false,
+		},
+		{
+			"if the authority is correct - pass, skipping currency pairs without a quorum",
+			&types.MsgRemoveMarketQuorums{
+				Authority:       sdk.AccAddress(moduleAuth).String(),
+				CurrencyPairIds: []string{cp.String(), "CC/DD"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.RemoveMarketQuorums(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			_, ok := s.oracleKeeper.GetMarketQuorum(s.ctx, cp)
+			s.Require().False(ok)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSetCircuitBreakers() {
+	ms := keeper.NewMsgServer(&s.oracleKeeper)
+
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	cb := types.NewCircuitBreaker(cp, 1_000, 2_000, 10, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP)
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgSetCircuitBreakers
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the authority of the module - fail",
+			&types.MsgSetCircuitBreakers{
+				Authority:       sdk.AccAddress("not-authority").String(),
+				CircuitBreakers: []types.CircuitBreaker{cb},
+			},
+			false,
+		},
+		{
+			"if a circuit breaker is invalid - fail",
+			&types.MsgSetCircuitBreakers{
+				Authority:       sdk.AccAddress(moduleAuth).String(),
+				CircuitBreakers: []types.CircuitBreaker{types.NewCircuitBreaker(cp, 0, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT)},
+			},
+			false,
+		},
+		{
+			"if a currency pair does not exist - fail",
+			&types.MsgSetCircuitBreakers{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				CircuitBreakers: []types.CircuitBreaker{
+					types.NewCircuitBreaker(connecttypes.NewCurrencyPair("CC", "DD"), 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT),
+				},
+			},
+			false,
+		},
+		{
+			"if the authority is correct and the circuit breakers are valid - pass",
+			&types.MsgSetCircuitBreakers{
+				Authority:       sdk.AccAddress(moduleAuth).String(),
+				CircuitBreakers: []types.CircuitBreaker{cb},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.SetCircuitBreakers(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			for _, expected := range tc.req.CircuitBreakers {
+				got, ok := s.oracleKeeper.GetCircuitBreaker(s.ctx, expected.CurrencyPair)
+				s.Require().True(ok)
+				s.Require().Equal(expected, got)
+			}
+		})
+	}
+
+	s.Run("circuit breakers can be removed by the authority of the module", func() {
+		_, err := ms.RemoveCircuitBreakers(s.ctx, &types.MsgRemoveCircuitBreakers{
+			Authority:       sdk.AccAddress("not-authority").String(),
+			CurrencyPairIds: []string{cp.String()},
+		})
+		s.Require().Error(err)
+
+		_, err = ms.RemoveCircuitBreakers(s.ctx, &types.MsgRemoveCircuitBreakers{
+			Authority:       sdk.AccAddress(moduleAuth).String(),
+			CurrencyPairIds: []string{cp.String(), "CC/DD"},
+		})
+		s.Require().NoError(err)
+
+		_, ok := s.oracleKeeper.GetCircuitBreaker(s.ctx, cp)
+		s.Require().False(ok)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgClearMarketHalts() {
+	ms := keeper.NewMsgServer(&s.oracleKeeper)
+
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	marketAuthority := sdk.AccAddress("market-authority").String()
+
+	// halt the market by breaching its circuit breaker
+	haltMarket := func() {
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, types.NewCircuitBreaker(
+			cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT,
+		)))
+		s.writePrice(1, cp, 100)
+		s.writePrice(2, cp, 200)
+		s.Require().True(s.oracleKeeper.IsMarketHalted(s.ctx, cp))
+	}
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgClearMarketHalts
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the signer is neither the authority nor a market authority - fail",
+			&types.MsgClearMarketHalts{
+				Authority:       sdk.AccAddress("not-authority").String(),
+				CurrencyPairIds: []string{cp.String()},
+			},
+			false,
+		},
+		{
+			"if a currency pair id is invalid - fail",
+			&types.MsgClearMarketHalts{
+				Authority:       sdk.AccAddress(moduleAuth).String(),
+				CurrencyPairIds: []string{"AABB"},
+			},
+			false,
+		},
+		{
+			"if the signer is the authority of the module - pass",
+			&types.MsgClearMarketHalts{
+				Authority:       sdk.AccAddress(moduleAuth).String(),
+				CurrencyPairIds: []string{cp.String()},
+			},
+			true,
+		},
+		{
+			"if the signer is a market authority - pass, skipping markets that are not halted",
+			&types.MsgClearMarketHalts{
+				Authority:       marketAuthority,
+				CurrencyPairIds: []string{cp.String(), "CC/DD"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			haltMarket()
+			s.mockMarketMapKeeper.On("GetParams", s.ctx).Return(mmtypes.Params{
+				MarketAuthorities: []string{marketAuthority},
+			}, nil).Maybe()
+
+			_, err := ms.ClearMarketHalts(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				s.Require().True(s.oracleKeeper.IsMarketHalted(s.ctx, cp))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().False(s.oracleKeeper.IsMarketHalted(s.ctx, cp))
+		})
+	}
+}
@@ -0,0 +1,467 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// RecordParticipation records the participation of a validator in the price updates of the given CurrencyPairs,
+// keyed by x/oracle ID, for the current block, which should be every CurrencyPair in state (as returned by
+// GetCurrencyPairMapping). params are the module params; both are read once per block by the caller. absent indicates
+// that the validator's vote was not included in the commit, validatorPrices are the prices reported by the
+// validator, and finalPrices are the aggregated prices for the block. Each validator's participation is tracked over
+// the last ParticipationWindowBlocks blocks in which it was recorded, and this is a no-op if participation tracking is
+// disabled.
+func (k *Keeper) RecordParticipation(
+	ctx context.Context,
+	params types.Params,
+	cps map[uint64]connecttypes.CurrencyPair,
+	validator sdk.ConsAddress,
+	absent bool,
+	validatorPrices, finalPrices map[connecttypes.CurrencyPair]*big.Int,
+) error {
+	window := params.ParticipationWindowBlocks
+	if window == 0 {
+		return nil
+	}
+
+	info, err := k.participationInfo.Get(ctx, validator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	ids := make([]uint64, 0, len(cps))
+	for id := range cps {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	record := types.ParticipationRecord{
+		CurrencyPairIds: make([]uint64, 0, len(ids)),
+		Statuses:        make([]byte, 0, len(ids)),
+	}
+	for _, id := range ids {
+		cp := cps[id]
+		record.Set(id, types.ClassifyParticipation(absent, validatorPrices[cp], finalPrices[cp], params.ParticipationDeviationBps))
+	}
+
+	if err := k.recordParticipationSlot(ctx, validator, info.BlocksTracked%window, record); err != nil {
+		return err
+	}
+
+	info.BlocksTracked++
+	info.LastRecordedHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.participationInfo.Set(ctx, validator, info)
+}
+
+// recordParticipationSlot overwrites the given slot of a validator's participation window with a ParticipationRecord,
+// and updates the validator's participation counts accordingly.
+func (k *Keeper) recordParticipationSlot(
+	ctx context.Context,
+	validator sdk.ConsAddress,
+	slot uint64,
+	record types.ParticipationRecord,
+) error {
+	slotKey := collections.Join([]byte(validator), slot)
+	prev, err := k.participationSlots.Get(ctx, slotKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	counts, err := k.participationCounts.Get(ctx, validator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	counts.AddRecord(prev, -1)
+	counts.AddRecord(record, 1)
+	if err := k.participationCounts.Set(ctx, validator, counts); err != nil {
+		return err
+	}
+
+	return k.participationSlots.Set(ctx, slotKey, record)
+}
+
+// GetValidatorParticipation returns the number of blocks in a validator's participation window, along with its
+// participation in each CurrencyPair over that window, ordered by CurrencyPair.
+func (k *Keeper) GetValidatorParticipation(
+	ctx context.Context,
+	validator sdk.ConsAddress,
+) (uint64, []types.MarketParticipation, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	info, err := k.participationInfo.Get(ctx, validator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, nil, err
+	}
+
+	counts, err := k.participationCounts.Get(ctx, validator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, nil, err
+	}
+
+	participation := make([]types.MarketParticipation, 0, len(counts.Markets))
+	for _, m := range counts.Markets {
+		cp, found := k.GetCurrencyPairFromID(ctx, m.CurrencyPairId)
+		if !found {
+			continue
+		}
+
+		participation = append(participation, types.MarketParticipation{
+			Validator:    validator.String(),
+			CurrencyPair: cp,
+			Counts:       m.Counts,
+		})
+	}
+
+	sort.Slice(participation, func(i, j int) bool {
+		return participation[i].CurrencyPair.String() < participation[j].CurrencyPair.String()
+	})
+
+	return min(info.BlocksTracked, params.ParticipationWindowBlocks), participation, nil
+}
+
+// GetMarketParticipation returns the participation of each validator in a CurrencyPair over their participation
+// windows, ordered by consensus address.
+func (k *Keeper) GetMarketParticipation(ctx context.Context, cp connecttypes.CurrencyPair) ([]types.MarketParticipation, error) {
+	participation := make([]types.MarketParticipation, 0)
+
+	id, found := k.GetIDForCurrencyPair(ctx, cp)
+	if !found {
+		return participation, nil
+	}
+
+	it, err := k.participationCounts.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		counts, ok := kv.Value.Get(id)
+		if !ok {
+			continue
+		}
+
+		participation = append(participation, types.MarketParticipation{
+			Validator:    sdk.ConsAddress(kv.Key).String(),
+			CurrencyPair: cp,
+			Counts:       counts,
+		})
+	}
+
+	return participation, nil
+}
+
+// GetMissRates returns the miss rates, over their full participation windows, of the validators whose participation
+// was recorded at the given height, ordered by consensus address. Validators that have not yet been tracked for a full
+// window are omitted.
+func (k *Keeper) GetMissRates(ctx context.Context, height int64) ([]types.ValidatorMissRate, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.participationInfo.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	missRates := make([]types.ValidatorMissRate, 0)
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		if kv.Value.LastRecordedHeight != height || kv.Value.BlocksTracked < params.ParticipationWindowBlocks {
+			continue
+		}
+
+		counts, err := k.participationCounts.Get(ctx, kv.Key)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		missRates = append(missRates, types.NewValidatorMissRate(kv.Key, counts.Total()))
+	}
+
+	return missRates, nil
+}
+
+// endParticipationWindow calls the ParticipationHooks with the miss rate of each validator if the current block ends a
+// participation window, and prunes the participation of validators that have left the active set. This is a no-op if
+// participation tracking is disabled.
+func (k *Keeper) endParticipationWindow(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	window := params.ParticipationWindowBlocks
+	if window == 0 || sdkCtx.BlockHeight() <= 0 || uint64(sdkCtx.BlockHeight())%window != 0 {
+		return nil
+	}
+
+	missRates, err := k.GetMissRates(ctx, sdkCtx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	if err := k.ParticipationHooks().AfterParticipationWindow(sdkCtx, missRates); err != nil {
+		return err
+	}
+
+	return k.pruneParticipation(ctx, sdkCtx.BlockHeight()-int64(window)) //nolint:gosec
+}
+
+// pruneParticipation removes the participation of every validator whose participation was last recorded at or before
+// the given height. Participation is recorded for every validator in the decided commit, i.e. every bonded validator,
+// so a validator that has not been recorded for a full window is no longer bonded.
+func (k *Keeper) pruneParticipation(ctx context.Context, height int64) error {
+	it, err := k.participationInfo.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := it.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if kv.Value.LastRecordedHeight > height {
+			continue
+		}
+
+		if err := k.removeValidatorParticipation(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeValidatorParticipation removes all tracked participation of a validator from state.
+func (k *Keeper) removeValidatorParticipation(ctx context.Context, validator sdk.ConsAddress) error {
+	if err := k.participationSlots.Clear(ctx, collections.NewPrefixedPairRange[[]byte, uint64](validator)); err != nil {
+		return err
+	}
+	if err := k.participationCounts.Remove(ctx, validator); err != nil {
+		return err
+	}
+
+	return k.participationInfo.Remove(ctx, validator)
+}
+
+// ParticipationHooks returns the ParticipationHooks registered with the keeper.
+func (k *Keeper) ParticipationHooks() types.ParticipationHooks {
+	if k.participationHooks == nil {
+		// return a no-op implementation if no hooks are set
+		return &types.NoopParticipationHooks{}
+	}
+
+	return k.participationHooks
+}
+
+// SetParticipationHooks sets the ParticipationHooks registered with the keeper.
+func (k *Keeper) SetParticipationHooks(hooks types.ParticipationHooks) {
+	k.participationHooks = hooks
+}
+
+// GetAllParticipation returns the participation tracking state of every validator, ordered by consensus address, with
+// the participation windows of each validator ordered by CurrencyPair.
+func (k *Keeper) GetAllParticipation(ctx context.Context) ([]types.ValidatorParticipationGenesis, error) {
+	cps, err := k.GetCurrencyPairMapping(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.participationInfo.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	participation := make([]types.ValidatorParticipationGenesis, 0)
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		windows, err := k.getParticipationWindows(ctx, kv.Key, cps)
+		if err != nil {
+			return nil, err
+		}
+
+		participation = append(participation, types.ValidatorParticipationGenesis{
+			Validator: sdk.ConsAddress(kv.Key).String(),
+			Info:      kv.Value,
+			Windows:   windows,
+		})
+	}
+
+	return participation, nil
+}
+
+// getParticipationWindows returns the participation windows of a validator for each of the given CurrencyPairs,
+// keyed by x/oracle ID, with the statuses of each window indexed by slot. The windows are ordered by CurrencyPair.
+func (k *Keeper) getParticipationWindows(
+	ctx context.Context,
+	validator sdk.ConsAddress,
+	cps map[uint64]connecttypes.CurrencyPair,
+) ([]types.MarketParticipationWindow, error) {
+	it, err := k.participationSlots.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, uint64](validator))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	windows := make(map[uint64]*types.MarketParticipationWindow)
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		slot := kv.Key.K2()
+		for i := 0; i < kv.Value.Len(); i++ {
+			id, status := kv.Value.Status(i)
+			cp, ok := cps[id]
+			if !ok {
+				continue
+			}
+
+			w, ok := windows[id]
+			if !ok {
+				w = &types.MarketParticipationWindow{CurrencyPair: cp}
+				windows[id] = w
+			}
+
+			for uint64(len(w.Statuses)) < slot {
+				w.Statuses = append(w.Statuses, types.ParticipationStatus_PARTICIPATION_STATUS_UNSPECIFIED)
+			}
+			w.Statuses = append(w.Statuses, status)
+		}
+	}
+
+	ordered := make([]types.MarketParticipationWindow, 0, len(windows))
+	for _, w := range windows {
+		ordered = append(ordered, *w)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].CurrencyPair.String() < ordered[j].CurrencyPair.String()
+	})
+
+	return ordered, nil
+}
+
+// setParticipation sets the participation tracking state of a validator, deriving its ParticipationRecords and
+// participation counts from its participation windows.
+func (k *Keeper) setParticipation(ctx context.Context, p types.ValidatorParticipationGenesis) error {
+	validator, err := sdk.ConsAddressFromBech32(p.Validator)
+	if err != nil {
+		return err
+	}
+
+	type entry struct {
+		id     uint64
+		status types.ParticipationStatus
+	}
+
+	slots := make(map[uint64][]entry)
+	for _, w := range p.Windows {
+		id, found := k.GetIDForCurrencyPair(ctx, w.CurrencyPair)
+		if !found {
+			return types.NewCurrencyPairNotExistError(w.CurrencyPair)
+		}
+
+		for slot, status := range w.Statuses {
+			if status == types.ParticipationStatus_PARTICIPATION_STATUS_UNSPECIFIED {
+				continue
+			}
+
+			slots[uint64(slot)] = append(slots[uint64(slot)], entry{id: id, status: status})
+		}
+	}
+
+	ordered := make([]uint64, 0, len(slots))
+	for slot := range slots {
+		ordered = append(ordered, slot)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i] < ordered[j] })
+
+	for _, slot := range ordered {
+		entries := slots[slot]
+		sort.Slice(entries, func(i, j int) bool { return entries[i].id < entries[j].id })
+
+		var record types.ParticipationRecord
+		for _, e := range entries {
+			record.Set(e.id, e.status)
+		}
+
+		if err := k.recordParticipationSlot(ctx, validator, slot, record); err != nil {
+			return err
+		}
+	}
+
+	return k.participationInfo.Set(ctx, validator, p.Info)
+}
+
+// ResetParticipation removes all tracked validator participation from state.
+func (k *Keeper) ResetParticipation(ctx context.Context) error {
+	if err := k.participationSlots.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.participationCounts.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	return k.participationInfo.Clear(ctx, nil)
+}
+
+// removeParticipation removes the participation counts of every validator for the CurrencyPair with the given
+// x/oracle ID. The ParticipationRecords that include the CurrencyPair are left to be evicted from the windows, and
+// are ignored from then on as x/oracle IDs are never reused.
+func (k *Keeper) removeParticipation(ctx context.Context, id uint64) error {
+	it, err := k.participationCounts.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := it.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if !kv.Value.Remove(id) {
+			continue
+		}
+
+		if err := k.participationCounts.Set(ctx, kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
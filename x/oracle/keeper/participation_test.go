@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// setParticipationParams sets the participation window and deviation in the module params.
+func (s *KeeperTestSuite) setParticipationParams(window uint64, deviationBps uint32) {
+	params := types.DefaultParams()
+	params.ParticipationWindowBlocks = window
+	params.ParticipationDeviationBps = deviationBps
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+}
+
+// recordParticipation records the participation of a validator in the price updates of every currency pair in state,
+// reading the module params and currency pairs as the PreBlocker does.
+func (s *KeeperTestSuite) recordParticipation(
+	ctx sdk.Context,
+	validator sdk.ConsAddress,
+	absent bool,
+	validatorPrices, finalPrices map[connecttypes.CurrencyPair]*big.Int,
+) error {
+	params, err := s.oracleKeeper.GetParams(ctx)
+	s.Require().NoError(err)
+
+	cps, err := s.oracleKeeper.GetCurrencyPairMapping(ctx)
+	s.Require().NoError(err)
+
+	return s.oracleKeeper.RecordParticipation(ctx, params, cps, validator, absent, validatorPrices, finalPrices)
+}
+
+func (s *KeeperTestSuite) TestRecordParticipation() {
+	btc := connecttypes.NewCurrencyPair("BTC", "USD")
+	eth := connecttypes.NewCurrencyPair("ETH", "USD")
+	val := sdk.ConsAddress("val1")
+	final := map[connecttypes.CurrencyPair]*big.Int{btc: big.NewInt(100), eth: big.NewInt(10)}
+
+	setup := func(window uint64, deviationBps uint32) {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btc))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, eth))
+		s.setParticipationParams(window, deviationBps)
+	}
+
+	s.Run("participation is not recorded when tracking is disabled", func() {
+		setup(0, 0)
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, final, final))
+
+		blocks, participation, err := s.oracleKeeper.GetValidatorParticipation(s.ctx, val)
+		s.Require().NoError(err)
+		s.Require().Zero(blocks)
+		s.Require().Empty(participation)
+	})
+
+	s.Run("each status is counted per currency pair", func() {
+		setup(10, 100)
+
+		// reports a price for both pairs
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, final, final))
+		// absent from the commit
+		s.Require().NoError(s.recordParticipation(s.ctx, val, true, nil, final))
+		// reports a deviated price for BTC, and no price for ETH
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, map[connecttypes.CurrencyPair]*big.Int{btc: big.NewInt(102)}, final))
+
+		blocks, participation, err := s.oracleKeeper.GetValidatorParticipation(s.ctx, val)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), blocks)
+		s.Require().Equal([]types.MarketParticipation{
+			{
+				Validator:    val.String(),
+				CurrencyPair: btc,
+				Counts:       types.ParticipationCounts{Reported: 1, Absent: 1, Deviated: 1},
+			},
+			{
+				Validator:    val.String(),
+				CurrencyPair: eth,
+				Counts:       types.ParticipationCounts{Reported: 1, Absent: 1, MissingPrice: 1},
+			},
+		}, participation)
+	})
+
+	s.Run("the oldest block is evicted once the window is full", func() {
+		setup(2, 0)
+
+		s.Require().NoError(s.recordParticipation(s.ctx, val, true, nil, final))
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, final, final))
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, final, final))
+
+		participation, err := s.oracleKeeper.GetMarketParticipation(s.ctx, btc)
+		s.Require().NoError(err)
+		s.Require().Len(participation, 1)
+		s.Require().Equal(types.ParticipationCounts{Reported: 2}, participation[0].Counts)
+
+		blocks, _, err := s.oracleKeeper.GetValidatorParticipation(s.ctx, val)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), blocks)
+	})
+
+	s.Run("removing a currency pair removes its participation", func() {
+		setup(10, 0)
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, final, final))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, btc))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btc))
+
+		participation, err := s.oracleKeeper.GetMarketParticipation(s.ctx, btc)
+		s.Require().NoError(err)
+		s.Require().Empty(participation)
+
+		participation, err = s.oracleKeeper.GetMarketParticipation(s.ctx, eth)
+		s.Require().NoError(err)
+		s.Require().Len(participation, 1)
+	})
+
+	s.Run("changing the window through governance resets participation", func() {
+		setup(10, 0)
+		s.Require().NoError(s.recordParticipation(s.ctx, val, false, final, final))
+
+		params := types.DefaultParams()
+		params.ParticipationWindowBlocks = 20
+		_, err := keeper.NewMsgServer(&s.oracleKeeper).UpdateParams(s.ctx, &types.MsgUpdateParams{
+			Authority: sdk.AccAddress(moduleAuth).String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+
+		blocks, participation, err := s.oracleKeeper.GetValidatorParticipation(s.ctx, val)
+		s.Require().NoError(err)
+		s.Require().Zero(blocks)
+		s.Require().Empty(participation)
+	})
+}
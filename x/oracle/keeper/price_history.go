@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// recordPriceHistory adds a price, and the PriceAccumulator after it was written, to the price history of a CurrencyPair,
+// keyed by the height at which it was written. This is a no-op if the price history is disabled. Prices beyond
+// PriceHistoryLength are pruned in the BeginBlocker.
+func (k *Keeper) recordPriceHistory(
+	ctx context.Context,
+	cp connecttypes.CurrencyPair,
+	qp types.QuotePrice,
+	acc types.PriceAccumulator,
+) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PriceHistoryLength == 0 {
+		return nil
+	}
+
+	key := collections.Join(cp.String(), qp.BlockHeight)
+	has, err := k.priceHistory.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if err := k.priceHistory.Set(ctx, key, qp); err != nil {
+		return err
+	}
+	if err := k.priceAccumulatorHistory.Set(ctx, key, acc); err != nil {
+		return err
+	}
+
+	if has {
+		return nil
+	}
+
+	count, err := k.priceHistoryCounts.Get(ctx, cp.String())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return k.priceHistoryCounts.Set(ctx, cp.String(), count+1)
+}
+
+// GetPriceHistory returns the historical prices of a CurrencyPair written between startHeight and endHeight (both
+// inclusive), ordered by height. An endHeight of zero leaves the range unbounded.
+func (k *Keeper) GetPriceHistory(ctx context.Context, cp connecttypes.CurrencyPair, startHeight, endHeight uint64) ([]types.QuotePrice, error) {
+	rng := collections.NewPrefixedPairRange[string, uint64](cp.String()).StartInclusive(startHeight)
+	if endHeight != 0 {
+		rng = rng.EndInclusive(endHeight)
+	}
+
+	it, err := k.priceHistory.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	prices := make([]types.QuotePrice, 0)
+	for ; it.Valid(); it.Next() {
+		qp, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		prices = append(prices, qp)
+	}
+
+	return prices, nil
+}
+
+// GetAllPriceHistory returns the price history of every CurrencyPair, ordered by CurrencyPair and height.
+func (k *Keeper) GetAllPriceHistory(ctx context.Context) ([]types.PriceHistoryEntry, error) {
+	it, err := k.priceHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	entries := make([]types.PriceHistoryEntry, 0)
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		cp, err := connecttypes.CurrencyPairFromString(kv.Key.K1())
+		if err != nil {
+			return nil, err
+		}
+
+		acc, err := k.priceAccumulatorHistory.Get(ctx, kv.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, types.PriceHistoryEntry{
+			CurrencyPair: cp,
+			Price:        kv.Value,
+			Accumulator:  acc,
+		})
+	}
+
+	return entries, nil
+}
+
+// setPriceHistoryEntry adds a price and its PriceAccumulator from genesis to the price history of its CurrencyPair,
+// keyed by the height at which it was written.
+func (k *Keeper) setPriceHistoryEntry(ctx context.Context, e types.PriceHistoryEntry) error {
+	key := collections.Join(e.CurrencyPair.String(), e.Price.BlockHeight)
+	if err := k.priceHistory.Set(ctx, key, e.Price); err != nil {
+		return err
+	}
+	if err := k.priceAccumulatorHistory.Set(ctx, key, e.Accumulator); err != nil {
+		return err
+	}
+
+	count, err := k.priceHistoryCounts.Get(ctx, e.CurrencyPair.String())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return k.priceHistoryCounts.Set(ctx, e.CurrencyPair.String(), count+1)
+}
+
+// prunePriceHistory removes the oldest historical prices of every CurrencyPair whose price history exceeds
+// PriceHistoryLength. If the price history is disabled, all historical prices are removed.
+func (k *Keeper) prunePriceHistory(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	it, err := k.priceHistoryCounts.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	counts, err := it.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range counts {
+		if kv.Value <= params.PriceHistoryLength {
+			continue
+		}
+
+		if err := k.pruneOldestPrices(ctx, kv.Key, kv.Value-params.PriceHistoryLength); err != nil {
+			return err
+		}
+
+		if params.PriceHistoryLength == 0 {
+			err = k.priceHistoryCounts.Remove(ctx, kv.Key)
+		} else {
+			err = k.priceHistoryCounts.Set(ctx, kv.Key, params.PriceHistoryLength)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneOldestPrices removes the n oldest historical prices of the CurrencyPair with the given string representation.
+func (k *Keeper) pruneOldestPrices(ctx context.Context, cp string, n uint64) error {
+	it, err := k.priceHistory.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](cp))
+	if err != nil {
+		return err
+	}
+
+	// collect the keys before removing them, so that the iterator is not invalidated
+	keys := make([]collections.Pair[string, uint64], 0, n)
+	for ; it.Valid() && uint64(len(keys)) < n; it.Next() {
+		key, err := it.Key()
+		if err != nil {
+			it.Close()
+			return err
+		}
+
+		keys = append(keys, key)
+	}
+	it.Close()
+
+	for _, key := range keys {
+		if err := k.priceHistory.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.priceAccumulatorHistory.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removePriceHistory removes the price history of a CurrencyPair.
+func (k *Keeper) removePriceHistory(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	if err := k.priceHistory.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String())); err != nil {
+		return err
+	}
+	if err := k.priceAccumulatorHistory.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String())); err != nil {
+		return err
+	}
+
+	return k.priceHistoryCounts.Remove(ctx, cp.String())
+}
@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) setPriceHistoryLength(length uint64) {
+	params := types.DefaultParams()
+	params.PriceHistoryLength = length
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+}
+
+// writeHistoricalPrices writes a price for the currency pair at each height, with a block time of height seconds.
+func (s *KeeperTestSuite) writeHistoricalPrices(cp connecttypes.CurrencyPair, heights ...uint64) []types.QuotePrice {
+	prices := make([]types.QuotePrice, 0, len(heights))
+	for _, height := range heights {
+		qp := types.QuotePrice{
+			Price:          sdkmath.NewIntFromUint64(height * 10),
+			BlockTimestamp: time.Unix(int64(height), 0).UTC(),
+			BlockHeight:    height,
+		}
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, qp))
+		prices = append(prices, qp)
+	}
+
+	return prices
+}
+
+func (s *KeeperTestSuite) TestPriceHistory() {
+	btc := connecttypes.NewCurrencyPair("BTC", "USD")
+	eth := connecttypes.NewCurrencyPair("ETH", "USD")
+
+	setup := func(length uint64) {
+		s.SetupTest()
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btc))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, eth))
+		s.setPriceHistoryLength(length)
+	}
+
+	s.Run("no history is recorded when the price history is disabled", func() {
+		setup(0)
+		s.writeHistoricalPrices(btc, 1, 2)
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Empty(prices)
+	})
+
+	s.Run("prices are recorded by height and filtered by range", func() {
+		setup(10)
+		written := s.writeHistoricalPrices(btc, 1, 2, 3, 4)
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Equal(written, prices)
+
+		prices, err = s.oracleKeeper.GetPriceHistory(s.ctx, btc, 2, 3)
+		s.Require().NoError(err)
+		s.Require().Equal(written[1:3], prices)
+
+		prices, err = s.oracleKeeper.GetPriceHistory(s.ctx, eth, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Empty(prices)
+	})
+
+	s.Run("rewriting a price at the same height replaces it", func() {
+		setup(2)
+		s.writeHistoricalPrices(btc, 1, 2)
+		written := s.writeHistoricalPrices(btc, 2)
+
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 2)
+		s.Require().Equal(written[0], prices[1])
+	})
+
+	s.Run("the oldest prices are pruned in the begin blocker", func() {
+		setup(2)
+		written := s.writeHistoricalPrices(btc, 1, 2, 3, 4)
+		ethWritten := s.writeHistoricalPrices(eth, 1)
+
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Equal(written[2:], prices)
+
+		prices, err = s.oracleKeeper.GetPriceHistory(s.ctx, eth, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Equal(ethWritten, prices)
+
+		// the pruned count is retained, so later prices continue to evict the oldest
+		written = append(written, s.writeHistoricalPrices(btc, 5)...)
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+
+		prices, err = s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Equal(written[3:], prices)
+	})
+
+	s.Run("disabling the price history prunes all prices", func() {
+		setup(10)
+		s.writeHistoricalPrices(btc, 1, 2)
+		s.setPriceHistoryLength(0)
+
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Empty(prices)
+	})
+
+	s.Run("removing a currency pair removes its price history", func() {
+		setup(10)
+		s.writeHistoricalPrices(btc, 1, 2)
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, btc))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btc))
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, btc, 0, 0)
+		s.Require().NoError(err)
+		s.Require().Empty(prices)
+	})
+}
+
+func (s *KeeperTestSuite) TestPriceHistoryGRPC() {
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.setPriceHistoryLength(10)
+	written := s.writeHistoricalPrices(cp, 1, 2, 3, 4, 5)
+
+	timestamp := func(seconds int64) *time.Time {
+		t := time.Unix(seconds, 0).UTC()
+		return &t
+	}
+
+	tcs := []struct {
+		name     string
+		req      *types.GetPriceHistoryRequest
+		expected []types.QuotePrice
+		expErr   bool
+	}{
+		{
+			"nil request - fail",
+			nil,
+			nil,
+			true,
+		},
+		{
+			"invalid currency pair - fail",
+			&types.GetPriceHistoryRequest{CurrencyPair: "invalid"},
+			nil,
+			true,
+		},
+		{
+			"unknown currency pair - fail",
+			&types.GetPriceHistoryRequest{CurrencyPair: "CC/DD"},
+			nil,
+			true,
+		},
+		{
+			"start height after end height - fail",
+			&types.GetPriceHistoryRequest{CurrencyPair: cp.String(), StartHeight: 3, EndHeight: 2},
+			nil,
+			true,
+		},
+		{
+			"start time after end time - fail",
+			&types.GetPriceHistoryRequest{CurrencyPair: cp.String(), StartTime: timestamp(3), EndTime: timestamp(2)},
+			nil,
+			true,
+		},
+		{
+			"unbounded - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: cp.String()},
+			written,
+			false,
+		},
+		{
+			"height range - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: cp.String(), StartHeight: 2, EndHeight: 4},
+			written[1:4],
+			false,
+		},
+		{
+			"time range - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: cp.String(), StartTime: timestamp(4), EndTime: timestamp(10)},
+			written[3:],
+			false,
+		},
+		{
+			"height and time range - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: cp.String(), StartHeight: 2, EndTime: timestamp(3)},
+			written[1:3],
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			res, err := qs.GetPriceHistory(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res.Prices)
+		})
+	}
+
+	s.Run("prices are paginated", func() {
+		req := &types.GetPriceHistoryRequest{
+			CurrencyPair: cp.String(),
+			StartHeight:  2,
+			Pagination:   &query.PageRequest{Limit: 2},
+		}
+
+		res, err := qs.GetPriceHistory(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().Equal(written[1:3], res.Prices)
+		s.Require().NotEmpty(res.Pagination.NextKey)
+
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+		res, err = qs.GetPriceHistory(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().Equal(written[3:], res.Prices)
+		s.Require().Empty(res.Pagination.NextKey)
+	})
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// updatePriceAccumulator advances the PriceAccumulator of a CurrencyPair to the given price, creating it if this is
+// the first price written for the CurrencyPair, and returns the updated PriceAccumulator.
+func (k *Keeper) updatePriceAccumulator(
+	ctx context.Context,
+	cp connecttypes.CurrencyPair,
+	qp types.QuotePrice,
+) (types.PriceAccumulator, error) {
+	acc, err := k.priceAccumulators.Get(ctx, cp.String())
+	switch {
+	case err == nil:
+		acc = acc.Advance(qp)
+	case errors.Is(err, collections.ErrNotFound):
+		acc = types.NewPriceAccumulator(qp)
+	default:
+		return types.PriceAccumulator{}, err
+	}
+
+	return acc, k.priceAccumulators.Set(ctx, cp.String(), acc)
+}
+
+// GetPriceAccumulator returns the latest PriceAccumulator of a CurrencyPair.
+func (k *Keeper) GetPriceAccumulator(ctx context.Context, cp connecttypes.CurrencyPair) (types.PriceAccumulator, error) {
+	acc, err := k.priceAccumulators.Get(ctx, cp.String())
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PriceAccumulator{}, types.NewQuotePriceNotExistError(cp)
+	}
+
+	return acc, err
+}
+
+// GetAllPriceAccumulators returns the latest PriceAccumulator of every CurrencyPair, ordered by CurrencyPair.
+func (k *Keeper) GetAllPriceAccumulators(ctx context.Context) ([]types.PriceAccumulatorGenesis, error) {
+	it, err := k.priceAccumulators.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	accs := make([]types.PriceAccumulatorGenesis, 0)
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		cp, err := connecttypes.CurrencyPairFromString(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		accs = append(accs, types.PriceAccumulatorGenesis{
+			CurrencyPair: cp,
+			Accumulator:  kv.Value,
+		})
+	}
+
+	return accs, nil
+}
+
+// GetTWAP returns the time weighted average price of a CurrencyPair between start and end, rounded down. The result
+// depends only on state, so it is deterministic and may be used by other modules. The interval must not end after the
+// current block time, and must not start before the oldest price retained in the CurrencyPair's price history, unless
+// the latest price was written at or before start.
+func (k *Keeper) GetTWAP(ctx context.Context, cp connecttypes.CurrencyPair, start, end time.Time) (math.Int, error) {
+	if !end.After(start) {
+		return math.Int{}, fmt.Errorf("twap end time %s must be after start time %s", end, start)
+	}
+
+	if blockTime := sdk.UnwrapSDKContext(ctx).BlockTime(); end.After(blockTime) {
+		return math.Int{}, fmt.Errorf("twap end time %s is after the current block time %s", end, blockTime)
+	}
+
+	startAcc, err := k.priceAccumulatorAt(ctx, cp, start)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	endAcc, err := k.priceAccumulatorAt(ctx, cp, end)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return types.ComputeTWAP(startAcc, start, endAcc, end)
+}
+
+// priceAccumulatorAt returns the PriceAccumulator of a CurrencyPair that was current at time t, i.e. the latest
+// PriceAccumulator written at or before t.
+func (k *Keeper) priceAccumulatorAt(ctx context.Context, cp connecttypes.CurrencyPair, t time.Time) (types.PriceAccumulator, error) {
+	latest, err := k.GetPriceAccumulator(ctx, cp)
+	if err != nil {
+		return types.PriceAccumulator{}, err
+	}
+
+	if !latest.BlockTimestamp.After(t) {
+		return latest, nil
+	}
+
+	it, err := k.priceAccumulatorHistory.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String()).Descending())
+	if err != nil {
+		return types.PriceAccumulator{}, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		acc, err := it.Value()
+		if err != nil {
+			return types.PriceAccumulator{}, err
+		}
+
+		if !acc.BlockTimestamp.After(t) {
+			return acc, nil
+		}
+	}
+
+	return types.PriceAccumulator{}, fmt.Errorf("insufficient price history for %s to compute a twap from %s", cp, t)
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestGetTWAP() {
+	cp := connecttypes.NewCurrencyPair("BTC", "USD")
+	at := func(seconds int64) time.Time {
+		return time.Unix(seconds, 0).UTC()
+	}
+
+	// prices of height * 10 are written at a block time of height seconds
+	setup := func(length uint64, heights ...uint64) {
+		s.SetupTest()
+		s.ctx = s.ctx.WithBlockTime(at(100))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		s.setPriceHistoryLength(length)
+		s.writeHistoricalPrices(cp, heights...)
+	}
+
+	s.Run("no twap is available before the first price", func() {
+		setup(10)
+
+		_, err := s.oracleKeeper.GetTWAP(s.ctx, cp, at(1), at(2))
+		s.Require().Error(err)
+	})
+
+	s.Run("twaps are computed over the price history", func() {
+		setup(10, 1, 2, 4)
+
+		// 10 for 1s, 20 for 2s, 40 for 1s
+		twap, err := s.oracleKeeper.GetTWAP(s.ctx, cp, at(1), at(5))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(22), twap)
+
+		// 20 for 1s
+		twap, err = s.oracleKeeper.GetTWAP(s.ctx, cp, at(2), at(3))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(20), twap)
+
+		// the latest price is carried forward to the end of the interval
+		twap, err = s.oracleKeeper.GetTWAP(s.ctx, cp, at(4), at(100))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(40), twap)
+	})
+
+	s.Run("twaps cannot start before the retained price history", func() {
+		setup(2, 1, 2, 4)
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+
+		_, err := s.oracleKeeper.GetTWAP(s.ctx, cp, at(1), at(5))
+		s.Require().Error(err)
+
+		// 20 for 2s, 40 for 1s
+		twap, err := s.oracleKeeper.GetTWAP(s.ctx, cp, at(2), at(5))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(26), twap)
+	})
+
+	s.Run("twaps since the latest price are available without price history", func() {
+		setup(0, 1, 2, 4)
+
+		_, err := s.oracleKeeper.GetTWAP(s.ctx, cp, at(2), at(5))
+		s.Require().Error(err)
+
+		twap, err := s.oracleKeeper.GetTWAP(s.ctx, cp, at(4), at(5))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(40), twap)
+	})
+
+	s.Run("invalid intervals are rejected", func() {
+		setup(10, 1, 2)
+
+		_, err := s.oracleKeeper.GetTWAP(s.ctx, cp, at(2), at(2))
+		s.Require().Error(err)
+
+		_, err = s.oracleKeeper.GetTWAP(s.ctx, cp, at(2), at(101))
+		s.Require().Error(err)
+	})
+
+	s.Run("removing the currency pair removes its accumulator", func() {
+		setup(10, 1, 2)
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+
+		_, err := s.oracleKeeper.GetPriceAccumulator(s.ctx, cp)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestTWAPGRPC() {
+	qs := keeper.NewQueryServer(&s.oracleKeeper)
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	start, end := time.Unix(1, 0).UTC(), time.Unix(2, 0).UTC()
+
+	s.ctx = s.ctx.WithBlockTime(time.Unix(3, 0).UTC())
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.setPriceHistoryLength(10)
+	s.writeHistoricalPrices(cp, 1, 2)
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8},
+	}, nil).Maybe()
+
+	tcs := []struct {
+		name     string
+		req      *types.GetTWAPRequest
+		expected sdkmath.Int
+		expErr   bool
+	}{
+		{
+			"nil request - fail",
+			nil,
+			sdkmath.Int{},
+			true,
+		},
+		{
+			"invalid currency pair - fail",
+			&types.GetTWAPRequest{CurrencyPair: "invalid", StartTime: start},
+			sdkmath.Int{},
+			true,
+		},
+		{
+			"unknown currency pair - fail",
+			&types.GetTWAPRequest{CurrencyPair: "CC/DD", StartTime: start},
+			sdkmath.Int{},
+			true,
+		},
+		{
+			"end time defaults to the block time - pass",
+			&types.GetTWAPRequest{CurrencyPair: cp.String(), StartTime: start},
+			sdkmath.NewInt(15),
+			false,
+		},
+		{
+			"empty interval - fail",
+			&types.GetTWAPRequest{CurrencyPair: cp.String(), StartTime: start, EndTime: &start},
+			sdkmath.Int{},
+			true,
+		},
+		{
+			"explicit end time - pass",
+			&types.GetTWAPRequest{CurrencyPair: cp.String(), StartTime: start, EndTime: &end},
+			sdkmath.NewInt(10),
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			res, err := qs.GetTWAP(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res.Price)
+			s.Require().Equal(uint64(8), res.Decimals)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package oracle
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -12,21 +14,24 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	oraclemodulev1 "github.com/skip-mev/connect/v2/api/connect/oracle/module/v2"
 	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/client/cli"
 	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/simulation"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // ConsensusVersion is the x/oracle module's current version, as modules integrate and updates are made, this value determines what
 // version of the module is being run by the chain.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.HasName        = AppModule{}
@@ -34,6 +39,9 @@ var (
 	_ module.AppModuleBasic = AppModule{}
 	_ module.HasServices    = AppModule{}
 
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
+
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
 	_ appmodule.HasEndBlocker   = AppModule{}
@@ -80,7 +88,7 @@ func (amb AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	k keeper.Keeper
+	k *keeper.Keeper
 }
 
 // BeginBlock calls the x/oracle keeper's BeginBlocker function.
@@ -94,7 +102,7 @@ func (am AppModule) EndBlock(_ context.Context) error {
 }
 
 // NewAppModule returns an application module for the x/oracle module.
-func NewAppModule(cdc codec.Codec, k keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, k *keeper.Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{
 			cdc: cdc,
@@ -118,6 +126,12 @@ func (am AppModule) RegisterServices(cfc module.Configurator) {
 	types.RegisterMsgServer(cfc.MsgServer(), keeper.NewMsgServer(am.k))
 	// register Query Service
 	types.RegisterQueryServer(cfc.QueryServer(), keeper.NewQueryServer(am.k))
+
+	// register in-place store migrations
+	m := keeper.NewMigrator(am.k)
+	if err := cfc.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the oracle
@@ -141,8 +155,10 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 
 // RegisterInvariants registers the invariants of the oracle module. If an invariant
 // deviates from its predicted value, the InvariantRegistry triggers appropriate
-// logic (most often the chain will be halted). No invariants exist for the oracle module.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// logic (most often the chain will be halted).
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.k)
+}
 
 // InitGenesis performs the genesis initialization for the x/oracle module. It determines the
 // genesis state to initialize from via a json-encoded genesis-state. This method returns no validator set updates.
@@ -163,10 +179,33 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// GenerateGenesisState creates a randomized GenState of the x/oracle module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.k)
+}
+
+// RegisterStoreDecoder registers a decoder for the x/oracle module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.k.Schema())
+}
+
+// WeightedOperations returns no operations, as all x/oracle messages are executed by governance, and prices are
+// written by the ABCI handlers.
+func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
+	return nil
+}
+
 func init() {
 	appmodule.Register(
 		&oraclemodulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetParticipationHooks),
+		appmodule.Invoke(InvokeSetPriceUpdateHooks),
 	)
 }
 
@@ -204,7 +243,7 @@ func ProvideModule(in Inputs) Outputs {
 		authority,
 	)
 
-	m := NewAppModule(in.Cdc, oracleKeeper)
+	m := NewAppModule(in.Cdc, &oracleKeeper)
 
 	return Outputs{
 		OracleKeeper: &oracleKeeper,
@@ -212,3 +251,49 @@ func ProvideModule(in Inputs) Outputs {
 		Hooks:        marketmaptypes.MarketMapHooksWrapper{MarketMapHooks: oracleKeeper.Hooks()},
 	}
 }
+
+// InvokeSetParticipationHooks sets the participation hooks provided by other modules on the x/oracle keeper. Hooks
+// are applied in alphabetical order of the names of the modules that provide them.
+func InvokeSetParticipationHooks(
+	keeper *keeper.Keeper,
+	hooks map[string]types.ParticipationHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || len(hooks) == 0 {
+		return nil
+	}
+
+	modNames := maps.Keys(hooks)
+	sort.Strings(modNames)
+
+	var multiHooks types.MultiParticipationHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, hooks[modName])
+	}
+
+	keeper.SetParticipationHooks(multiHooks)
+	return nil
+}
+
+// InvokeSetPriceUpdateHooks sets the price update hooks provided by other modules on the x/oracle keeper. Hooks
+// are applied in alphabetical order of the names of the modules that provide them.
+func InvokeSetPriceUpdateHooks(
+	keeper *keeper.Keeper,
+	hooks map[string]types.PriceUpdateHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || len(hooks) == 0 {
+		return nil
+	}
+
+	modNames := maps.Keys(hooks)
+	sort.Strings(modNames)
+
+	var multiHooks types.MultiPriceUpdateHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, hooks[modName])
+	}
+
+	keeper.SetPriceUpdateHooks(multiHooks)
+	return nil
+}
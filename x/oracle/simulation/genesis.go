@@ -0,0 +1,98 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// Simulation parameter constants.
+const (
+	CurrencyPairs = "currency_pairs"
+	Params        = "params"
+
+	// maxCurrencyPairs is the maximum number of currency pairs in the simulated genesis.
+	maxCurrencyPairs = 10
+
+	// maxPriceHistoryLength is the maximum number of historical prices retained per currency pair in simulations.
+	maxPriceHistoryLength = 100
+
+	// maxParticipationWindowBlocks is the maximum participation window in simulations.
+	maxParticipationWindowBlocks = 100
+
+	// maxVoteExtensionBytes is the maximum vote extension size limit in simulations.
+	maxVoteExtensionBytes = 4096
+)
+
+// quoteAssets are the quote assets of simulated currency pairs.
+var quoteAssets = []string{"USD", "USDT", "ETH"}
+
+// RandomizedGenState generates a random GenesisState for the x/oracle module, with random params and currency pairs
+// that have not yet been priced. The vote extension version is not randomized, as it must match the version that
+// validators extend their votes with.
+func RandomizedGenState(simState *module.SimulationState) {
+	var cps []connecttypes.CurrencyPair
+	simState.AppParams.GetOrGenerate(CurrencyPairs, &cps, simState.Rand, func(r *rand.Rand) {
+		cps = RandomCurrencyPairs(r, r.Intn(maxCurrencyPairs+1))
+	})
+
+	var params types.Params
+	simState.AppParams.GetOrGenerate(Params, &params, simState.Rand, func(r *rand.Rand) {
+		params = RandomParams(r)
+	})
+
+	cpgs := make([]types.CurrencyPairGenesis, 0, len(cps))
+	for id, cp := range cps {
+		cpgs = append(cpgs, types.CurrencyPairGenesis{
+			CurrencyPair: cp,
+			Id:           uint64(id),
+		})
+	}
+
+	gs := types.NewGenesisState(cpgs, uint64(len(cpgs)))
+	gs.Params = params
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
+}
+
+// RandomParams returns random, valid x/oracle params. The vote extension versions are left at their defaults.
+func RandomParams(r *rand.Rand) types.Params {
+	params := types.DefaultParams()
+	params.MaxPriceAgeMs = uint32(r.Intn(2) * simtypes.RandIntBetween(r, 1_000, 60_000))
+	params.AggregationMethod = types.AggregationMethod(r.Intn(len(types.AggregationMethod_name)))
+	params.PowerThresholdBps = uint32(r.Intn(int(types.MaxBps) + 1))
+	params.MinValidators = uint32(r.Intn(4))
+	params.TrimBps = uint32(r.Intn(int(types.MaxTrimBps)))
+	params.ParticipationWindowBlocks = uint64(r.Intn(maxParticipationWindowBlocks + 1))
+	params.ParticipationDeviationBps = uint32(r.Intn(int(types.MaxBps) + 1))
+	params.PriceUpdateEvents = types.PriceUpdateEventMode(r.Intn(len(types.PriceUpdateEventMode_name)))
+	params.PriceHistoryLength = uint64(r.Intn(maxPriceHistoryLength + 1))
+	params.MaxVoteExtensionBytes = uint32(r.Intn(maxVoteExtensionBytes + 1))
+
+	return params
+}
+
+// RandomCurrencyPairs returns n distinct random currency pairs, each with a three to five letter base asset.
+func RandomCurrencyPairs(r *rand.Rand, n int) []connecttypes.CurrencyPair {
+	cps := make([]connecttypes.CurrencyPair, 0, n)
+	seen := make(map[string]struct{}, n)
+	for len(cps) < n {
+		base := make([]byte, simtypes.RandIntBetween(r, 3, 6))
+		for i := range base {
+			base[i] = byte('A' + r.Intn(26))
+		}
+
+		cp := connecttypes.NewCurrencyPair(string(base), quoteAssets[r.Intn(len(quoteAssets))])
+		if _, ok := seen[cp.String()]; ok {
+			continue
+		}
+		seen[cp.String()] = struct{}{}
+		cps = append(cps, cp)
+	}
+
+	return cps
+}
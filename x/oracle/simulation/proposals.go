@@ -0,0 +1,97 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgUpdateParams        = "op_weight_msg_update_params"
+	OpWeightMsgAddCurrencyPairs    = "op_weight_msg_add_currency_pairs"
+	OpWeightMsgRemoveCurrencyPairs = "op_weight_msg_remove_currency_pairs"
+
+	DefaultWeightMsgUpdateParams        int = 100
+	DefaultWeightMsgAddCurrencyPairs    int = 50
+	DefaultWeightMsgRemoveCurrencyPairs int = 25
+
+	// maxCurrencyPairsPerMsg is the maximum number of currency pairs added or removed by a single message.
+	maxCurrencyPairsPerMsg = 3
+)
+
+// ProposalMsgs defines the module weighted proposals' contents. Currency pairs are only added and removed by
+// governance if the x/oracle module is used without x/marketmap, otherwise the proposals fail, and currency pairs are
+// added by the market map hooks instead.
+func ProposalMsgs(k *keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgAddCurrencyPairs,
+			DefaultWeightMsgAddCurrencyPairs,
+			SimulateMsgAddCurrencyPairs,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgRemoveCurrencyPairs,
+			DefaultWeightMsgRemoveCurrencyPairs,
+			SimulateMsgRemoveCurrencyPairs(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams.
+func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    RandomParams(r),
+	}
+}
+
+// SimulateMsgAddCurrencyPairs returns a MsgAddCurrencyPairs for random currency pairs.
+func SimulateMsgAddCurrencyPairs(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgAddCurrencyPairs{
+		Authority:     authority.String(),
+		CurrencyPairs: RandomCurrencyPairs(r, simtypes.RandIntBetween(r, 1, maxCurrencyPairsPerMsg+1)),
+	}
+}
+
+// SimulateMsgRemoveCurrencyPairs returns a function that generates a MsgRemoveCurrencyPairs for random currency pairs
+// in state. Random currency pairs are removed if there are none in state, which is a no-op.
+func SimulateMsgRemoveCurrencyPairs(k *keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		cps := k.GetAllCurrencyPairs(ctx)
+		if len(cps) == 0 {
+			cps = RandomCurrencyPairs(r, 1)
+		}
+
+		n := simtypes.RandIntBetween(r, 1, min(maxCurrencyPairsPerMsg, len(cps))+1)
+		ids := make([]string, 0, n)
+		for _, i := range r.Perm(len(cps))[:n] {
+			ids = append(ids, cps[i].String())
+		}
+
+		return &types.MsgRemoveCurrencyPairs{
+			Authority:       authority.String(),
+			CurrencyPairIds: ids,
+		}
+	}
+}
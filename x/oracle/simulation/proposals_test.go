@@ -0,0 +1,24 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/oracle/simulation"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestSimulateProposalMsgs(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 50; i++ {
+		msg := simulation.SimulateMsgUpdateParams(r, sdk.Context{}, nil).(*types.MsgUpdateParams)
+		require.NoError(t, msg.Params.ValidateBasic())
+
+		addMsg := simulation.SimulateMsgAddCurrencyPairs(r, sdk.Context{}, nil).(*types.MsgAddCurrencyPairs)
+		require.NoError(t, addMsg.ValidateBasic())
+	}
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// MaxCircuitBreakerWindowBlocks is the maximum number of blocks in the rolling window of a CircuitBreaker. This
+// bounds the number of PriceSamples stored per currency pair.
+const MaxCircuitBreakerWindowBlocks uint64 = 1_000
+
+// NewCircuitBreaker returns a new CircuitBreaker for the given currency pair.
+func NewCircuitBreaker(
+	cp connecttypes.CurrencyPair,
+	maxBlockMoveBps, maxWindowMoveBps uint32,
+	windowBlocks uint64,
+	action CircuitBreakerAction,
+) CircuitBreaker {
+	return CircuitBreaker{
+		CurrencyPair:     cp,
+		MaxBlockMoveBps:  maxBlockMoveBps,
+		MaxWindowMoveBps: maxWindowMoveBps,
+		WindowBlocks:     windowBlocks,
+		Action:           action,
+	}
+}
+
+// ValidateBasic performs stateless validation of the CircuitBreaker. This fails if the CurrencyPair is invalid, if
+// the action is unknown, if neither check is enabled, or if the window is not set consistently with MaxWindowMoveBps.
+func (cb *CircuitBreaker) ValidateBasic() error {
+	if err := cb.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, ok := CircuitBreakerAction_name[int32(cb.Action)]; !ok {
+		return fmt.Errorf("unknown circuit breaker action %d for %s", cb.Action, cb.CurrencyPair)
+	}
+
+	if cb.MaxBlockMoveBps == 0 && cb.MaxWindowMoveBps == 0 {
+		return fmt.Errorf("circuit breaker for %s must bound the block move, the window move, or both", cb.CurrencyPair)
+	}
+
+	if cb.MaxWindowMoveBps == 0 {
+		if cb.WindowBlocks != 0 {
+			return fmt.Errorf("window blocks set for %s without a max window move", cb.CurrencyPair)
+		}
+
+		return nil
+	}
+
+	if cb.WindowBlocks == 0 || cb.WindowBlocks > MaxCircuitBreakerWindowBlocks {
+		return fmt.Errorf(
+			"window blocks for %s must be between 1 and %d, got %d",
+			cb.CurrencyPair, MaxCircuitBreakerWindowBlocks, cb.WindowBlocks,
+		)
+	}
+
+	return nil
+}
+
+// ValidateCircuitBreakers validates each of the given CircuitBreakers, and checks that no currency pair is repeated.
+func ValidateCircuitBreakers(cbs []CircuitBreaker) error {
+	seen := make(map[string]struct{}, len(cbs))
+	for _, cb := range cbs {
+		if err := cb.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[cb.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated circuit breaker for currency-pair: %s", cb.CurrencyPair)
+		}
+
+		seen[cb.CurrencyPair.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+// PriceBand returns the lowest and highest prices that are within the given number of basis points of the
+// reference price. The lower bound is never negative.
+func PriceBand(ref math.Int, bps uint32) (lower, upper math.Int) {
+	maxBps := math.NewIntFromUint64(uint64(MaxBps))
+	move := ref.Abs().Mul(math.NewIntFromUint64(uint64(bps))).Quo(maxBps)
+
+	lower = ref.Sub(move)
+	if lower.IsNegative() {
+		lower = math.ZeroInt()
+	}
+
+	return lower, ref.Add(move)
+}
+
+// ValidateBasic checks that the PriceSamples of the CircuitBreakerState are non-negative and strictly ordered by
+// height.
+func (s *CircuitBreakerState) ValidateBasic() error {
+	for i, sample := range s.Samples {
+		if sample.Price.IsNil() || sample.Price.IsNegative() {
+			return fmt.Errorf("price sample at height %d must be non-negative", sample.BlockHeight)
+		}
+
+		if i > 0 && sample.BlockHeight <= s.Samples[i-1].BlockHeight {
+			return fmt.Errorf("price samples must be ordered by height, got %d after %d", sample.BlockHeight, s.Samples[i-1].BlockHeight)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation of the MarketHalt.
+func (h *MarketHalt) ValidateBasic() error {
+	if err := h.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if h.LowerBound.IsNil() || h.UpperBound.IsNil() || h.Price.IsNil() {
+		return fmt.Errorf("market halt for %s must set the price and bounds", h.CurrencyPair)
+	}
+
+	if h.LowerBound.GT(h.UpperBound) {
+		return fmt.Errorf("market halt for %s has a lower bound above its upper bound", h.CurrencyPair)
+	}
+
+	return nil
+}
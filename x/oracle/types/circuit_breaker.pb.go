@@ -0,0 +1,1326 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/circuit_breaker.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/skip-mev/connect/v2/pkg/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreakerAction defines what is done with a price update that moves
+// further than a CircuitBreaker allows.
+type CircuitBreakerAction int32
+
+const (
+	// CIRCUIT_BREAKER_ACTION_REJECT drops the price update and halts price
+	// updates for the currency pair until the halt is cleared. This is the
+	// default.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT CircuitBreakerAction = 0
+	// CIRCUIT_BREAKER_ACTION_CLAMP writes the price update clamped to the
+	// furthest move allowed by the CircuitBreaker.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP CircuitBreakerAction = 1
+)
+
+var CircuitBreakerAction_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_ACTION_REJECT",
+	1: "CIRCUIT_BREAKER_ACTION_CLAMP",
+}
+
+var CircuitBreakerAction_value = map[string]int32{
+	"CIRCUIT_BREAKER_ACTION_REJECT": 0,
+	"CIRCUIT_BREAKER_ACTION_CLAMP":  1,
+}
+
+func (x CircuitBreakerAction) String() string {
+	return proto.EnumName(CircuitBreakerAction_name, int32(x))
+}
+
+func (CircuitBreakerAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_42380608921be3db, []int{0}
+}
+
+// CircuitBreaker bounds how far the on-chain price of a currency pair may move
+// in a single block, and over a rolling window of blocks.
+type CircuitBreaker struct {
+	// CurrencyPair is the currency pair that this circuit breaker applies to.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// MaxBlockMoveBps is the maximum move, in basis points, from the latest
+	// on-chain price allowed in a single price update. A value of zero disables
+	// the check.
+	MaxBlockMoveBps uint32 `protobuf:"varint,2,opt,name=max_block_move_bps,json=maxBlockMoveBps,proto3" json:"max_block_move_bps,omitempty"`
+	// MaxWindowMoveBps is the maximum move, in basis points, from the oldest
+	// price written within the last WindowBlocks blocks. A value of zero
+	// disables the check.
+	MaxWindowMoveBps uint32 `protobuf:"varint,3,opt,name=max_window_move_bps,json=maxWindowMoveBps,proto3" json:"max_window_move_bps,omitempty"`
+	// WindowBlocks is the number of blocks in the rolling window used by
+	// MaxWindowMoveBps.
+	WindowBlocks uint64 `protobuf:"varint,4,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// Action is what is done with a price update that breaches the circuit
+	// breaker.
+	Action CircuitBreakerAction `protobuf:"varint,5,opt,name=action,proto3,enum=connect.oracle.v2.CircuitBreakerAction" json:"action,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42380608921be3db, []int{0}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CircuitBreaker) GetMaxBlockMoveBps() uint32 {
+	if m != nil {
+		return m.MaxBlockMoveBps
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetMaxWindowMoveBps() uint32 {
+	if m != nil {
+		return m.MaxWindowMoveBps
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetAction() CircuitBreakerAction {
+	if m != nil {
+		return m.Action
+	}
+	return CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT
+}
+
+// PriceSample is a price written to state for a currency pair at a given
+// height.
+type PriceSample struct {
+	// BlockHeight is the height at which the price was written.
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Price is the price that was written.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+}
+
+func (m *PriceSample) Reset()         { *m = PriceSample{} }
+func (m *PriceSample) String() string { return proto.CompactTextString(m) }
+func (*PriceSample) ProtoMessage()    {}
+func (*PriceSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42380608921be3db, []int{1}
+}
+func (m *PriceSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSample.Merge(m, src)
+}
+func (m *PriceSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSample proto.InternalMessageInfo
+
+func (m *PriceSample) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// CircuitBreakerState is the state tracked by the x/oracle module for each
+// currency pair with a CircuitBreaker, as exported in genesis. In state, each
+// PriceSample is stored under the currency pair and its height.
+type CircuitBreakerState struct {
+	// Samples are the prices written within the rolling window, ordered by
+	// height.
+	Samples []PriceSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples"`
+	// BypassNext is set when a halt is cleared, so that the next price update
+	// re-anchors the circuit breaker instead of being checked against the price
+	// from before the halt.
+	BypassNext bool `protobuf:"varint,2,opt,name=bypass_next,json=bypassNext,proto3" json:"bypass_next,omitempty"`
+}
+
+func (m *CircuitBreakerState) Reset()         { *m = CircuitBreakerState{} }
+func (m *CircuitBreakerState) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerState) ProtoMessage()    {}
+func (*CircuitBreakerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42380608921be3db, []int{2}
+}
+func (m *CircuitBreakerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerState.Merge(m, src)
+}
+func (m *CircuitBreakerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerState proto.InternalMessageInfo
+
+func (m *CircuitBreakerState) GetSamples() []PriceSample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+func (m *CircuitBreakerState) GetBypassNext() bool {
+	if m != nil {
+		return m.BypassNext
+	}
+	return false
+}
+
+// MarketHalt records that price updates for a currency pair are halted after
+// a price update breached its CircuitBreaker.
+type MarketHalt struct {
+	// CurrencyPair is the currency pair whose price updates are halted.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// BlockHeight is the height at which the circuit breaker was tripped.
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Price is the rejected price update.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// LowerBound is the lowest price the circuit breaker allowed.
+	LowerBound cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=lower_bound,json=lowerBound,proto3,customtype=cosmossdk.io/math.Int" json:"lower_bound"`
+	// UpperBound is the highest price the circuit breaker allowed.
+	UpperBound cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=upper_bound,json=upperBound,proto3,customtype=cosmossdk.io/math.Int" json:"upper_bound"`
+}
+
+func (m *MarketHalt) Reset()         { *m = MarketHalt{} }
+func (m *MarketHalt) String() string { return proto.CompactTextString(m) }
+func (*MarketHalt) ProtoMessage()    {}
+func (*MarketHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42380608921be3db, []int{3}
+}
+func (m *MarketHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHalt.Merge(m, src)
+}
+func (m *MarketHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketHalt proto.InternalMessageInfo
+
+func (m *MarketHalt) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *MarketHalt) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("connect.oracle.v2.CircuitBreakerAction", CircuitBreakerAction_name, CircuitBreakerAction_value)
+	proto.RegisterType((*CircuitBreaker)(nil), "connect.oracle.v2.CircuitBreaker")
+	proto.RegisterType((*PriceSample)(nil), "connect.oracle.v2.PriceSample")
+	proto.RegisterType((*CircuitBreakerState)(nil), "connect.oracle.v2.CircuitBreakerState")
+	proto.RegisterType((*MarketHalt)(nil), "connect.oracle.v2.MarketHalt")
+}
+
+func init() {
+	proto.RegisterFile("connect/oracle/v2/circuit_breaker.proto", fileDescriptor_42380608921be3db)
+}
+
+var fileDescriptor_42380608921be3db = []byte{
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x18, 0x6d, 0xba, 0x6e, 0x80, 0xbb, 0x8d, 0xe1, 0x0d, 0x29, 0x4c, 0x90, 0x65, 0x05, 0x69, 0x15,
+	0x53, 0x13, 0x29, 0xdc, 0x83, 0x9a, 0xa8, 0x62, 0x81, 0x6d, 0x4c, 0x59, 0x11, 0x12, 0x5c, 0x44,
+	0x8e, 0x6b, 0xb5, 0x51, 0x9b, 0x38, 0x8a, 0xdd, 0x34, 0x7d, 0x0b, 0x5e, 0x80, 0xb7, 0xe0, 0x21,
+	0x76, 0x39, 0x71, 0x05, 0x5c, 0x4c, 0xa8, 0x7d, 0x11, 0x14, 0x27, 0x61, 0x2b, 0x7f, 0x12, 0x13,
+	0x77, 0xc9, 0xf7, 0x9d, 0x73, 0x7c, 0xbe, 0xf3, 0x59, 0x06, 0x7b, 0x98, 0x86, 0x21, 0xc1, 0x5c,
+	0xa7, 0x31, 0xc2, 0x23, 0xa2, 0x27, 0x86, 0x8e, 0xfd, 0x18, 0x8f, 0x7d, 0xee, 0x7a, 0x31, 0x41,
+	0x43, 0x12, 0x6b, 0x51, 0x4c, 0x39, 0x85, 0x77, 0x0a, 0xa0, 0x96, 0x03, 0xb5, 0xc4, 0xd8, 0xde,
+	0xea, 0xd3, 0x3e, 0x15, 0x5d, 0x3d, 0xfb, 0xca, 0x81, 0xdb, 0xf7, 0x30, 0x65, 0x01, 0x65, 0x6e,
+	0xde, 0xc8, 0x7f, 0x8a, 0xd6, 0xa3, 0xf2, 0x30, 0x3e, 0x8d, 0x08, 0x13, 0x67, 0x8d, 0xe3, 0x98,
+	0x84, 0x78, 0xea, 0x46, 0xc8, 0x2f, 0x4e, 0x6a, 0x7c, 0xa8, 0x82, 0x75, 0x2b, 0xf7, 0x60, 0xe6,
+	0x16, 0xa0, 0x0d, 0xd6, 0x16, 0x90, 0xb2, 0xa4, 0x4a, 0xcd, 0xba, 0xa1, 0x68, 0xa5, 0x29, 0x21,
+	0xa8, 0x25, 0x86, 0x66, 0x15, 0xb0, 0x13, 0xe4, 0xc7, 0x66, 0xed, 0xec, 0x62, 0xa7, 0xe2, 0xac,
+	0xe2, 0x2b, 0x35, 0xb8, 0x0f, 0x60, 0x80, 0x52, 0xd7, 0x1b, 0x51, 0x3c, 0x74, 0x03, 0x9a, 0x10,
+	0xd7, 0x8b, 0x98, 0x5c, 0x55, 0xa5, 0xe6, 0x9a, 0x73, 0x3b, 0x40, 0xa9, 0x99, 0x35, 0x8e, 0x68,
+	0x42, 0xcc, 0x88, 0xc1, 0x16, 0xd8, 0xcc, 0xc0, 0x13, 0x3f, 0xec, 0xd1, 0xc9, 0x25, 0x7a, 0x49,
+	0xa0, 0x37, 0x02, 0x94, 0xbe, 0x11, 0x9d, 0x12, 0xfe, 0x10, 0xac, 0x15, 0x50, 0x21, 0xcf, 0xe4,
+	0x9a, 0x2a, 0x35, 0x6b, 0xce, 0x6a, 0x5e, 0x14, 0xca, 0x0c, 0x3e, 0x03, 0x2b, 0x08, 0x73, 0x9f,
+	0x86, 0xf2, 0xb2, 0x2a, 0x35, 0xd7, 0x8d, 0x3d, 0xed, 0x97, 0x64, 0xb5, 0xc5, 0xf1, 0xdb, 0x02,
+	0xee, 0x14, 0xb4, 0x06, 0x03, 0xf5, 0x93, 0xd8, 0xc7, 0xe4, 0x14, 0x05, 0xd1, 0x88, 0xc0, 0x5d,
+	0xb0, 0x9a, 0x0f, 0x33, 0x20, 0x7e, 0x7f, 0xc0, 0x45, 0x34, 0x35, 0xa7, 0x2e, 0x6a, 0x07, 0xa2,
+	0x04, 0xdb, 0x60, 0x39, 0xca, 0x18, 0x62, 0xcc, 0x5b, 0xe6, 0x7e, 0x16, 0xcb, 0xd7, 0x8b, 0x9d,
+	0xbb, 0xf9, 0x72, 0x58, 0x6f, 0xa8, 0xf9, 0x54, 0x0f, 0x10, 0x1f, 0x68, 0x76, 0xc8, 0x3f, 0x7d,
+	0x6c, 0x81, 0x62, 0x6b, 0x76, 0xc8, 0x9d, 0x9c, 0xd9, 0x48, 0xc0, 0xe6, 0xa2, 0xa9, 0x53, 0x8e,
+	0x38, 0x81, 0x4f, 0xc1, 0x0d, 0x26, 0x6c, 0x30, 0x59, 0x52, 0x97, 0x16, 0x56, 0x72, 0x39, 0xcd,
+	0x15, 0xb7, 0xc5, 0x4a, 0x4a, 0x12, 0xdc, 0x01, 0x75, 0x6f, 0x1a, 0x21, 0xc6, 0xdc, 0x90, 0xa4,
+	0x5c, 0xf8, 0xbb, 0xe9, 0x80, 0xbc, 0x74, 0x4c, 0x52, 0xde, 0xf8, 0x52, 0x05, 0xe0, 0x08, 0xc5,
+	0x43, 0xc2, 0x0f, 0xd0, 0x88, 0xff, 0xcf, 0x8b, 0xf0, 0x73, 0x6e, 0xd5, 0xbf, 0xe4, 0xb6, 0x74,
+	0xdd, 0xdc, 0xe0, 0x21, 0xa8, 0x8f, 0xe8, 0x84, 0xc4, 0xae, 0x47, 0xc7, 0x61, 0x4f, 0xae, 0xfd,
+	0xbb, 0x10, 0x10, 0x7c, 0x33, 0xa3, 0x67, 0x6a, 0xe3, 0x28, 0xfa, 0xa1, 0xb6, 0x7c, 0x0d, 0x35,
+	0xc1, 0x17, 0x6a, 0x8f, 0xdf, 0x81, 0xad, 0xdf, 0x5d, 0x34, 0xb8, 0x0b, 0x1e, 0x58, 0xb6, 0x63,
+	0xbd, 0xb6, 0xbb, 0xae, 0xe9, 0x74, 0xda, 0x2f, 0x3b, 0x8e, 0xdb, 0xb6, 0xba, 0xf6, 0xab, 0x63,
+	0xd7, 0xe9, 0xbc, 0xe8, 0x58, 0xdd, 0x8d, 0x0a, 0x54, 0xc1, 0xfd, 0x3f, 0x40, 0xac, 0xc3, 0xf6,
+	0xd1, 0xc9, 0x86, 0x64, 0x3e, 0x3f, 0x9b, 0x29, 0xd2, 0xf9, 0x4c, 0x91, 0xbe, 0xcd, 0x14, 0xe9,
+	0xfd, 0x5c, 0xa9, 0x9c, 0xcf, 0x95, 0xca, 0xe7, 0xb9, 0x52, 0x79, 0xdb, 0xea, 0xfb, 0x7c, 0x30,
+	0xf6, 0x34, 0x4c, 0x03, 0x9d, 0x0d, 0xfd, 0xa8, 0x15, 0x90, 0x44, 0x2f, 0x5f, 0x86, 0xc4, 0xd0,
+	0xd3, 0xf2, 0x2d, 0x12, 0xbb, 0xf4, 0x56, 0xc4, 0xab, 0xf0, 0xe4, 0xfb, 0x00, 0x21, 0xad, 0xeb,
+	0x12, 0xaa, 0x04, 0x00, 0x00,
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxWindowMoveBps != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.MaxWindowMoveBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBlockMoveBps != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.MaxBlockMoveBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BypassNext {
+		i--
+		if m.BypassNext {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Samples) > 0 {
+		for iNdEx := len(m.Samples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Samples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UpperBound.Size()
+		i -= size
+		if _, err := m.UpperBound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LowerBound.Size()
+		i -= size
+		if _, err := m.LowerBound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	if m.MaxBlockMoveBps != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.MaxBlockMoveBps))
+	}
+	if m.MaxWindowMoveBps != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.MaxWindowMoveBps))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.WindowBlocks))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *PriceSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.BlockHeight))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	return n
+}
+
+func (m *CircuitBreakerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovCircuitBreaker(uint64(l))
+		}
+	}
+	if m.BypassNext {
+		n += 2
+	}
+	return n
+}
+
+func (m *MarketHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.BlockHeight))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	l = m.LowerBound.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	l = m.UpperBound.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockMoveBps", wireType)
+			}
+			m.MaxBlockMoveBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockMoveBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWindowMoveBps", wireType)
+			}
+			m.MaxWindowMoveBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWindowMoveBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= CircuitBreakerAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, PriceSample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassNext", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BypassNext = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerBound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperBound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
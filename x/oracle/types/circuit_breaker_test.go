@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestCircuitBreakerValidateBasic(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	reject := types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT
+
+	tcs := []struct {
+		name       string
+		cb         types.CircuitBreaker
+		expectPass bool
+	}{
+		{
+			"invalid currency pair - fail",
+			types.NewCircuitBreaker(connecttypes.CurrencyPair{Base: "AA"}, 1_000, 0, 0, reject),
+			false,
+		},
+		{
+			"unknown action - fail",
+			types.NewCircuitBreaker(cp, 1_000, 0, 0, types.CircuitBreakerAction(100)),
+			false,
+		},
+		{
+			"no checks enabled - fail",
+			types.NewCircuitBreaker(cp, 0, 0, 0, reject),
+			false,
+		},
+		{
+			"window blocks without a window move - fail",
+			types.NewCircuitBreaker(cp, 1_000, 0, 10, reject),
+			false,
+		},
+		{
+			"window move without window blocks - fail",
+			types.NewCircuitBreaker(cp, 0, 1_000, 0, reject),
+			false,
+		},
+		{
+			"window blocks above the maximum - fail",
+			types.NewCircuitBreaker(cp, 0, 1_000, types.MaxCircuitBreakerWindowBlocks+1, reject),
+			false,
+		},
+		{
+			"block move only - pass",
+			types.NewCircuitBreaker(cp, 1_000, 0, 0, reject),
+			true,
+		},
+		{
+			"block and window move - pass",
+			types.NewCircuitBreaker(cp, 1_000, 2_000, types.MaxCircuitBreakerWindowBlocks, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cb.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	t.Run("repeated currency pairs - fail", func(t *testing.T) {
+		cb := types.NewCircuitBreaker(cp, 1_000, 0, 0, reject)
+		require.Error(t, types.ValidateCircuitBreakers([]types.CircuitBreaker{cb, cb}))
+	})
+}
+
+func TestPriceBand(t *testing.T) {
+	lower, upper := types.PriceBand(math.NewInt(1_000), 1_000)
+	require.Equal(t, math.NewInt(900), lower)
+	require.Equal(t, math.NewInt(1_100), upper)
+
+	// the lower bound is never negative
+	lower, upper = types.PriceBand(math.NewInt(1_000), 2*types.MaxBps)
+	require.Equal(t, math.ZeroInt(), lower)
+	require.Equal(t, math.NewInt(3_000), upper)
+}
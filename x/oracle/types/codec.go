@@ -16,6 +16,31 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	// register the MsgRemoveCurrencyPairs for amino serialization
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveCurrencyPairs{}, "connect/x/oracle/MsgRemoveCurrencyPairs")
+
+	// register the MsgUpdateParams for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "connect/x/oracle/MsgUpdateParams")
+
+	// register the MsgSetMarketQuorums for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgSetMarketQuorums{}, "connect/x/oracle/MsgSetMarketQuorums")
+
+	// register the MsgRemoveMarketQuorums for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveMarketQuorums{}, "connect/x/oracle/MsgRemoveMarketQuorums")
+
+	// register the MsgSetCircuitBreakers for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgSetCircuitBreakers{}, "connect/x/oracle/MsgSetCircuitBreakers")
+
+	// register the MsgRemoveCircuitBreakers for amino serialization. amino names are limited to 39 characters,
+	// so the module path is omitted.
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveCircuitBreakers{}, "connect/MsgRemoveCircuitBreakers")
+
+	// register the MsgClearMarketHalts for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgClearMarketHalts{}, "connect/x/oracle/MsgClearMarketHalts")
+
+	// register the MsgSetPriceMaxAges for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgSetPriceMaxAges{}, "connect/x/oracle/MsgSetPriceMaxAges")
+
+	// register the MsgRemovePriceMaxAges for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgRemovePriceMaxAges{}, "connect/x/oracle/MsgRemovePriceMaxAges")
 }
 
 // RegisterInterfaces registers the x/oracle messages + message service w/ the InterfaceRegistry (registry).
@@ -24,6 +49,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddCurrencyPairs{},
 		&MsgRemoveCurrencyPairs{},
+		&MsgUpdateParams{},
+		&MsgSetMarketQuorums{},
+		&MsgRemoveMarketQuorums{},
+		&MsgSetCircuitBreakers{},
+		&MsgRemoveCircuitBreakers{},
+		&MsgClearMarketHalts{},
+		&MsgSetPriceMaxAges{},
+		&MsgRemovePriceMaxAges{},
 	)
 
 	// register the x/oracle message-service
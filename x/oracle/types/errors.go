@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 )
@@ -41,3 +42,37 @@ func NewCurrencyPairAlreadyExistsError(cp connecttypes.CurrencyPair) CurrencyPai
 func (e CurrencyPairAlreadyExistsError) Error() string {
 	return fmt.Sprintf("currency pair already exists: %s", e.cp)
 }
+
+// StalePriceError is returned when the price of a CurrencyPair is older than the max age enforced when reading it.
+type StalePriceError struct {
+	MaxAge    PriceMaxAge
+	AgeBlocks uint64
+	Age       time.Duration
+}
+
+func NewStalePriceError(maxAge PriceMaxAge, ageBlocks uint64, age time.Duration) StalePriceError {
+	return StalePriceError{
+		MaxAge:    maxAge,
+		AgeBlocks: ageBlocks,
+		Age:       age,
+	}
+}
+
+func (e StalePriceError) Error() string {
+	return fmt.Sprintf(
+		"price for CurrencyPair: %s is stale, updated %d blocks / %s ago, max age is %d blocks / %ds",
+		e.MaxAge.CurrencyPair, e.AgeBlocks, e.Age, e.MaxAge.MaxAgeBlocks, e.MaxAge.MaxAgeSeconds,
+	)
+}
+
+type PriceMaxAgeNotExistError struct {
+	cp string
+}
+
+func NewPriceMaxAgeNotExistError(cp connecttypes.CurrencyPair) PriceMaxAgeNotExistError {
+	return PriceMaxAgeNotExistError{cp.String()}
+}
+
+func (e PriceMaxAgeNotExistError) Error() string {
+	return fmt.Sprintf("no price max age set for CurrencyPair: %s", e.cp)
+}
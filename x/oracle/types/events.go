@@ -0,0 +1,15 @@
+package types
+
+// oracle module event types
+
+const (
+	EventTypeCircuitBreakerTripped = "circuit_breaker_tripped"
+	EventTypeMarketHaltCleared     = "market_halt_cleared"
+
+	AttributeKeyCurrencyPair = "currency_pair"
+	AttributeKeyPrice        = "price"
+	AttributeKeyAppliedPrice = "applied_price"
+	AttributeKeyLowerBound   = "lower_bound"
+	AttributeKeyUpperBound   = "upper_bound"
+	AttributeKeyAction       = "action"
+)
@@ -0,0 +1,748 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/events.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventPriceUpdate is emitted when the price of a currency pair is written to
+// state.
+type EventPriceUpdate struct {
+	// CurrencyPair is the stringified currency pair (base/quote).
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Id is the unique identifier of the currency pair.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Price is the price that was written.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// BlockHeight is the height at which the price was written.
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Nonce is the nonce of the currency pair after the update.
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// NumValidators is the number of validators that reported a price for the
+	// currency pair. A value of zero indicates that the count is unknown.
+	NumValidators uint32 `protobuf:"varint,6,opt,name=num_validators,json=numValidators,proto3" json:"num_validators,omitempty"`
+}
+
+func (m *EventPriceUpdate) Reset()         { *m = EventPriceUpdate{} }
+func (m *EventPriceUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventPriceUpdate) ProtoMessage()    {}
+func (*EventPriceUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad67d2ed2b325f28, []int{0}
+}
+func (m *EventPriceUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceUpdate.Merge(m, src)
+}
+func (m *EventPriceUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceUpdate proto.InternalMessageInfo
+
+func (m *EventPriceUpdate) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *EventPriceUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventPriceUpdate) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventPriceUpdate) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EventPriceUpdate) GetNumValidators() uint32 {
+	if m != nil {
+		return m.NumValidators
+	}
+	return 0
+}
+
+// EventPriceUpdates is emitted once per block with all of the price updates
+// written to state in the block.
+type EventPriceUpdates struct {
+	// BlockHeight is the height at which the prices were written.
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// PriceUpdates are the price updates written in the block.
+	PriceUpdates []EventPriceUpdate `protobuf:"bytes,2,rep,name=price_updates,json=priceUpdates,proto3" json:"price_updates"`
+}
+
+func (m *EventPriceUpdates) Reset()         { *m = EventPriceUpdates{} }
+func (m *EventPriceUpdates) String() string { return proto.CompactTextString(m) }
+func (*EventPriceUpdates) ProtoMessage()    {}
+func (*EventPriceUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad67d2ed2b325f28, []int{1}
+}
+func (m *EventPriceUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceUpdates.Merge(m, src)
+}
+func (m *EventPriceUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceUpdates proto.InternalMessageInfo
+
+func (m *EventPriceUpdates) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventPriceUpdates) GetPriceUpdates() []EventPriceUpdate {
+	if m != nil {
+		return m.PriceUpdates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventPriceUpdate)(nil), "connect.oracle.v2.EventPriceUpdate")
+	proto.RegisterType((*EventPriceUpdates)(nil), "connect.oracle.v2.EventPriceUpdates")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/events.proto", fileDescriptor_ad67d2ed2b325f28) }
+
+var fileDescriptor_ad67d2ed2b325f28 = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0xcd, 0xe4, 0xb5, 0x0f, 0x9c, 0xd7, 0x3c, 0x7c, 0xe1, 0x09, 0xb1, 0x8b, 0x34, 0xb6, 0x08,
+	0x01, 0xe9, 0x0c, 0xc4, 0x2f, 0xb0, 0x20, 0xda, 0x8d, 0x94, 0x80, 0x2e, 0xdc, 0x84, 0x74, 0x32,
+	0x24, 0x43, 0x9b, 0x99, 0x30, 0x33, 0x09, 0xf6, 0x07, 0x5c, 0xfb, 0x31, 0x7e, 0x44, 0x97, 0xc5,
+	0x95, 0xb8, 0x28, 0xd2, 0xee, 0xfc, 0x0a, 0x49, 0xa6, 0x15, 0x69, 0x77, 0x73, 0xcf, 0xb9, 0xf7,
+	0x9c, 0x3b, 0xf7, 0x5e, 0xe8, 0x13, 0xc1, 0x39, 0x25, 0x1a, 0x0b, 0x99, 0x92, 0x35, 0xc5, 0x4d,
+	0x84, 0x69, 0x43, 0xb9, 0x56, 0xa8, 0x92, 0x42, 0x0b, 0xf7, 0xe1, 0xc4, 0x23, 0xc3, 0xa3, 0x26,
+	0x1a, 0x3e, 0xe6, 0x22, 0x17, 0x1d, 0x8b, 0xdb, 0x97, 0x49, 0x1c, 0x3e, 0x27, 0x42, 0x95, 0x42,
+	0x25, 0x86, 0x30, 0x81, 0xa1, 0xc6, 0x7f, 0x00, 0x7c, 0xfa, 0xb6, 0x15, 0x5d, 0x48, 0x46, 0xe8,
+	0xc7, 0x2a, 0x4b, 0x35, 0x75, 0x27, 0xd0, 0x21, 0xb5, 0x94, 0x94, 0x93, 0x4d, 0x52, 0xa5, 0x4c,
+	0x7a, 0x20, 0x00, 0xe1, 0x93, 0x78, 0x70, 0x06, 0x17, 0x29, 0x93, 0xee, 0x3d, 0xb4, 0x59, 0xe6,
+	0xd9, 0x01, 0x08, 0x7b, 0xb1, 0xcd, 0x32, 0xf7, 0x0d, 0xec, 0x57, 0xad, 0x86, 0x77, 0xd3, 0x26,
+	0xcf, 0x5e, 0x6d, 0xf7, 0x23, 0xeb, 0xd7, 0x7e, 0xf4, 0xcc, 0xd8, 0xa9, 0x6c, 0x85, 0x98, 0xc0,
+	0x65, 0xaa, 0x0b, 0x34, 0xe7, 0xfa, 0xc7, 0xf7, 0x29, 0x3c, 0xf5, 0x31, 0xe7, 0x3a, 0x36, 0x95,
+	0xee, 0x0b, 0x38, 0x58, 0xae, 0x05, 0x59, 0x25, 0x05, 0x65, 0x79, 0xa1, 0xbd, 0x5e, 0x27, 0x7e,
+	0xd7, 0x61, 0xef, 0x3b, 0xc8, 0x7d, 0x84, 0x7d, 0x2e, 0x38, 0xa1, 0x5e, 0xbf, 0xe3, 0x4c, 0xe0,
+	0xbe, 0x84, 0xf7, 0xbc, 0x2e, 0x93, 0x26, 0x5d, 0xb3, 0x2c, 0xd5, 0x42, 0x2a, 0xef, 0x36, 0x00,
+	0xa1, 0x13, 0x3b, 0xbc, 0x2e, 0x3f, 0xfd, 0x03, 0xc7, 0x5f, 0x01, 0x7c, 0xb8, 0xfc, 0xac, 0xba,
+	0x72, 0x05, 0xd7, 0xae, 0x1f, 0xa0, 0xd3, 0x75, 0x98, 0xd4, 0xa6, 0xc6, 0xb3, 0x83, 0x9b, 0xf0,
+	0x2e, 0x9a, 0xa0, 0xab, 0x0d, 0xa0, 0x4b, 0xfd, 0x59, 0xaf, 0x1d, 0x44, 0x3c, 0xa8, 0xfe, 0xb3,
+	0x9c, 0xbd, 0xdb, 0x1e, 0x7c, 0xb0, 0x3b, 0xf8, 0xe0, 0xf7, 0xc1, 0x07, 0xdf, 0x8e, 0xbe, 0xb5,
+	0x3b, 0xfa, 0xd6, 0xcf, 0xa3, 0x6f, 0x7d, 0x9e, 0xe6, 0x4c, 0x17, 0xf5, 0x12, 0x11, 0x51, 0x62,
+	0xb5, 0x62, 0xd5, 0xb4, 0xa4, 0x0d, 0x3e, 0xdf, 0x41, 0x13, 0xe1, 0x2f, 0xe7, 0x63, 0xd0, 0x9b,
+	0x8a, 0xaa, 0xe5, 0x6d, 0xb7, 0xc5, 0xd7, 0x7f, 0x07, 0x00, 0xd9, 0x10, 0x8f, 0xfb, 0x2b, 0x02,
+	0x00, 0x00,
+}
+
+func (m *EventPriceUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumValidators != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumValidators))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPriceUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceUpdates) > 0 {
+		for iNdEx := len(m.PriceUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	if m.NumValidators != 0 {
+		n += 1 + sovEvents(uint64(m.NumValidators))
+	}
+	return n
+}
+
+func (m *EventPriceUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.PriceUpdates) > 0 {
+		for _, e := range m.PriceUpdates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidators", wireType)
+			}
+			m.NumValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPriceUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceUpdates = append(m.PriceUpdates, EventPriceUpdate{})
+			if err := m.PriceUpdates[len(m.PriceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
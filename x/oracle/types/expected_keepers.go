@@ -11,4 +11,6 @@ import (
 //go:generate mockery --name MarketMapKeeper --output ./mocks/ --case underscore
 type MarketMapKeeper interface {
 	GetMarket(ctx context.Context, tickerStr string) (types.Market, error)
+	GetAllMarkets(ctx context.Context) (map[string]types.Market, error)
+	GetParams(ctx context.Context) (types.Params, error)
 }
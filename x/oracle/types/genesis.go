@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ValidateBasic validates that the CurrencyPair is valid, and performs any necessary validation on the
@@ -24,11 +25,105 @@ func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 	return nil
 }
 
-// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses.
+// ValidateBasic validates that the CurrencyPair of the PriceHistoryEntry is valid, that its price is set and
+// non-negative, and that its PriceAccumulator is valid and was written with the price.
+func (e *PriceHistoryEntry) ValidateBasic() error {
+	if err := e.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if e.Price.Price.IsNil() {
+		return fmt.Errorf("price history entry for %s at height %d must set the price", e.CurrencyPair, e.Price.BlockHeight)
+	}
+
+	if err := e.Price.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := e.Accumulator.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid price history entry for %s at height %d: %w", e.CurrencyPair, e.Price.BlockHeight, err)
+	}
+
+	if e.Accumulator.BlockHeight != e.Price.BlockHeight {
+		return fmt.Errorf(
+			"price history entry for %s at height %d has a price accumulator for height %d",
+			e.CurrencyPair, e.Price.BlockHeight, e.Accumulator.BlockHeight,
+		)
+	}
+
+	return nil
+}
+
+// ValidateBasic validates that the CurrencyPair and PriceAccumulator of the PriceAccumulatorGenesis are valid.
+func (g *PriceAccumulatorGenesis) ValidateBasic() error {
+	if err := g.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := g.Accumulator.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid price accumulator for %s: %w", g.CurrencyPair, err)
+	}
+
+	return nil
+}
+
+// ValidateBasic validates that the CurrencyPair and CircuitBreakerState of the CircuitBreakerStateGenesis are valid.
+func (g *CircuitBreakerStateGenesis) ValidateBasic() error {
+	if err := g.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := g.State.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid circuit breaker state for %s: %w", g.CurrencyPair, err)
+	}
+
+	return nil
+}
+
+// ValidateBasic validates that the validator of the ValidatorParticipationGenesis is a valid consensus address, and
+// that each of its participation windows is for a unique, valid CurrencyPair, has no more than window slots, and
+// only contains known ParticipationStatuses.
+func (g *ValidatorParticipationGenesis) ValidateBasic(window uint64) error {
+	if _, err := sdk.ConsAddressFromBech32(g.Validator); err != nil {
+		return fmt.Errorf("invalid participation validator %s: %w", g.Validator, err)
+	}
+
+	cps := make(map[string]struct{}, len(g.Windows))
+	for _, w := range g.Windows {
+		if err := w.CurrencyPair.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := cps[w.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated participation window of %s for currency-pair: %s", g.Validator, w.CurrencyPair)
+		}
+
+		cps[w.CurrencyPair.String()] = struct{}{}
+
+		if uint64(len(w.Statuses)) > window {
+			return fmt.Errorf(
+				"participation window of %s for %s has %d slots, more than the participation window of %d blocks",
+				g.Validator, w.CurrencyPair, len(w.Statuses), window,
+			)
+		}
+
+		for _, status := range w.Statuses {
+			if _, ok := ParticipationStatus_name[int32(status)]; !ok {
+				return fmt.Errorf("unknown participation status %d of %s for %s", status, g.Validator, w.CurrencyPair)
+			}
+		}
+	}
+
+	return nil
+}
+
+// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses, using the
+// default module parameters.
 func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64) *GenesisState {
 	return &GenesisState{
 		CurrencyPairGenesis: cpgs,
 		NextId:              nextID,
+		Params:              DefaultParams(),
 	}
 }
 
@@ -38,7 +133,10 @@ func DefaultGenesisState() *GenesisState {
 }
 
 // Validate validates the currency-pair geneses that the Genesis-State is composed of
-// valid CurrencyPairGenesis, and that no ID for a currency-pair is repeated.
+// valid CurrencyPairGenesis, that no ID for a currency-pair is repeated, that each market
+// quorum, circuit breaker, circuit breaker state, market halt, price history entry, price
+// accumulator and participation window is valid and set for a currency-pair in genesis, that
+// participation is only tracked for unique validators, and that the module parameters are valid.
 func (gs *GenesisState) Validate() error {
 	ids := make(map[uint64]struct{})
 	cps := make(map[string]struct{})
@@ -70,7 +168,132 @@ func (gs *GenesisState) Validate() error {
 		cps[cpg.CurrencyPair.String()] = struct{}{}
 	}
 
-	return nil
+	if err := ValidateMarketQuorums(gs.MarketQuorums); err != nil {
+		return err
+	}
+
+	// check that each market quorum is for a currency-pair in genesis
+	for _, q := range gs.MarketQuorums {
+		if _, ok := cps[q.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("market quorum set for unknown currency-pair: %s", q.CurrencyPair)
+		}
+	}
+
+	if err := ValidateCircuitBreakers(gs.CircuitBreakers); err != nil {
+		return err
+	}
+
+	// check that each circuit breaker is for a currency-pair in genesis
+	for _, cb := range gs.CircuitBreakers {
+		if _, ok := cps[cb.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("circuit breaker set for unknown currency-pair: %s", cb.CurrencyPair)
+		}
+	}
+
+	if err := ValidatePriceMaxAges(gs.PriceMaxAges); err != nil {
+		return err
+	}
+
+	// check that each price max age is for a currency-pair in genesis
+	for _, m := range gs.PriceMaxAges {
+		if _, ok := cps[m.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("price max age set for unknown currency-pair: %s", m.CurrencyPair)
+		}
+	}
+
+	halts := make(map[string]struct{}, len(gs.MarketHalts))
+	for _, h := range gs.MarketHalts {
+		if err := h.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := cps[h.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("market halt set for unknown currency-pair: %s", h.CurrencyPair)
+		}
+
+		if _, ok := halts[h.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated market halt for currency-pair: %s", h.CurrencyPair)
+		}
+
+		halts[h.CurrencyPair.String()] = struct{}{}
+	}
+
+	history := make(map[string]map[uint64]struct{})
+	for _, e := range gs.PriceHistory {
+		if err := e.ValidateBasic(); err != nil {
+			return err
+		}
+
+		cp := e.CurrencyPair.String()
+		if _, ok := cps[cp]; !ok {
+			return fmt.Errorf("price history set for unknown currency-pair: %s", e.CurrencyPair)
+		}
+
+		if history[cp] == nil {
+			history[cp] = make(map[uint64]struct{})
+		}
+
+		if _, ok := history[cp][e.Price.BlockHeight]; ok {
+			return fmt.Errorf("repeated price history for currency-pair %s at height %d", e.CurrencyPair, e.Price.BlockHeight)
+		}
+
+		history[cp][e.Price.BlockHeight] = struct{}{}
+	}
+
+	accumulators := make(map[string]struct{}, len(gs.PriceAccumulators))
+	for _, acc := range gs.PriceAccumulators {
+		if err := acc.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := cps[acc.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("price accumulator set for unknown currency-pair: %s", acc.CurrencyPair)
+		}
+
+		if _, ok := accumulators[acc.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated price accumulator for currency-pair: %s", acc.CurrencyPair)
+		}
+
+		accumulators[acc.CurrencyPair.String()] = struct{}{}
+	}
+
+	cbStates := make(map[string]struct{}, len(gs.CircuitBreakerStates))
+	for _, st := range gs.CircuitBreakerStates {
+		if err := st.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := cps[st.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("circuit breaker state set for unknown currency-pair: %s", st.CurrencyPair)
+		}
+
+		if _, ok := cbStates[st.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated circuit breaker state for currency-pair: %s", st.CurrencyPair)
+		}
+
+		cbStates[st.CurrencyPair.String()] = struct{}{}
+	}
+
+	validators := make(map[string]struct{}, len(gs.Participation))
+	for _, p := range gs.Participation {
+		if err := p.ValidateBasic(gs.Params.ParticipationWindowBlocks); err != nil {
+			return err
+		}
+
+		for _, w := range p.Windows {
+			if _, ok := cps[w.CurrencyPair.String()]; !ok {
+				return fmt.Errorf("participation window set for unknown currency-pair: %s", w.CurrencyPair)
+			}
+		}
+
+		if _, ok := validators[p.Validator]; ok {
+			return fmt.Errorf("repeated participation for validator: %s", p.Validator)
+		}
+
+		validators[p.Validator] = struct{}{}
+	}
+
+	return gs.Params.ValidateBasic()
 }
 
 // GetGenesisStateFromAppState returns x/oracle GenesisState given raw application
@@ -40,6 +40,10 @@ type QuotePrice struct {
 	BlockTimestamp time.Time `protobuf:"bytes,2,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
 	// BlockHeight is height of block mentioned above
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// NumProviders is the median number of providers reported by the validators
+	// whose votes were used to compute this price. A zero value indicates that
+	// no provider counts were reported.
+	NumProviders uint32 `protobuf:"varint,4,opt,name=num_providers,json=numProviders,proto3" json:"num_providers,omitempty"`
 }
 
 func (m *QuotePrice) Reset()         { *m = QuotePrice{} }
@@ -89,6 +93,13 @@ func (m *QuotePrice) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (m *QuotePrice) GetNumProviders() uint32 {
+	if m != nil {
+		return m.NumProviders
+	}
+	return 0
+}
+
 // CurrencyPairState represents the stateful information tracked by the x/oracle
 // module per-currency-pair.
 type CurrencyPairState struct {
@@ -231,6 +242,305 @@ func (m *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+// PriceHistoryEntry is a price in the price history of a currency pair, keyed
+// by the block height at which it was written.
+type PriceHistoryEntry struct {
+	// CurrencyPair is the currency pair that the price was written for.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Price is the historical price.
+	Price QuotePrice `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// Accumulator is the PriceAccumulator of the currency pair after the price
+	// was written.
+	Accumulator PriceAccumulator `protobuf:"bytes,3,opt,name=accumulator,proto3" json:"accumulator"`
+}
+
+func (m *PriceHistoryEntry) Reset()         { *m = PriceHistoryEntry{} }
+func (m *PriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PriceHistoryEntry) ProtoMessage()    {}
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a688f927817fa7da, []int{3}
+}
+func (m *PriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistoryEntry.Merge(m, src)
+}
+func (m *PriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistoryEntry proto.InternalMessageInfo
+
+func (m *PriceHistoryEntry) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *PriceHistoryEntry) GetPrice() QuotePrice {
+	if m != nil {
+		return m.Price
+	}
+	return QuotePrice{}
+}
+
+func (m *PriceHistoryEntry) GetAccumulator() PriceAccumulator {
+	if m != nil {
+		return m.Accumulator
+	}
+	return PriceAccumulator{}
+}
+
+// PriceAccumulatorGenesis is the latest PriceAccumulator of a currency pair.
+type PriceAccumulatorGenesis struct {
+	// CurrencyPair is the currency pair of the accumulator.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Accumulator is the latest PriceAccumulator of the currency pair.
+	Accumulator PriceAccumulator `protobuf:"bytes,2,opt,name=accumulator,proto3" json:"accumulator"`
+}
+
+func (m *PriceAccumulatorGenesis) Reset()         { *m = PriceAccumulatorGenesis{} }
+func (m *PriceAccumulatorGenesis) String() string { return proto.CompactTextString(m) }
+func (*PriceAccumulatorGenesis) ProtoMessage()    {}
+func (*PriceAccumulatorGenesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a688f927817fa7da, []int{4}
+}
+func (m *PriceAccumulatorGenesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAccumulatorGenesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAccumulatorGenesis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAccumulatorGenesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAccumulatorGenesis.Merge(m, src)
+}
+func (m *PriceAccumulatorGenesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAccumulatorGenesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAccumulatorGenesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAccumulatorGenesis proto.InternalMessageInfo
+
+func (m *PriceAccumulatorGenesis) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *PriceAccumulatorGenesis) GetAccumulator() PriceAccumulator {
+	if m != nil {
+		return m.Accumulator
+	}
+	return PriceAccumulator{}
+}
+
+// CircuitBreakerStateGenesis is the CircuitBreakerState of a currency pair.
+type CircuitBreakerStateGenesis struct {
+	// CurrencyPair is the currency pair of the state.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// State is the rolling window of the currency pair's circuit breaker.
+	State CircuitBreakerState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+}
+
+func (m *CircuitBreakerStateGenesis) Reset()         { *m = CircuitBreakerStateGenesis{} }
+func (m *CircuitBreakerStateGenesis) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerStateGenesis) ProtoMessage()    {}
+func (*CircuitBreakerStateGenesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a688f927817fa7da, []int{5}
+}
+func (m *CircuitBreakerStateGenesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerStateGenesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerStateGenesis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerStateGenesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerStateGenesis.Merge(m, src)
+}
+func (m *CircuitBreakerStateGenesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerStateGenesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerStateGenesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerStateGenesis proto.InternalMessageInfo
+
+func (m *CircuitBreakerStateGenesis) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CircuitBreakerStateGenesis) GetState() CircuitBreakerState {
+	if m != nil {
+		return m.State
+	}
+	return CircuitBreakerState{}
+}
+
+// MarketParticipationWindow is the participation window of a validator for a
+// currency pair.
+type MarketParticipationWindow struct {
+	// CurrencyPair is the currency pair of the window.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Statuses are the participation statuses of the validator, indexed by slot
+	// in the window. Slots that have not been recorded are unspecified.
+	Statuses []ParticipationStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=connect.oracle.v2.ParticipationStatus" json:"statuses,omitempty"`
+}
+
+func (m *MarketParticipationWindow) Reset()         { *m = MarketParticipationWindow{} }
+func (m *MarketParticipationWindow) String() string { return proto.CompactTextString(m) }
+func (*MarketParticipationWindow) ProtoMessage()    {}
+func (*MarketParticipationWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a688f927817fa7da, []int{6}
+}
+func (m *MarketParticipationWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketParticipationWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketParticipationWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketParticipationWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketParticipationWindow.Merge(m, src)
+}
+func (m *MarketParticipationWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketParticipationWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketParticipationWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketParticipationWindow proto.InternalMessageInfo
+
+func (m *MarketParticipationWindow) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *MarketParticipationWindow) GetStatuses() []ParticipationStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// ValidatorParticipationGenesis is the participation tracking state of a
+// single validator.
+type ValidatorParticipationGenesis struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Info is the participation tracking state of the validator.
+	Info ValidatorParticipationInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info"`
+	// Windows are the participation windows of the validator for each currency
+	// pair, ordered by currency pair.
+	Windows []MarketParticipationWindow `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows"`
+}
+
+func (m *ValidatorParticipationGenesis) Reset()         { *m = ValidatorParticipationGenesis{} }
+func (m *ValidatorParticipationGenesis) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipationGenesis) ProtoMessage()    {}
+func (*ValidatorParticipationGenesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a688f927817fa7da, []int{7}
+}
+func (m *ValidatorParticipationGenesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationGenesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipationGenesis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorParticipationGenesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationGenesis.Merge(m, src)
+}
+func (m *ValidatorParticipationGenesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationGenesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationGenesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipationGenesis proto.InternalMessageInfo
+
+func (m *ValidatorParticipationGenesis) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorParticipationGenesis) GetInfo() ValidatorParticipationInfo {
+	if m != nil {
+		return m.Info
+	}
+	return ValidatorParticipationInfo{}
+}
+
+func (m *ValidatorParticipationGenesis) GetWindows() []MarketParticipationWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -240,13 +550,35 @@ type GenesisState struct {
 	CurrencyPairGenesis []CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// Params are the parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// MarketQuorums are the per-currency-pair quorums that override the
+	// module-wide quorum in Params.
+	MarketQuorums []MarketQuorum `protobuf:"bytes,4,rep,name=market_quorums,json=marketQuorums,proto3" json:"market_quorums"`
+	// CircuitBreakers are the per-currency-pair circuit breakers.
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,5,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	// MarketHalts are the currency pairs whose price updates are halted.
+	MarketHalts []MarketHalt `protobuf:"bytes,6,rep,name=market_halts,json=marketHalts,proto3" json:"market_halts"`
+	// PriceMaxAges are the per-currency-pair default max ages of prices.
+	PriceMaxAges []PriceMaxAge `protobuf:"bytes,7,rep,name=price_max_ages,json=priceMaxAges,proto3" json:"price_max_ages"`
+	// PriceHistory are the historical prices of each currency pair, ordered by
+	// currency pair and height.
+	PriceHistory []PriceHistoryEntry `protobuf:"bytes,8,rep,name=price_history,json=priceHistory,proto3" json:"price_history"`
+	// PriceAccumulators are the latest PriceAccumulators of each currency pair.
+	PriceAccumulators []PriceAccumulatorGenesis `protobuf:"bytes,9,rep,name=price_accumulators,json=priceAccumulators,proto3" json:"price_accumulators"`
+	// CircuitBreakerStates are the rolling windows of the circuit breakers of
+	// each currency pair.
+	CircuitBreakerStates []CircuitBreakerStateGenesis `protobuf:"bytes,10,rep,name=circuit_breaker_states,json=circuitBreakerStates,proto3" json:"circuit_breaker_states"`
+	// Participation is the participation tracking state of each validator,
+	// ordered by consensus address.
+	Participation []ValidatorParticipationGenesis `protobuf:"bytes,11,rep,name=participation,proto3" json:"participation"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a688f927817fa7da, []int{3}
+	return fileDescriptor_a688f927817fa7da, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,49 +621,149 @@ func (m *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetMarketQuorums() []MarketQuorum {
+	if m != nil {
+		return m.MarketQuorums
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketHalts() []MarketHalt {
+	if m != nil {
+		return m.MarketHalts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceMaxAges() []PriceMaxAge {
+	if m != nil {
+		return m.PriceMaxAges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHistory() []PriceHistoryEntry {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceAccumulators() []PriceAccumulatorGenesis {
+	if m != nil {
+		return m.PriceAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerStates() []CircuitBreakerStateGenesis {
+	if m != nil {
+		return m.CircuitBreakerStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipation() []ValidatorParticipationGenesis {
+	if m != nil {
+		return m.Participation
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuotePrice)(nil), "connect.oracle.v2.QuotePrice")
 	proto.RegisterType((*CurrencyPairState)(nil), "connect.oracle.v2.CurrencyPairState")
 	proto.RegisterType((*CurrencyPairGenesis)(nil), "connect.oracle.v2.CurrencyPairGenesis")
+	proto.RegisterType((*PriceHistoryEntry)(nil), "connect.oracle.v2.PriceHistoryEntry")
+	proto.RegisterType((*PriceAccumulatorGenesis)(nil), "connect.oracle.v2.PriceAccumulatorGenesis")
+	proto.RegisterType((*CircuitBreakerStateGenesis)(nil), "connect.oracle.v2.CircuitBreakerStateGenesis")
+	proto.RegisterType((*MarketParticipationWindow)(nil), "connect.oracle.v2.MarketParticipationWindow")
+	proto.RegisterType((*ValidatorParticipationGenesis)(nil), "connect.oracle.v2.ValidatorParticipationGenesis")
 	proto.RegisterType((*GenesisState)(nil), "connect.oracle.v2.GenesisState")
 }
 
 func init() { proto.RegisterFile("connect/oracle/v2/genesis.proto", fileDescriptor_a688f927817fa7da) }
 
 var fileDescriptor_a688f927817fa7da = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0xc0, 0xae, 0x3a, 0xe0, 0x1a, 0xca, 0x6e, 0x44, 0x12, 0x5b, 0x24, 0xc6, 0x90,
-	0x18, 0x66, 0x92, 0x7a, 0xf2, 0x28, 0x1e, 0x56, 0x0e, 0x26, 0xd8, 0xf5, 0xe4, 0xa5, 0x96, 0xe9,
-	0x58, 0x26, 0xd0, 0x4e, 0xd3, 0x0e, 0x64, 0xf7, 0x23, 0x78, 0xdb, 0x0f, 0xe3, 0x17, 0xf0, 0xc6,
-	0x71, 0xe3, 0xc9, 0x68, 0x82, 0x06, 0xbe, 0x88, 0xe9, 0xcc, 0x14, 0x4b, 0x96, 0xc3, 0xde, 0xfa,
-	0xce, 0xbc, 0x7f, 0x9e, 0xdf, 0x33, 0x6f, 0xa1, 0x4d, 0x78, 0x1c, 0x53, 0x22, 0x30, 0x4f, 0x7d,
-	0x32, 0xa7, 0x78, 0xe9, 0xe0, 0x90, 0xc6, 0x34, 0x63, 0x19, 0x4a, 0x52, 0x2e, 0xb8, 0xd9, 0xd4,
-	0x09, 0x48, 0x25, 0xa0, 0xa5, 0xd3, 0x39, 0x0d, 0x79, 0xc8, 0xe5, 0x2d, 0xce, 0xbf, 0x54, 0x62,
-	0xc7, 0x0e, 0x39, 0x0f, 0xe7, 0x14, 0xcb, 0x68, 0xb2, 0xf8, 0x82, 0x05, 0x8b, 0x68, 0x26, 0xfc,
-	0x28, 0xd1, 0x09, 0x4f, 0x08, 0xcf, 0x22, 0x9e, 0x79, 0xaa, 0x52, 0x05, 0xfa, 0xea, 0x79, 0xa1,
-	0x42, 0x5c, 0x25, 0x34, 0xcb, 0x45, 0x90, 0x45, 0x9a, 0xd2, 0x98, 0x5c, 0x79, 0x89, 0xcf, 0x52,
-	0x95, 0xd5, 0xfb, 0x0e, 0x20, 0xfc, 0xb0, 0xe0, 0x82, 0x8e, 0x53, 0x46, 0xa8, 0xf9, 0x06, 0x1e,
-	0x25, 0xf9, 0x47, 0x1b, 0x74, 0x41, 0xff, 0xc1, 0xf0, 0xe5, 0x6a, 0x6d, 0x1b, 0xbf, 0xd6, 0xf6,
-	0x99, 0xea, 0x9c, 0x05, 0x33, 0xc4, 0x38, 0x8e, 0x7c, 0x31, 0x45, 0xa3, 0x58, 0xfc, 0xf8, 0x36,
-	0x80, 0x7a, 0xe4, 0x28, 0x16, 0xae, 0xaa, 0x34, 0xdf, 0xc3, 0x47, 0x93, 0x39, 0x27, 0x33, 0x6f,
-	0xa7, 0xb5, 0x5d, 0xe9, 0x82, 0x7e, 0xdd, 0xe9, 0x20, 0x45, 0x83, 0x0a, 0x1a, 0xf4, 0xb1, 0xc8,
-	0x18, 0xde, 0xcf, 0x07, 0x5d, 0xff, 0xb1, 0x81, 0x7b, 0x22, 0x8b, 0x77, 0x37, 0xe6, 0x33, 0xd8,
-	0x50, 0xed, 0xa6, 0x94, 0x85, 0x53, 0xd1, 0xae, 0x76, 0x41, 0xbf, 0xe6, 0xd6, 0xe5, 0xd9, 0x3b,
-	0x79, 0xd4, 0x13, 0xb0, 0xf9, 0x56, 0xa3, 0x8d, 0x7d, 0x96, 0x5e, 0x08, 0x5f, 0x50, 0xf3, 0x75,
-	0x99, 0xa4, 0xee, 0x3c, 0x45, 0xb7, 0x3c, 0x47, 0xff, 0xb9, 0x87, 0xb5, 0xd5, 0xda, 0x06, 0x05,
-	0xc1, 0x29, 0x3c, 0x8a, 0x79, 0x4c, 0xa8, 0xd4, 0x5d, 0x73, 0x55, 0x60, 0x9e, 0xc0, 0x0a, 0x0b,
-	0xf4, 0xf8, 0x0a, 0x0b, 0x7a, 0xbf, 0x01, 0x6c, 0x95, 0xc7, 0x9e, 0xab, 0x27, 0x36, 0x47, 0xf0,
-	0xe1, 0x9e, 0xd1, 0x5a, 0x80, 0xb5, 0x13, 0x20, 0xdf, 0x23, 0x9f, 0x5f, 0xae, 0x96, 0x0a, 0x0c,
-	0xb7, 0x41, 0x4a, 0x67, 0xe6, 0x05, 0x6c, 0xed, 0xb5, 0xf2, 0x14, 0x51, 0xe5, 0xee, 0x44, 0xcd,
-	0x72, 0xbf, 0xf1, 0x3e, 0x5d, 0xf5, 0x36, 0x5d, 0x6d, 0x47, 0xf7, 0x15, 0xc0, 0x86, 0x26, 0x52,
-	0x7e, 0x7e, 0x86, 0x67, 0xfb, 0x5a, 0xf4, 0x4a, 0xb7, 0x41, 0xb7, 0xda, 0xaf, 0x3b, 0x2f, 0x0e,
-	0xa8, 0x39, 0xe0, 0x8e, 0xc6, 0x6c, 0x91, 0x03, 0xc6, 0x3d, 0x86, 0xf7, 0x62, 0x7a, 0x29, 0x3c,
-	0x16, 0x68, 0xe3, 0x8f, 0xf3, 0x70, 0x14, 0x0c, 0xcf, 0x57, 0x1b, 0x0b, 0xdc, 0x6c, 0x2c, 0xf0,
-	0x77, 0x63, 0x81, 0xeb, 0xad, 0x65, 0xdc, 0x6c, 0x2d, 0xe3, 0xe7, 0xd6, 0x32, 0x3e, 0x0d, 0x42,
-	0x26, 0xa6, 0x8b, 0x09, 0x22, 0x3c, 0xc2, 0xd9, 0x8c, 0x25, 0x83, 0x88, 0x2e, 0x71, 0xb1, 0xf7,
-	0x4b, 0x07, 0x5f, 0x16, 0xbf, 0xa0, 0xf4, 0x7c, 0x72, 0x2c, 0x37, 0xef, 0xd5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa6, 0xbb, 0xf6, 0x48, 0xa1, 0x03, 0x00, 0x00,
+	// 1013 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0x34, 0x6d, 0x27, 0x3f, 0x4b, 0xa6, 0x5d, 0xd6, 0xad, 0x76, 0x93, 0x6c, 0x76,
+	0x81, 0x08, 0xa8, 0x8d, 0xc2, 0x05, 0xe2, 0xb2, 0x59, 0x41, 0x1b, 0xd8, 0x8a, 0x6c, 0x8a, 0x40,
+	0x42, 0x48, 0x66, 0xe2, 0x4c, 0x93, 0x51, 0x62, 0x8f, 0xf1, 0x8c, 0xd3, 0xf6, 0x2d, 0xf6, 0x39,
+	0xe0, 0x0a, 0x89, 0x87, 0xe8, 0xe5, 0x0a, 0x71, 0x81, 0x40, 0x2a, 0xa8, 0x95, 0xf6, 0x05, 0x78,
+	0x01, 0xe4, 0x99, 0x71, 0x6a, 0x27, 0x8e, 0xa8, 0xb4, 0xbb, 0x77, 0xf6, 0x99, 0x6f, 0xbe, 0xf3,
+	0x9d, 0x73, 0x66, 0x3e, 0x1b, 0xd4, 0x6d, 0xea, 0xba, 0xd8, 0xe6, 0x26, 0xf5, 0x91, 0x3d, 0xc5,
+	0xe6, 0xac, 0x6d, 0x8e, 0xb0, 0x8b, 0x19, 0x61, 0x86, 0xe7, 0x53, 0x4e, 0x61, 0x55, 0x01, 0x0c,
+	0x09, 0x30, 0x66, 0xed, 0xdd, 0xed, 0x11, 0x1d, 0x51, 0xb1, 0x6a, 0x86, 0x4f, 0x12, 0xb8, 0x5b,
+	0x1f, 0x51, 0x3a, 0x9a, 0x62, 0x53, 0xbc, 0x0d, 0x82, 0x13, 0x93, 0x13, 0x07, 0x33, 0x8e, 0x1c,
+	0x4f, 0x01, 0x76, 0x6c, 0xca, 0x1c, 0xca, 0x2c, 0xb9, 0x53, 0xbe, 0xa8, 0xa5, 0xc7, 0x91, 0x0a,
+	0x7e, 0xee, 0x61, 0x16, 0x8a, 0xb0, 0x03, 0xdf, 0xc7, 0xae, 0x7d, 0x6e, 0x79, 0x88, 0xf8, 0x0a,
+	0x55, 0x5b, 0xd6, 0xea, 0x21, 0x1f, 0x39, 0x11, 0xcb, 0x7b, 0xcb, 0xeb, 0x36, 0xf1, 0xed, 0x80,
+	0x70, 0x6b, 0xe0, 0x63, 0x34, 0xc1, 0x11, 0x51, 0x4a, 0xd1, 0x0e, 0x3a, 0xb3, 0xd0, 0x08, 0x2b,
+	0xc0, 0xfd, 0x65, 0x00, 0x3f, 0x45, 0x51, 0x21, 0xef, 0xa4, 0xea, 0xe0, 0xc4, 0x26, 0x1e, 0xe2,
+	0x84, 0xba, 0x12, 0xd6, 0x7c, 0xa9, 0x01, 0xf0, 0x2c, 0xa0, 0x1c, 0xf7, 0x7c, 0x62, 0x63, 0xb8,
+	0x0f, 0xd6, 0xbc, 0xf0, 0x41, 0xd7, 0x1a, 0x5a, 0x6b, 0xb3, 0xf3, 0xc1, 0xc5, 0x65, 0x3d, 0xf3,
+	0xe7, 0x65, 0xfd, 0xae, 0x6c, 0x04, 0x1b, 0x4e, 0x0c, 0x42, 0x4d, 0x07, 0xf1, 0xb1, 0xd1, 0x75,
+	0xf9, 0x6f, 0xbf, 0xee, 0x01, 0xd5, 0xa1, 0xae, 0xcb, 0xfb, 0x72, 0x27, 0x3c, 0x02, 0x77, 0x06,
+	0x53, 0x6a, 0x4f, 0xac, 0x79, 0x6b, 0xf5, 0x6c, 0x43, 0x6b, 0x15, 0xdb, 0xbb, 0x86, 0x6c, 0xbe,
+	0x11, 0x35, 0xdf, 0xf8, 0x3a, 0x42, 0x74, 0x36, 0xc2, 0x44, 0xcf, 0xff, 0xae, 0x6b, 0xfd, 0x8a,
+	0xd8, 0x3c, 0x5f, 0x81, 0x0f, 0x41, 0x49, 0xd2, 0x8d, 0x31, 0x19, 0x8d, 0xb9, 0x9e, 0x6b, 0x68,
+	0xad, 0x7c, 0xbf, 0x28, 0x62, 0x87, 0x22, 0x04, 0x1f, 0x81, 0xb2, 0x1b, 0x38, 0xe1, 0xc8, 0x66,
+	0x64, 0x88, 0x7d, 0xa6, 0xe7, 0x1b, 0x5a, 0xab, 0xdc, 0x2f, 0xb9, 0x81, 0xd3, 0x8b, 0x62, 0x4d,
+	0x0e, 0xaa, 0x4f, 0xd4, 0xb8, 0x7a, 0x88, 0xf8, 0xc7, 0x1c, 0x71, 0x0c, 0x3f, 0x8d, 0x97, 0x5b,
+	0x6c, 0x3f, 0x30, 0x96, 0xce, 0x91, 0x71, 0xd3, 0x9c, 0x4e, 0xfe, 0xe2, 0xb2, 0xae, 0x45, 0x65,
+	0x6e, 0x83, 0x35, 0x97, 0xba, 0x36, 0x16, 0xc5, 0xe5, 0xfb, 0xf2, 0x05, 0x56, 0x40, 0x96, 0x0c,
+	0x95, 0xc6, 0x2c, 0x19, 0x36, 0xff, 0xd2, 0xc0, 0x56, 0x3c, 0xed, 0x81, 0x3c, 0xb6, 0xb0, 0x0b,
+	0xca, 0x89, 0xc3, 0xa3, 0x04, 0xd4, 0xe6, 0x02, 0xc4, 0x19, 0x0b, 0xf3, 0xc7, 0x77, 0x0b, 0x05,
+	0x99, 0x7e, 0xc9, 0x8e, 0xc5, 0xe0, 0x31, 0xd8, 0x4a, 0x50, 0x59, 0xb2, 0xa2, 0xec, 0xed, 0x2b,
+	0xaa, 0xc6, 0xf9, 0x7a, 0xc9, 0xea, 0x72, 0xcb, 0xd5, 0xe5, 0xe7, 0xd5, 0xbd, 0xd4, 0x40, 0x55,
+	0xe0, 0x0f, 0x09, 0xe3, 0xd4, 0x3f, 0xff, 0xcc, 0xe5, 0xfe, 0xf9, 0xeb, 0xac, 0x6d, 0x3e, 0x9f,
+	0x5b, 0x57, 0x93, 0x89, 0xe6, 0xf3, 0x25, 0x28, 0x22, 0xdb, 0x0e, 0x9c, 0x60, 0x8a, 0x38, 0xf5,
+	0x45, 0x1d, 0xc5, 0xf6, 0xa3, 0x14, 0x02, 0xb1, 0x77, 0xff, 0x06, 0xaa, 0x68, 0xe2, 0xbb, 0x9b,
+	0xbf, 0x68, 0xe0, 0xde, 0x22, 0xee, 0x0d, 0x8c, 0x72, 0x41, 0x73, 0xf6, 0x95, 0x34, 0xff, 0xac,
+	0x81, 0xdd, 0x27, 0xd2, 0x59, 0x3a, 0xd2, 0x58, 0xc4, 0x99, 0x7f, 0x03, 0xb2, 0x3b, 0x60, 0x8d,
+	0x85, 0xd4, 0x4a, 0xf0, 0xbb, 0x29, 0x82, 0x53, 0x84, 0x44, 0xe3, 0x12, 0x5b, 0x9b, 0x3f, 0x69,
+	0x60, 0xe7, 0x08, 0xf9, 0x13, 0xcc, 0x7b, 0x71, 0x97, 0xfa, 0x96, 0xb8, 0x43, 0x7a, 0xfa, 0x7a,
+	0xc5, 0x6e, 0x84, 0x19, 0x03, 0x86, 0x99, 0x9e, 0x6d, 0xe4, 0x5a, 0x95, 0x54, 0xbd, 0x09, 0x11,
+	0xc7, 0x02, 0xdf, 0x9f, 0xef, 0x6b, 0xfe, 0xae, 0x81, 0x07, 0xdf, 0xa0, 0x29, 0x19, 0x86, 0x8d,
+	0x4e, 0x40, 0xa3, 0xee, 0xde, 0x07, 0x9b, 0xb3, 0x08, 0x20, 0xbd, 0xb4, 0x7f, 0x13, 0x80, 0x07,
+	0x20, 0x4f, 0xdc, 0x13, 0xaa, 0xfa, 0xb5, 0x97, 0x92, 0x3f, 0x9d, 0xbd, 0xeb, 0x9e, 0x50, 0x55,
+	0x94, 0x20, 0x80, 0x4f, 0xc1, 0xfa, 0xa9, 0xe8, 0x10, 0xd3, 0x73, 0x8d, 0x5c, 0xab, 0xd8, 0xfe,
+	0x30, 0x85, 0x6b, 0x65, 0x5b, 0x15, 0x55, 0x44, 0xd1, 0xfc, 0xb7, 0x00, 0x4a, 0xaa, 0x00, 0x69,
+	0x8f, 0x3f, 0x80, 0xbb, 0x49, 0x6b, 0x51, 0x5f, 0x5d, 0x5d, 0x13, 0xc9, 0x52, 0x07, 0xbd, 0x6c,
+	0x76, 0x2a, 0xcd, 0x96, 0xbd, 0xbc, 0x04, 0xef, 0x81, 0x75, 0x17, 0x9f, 0x71, 0x8b, 0x0c, 0x95,
+	0x8f, 0x16, 0xc2, 0xd7, 0xee, 0x10, 0x7e, 0x02, 0x0a, 0xf2, 0xb3, 0xa9, 0x6e, 0xee, 0x4e, 0xfa,
+	0x90, 0x90, 0x13, 0xd1, 0x2b, 0x38, 0x7c, 0x0a, 0x2a, 0x8e, 0x28, 0xd8, 0xfa, 0x31, 0xa0, 0x7e,
+	0xe0, 0x84, 0x5f, 0x83, 0x50, 0x6c, 0x7d, 0x65, 0x67, 0x9e, 0x09, 0x9c, 0xa2, 0x29, 0x3b, 0xb1,
+	0x18, 0x83, 0x7d, 0xf0, 0xd6, 0xc2, 0xd7, 0x99, 0xe9, 0x6b, 0x82, 0xef, 0xe1, 0xff, 0x9e, 0x72,
+	0xc5, 0x78, 0xc7, 0x4e, 0x44, 0x19, 0xfc, 0x1c, 0x94, 0x94, 0xc2, 0x31, 0x9a, 0x72, 0xa6, 0x17,
+	0x1a, 0xb9, 0x15, 0xde, 0x26, 0xf5, 0x1d, 0xa2, 0x29, 0x8f, 0x2e, 0xb8, 0x33, 0x8f, 0x30, 0xf8,
+	0x05, 0xa8, 0x08, 0xab, 0xb3, 0xd4, 0x6f, 0x01, 0xd3, 0xd7, 0x1b, 0xb9, 0xc4, 0xad, 0x58, 0x30,
+	0x8c, 0x23, 0x74, 0xb6, 0x3f, 0x8a, 0xee, 0x5d, 0xc9, 0xbb, 0x09, 0x31, 0xf8, 0x15, 0x28, 0x4b,
+	0xae, 0xb1, 0x74, 0x72, 0x7d, 0x43, 0x50, 0x3d, 0x5e, 0x45, 0x15, 0x37, 0xfc, 0x04, 0xa1, 0x5a,
+	0x80, 0x16, 0x80, 0x92, 0x30, 0x66, 0x49, 0x4c, 0xdf, 0x14, 0xac, 0xef, 0xdf, 0xc2, 0xd1, 0x92,
+	0x67, 0xa7, 0xea, 0x2d, 0x2c, 0x33, 0x48, 0xc0, 0xdb, 0x0b, 0x93, 0xb1, 0x84, 0x93, 0x30, 0x1d,
+	0x34, 0x72, 0x2b, 0x6e, 0xd5, 0x6a, 0x3b, 0x54, 0x79, 0xb6, 0xed, 0x65, 0x04, 0x83, 0xdf, 0x83,
+	0x72, 0xe2, 0xd7, 0x49, 0x2f, 0x8a, 0x0c, 0x1f, 0xdd, 0xfa, 0xde, 0x26, 0x93, 0x24, 0xc9, 0x3a,
+	0x07, 0x17, 0x57, 0x35, 0xed, 0xc5, 0x55, 0x4d, 0xfb, 0xe7, 0xaa, 0xa6, 0x3d, 0xbf, 0xae, 0x65,
+	0x5e, 0x5c, 0xd7, 0x32, 0x7f, 0x5c, 0xd7, 0x32, 0xdf, 0xed, 0x8d, 0x08, 0x1f, 0x07, 0x03, 0xc3,
+	0xa6, 0x8e, 0xc9, 0x26, 0xc4, 0xdb, 0x73, 0xf0, 0xcc, 0x8c, 0x7e, 0xeb, 0x66, 0x6d, 0xf3, 0x2c,
+	0xfa, 0xb7, 0x13, 0xee, 0x37, 0x28, 0x88, 0xff, 0xaa, 0x8f, 0xff, 0x1b, 0x00, 0x8c, 0x9c, 0x3c,
+	0x86, 0x2e, 0x0b, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -354,6 +786,11 @@ func (m *QuotePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NumProviders != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NumProviders))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -480,7 +917,7 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *PriceHistoryEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -490,130 +927,1598 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NextId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.CurrencyPairGenesis) > 0 {
-		for iNdEx := len(m.CurrencyPairGenesis) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CurrencyPairGenesis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *QuotePrice) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PriceAccumulatorGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAccumulatorGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAccumulatorGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTimestamp)
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	{
+		size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrencyPairState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitBreakerStateGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerStateGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerStateGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovGenesis(uint64(m.Nonce))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.Id != 0 {
-		n += 1 + sovGenesis(uint64(m.Id))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketParticipationWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketParticipationWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketParticipationWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		dAtA13 := make([]byte, len(m.Statuses)*10)
+		var j12 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintGenesis(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participation) > 0 {
+		for iNdEx := len(m.Participation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CircuitBreakerStates) > 0 {
+		for iNdEx := len(m.CircuitBreakerStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PriceAccumulators) > 0 {
+		for iNdEx := len(m.PriceAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PriceMaxAges) > 0 {
+		for iNdEx := len(m.PriceMaxAges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceMaxAges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MarketHalts) > 0 {
+		for iNdEx := len(m.MarketHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MarketQuorums) > 0 {
+		for iNdEx := len(m.MarketQuorums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketQuorums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NextId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrencyPairGenesis) > 0 {
+		for iNdEx := len(m.CurrencyPairGenesis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairGenesis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuotePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.NumProviders != 0 {
+		n += 1 + sovGenesis(uint64(m.NumProviders))
+	}
+	return n
+}
+
+func (m *CurrencyPairState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *CurrencyPairGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CurrencyPairPrice != nil {
+		l = m.CurrencyPairPrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *PriceHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Accumulator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PriceAccumulatorGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Accumulator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CircuitBreakerStateGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.State.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *MarketParticipationWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ValidatorParticipationGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairGenesis) > 0 {
+		for _, e := range m.CurrencyPairGenesis {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextId))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MarketQuorums) > 0 {
+		for _, e := range m.MarketQuorums {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketHalts) > 0 {
+		for _, e := range m.MarketHalts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceMaxAges) > 0 {
+		for _, e := range m.PriceMaxAges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceAccumulators) > 0 {
+		for _, e := range m.PriceAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerStates) > 0 {
+		for _, e := range m.CircuitBreakerStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Participation) > 0 {
+		for _, e := range m.Participation {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuotePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProviders", wireType)
+			}
+			m.NumProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumProviders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairGenesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrencyPairPrice == nil {
+				m.CurrencyPairPrice = &QuotePrice{}
+			}
+			if err := m.CurrencyPairPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceAccumulatorGenesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceAccumulatorGenesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceAccumulatorGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *CurrencyPairGenesis) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.CurrencyPairPrice != nil {
-		l = m.CurrencyPairPrice.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovGenesis(uint64(m.Nonce))
-	}
-	if m.Id != 0 {
-		n += 1 + sovGenesis(uint64(m.Id))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairGenesis) > 0 {
-		for _, e := range m.CurrencyPairGenesis {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+func (m *CircuitBreakerStateGenesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerStateGenesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerStateGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.NextId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextId))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MarketParticipationWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketParticipationWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketParticipationWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v ParticipationStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ParticipationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]ParticipationStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ParticipationStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ParticipationStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuotePrice) Unmarshal(dAtA []byte) error {
+func (m *ValidatorParticipationGenesis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -636,15 +2541,15 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuotePrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorParticipationGenesis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuotePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorParticipationGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -672,13 +2577,11 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -705,15 +2608,15 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -723,11 +2626,26 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, MarketParticipationWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -749,7 +2667,7 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -772,15 +2690,15 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairGenesis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -807,18 +2725,16 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CurrencyPairGenesis = append(m.CurrencyPairGenesis, CurrencyPairGenesis{})
+			if err := m.CurrencyPairGenesis[len(m.CurrencyPairGenesis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
 			}
-			m.Nonce = 0
+			m.NextId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -828,16 +2744,16 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.NextId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -847,64 +2763,62 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketQuorums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MarketQuorums = append(m.MarketQuorums, MarketQuorum{})
+			if err := m.MarketQuorums[len(m.MarketQuorums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairGenesis: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -931,13 +2845,14 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketHalts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -964,18 +2879,16 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrencyPairPrice == nil {
-				m.CurrencyPairPrice = &QuotePrice{}
-			}
-			if err := m.CurrencyPairPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketHalts = append(m.MarketHalts, MarketHalt{})
+			if err := m.MarketHalts[len(m.MarketHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMaxAges", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -985,16 +2898,31 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.Id = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceMaxAges = append(m.PriceMaxAges, PriceMaxAge{})
+			if err := m.PriceMaxAges[len(m.PriceMaxAges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1004,64 +2932,63 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.PriceHistory = append(m.PriceHistory, PriceHistoryEntry{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PriceAccumulators = append(m.PriceAccumulators, PriceAccumulatorGenesis{})
+			if err := m.PriceAccumulators[len(m.PriceAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairGenesis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1088,16 +3015,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairGenesis = append(m.CurrencyPairGenesis, CurrencyPairGenesis{})
-			if err := m.CurrencyPairGenesis[len(m.CurrencyPairGenesis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CircuitBreakerStates = append(m.CircuitBreakerStates, CircuitBreakerStateGenesis{})
+			if err := m.CircuitBreakerStates[len(m.CircuitBreakerStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
 			}
-			m.NextId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1107,11 +3034,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participation = append(m.Participation, ValidatorParticipationGenesis{})
+			if err := m.Participation[len(m.Participation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
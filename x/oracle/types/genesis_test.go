@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -129,3 +131,496 @@ func TestGenesisValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisValidationMarketQuorums(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: cp,
+			Id:           0,
+		},
+	}
+
+	tcs := []struct {
+		name       string
+		quorums    []types.MarketQuorum
+		expectPass bool
+	}{
+		{
+			"if a market quorum is invalid - fail",
+			[]types.MarketQuorum{types.NewMarketQuorum(cp, 0, 1)},
+			false,
+		},
+		{
+			"if a market quorum is repeated - fail",
+			[]types.MarketQuorum{types.NewMarketQuorum(cp, 5_000, 1), types.NewMarketQuorum(cp, 6_000, 1)},
+			false,
+		},
+		{
+			"if a market quorum is set for a currency-pair not in genesis - fail",
+			[]types.MarketQuorum{types.NewMarketQuorum(connecttypes.NewCurrencyPair("CC", "DD"), 5_000, 1)},
+			false,
+		},
+		{
+			"if all market quorums are valid - pass",
+			[]types.MarketQuorum{types.NewMarketQuorum(cp, 5_000, 1)},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.MarketQuorums = tc.quorums
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationCircuitBreakers(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	unknown := connecttypes.NewCurrencyPair("CC", "DD")
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: cp,
+			Id:           0,
+		},
+	}
+	halt := func(cp connecttypes.CurrencyPair) types.MarketHalt {
+		return types.MarketHalt{
+			CurrencyPair: cp,
+			Price:        math.NewInt(200),
+			LowerBound:   math.NewInt(90),
+			UpperBound:   math.NewInt(110),
+		}
+	}
+	cb := func(cp connecttypes.CurrencyPair) types.CircuitBreaker {
+		return types.NewCircuitBreaker(cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT)
+	}
+
+	tcs := []struct {
+		name       string
+		cbs        []types.CircuitBreaker
+		halts      []types.MarketHalt
+		expectPass bool
+	}{
+		{
+			"if a circuit breaker is set for a currency-pair not in genesis - fail",
+			[]types.CircuitBreaker{cb(unknown)},
+			nil,
+			false,
+		},
+		{
+			"if a market halt is set for a currency-pair not in genesis - fail",
+			nil,
+			[]types.MarketHalt{halt(unknown)},
+			false,
+		},
+		{
+			"if a market halt is repeated - fail",
+			nil,
+			[]types.MarketHalt{halt(cp), halt(cp)},
+			false,
+		},
+		{
+			"if a market halt has no price - fail",
+			nil,
+			[]types.MarketHalt{{CurrencyPair: cp}},
+			false,
+		},
+		{
+			"if all circuit breakers and halts are valid - pass",
+			[]types.CircuitBreaker{cb(cp)},
+			[]types.MarketHalt{halt(cp)},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.CircuitBreakers = tc.cbs
+			gs.MarketHalts = tc.halts
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationPriceMaxAges(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: cp,
+			Id:           0,
+		},
+	}
+
+	tcs := []struct {
+		name       string
+		maxAges    []types.PriceMaxAge
+		expectPass bool
+	}{
+		{
+			"if a price max age is set for a currency-pair not in genesis - fail",
+			[]types.PriceMaxAge{types.NewPriceMaxAge(connecttypes.NewCurrencyPair("CC", "DD"), 10, 0)},
+			false,
+		},
+		{
+			"if a price max age is invalid - fail",
+			[]types.PriceMaxAge{types.NewPriceMaxAge(cp, 0, 0)},
+			false,
+		},
+		{
+			"if all price max ages are valid - pass",
+			[]types.PriceMaxAge{types.NewPriceMaxAge(cp, 10, 60)},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.PriceMaxAges = tc.maxAges
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationPriceHistory(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: cp,
+			Id:           0,
+		},
+	}
+
+	entry := func(cp connecttypes.CurrencyPair, height uint64, price int64) types.PriceHistoryEntry {
+		qp := types.QuotePrice{
+			Price:       math.NewInt(price),
+			BlockHeight: height,
+		}
+
+		return types.PriceHistoryEntry{
+			CurrencyPair: cp,
+			Price:        qp,
+			Accumulator:  types.NewPriceAccumulator(qp),
+		}
+	}
+
+	mismatched := entry(cp, 2, 100)
+	mismatched.Accumulator.BlockHeight = 1
+
+	tcs := []struct {
+		name       string
+		history    []types.PriceHistoryEntry
+		expectPass bool
+	}{
+		{
+			"if a price history entry is set for a currency-pair not in genesis - fail",
+			[]types.PriceHistoryEntry{entry(connecttypes.NewCurrencyPair("CC", "DD"), 1, 100)},
+			false,
+		},
+		{
+			"if a price history entry has no price - fail",
+			[]types.PriceHistoryEntry{{CurrencyPair: cp, Price: types.QuotePrice{BlockHeight: 1}}},
+			false,
+		},
+		{
+			"if a price history entry has a negative price - fail",
+			[]types.PriceHistoryEntry{entry(cp, 1, -100)},
+			false,
+		},
+		{
+			"if a price history entry has no price accumulator - fail",
+			[]types.PriceHistoryEntry{{CurrencyPair: cp, Price: types.QuotePrice{Price: math.NewInt(100), BlockHeight: 1}}},
+			false,
+		},
+		{
+			"if a price history entry has a price accumulator for another height - fail",
+			[]types.PriceHistoryEntry{mismatched},
+			false,
+		},
+		{
+			"if a price history entry is repeated for a height - fail",
+			[]types.PriceHistoryEntry{entry(cp, 1, 100), entry(cp, 1, 110)},
+			false,
+		},
+		{
+			"if all price history entries are valid - pass",
+			[]types.PriceHistoryEntry{entry(cp, 1, 100), entry(cp, 2, 110)},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.PriceHistory = tc.history
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationPriceAccumulators(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: cp,
+			Id:           0,
+		},
+	}
+
+	acc := func(cp connecttypes.CurrencyPair, cumulative int64) types.PriceAccumulatorGenesis {
+		return types.PriceAccumulatorGenesis{
+			CurrencyPair: cp,
+			Accumulator: types.PriceAccumulator{
+				CumulativePrice: math.NewInt(cumulative),
+				Price:           math.NewInt(100),
+				BlockHeight:     1,
+			},
+		}
+	}
+
+	tcs := []struct {
+		name         string
+		accumulators []types.PriceAccumulatorGenesis
+		expectPass   bool
+	}{
+		{
+			"if a price accumulator is set for a currency-pair not in genesis - fail",
+			[]types.PriceAccumulatorGenesis{acc(connecttypes.NewCurrencyPair("CC", "DD"), 0)},
+			false,
+		},
+		{
+			"if a price accumulator has no prices - fail",
+			[]types.PriceAccumulatorGenesis{{CurrencyPair: cp}},
+			false,
+		},
+		{
+			"if a price accumulator has a negative cumulative price - fail",
+			[]types.PriceAccumulatorGenesis{acc(cp, -1)},
+			false,
+		},
+		{
+			"if a price accumulator is repeated - fail",
+			[]types.PriceAccumulatorGenesis{acc(cp, 0), acc(cp, 1)},
+			false,
+		},
+		{
+			"if all price accumulators are valid - pass",
+			[]types.PriceAccumulatorGenesis{acc(cp, 1000)},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.PriceAccumulators = tc.accumulators
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationCircuitBreakerStates(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: cp,
+			Id:           0,
+		},
+	}
+
+	state := func(cp connecttypes.CurrencyPair, heights ...uint64) types.CircuitBreakerStateGenesis {
+		st := types.CircuitBreakerStateGenesis{CurrencyPair: cp}
+		for _, height := range heights {
+			st.State.Samples = append(st.State.Samples, types.PriceSample{BlockHeight: height, Price: math.NewInt(100)})
+		}
+
+		return st
+	}
+
+	tcs := []struct {
+		name       string
+		states     []types.CircuitBreakerStateGenesis
+		expectPass bool
+	}{
+		{
+			"if a circuit breaker state is set for a currency-pair not in genesis - fail",
+			[]types.CircuitBreakerStateGenesis{state(connecttypes.NewCurrencyPair("CC", "DD"), 1)},
+			false,
+		},
+		{
+			"if a price sample has no price - fail",
+			[]types.CircuitBreakerStateGenesis{{
+				CurrencyPair: cp,
+				State:        types.CircuitBreakerState{Samples: []types.PriceSample{{BlockHeight: 1}}},
+			}},
+			false,
+		},
+		{
+			"if a price sample has a negative price - fail",
+			[]types.CircuitBreakerStateGenesis{{
+				CurrencyPair: cp,
+				State:        types.CircuitBreakerState{Samples: []types.PriceSample{{BlockHeight: 1, Price: math.NewInt(-1)}}},
+			}},
+			false,
+		},
+		{
+			"if price samples are not ordered by height - fail",
+			[]types.CircuitBreakerStateGenesis{state(cp, 2, 1)},
+			false,
+		},
+		{
+			"if a circuit breaker state is repeated - fail",
+			[]types.CircuitBreakerStateGenesis{state(cp, 1), state(cp, 2)},
+			false,
+		},
+		{
+			"if a circuit breaker state only bypasses the next price - pass",
+			[]types.CircuitBreakerStateGenesis{{CurrencyPair: cp, State: types.CircuitBreakerState{BypassNext: true}}},
+			true,
+		},
+		{
+			"if all circuit breaker states are valid - pass",
+			[]types.CircuitBreakerStateGenesis{state(cp, 1, 2)},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.CircuitBreakerStates = tc.states
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationParticipation(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: cp,
+			Id:           0,
+		},
+	}
+	val := sdk.ConsAddress("val1").String()
+
+	window := func(cp connecttypes.CurrencyPair, statuses ...types.ParticipationStatus) types.MarketParticipationWindow {
+		return types.MarketParticipationWindow{CurrencyPair: cp, Statuses: statuses}
+	}
+	reported := types.ParticipationStatus_PARTICIPATION_STATUS_REPORTED
+
+	tcs := []struct {
+		name          string
+		participation []types.ValidatorParticipationGenesis
+		expectPass    bool
+	}{
+		{
+			"if the validator is not a consensus address - fail",
+			[]types.ValidatorParticipationGenesis{{Validator: "invalid"}},
+			false,
+		},
+		{
+			"if a participation window is set for a currency-pair not in genesis - fail",
+			[]types.ValidatorParticipationGenesis{{
+				Validator: val,
+				Windows:   []types.MarketParticipationWindow{window(connecttypes.NewCurrencyPair("CC", "DD"), reported)},
+			}},
+			false,
+		},
+		{
+			"if a participation window is longer than the participation window - fail",
+			[]types.ValidatorParticipationGenesis{{
+				Validator: val,
+				Windows:   []types.MarketParticipationWindow{window(cp, reported, reported, reported)},
+			}},
+			false,
+		},
+		{
+			"if a participation window has an unknown status - fail",
+			[]types.ValidatorParticipationGenesis{{
+				Validator: val,
+				Windows:   []types.MarketParticipationWindow{window(cp, types.ParticipationStatus(10))},
+			}},
+			false,
+		},
+		{
+			"if a participation window is repeated - fail",
+			[]types.ValidatorParticipationGenesis{{
+				Validator: val,
+				Windows:   []types.MarketParticipationWindow{window(cp, reported), window(cp, reported)},
+			}},
+			false,
+		},
+		{
+			"if a validator is repeated - fail",
+			[]types.ValidatorParticipationGenesis{{Validator: val}, {Validator: val}},
+			false,
+		},
+		{
+			"if all participation is valid - pass",
+			[]types.ValidatorParticipationGenesis{{
+				Validator: val,
+				Info:      types.ValidatorParticipationInfo{BlocksTracked: 2, LastRecordedHeight: 2},
+				Windows: []types.MarketParticipationWindow{
+					window(cp, reported, types.ParticipationStatus_PARTICIPATION_STATUS_ABSENT),
+				},
+			}},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.Params.ParticipationWindowBlocks = 2
+			gs.Participation = tc.participation
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// ValidatorMissRate is the share of a validator's participation, over a full participation window, in which it
+// failed to report a price, i.e. in which it was absent from the commit or missing a price.
+type ValidatorMissRate struct {
+	// Validator is the consensus address of the validator.
+	Validator sdk.ConsAddress
+
+	// MissRate is the share of the validator's participation counts that were missed.
+	MissRate math.LegacyDec
+
+	// Counts are the validator's participation counts summed over all currency pairs.
+	Counts ParticipationCounts
+}
+
+// NewValidatorMissRate returns the ValidatorMissRate of a validator with the given participation counts. The
+// miss rate of a validator with no participation counts is zero.
+func NewValidatorMissRate(validator sdk.ConsAddress, counts ParticipationCounts) ValidatorMissRate {
+	missRate := math.LegacyZeroDec()
+	if total := counts.Total(); total != 0 {
+		missRate = math.LegacyNewDecFromInt(math.NewIntFromUint64(counts.Absent + counts.MissingPrice)).
+			QuoInt(math.NewIntFromUint64(total))
+	}
+
+	return ValidatorMissRate{
+		Validator: validator,
+		MissRate:  missRate,
+		Counts:    counts,
+	}
+}
+
+// ParticipationHooks is the interface that defines the participation hooks that can be integrated by other modules.
+//
+//go:generate mockery --name ParticipationHooks --output ./mocks/ --case underscore
+type ParticipationHooks interface {
+	// AfterParticipationWindow is called at the end of each participation window, i.e. every
+	// ParticipationWindowBlocks blocks, with the miss rates of the validators that were part of the decided
+	// commit and whose participation window is full.
+	AfterParticipationWindow(ctx sdk.Context, missRates []ValidatorMissRate) error
+}
+
+var _ ParticipationHooks = &MultiParticipationHooks{}
+
+// MultiParticipationHooks defines an array of ParticipationHooks which can be executed in sequence.
+type MultiParticipationHooks []ParticipationHooks
+
+// AfterParticipationWindow calls all AfterParticipationWindow hooks registered to the MultiParticipationHooks.
+func (ph MultiParticipationHooks) AfterParticipationWindow(ctx sdk.Context, missRates []ValidatorMissRate) error {
+	for i := range ph {
+		if err := ph[i].AfterParticipationWindow(ctx, missRates); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ParticipationHooksWrapper is a wrapper for modules to inject ParticipationHooks using depinject.
+type ParticipationHooksWrapper struct{ ParticipationHooks }
+
+var _ ParticipationHooks = &NoopParticipationHooks{}
+
+// NoopParticipationHooks defines participation hooks that are a no-op.
+type NoopParticipationHooks struct{}
+
+func (n *NoopParticipationHooks) AfterParticipationWindow(_ sdk.Context, _ []ValidatorMissRate) error {
+	return nil
+}
+
+// PriceUpdateHooks is the interface that defines the price update hooks that can be integrated by other modules.
+//
+//go:generate mockery --name PriceUpdateHooks --output ./mocks/ --case underscore
+type PriceUpdateHooks interface {
+	// AfterPriceUpdated is called by the price applier after the price of a currency pair aggregated from the vote
+	// extensions is written to state. The old price is nil if the currency pair had no price. Hooks are run in a
+	// cache context: if they return an error, the error is logged and their writes are discarded, but the block
+	// does not fail. Note that prices are also optimistically applied when extending votes, so hooks may be called
+	// on a branch of state that is discarded.
+	AfterPriceUpdated(ctx sdk.Context, cp connecttypes.CurrencyPair, oldPrice *QuotePrice, newPrice QuotePrice) error
+}
+
+var _ PriceUpdateHooks = &MultiPriceUpdateHooks{}
+
+// MultiPriceUpdateHooks defines an array of PriceUpdateHooks which can be executed in sequence.
+type MultiPriceUpdateHooks []PriceUpdateHooks
+
+// AfterPriceUpdated calls all AfterPriceUpdated hooks registered to the MultiPriceUpdateHooks.
+func (ph MultiPriceUpdateHooks) AfterPriceUpdated(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	oldPrice *QuotePrice,
+	newPrice QuotePrice,
+) error {
+	for i := range ph {
+		if err := ph[i].AfterPriceUpdated(ctx, cp, oldPrice, newPrice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PriceUpdateHooksWrapper is a wrapper for modules to inject PriceUpdateHooks using depinject.
+type PriceUpdateHooksWrapper struct{ PriceUpdateHooks }
+
+var _ PriceUpdateHooks = &NoopPriceUpdateHooks{}
+
+// NoopPriceUpdateHooks defines price update hooks that are a no-op.
+type NoopPriceUpdateHooks struct{}
+
+func (n *NoopPriceUpdateHooks) AfterPriceUpdated(_ sdk.Context, _ connecttypes.CurrencyPair, _ *QuotePrice, _ QuotePrice) error {
+	return nil
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
+)
+
+func TestMultiPriceUpdateHooks(t *testing.T) {
+	ctx := sdk.Context{}
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	price := types.QuotePrice{Price: math.NewInt(100)}
+
+	t.Run("all hooks are called in order", func(t *testing.T) {
+		first := mocks.NewPriceUpdateHooks(t)
+		second := mocks.NewPriceUpdateHooks(t)
+
+		var calls []string
+		first.On("AfterPriceUpdated", ctx, cp, (*types.QuotePrice)(nil), price).Return(nil).Run(func(_ mock.Arguments) {
+			calls = append(calls, "first")
+		}).Once()
+		second.On("AfterPriceUpdated", ctx, cp, (*types.QuotePrice)(nil), price).Return(nil).Run(func(_ mock.Arguments) {
+			calls = append(calls, "second")
+		}).Once()
+
+		hooks := types.MultiPriceUpdateHooks{first, second}
+		require.NoError(t, hooks.AfterPriceUpdated(ctx, cp, nil, price))
+		require.Equal(t, []string{"first", "second"}, calls)
+	})
+
+	t.Run("an error stops execution", func(t *testing.T) {
+		first := mocks.NewPriceUpdateHooks(t)
+		second := mocks.NewPriceUpdateHooks(t)
+		first.On("AfterPriceUpdated", ctx, cp, (*types.QuotePrice)(nil), price).Return(errors.New("error")).Once()
+
+		hooks := types.MultiPriceUpdateHooks{first, second}
+		require.Error(t, hooks.AfterPriceUpdated(ctx, cp, nil, price))
+	})
+}
@@ -33,6 +33,58 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// ParamsKeyPrefix is the key-prefix under which the module parameters are stored.
+	ParamsKeyPrefix = collections.NewPrefix(6)
+
+	// MarketQuorumKeyPrefix is the key-prefix under which per-currency-pair quorums are stored.
+	MarketQuorumKeyPrefix = collections.NewPrefix(7)
+
+	// CircuitBreakerKeyPrefix is the key-prefix under which per-currency-pair circuit breakers are stored.
+	CircuitBreakerKeyPrefix = collections.NewPrefix(8)
+
+	// CircuitBreakerSampleKeyPrefix is the key-prefix under which the PriceSamples in the rolling window of each
+	// circuit breaker are stored.
+	CircuitBreakerSampleKeyPrefix = collections.NewPrefix(9)
+
+	// MarketHaltKeyPrefix is the key-prefix under which halted currency-pairs are stored.
+	MarketHaltKeyPrefix = collections.NewPrefix(10)
+
+	// ParticipationInfoKeyPrefix is the key-prefix under which the participation tracking state of each validator
+	// is stored.
+	ParticipationInfoKeyPrefix = collections.NewPrefix(11)
+
+	// ParticipationCountsKeyPrefix is the key-prefix under which the participation counts of each validator are
+	// stored.
+	ParticipationCountsKeyPrefix = collections.NewPrefix(12)
+
+	// ParticipationSlotKeyPrefix is the key-prefix under which the participation record of each validator is stored
+	// for each block in the participation window.
+	ParticipationSlotKeyPrefix = collections.NewPrefix(13)
+
+	// PriceHistoryKeyPrefix is the key-prefix under which the historical prices of each currency-pair are stored.
+	PriceHistoryKeyPrefix = collections.NewPrefix(14)
+
+	// PriceHistoryCountKeyPrefix is the key-prefix under which the number of historical prices stored for each
+	// currency-pair is stored.
+	PriceHistoryCountKeyPrefix = collections.NewPrefix(15)
+
+	// PriceAccumulatorKeyPrefix is the key-prefix under which the latest PriceAccumulator of each currency-pair is stored.
+	PriceAccumulatorKeyPrefix = collections.NewPrefix(16)
+
+	// PriceAccumulatorHistoryKeyPrefix is the key-prefix under which the historical PriceAccumulators of each
+	// currency-pair are stored.
+	PriceAccumulatorHistoryKeyPrefix = collections.NewPrefix(17)
+
+	// PriceMaxAgeKeyPrefix is the key-prefix under which the per-currency-pair PriceMaxAges are stored.
+	PriceMaxAgeKeyPrefix = collections.NewPrefix(18)
+
+	// RemovedCurrencyPairKeyPrefix is the key-prefix under which the CPs removed in the previous block are stored.
+	RemovedCurrencyPairKeyPrefix = collections.NewPrefix(19)
+
+	// CircuitBreakerBypassKeyPrefix is the key-prefix under which the currency-pairs whose next price update bypasses
+	// their circuit breaker are stored.
+	CircuitBreakerBypassKeyPrefix = collections.NewPrefix(20)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
 )
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// NewMarketQuorum returns a new MarketQuorum for the given currency pair.
+func NewMarketQuorum(cp connecttypes.CurrencyPair, powerThresholdBps, minValidators uint32) MarketQuorum {
+	return MarketQuorum{
+		CurrencyPair:      cp,
+		PowerThresholdBps: powerThresholdBps,
+		MinValidators:     minValidators,
+	}
+}
+
+// ValidateBasic performs stateless validation of the MarketQuorum. This fails if the CurrencyPair is
+// invalid, or if the power threshold is zero or exceeds 100%.
+func (q *MarketQuorum) ValidateBasic() error {
+	if err := q.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if q.PowerThresholdBps == 0 {
+		return fmt.Errorf("power threshold for %s must be non-zero", q.CurrencyPair)
+	}
+
+	if q.PowerThresholdBps > MaxBps {
+		return fmt.Errorf("power threshold of %d bps for %s exceeds %d bps", q.PowerThresholdBps, q.CurrencyPair, MaxBps)
+	}
+
+	return nil
+}
+
+// PowerThreshold returns the share of the total bonded stake that must report a price for the currency pair
+// for it to be aggregated.
+func (q *MarketQuorum) PowerThreshold() math.LegacyDec {
+	return BpsToDec(q.PowerThresholdBps)
+}
+
+// ValidateMarketQuorums validates each of the given MarketQuorums, and checks that no currency pair is
+// repeated.
+func ValidateMarketQuorums(quorums []MarketQuorum) error {
+	seen := make(map[string]struct{}, len(quorums))
+	for _, q := range quorums {
+		if err := q.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[q.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated market quorum for currency-pair: %s", q.CurrencyPair)
+		}
+
+		seen[q.CurrencyPair.String()] = struct{}{}
+	}
+
+	return nil
+}
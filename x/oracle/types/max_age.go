@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// NewPriceMaxAge returns a new PriceMaxAge for the given currency pair.
+func NewPriceMaxAge(cp connecttypes.CurrencyPair, maxAgeBlocks, maxAgeSeconds uint64) PriceMaxAge {
+	return PriceMaxAge{
+		CurrencyPair:  cp,
+		MaxAgeBlocks:  maxAgeBlocks,
+		MaxAgeSeconds: maxAgeSeconds,
+	}
+}
+
+// ValidateBasic performs stateless validation of the PriceMaxAge. This fails if the CurrencyPair is invalid, or if
+// neither max age is set.
+func (m *PriceMaxAge) ValidateBasic() error {
+	if err := m.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if m.MaxAgeBlocks == 0 && m.MaxAgeSeconds == 0 {
+		return fmt.Errorf("price max age for %s must set a max age in blocks or seconds", m.CurrencyPair)
+	}
+
+	return nil
+}
+
+// CheckPrice returns a StalePriceError if the QuotePrice was updated more than MaxAgeBlocks blocks or MaxAgeSeconds
+// seconds before the given block height and time.
+func (m *PriceMaxAge) CheckPrice(qp QuotePrice, height int64, blockTime time.Time) error {
+	ageBlocks := uint64(0)
+	if uint64(height) > qp.BlockHeight { //nolint:gosec
+		ageBlocks = uint64(height) - qp.BlockHeight //nolint:gosec
+	}
+
+	age := blockTime.Sub(qp.BlockTimestamp)
+	if (m.MaxAgeBlocks != 0 && ageBlocks > m.MaxAgeBlocks) ||
+		(m.MaxAgeSeconds != 0 && age > time.Duration(m.MaxAgeSeconds)*time.Second) { //nolint:gosec
+		return NewStalePriceError(*m, ageBlocks, age)
+	}
+
+	return nil
+}
+
+// ValidatePriceMaxAges validates each of the given PriceMaxAges, and checks that no currency pair is repeated.
+func ValidatePriceMaxAges(maxAges []PriceMaxAge) error {
+	seen := make(map[string]struct{}, len(maxAges))
+	for _, m := range maxAges {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[m.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated price max age for currency-pair: %s", m.CurrencyPair)
+		}
+
+		seen[m.CurrencyPair.String()] = struct{}{}
+	}
+
+	return nil
+}
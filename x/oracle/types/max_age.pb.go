@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/max_age.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/skip-mev/connect/v2/pkg/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceMaxAge defines how recently the price of a currency pair must have been
+// updated, relative to the current block, for it to be considered fresh. It is
+// the default policy enforced when reading prices with a max age, so that
+// every integrating module applies the same policy.
+type PriceMaxAge struct {
+	// CurrencyPair is the currency pair that this max age applies to.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// MaxAgeBlocks is the maximum number of blocks since the price was updated.
+	// A value of zero disables the check.
+	MaxAgeBlocks uint64 `protobuf:"varint,2,opt,name=max_age_blocks,json=maxAgeBlocks,proto3" json:"max_age_blocks,omitempty"`
+	// MaxAgeSeconds is the maximum number of seconds since the price was
+	// updated, measured in block time. A value of zero disables the check.
+	MaxAgeSeconds uint64 `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+}
+
+func (m *PriceMaxAge) Reset()         { *m = PriceMaxAge{} }
+func (m *PriceMaxAge) String() string { return proto.CompactTextString(m) }
+func (*PriceMaxAge) ProtoMessage()    {}
+func (*PriceMaxAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfa6477f2b5aa980, []int{0}
+}
+func (m *PriceMaxAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMaxAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMaxAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceMaxAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMaxAge.Merge(m, src)
+}
+func (m *PriceMaxAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceMaxAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMaxAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceMaxAge proto.InternalMessageInfo
+
+func (m *PriceMaxAge) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *PriceMaxAge) GetMaxAgeBlocks() uint64 {
+	if m != nil {
+		return m.MaxAgeBlocks
+	}
+	return 0
+}
+
+func (m *PriceMaxAge) GetMaxAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PriceMaxAge)(nil), "connect.oracle.v2.PriceMaxAge")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/max_age.proto", fileDescriptor_dfa6477f2b5aa980) }
+
+var fileDescriptor_dfa6477f2b5aa980 = []byte{
+	// 276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0xcf, 0xcb,
+	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd2, 0xcf, 0x4d,
+	0xac, 0x88, 0x4f, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x2a, 0xd0,
+	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
+	0x10, 0x85, 0x52, 0x2a, 0x30, 0x93, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x41, 0x06, 0x25, 0x97, 0x16,
+	0x15, 0xa5, 0xe6, 0x25, 0x57, 0xc6, 0x17, 0x24, 0x66, 0x16, 0x41, 0x54, 0x29, 0x2d, 0x63, 0xe4,
+	0xe2, 0x0e, 0x28, 0xca, 0x4c, 0x4e, 0xf5, 0x4d, 0xac, 0x70, 0x4c, 0x4f, 0x15, 0xf2, 0xe4, 0xe2,
+	0x45, 0x51, 0x26, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa7, 0x07, 0xb3, 0x16, 0x6c, 0x9a,
+	0x5e, 0x99, 0x91, 0x9e, 0x33, 0x54, 0x59, 0x40, 0x62, 0x66, 0x91, 0x13, 0xcb, 0x89, 0x7b, 0xf2,
+	0x0c, 0x41, 0x3c, 0xc9, 0x48, 0x62, 0x42, 0x2a, 0x5c, 0x7c, 0x50, 0xa7, 0xc7, 0x27, 0xe5, 0xe4,
+	0x27, 0x67, 0x17, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x04, 0xf1, 0xe4, 0x82, 0xad, 0x72, 0x02,
+	0x8b, 0x09, 0xa9, 0x71, 0xf1, 0xc3, 0x54, 0x15, 0xa7, 0x26, 0xe7, 0xe7, 0xa5, 0x14, 0x4b, 0x30,
+	0x83, 0x95, 0xf1, 0x42, 0x94, 0x05, 0x43, 0x04, 0x9d, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
+	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
+	0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
+	0xbf, 0x38, 0x3b, 0xb3, 0x40, 0x37, 0x37, 0xb5, 0x4c, 0x1f, 0xe6, 0xf9, 0x32, 0x23, 0xfd, 0x0a,
+	0x58, 0x58, 0x82, 0x9d, 0x9e, 0xc4, 0x06, 0xf6, 0xb8, 0x31, 0x60, 0x00, 0x48, 0x78, 0x80, 0xbb,
+	0x6a, 0x01, 0x00, 0x00,
+}
+
+func (m *PriceMaxAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMaxAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMaxAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAgeSeconds != 0 {
+		i = encodeVarintMaxAge(dAtA, i, uint64(m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAgeBlocks != 0 {
+		i = encodeVarintMaxAge(dAtA, i, uint64(m.MaxAgeBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaxAge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMaxAge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMaxAge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceMaxAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovMaxAge(uint64(l))
+	if m.MaxAgeBlocks != 0 {
+		n += 1 + sovMaxAge(uint64(m.MaxAgeBlocks))
+	}
+	if m.MaxAgeSeconds != 0 {
+		n += 1 + sovMaxAge(uint64(m.MaxAgeSeconds))
+	}
+	return n
+}
+
+func sovMaxAge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMaxAge(x uint64) (n int) {
+	return sovMaxAge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceMaxAge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaxAge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceMaxAge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceMaxAge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaxAge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaxAge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaxAge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeBlocks", wireType)
+			}
+			m.MaxAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaxAge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
+			}
+			m.MaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaxAge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaxAge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaxAge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMaxAge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMaxAge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMaxAge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMaxAge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMaxAge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMaxAge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMaxAge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMaxAge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMaxAge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMaxAge = fmt.Errorf("proto: unexpected end of group")
+)
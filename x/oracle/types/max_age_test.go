@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestPriceMaxAgeValidateBasic(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+
+	require.Error(t, (&types.PriceMaxAge{CurrencyPair: connecttypes.CurrencyPair{Base: "AA"}, MaxAgeBlocks: 1}).ValidateBasic())
+	require.Error(t, (&types.PriceMaxAge{CurrencyPair: cp}).ValidateBasic())
+
+	blocks := types.NewPriceMaxAge(cp, 1, 0)
+	require.NoError(t, blocks.ValidateBasic())
+
+	seconds := types.NewPriceMaxAge(cp, 0, 1)
+	require.NoError(t, seconds.ValidateBasic())
+
+	require.Error(t, types.ValidatePriceMaxAges([]types.PriceMaxAge{blocks, seconds}))
+	require.NoError(t, types.ValidatePriceMaxAges([]types.PriceMaxAge{blocks}))
+}
+
+func TestPriceMaxAgeCheckPrice(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	updated := time.Unix(1_000, 0).UTC()
+	qp := types.QuotePrice{Price: math.NewInt(100), BlockHeight: 10, BlockTimestamp: updated}
+
+	tcs := []struct {
+		name      string
+		maxAge    types.PriceMaxAge
+		height    int64
+		blockTime time.Time
+		fresh     bool
+	}{
+		{
+			"within the max age in blocks - fresh",
+			types.NewPriceMaxAge(cp, 5, 0),
+			15,
+			updated.Add(time.Hour),
+			true,
+		},
+		{
+			"beyond the max age in blocks - stale",
+			types.NewPriceMaxAge(cp, 5, 0),
+			16,
+			updated,
+			false,
+		},
+		{
+			"within the max age in seconds - fresh",
+			types.NewPriceMaxAge(cp, 0, 60),
+			1_000,
+			updated.Add(time.Minute),
+			true,
+		},
+		{
+			"beyond the max age in seconds - stale",
+			types.NewPriceMaxAge(cp, 0, 60),
+			10,
+			updated.Add(time.Minute + time.Millisecond),
+			false,
+		},
+		{
+			"both max ages must be met - stale",
+			types.NewPriceMaxAge(cp, 5, 60),
+			11,
+			updated.Add(2 * time.Minute),
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.maxAge.CheckPrice(qp, tc.height, tc.blockTime)
+			if tc.fresh {
+				require.NoError(t, err)
+				return
+			}
+
+			var stale types.StalePriceError
+			require.True(t, errors.As(err, &stale))
+			require.Equal(t, tc.maxAge, stale.MaxAge)
+		})
+	}
+}
@@ -23,6 +23,64 @@ func (_m *MarketMapKeeper) EXPECT() *MarketMapKeeper_Expecter {
 	return &MarketMapKeeper_Expecter{mock: &_m.Mock}
 }
 
+// GetAllMarkets provides a mock function with given fields: ctx
+func (_m *MarketMapKeeper) GetAllMarkets(ctx context.Context) (map[string]types.Market, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllMarkets")
+	}
+
+	var r0 map[string]types.Market
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]types.Market, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]types.Market); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]types.Market)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketMapKeeper_GetAllMarkets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllMarkets'
+type MarketMapKeeper_GetAllMarkets_Call struct {
+	*mock.Call
+}
+
+// GetAllMarkets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MarketMapKeeper_Expecter) GetAllMarkets(ctx interface{}) *MarketMapKeeper_GetAllMarkets_Call {
+	return &MarketMapKeeper_GetAllMarkets_Call{Call: _e.mock.On("GetAllMarkets", ctx)}
+}
+
+func (_c *MarketMapKeeper_GetAllMarkets_Call) Run(run func(ctx context.Context)) *MarketMapKeeper_GetAllMarkets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MarketMapKeeper_GetAllMarkets_Call) Return(_a0 map[string]types.Market, _a1 error) *MarketMapKeeper_GetAllMarkets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketMapKeeper_GetAllMarkets_Call) RunAndReturn(run func(context.Context) (map[string]types.Market, error)) *MarketMapKeeper_GetAllMarkets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMarket provides a mock function with given fields: ctx, tickerStr
 func (_m *MarketMapKeeper) GetMarket(ctx context.Context, tickerStr string) (types.Market, error) {
 	ret := _m.Called(ctx, tickerStr)
@@ -80,6 +138,62 @@ func (_c *MarketMapKeeper_GetMarket_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetParams provides a mock function with given fields: ctx
+func (_m *MarketMapKeeper) GetParams(ctx context.Context) (types.Params, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParams")
+	}
+
+	var r0 types.Params
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (types.Params, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) types.Params); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(types.Params)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketMapKeeper_GetParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParams'
+type MarketMapKeeper_GetParams_Call struct {
+	*mock.Call
+}
+
+// GetParams is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MarketMapKeeper_Expecter) GetParams(ctx interface{}) *MarketMapKeeper_GetParams_Call {
+	return &MarketMapKeeper_GetParams_Call{Call: _e.mock.On("GetParams", ctx)}
+}
+
+func (_c *MarketMapKeeper_GetParams_Call) Run(run func(ctx context.Context)) *MarketMapKeeper_GetParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MarketMapKeeper_GetParams_Call) Return(_a0 types.Params, _a1 error) *MarketMapKeeper_GetParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketMapKeeper_GetParams_Call) RunAndReturn(run func(context.Context) (types.Params, error)) *MarketMapKeeper_GetParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMarketMapKeeper creates a new instance of MarketMapKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMarketMapKeeper(t interface {
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	types "github.com/cosmos/cosmos-sdk/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ParticipationHooks is an autogenerated mock type for the ParticipationHooks type
+type ParticipationHooks struct {
+	mock.Mock
+}
+
+type ParticipationHooks_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ParticipationHooks) EXPECT() *ParticipationHooks_Expecter {
+	return &ParticipationHooks_Expecter{mock: &_m.Mock}
+}
+
+// AfterParticipationWindow provides a mock function with given fields: ctx, missRates
+func (_m *ParticipationHooks) AfterParticipationWindow(ctx types.Context, missRates []oracletypes.ValidatorMissRate) error {
+	ret := _m.Called(ctx, missRates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterParticipationWindow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, []oracletypes.ValidatorMissRate) error); ok {
+		r0 = rf(ctx, missRates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ParticipationHooks_AfterParticipationWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterParticipationWindow'
+type ParticipationHooks_AfterParticipationWindow_Call struct {
+	*mock.Call
+}
+
+// AfterParticipationWindow is a helper method to define mock.On call
+//   - ctx types.Context
+//   - missRates []oracletypes.ValidatorMissRate
+func (_e *ParticipationHooks_Expecter) AfterParticipationWindow(ctx interface{}, missRates interface{}) *ParticipationHooks_AfterParticipationWindow_Call {
+	return &ParticipationHooks_AfterParticipationWindow_Call{Call: _e.mock.On("AfterParticipationWindow", ctx, missRates)}
+}
+
+func (_c *ParticipationHooks_AfterParticipationWindow_Call) Run(run func(ctx types.Context, missRates []oracletypes.ValidatorMissRate)) *ParticipationHooks_AfterParticipationWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].([]oracletypes.ValidatorMissRate))
+	})
+	return _c
+}
+
+func (_c *ParticipationHooks_AfterParticipationWindow_Call) Return(_a0 error) *ParticipationHooks_AfterParticipationWindow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParticipationHooks_AfterParticipationWindow_Call) RunAndReturn(run func(types.Context, []oracletypes.ValidatorMissRate) error) *ParticipationHooks_AfterParticipationWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewParticipationHooks creates a new instance of ParticipationHooks. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewParticipationHooks(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ParticipationHooks {
+	mock := &ParticipationHooks{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
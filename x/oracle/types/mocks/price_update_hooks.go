@@ -0,0 +1,87 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceUpdateHooks is an autogenerated mock type for the PriceUpdateHooks type
+type PriceUpdateHooks struct {
+	mock.Mock
+}
+
+type PriceUpdateHooks_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PriceUpdateHooks) EXPECT() *PriceUpdateHooks_Expecter {
+	return &PriceUpdateHooks_Expecter{mock: &_m.Mock}
+}
+
+// AfterPriceUpdated provides a mock function with given fields: ctx, cp, oldPrice, newPrice
+func (_m *PriceUpdateHooks) AfterPriceUpdated(ctx types.Context, cp pkgtypes.CurrencyPair, oldPrice *oracletypes.QuotePrice, newPrice oracletypes.QuotePrice) error {
+	ret := _m.Called(ctx, cp, oldPrice, newPrice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterPriceUpdated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, *oracletypes.QuotePrice, oracletypes.QuotePrice) error); ok {
+		r0 = rf(ctx, cp, oldPrice, newPrice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceUpdateHooks_AfterPriceUpdated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterPriceUpdated'
+type PriceUpdateHooks_AfterPriceUpdated_Call struct {
+	*mock.Call
+}
+
+// AfterPriceUpdated is a helper method to define mock.On call
+//   - ctx types.Context
+//   - cp pkgtypes.CurrencyPair
+//   - oldPrice *oracletypes.QuotePrice
+//   - newPrice oracletypes.QuotePrice
+func (_e *PriceUpdateHooks_Expecter) AfterPriceUpdated(ctx interface{}, cp interface{}, oldPrice interface{}, newPrice interface{}) *PriceUpdateHooks_AfterPriceUpdated_Call {
+	return &PriceUpdateHooks_AfterPriceUpdated_Call{Call: _e.mock.On("AfterPriceUpdated", ctx, cp, oldPrice, newPrice)}
+}
+
+func (_c *PriceUpdateHooks_AfterPriceUpdated_Call) Run(run func(ctx types.Context, cp pkgtypes.CurrencyPair, oldPrice *oracletypes.QuotePrice, newPrice oracletypes.QuotePrice)) *PriceUpdateHooks_AfterPriceUpdated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(pkgtypes.CurrencyPair), args[2].(*oracletypes.QuotePrice), args[3].(oracletypes.QuotePrice))
+	})
+	return _c
+}
+
+func (_c *PriceUpdateHooks_AfterPriceUpdated_Call) Return(_a0 error) *PriceUpdateHooks_AfterPriceUpdated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceUpdateHooks_AfterPriceUpdated_Call) RunAndReturn(run func(types.Context, pkgtypes.CurrencyPair, *oracletypes.QuotePrice, oracletypes.QuotePrice) error) *PriceUpdateHooks_AfterPriceUpdated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPriceUpdateHooks creates a new instance of PriceUpdateHooks. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceUpdateHooks(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceUpdateHooks {
+	mock := &PriceUpdateHooks{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
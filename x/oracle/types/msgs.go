@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -9,6 +11,14 @@ import (
 var (
 	_ sdk.Msg = &MsgAddCurrencyPairs{}
 	_ sdk.Msg = &MsgRemoveCurrencyPairs{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetMarketQuorums{}
+	_ sdk.Msg = &MsgRemoveMarketQuorums{}
+	_ sdk.Msg = &MsgSetCircuitBreakers{}
+	_ sdk.Msg = &MsgRemoveCircuitBreakers{}
+	_ sdk.Msg = &MsgClearMarketHalts{}
+	_ sdk.Msg = &MsgSetPriceMaxAges{}
+	_ sdk.Msg = &MsgRemovePriceMaxAges{}
 )
 
 // NewMsgAddCurrencyPairs returns a new message from a set of currency-pairs and an authority.
@@ -65,3 +75,163 @@ func (m *MsgRemoveCurrencyPairs) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateParams returns a new message to update the x/oracle module's parameters.
+func NewMsgUpdateParams(authority string, params Params) MsgUpdateParams {
+	return MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, and that the Params are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	// validate authority address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	return m.Params.ValidateBasic()
+}
+
+// NewMsgSetMarketQuorums returns a new message to set per-currency-pair quorums in the x/oracle module.
+func NewMsgSetMarketQuorums(authority string, quorums []MarketQuorum) MsgSetMarketQuorums {
+	return MsgSetMarketQuorums{
+		Authority:     authority,
+		MarketQuorums: quorums,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, and that each MarketQuorum is valid and set at most once.
+func (m *MsgSetMarketQuorums) ValidateBasic() error {
+	// validate authority address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.MarketQuorums) == 0 {
+		return fmt.Errorf("market quorums cannot be empty")
+	}
+
+	return ValidateMarketQuorums(m.MarketQuorums)
+}
+
+// NewMsgRemoveMarketQuorums returns a new message to remove the quorums set for a set of currency-pairs.
+func NewMsgRemoveMarketQuorums(authority string, currencyPairIDs []string) MsgRemoveMarketQuorums {
+	return MsgRemoveMarketQuorums{
+		Authority:       authority,
+		CurrencyPairIds: currencyPairIDs,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that each CurrencyPairID in the message is formatted correctly.
+func (m *MsgRemoveMarketQuorums) ValidateBasic() error {
+	return validateAuthorityAndCurrencyPairIDs(m.Authority, m.CurrencyPairIds)
+}
+
+// NewMsgSetCircuitBreakers returns a new message to set per-currency-pair circuit breakers in the x/oracle module.
+func NewMsgSetCircuitBreakers(authority string, cbs []CircuitBreaker) MsgSetCircuitBreakers {
+	return MsgSetCircuitBreakers{
+		Authority:       authority,
+		CircuitBreakers: cbs,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, and that each CircuitBreaker is valid and set at most once.
+func (m *MsgSetCircuitBreakers) ValidateBasic() error {
+	// validate authority address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.CircuitBreakers) == 0 {
+		return fmt.Errorf("circuit breakers cannot be empty")
+	}
+
+	return ValidateCircuitBreakers(m.CircuitBreakers)
+}
+
+// NewMsgRemoveCircuitBreakers returns a new message to remove the circuit breakers set for a set of currency-pairs.
+func NewMsgRemoveCircuitBreakers(authority string, currencyPairIDs []string) MsgRemoveCircuitBreakers {
+	return MsgRemoveCircuitBreakers{
+		Authority:       authority,
+		CurrencyPairIds: currencyPairIDs,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that each CurrencyPairID in the message is formatted correctly.
+func (m *MsgRemoveCircuitBreakers) ValidateBasic() error {
+	return validateAuthorityAndCurrencyPairIDs(m.Authority, m.CurrencyPairIds)
+}
+
+// NewMsgClearMarketHalts returns a new message to resume price updates for a set of halted currency-pairs.
+func NewMsgClearMarketHalts(authority string, currencyPairIDs []string) MsgClearMarketHalts {
+	return MsgClearMarketHalts{
+		Authority:       authority,
+		CurrencyPairIds: currencyPairIDs,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that each CurrencyPairID in the message is formatted correctly.
+func (m *MsgClearMarketHalts) ValidateBasic() error {
+	return validateAuthorityAndCurrencyPairIDs(m.Authority, m.CurrencyPairIds)
+}
+
+// NewMsgSetPriceMaxAges returns a new message to set per-currency-pair default price max ages in the x/oracle module.
+func NewMsgSetPriceMaxAges(authority string, maxAges []PriceMaxAge) MsgSetPriceMaxAges {
+	return MsgSetPriceMaxAges{
+		Authority:    authority,
+		PriceMaxAges: maxAges,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, and that each PriceMaxAge is valid and set at most once.
+func (m *MsgSetPriceMaxAges) ValidateBasic() error {
+	// validate authority address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.PriceMaxAges) == 0 {
+		return fmt.Errorf("price max ages cannot be empty")
+	}
+
+	return ValidatePriceMaxAges(m.PriceMaxAges)
+}
+
+// NewMsgRemovePriceMaxAges returns a new message to remove the default price max ages set for a set of currency-pairs.
+func NewMsgRemovePriceMaxAges(authority string, currencyPairIDs []string) MsgRemovePriceMaxAges {
+	return MsgRemovePriceMaxAges{
+		Authority:       authority,
+		CurrencyPairIds: currencyPairIDs,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that each CurrencyPairID in the message is formatted correctly.
+func (m *MsgRemovePriceMaxAges) ValidateBasic() error {
+	return validateAuthorityAndCurrencyPairIDs(m.Authority, m.CurrencyPairIds)
+}
+
+func validateAuthorityAndCurrencyPairIDs(authority string, currencyPairIDs []string) error {
+	// validate authority address
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return err
+	}
+
+	// check that each CurrencyPairID is correctly formatted
+	for _, id := range currencyPairIDs {
+		if _, err := connecttypes.CurrencyPairFromString(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -105,3 +105,199 @@ func TestValidateBasicMsgRemoveCurrencyPairs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgSetMarketQuorums(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgSetMarketQuorums
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgSetMarketQuorums("abc", []types.MarketQuorum{types.NewMarketQuorum(cp, 5_000, 1)}),
+			false,
+		},
+		{
+			"if there are no quorums - fail",
+			types.NewMsgSetMarketQuorums(sdk.AccAddress("abc").String(), nil),
+			false,
+		},
+		{
+			"if any of the quorums are invalid - fail",
+			types.NewMsgSetMarketQuorums(sdk.AccAddress("abc").String(), []types.MarketQuorum{
+				types.NewMarketQuorum(cp, types.MaxBps+1, 1),
+			}),
+			false,
+		},
+		{
+			"if a currency pair is repeated - fail",
+			types.NewMsgSetMarketQuorums(sdk.AccAddress("abc").String(), []types.MarketQuorum{
+				types.NewMarketQuorum(cp, 5_000, 1),
+				types.NewMarketQuorum(cp, 6_000, 2),
+			}),
+			false,
+		},
+		{
+			"if all quorums are valid + authority is valid - pass",
+			types.NewMsgSetMarketQuorums(sdk.AccAddress("abc").String(), []types.MarketQuorum{
+				types.NewMarketQuorum(cp, 5_000, 1),
+				types.NewMarketQuorum(connecttypes.NewCurrencyPair("CC", "DD"), types.MaxBps, 0),
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgRemoveMarketQuorums(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgRemoveMarketQuorums
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgRemoveMarketQuorums("abc", nil),
+			false,
+		},
+		{
+			"if any of the currency pairs are invalid - fail",
+			types.NewMsgRemoveMarketQuorums(sdk.AccAddress("abc").String(), []string{"AA"}),
+			false,
+		},
+		{
+			"if all currency pairs are valid + authority is valid - pass",
+			types.NewMsgRemoveMarketQuorums(sdk.AccAddress("abc").String(), []string{
+				connecttypes.CurrencyPairString("A", "B"),
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgSetCircuitBreakers(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("AA", "BB")
+	cb := types.NewCircuitBreaker(cp, 1_000, 0, 0, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT)
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgSetCircuitBreakers
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgSetCircuitBreakers("abc", []types.CircuitBreaker{cb}),
+			false,
+		},
+		{
+			"if there are no circuit breakers - fail",
+			types.NewMsgSetCircuitBreakers(sdk.AccAddress("abc").String(), nil),
+			false,
+		},
+		{
+			"if a circuit breaker is repeated - fail",
+			types.NewMsgSetCircuitBreakers(sdk.AccAddress("abc").String(), []types.CircuitBreaker{cb, cb}),
+			false,
+		},
+		{
+			"if all circuit breakers are valid + authority is valid - pass",
+			types.NewMsgSetCircuitBreakers(sdk.AccAddress("abc").String(), []types.CircuitBreaker{cb}),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgClearMarketHalts(t *testing.T) {
+	require.Error(t, (&types.MsgClearMarketHalts{Authority: "abc"}).ValidateBasic())
+
+	msg := types.NewMsgClearMarketHalts(sdk.AccAddress("abc").String(), []string{"AA"})
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.NewMsgClearMarketHalts(sdk.AccAddress("abc").String(), []string{connecttypes.CurrencyPairString("A", "B")})
+	require.NoError(t, msg.ValidateBasic())
+
+	remove := types.NewMsgRemoveCircuitBreakers(sdk.AccAddress("abc").String(), []string{connecttypes.CurrencyPairString("A", "B")})
+	require.NoError(t, remove.ValidateBasic())
+}
+
+func TestValidateBasicMsgSetPriceMaxAges(t *testing.T) {
+	maxAge := types.NewPriceMaxAge(connecttypes.NewCurrencyPair("AA", "BB"), 10, 0)
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgSetPriceMaxAges
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgSetPriceMaxAges("abc", []types.PriceMaxAge{maxAge}),
+			false,
+		},
+		{
+			"if there are no price max ages - fail",
+			types.NewMsgSetPriceMaxAges(sdk.AccAddress("abc").String(), nil),
+			false,
+		},
+		{
+			"if a price max age is repeated - fail",
+			types.NewMsgSetPriceMaxAges(sdk.AccAddress("abc").String(), []types.PriceMaxAge{maxAge, maxAge}),
+			false,
+		},
+		{
+			"if all price max ages are valid + authority is valid - pass",
+			types.NewMsgSetPriceMaxAges(sdk.AccAddress("abc").String(), []types.PriceMaxAge{maxAge}),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+
+	remove := types.NewMsgRemovePriceMaxAges(sdk.AccAddress("abc").String(), []string{"AA"})
+	require.Error(t, remove.ValidateBasic())
+
+	remove = types.NewMsgRemovePriceMaxAges(sdk.AccAddress("abc").String(), []string{connecttypes.CurrencyPairString("A", "B")})
+	require.NoError(t, remove.ValidateBasic())
+}
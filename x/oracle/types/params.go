@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultVoteExtensionVersion is the vote extension version used by default. Vote extensions
+// created before versioning was introduced carry no version, and are therefore decoded as this
+// version.
+const DefaultVoteExtensionVersion uint32 = 0
+
+const (
+	// MaxBps is the number of basis points that make up 100%.
+	MaxBps uint32 = 10_000
+
+	// MaxTrimBps is the exclusive upper bound on the share of stake that may be trimmed from each tail
+	// when aggregating prices with a stake-weighted trimmed mean.
+	MaxTrimBps uint32 = MaxBps / 2
+
+	// MaxPriceHistoryLength is the maximum number of historical prices that may be retained per currency pair.
+	MaxPriceHistoryLength uint64 = 10_000
+)
+
+// DefaultPowerThreshold is the share of the total bonded stake that must report a price for a currency
+// pair when no power threshold is set in the params.
+var DefaultPowerThreshold = math.LegacyNewDecWithPrec(667, 3)
+
+// DefaultParams returns default oracle parameters.
+func DefaultParams() Params {
+	return Params{
+		VoteExtensionVersion: DefaultVoteExtensionVersion,
+	}
+}
+
+// NewParams returns a new Params instance.
+func NewParams(version, previousVersion uint32, transitionHeight uint64) Params {
+	return Params{
+		VoteExtensionVersion:          version,
+		PreviousVoteExtensionVersion:  previousVersion,
+		VoteExtensionTransitionHeight: transitionHeight,
+	}
+}
+
+// ValidateBasic performs stateless validation of the Params.
+func (p *Params) ValidateBasic() error {
+	if p.VoteExtensionTransitionHeight == 0 && p.PreviousVoteExtensionVersion != 0 {
+		return fmt.Errorf("previous vote extension version %d set without a transition height", p.PreviousVoteExtensionVersion)
+	}
+
+	if p.VoteExtensionTransitionHeight != 0 && p.PreviousVoteExtensionVersion == p.VoteExtensionVersion {
+		return fmt.Errorf("previous vote extension version must differ from the current version %d", p.VoteExtensionVersion)
+	}
+
+	if _, ok := AggregationMethod_name[int32(p.AggregationMethod)]; !ok {
+		return fmt.Errorf("unknown aggregation method %d", p.AggregationMethod)
+	}
+
+	if p.PowerThresholdBps > MaxBps {
+		return fmt.Errorf("power threshold of %d bps exceeds %d bps", p.PowerThresholdBps, MaxBps)
+	}
+
+	if p.TrimBps >= MaxTrimBps {
+		return fmt.Errorf("trim of %d bps must be less than %d bps", p.TrimBps, MaxTrimBps)
+	}
+
+	if _, ok := PriceUpdateEventMode_name[int32(p.PriceUpdateEvents)]; !ok {
+		return fmt.Errorf("unknown price update event mode %d", p.PriceUpdateEvents)
+	}
+
+	if p.PriceHistoryLength > MaxPriceHistoryLength {
+		return fmt.Errorf("price history length of %d exceeds %d", p.PriceHistoryLength, MaxPriceHistoryLength)
+	}
+
+	if p.ParticipationWindowBlocks > MaxParticipationWindowBlocks {
+		return fmt.Errorf(
+			"participation window of %d blocks exceeds %d blocks", p.ParticipationWindowBlocks, MaxParticipationWindowBlocks,
+		)
+	}
+
+	return nil
+}
+
+// PowerThreshold returns the share of the total bonded stake that must report a price for a currency pair
+// for it to be aggregated.
+func (p *Params) PowerThreshold() math.LegacyDec {
+	if p.PowerThresholdBps == 0 {
+		return DefaultPowerThreshold
+	}
+
+	return BpsToDec(p.PowerThresholdBps)
+}
+
+// BpsToDec converts a value in basis points to a decimal.
+func BpsToDec(bps uint32) math.LegacyDec {
+	return math.LegacyNewDec(int64(bps)).QuoInt64(int64(MaxBps))
+}
+
+// InVoteExtensionTransition returns true if the given height falls within the window in which
+// vote extensions may be created with either the current or the previous vote extension version.
+func (p *Params) InVoteExtensionTransition(height int64) bool {
+	return height >= 0 && uint64(height) < p.VoteExtensionTransitionHeight
+}
+
+// IsVoteExtensionVersionAccepted returns true if a vote extension with the given version, created
+// at the given height, is accepted. Note that the height is the height the vote extension was
+// created at, i.e. the previous height for the vote extensions of an extended commit, so that the
+// vote extensions created just before the transition height are accepted at the transition height.
+func (p *Params) IsVoteExtensionVersionAccepted(version uint32, height int64) bool {
+	if version == p.VoteExtensionVersion {
+		return true
+	}
+
+	return version == p.PreviousVoteExtensionVersion && p.InVoteExtensionTransition(height)
+}
+
+// IsPriceAgeExceeded returns true if a price reported with the given age (in milliseconds) is older
+// than the maximum price age allowed by the params. A zero maximum price age disables the check.
+func (p *Params) IsPriceAgeExceeded(ageMs uint32) bool {
+	return p.MaxPriceAgeMs != 0 && ageMs > p.MaxPriceAgeMs
+}
@@ -0,0 +1,1122 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/skip-mev/connect/v2/pkg/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AggregationMethod defines the function used to aggregate the prices reported
+// by validators in their vote extensions into a single on-chain price.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN computes the stake-weighted median
+	// of the reported prices. This is the default.
+	AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN AggregationMethod = 0
+	// AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN computes the stake-weighted
+	// mean of the reported prices after trimming TrimBps of the reporting stake
+	// from each tail.
+	AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN AggregationMethod = 1
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN",
+	1: "AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN":       0,
+	"AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN": 1,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3529c71237e76268, []int{0}
+}
+
+// PriceUpdateEventMode defines the events emitted when prices are written to
+// state.
+type PriceUpdateEventMode int32
+
+const (
+	// PRICE_UPDATE_EVENT_MODE_DISABLED emits no price update events. This is the
+	// default.
+	PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_DISABLED PriceUpdateEventMode = 0
+	// PRICE_UPDATE_EVENT_MODE_PER_PRICE emits an EventPriceUpdate for each price
+	// written to state.
+	PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_PER_PRICE PriceUpdateEventMode = 1
+	// PRICE_UPDATE_EVENT_MODE_AGGREGATED emits a single EventPriceUpdates per
+	// block with all of the prices written to state.
+	PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_AGGREGATED PriceUpdateEventMode = 2
+)
+
+var PriceUpdateEventMode_name = map[int32]string{
+	0: "PRICE_UPDATE_EVENT_MODE_DISABLED",
+	1: "PRICE_UPDATE_EVENT_MODE_PER_PRICE",
+	2: "PRICE_UPDATE_EVENT_MODE_AGGREGATED",
+}
+
+var PriceUpdateEventMode_value = map[string]int32{
+	"PRICE_UPDATE_EVENT_MODE_DISABLED":   0,
+	"PRICE_UPDATE_EVENT_MODE_PER_PRICE":  1,
+	"PRICE_UPDATE_EVENT_MODE_AGGREGATED": 2,
+}
+
+func (x PriceUpdateEventMode) String() string {
+	return proto.EnumName(PriceUpdateEventMode_name, int32(x))
+}
+
+func (PriceUpdateEventMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3529c71237e76268, []int{1}
+}
+
+// Params defines the parameters for the x/oracle module.
+type Params struct {
+	// VoteExtensionVersion is the version of the vote extension (codec +
+	// currency pair strategy) that validators are expected to produce.
+	VoteExtensionVersion uint32 `protobuf:"varint,1,opt,name=vote_extension_version,json=voteExtensionVersion,proto3" json:"vote_extension_version,omitempty"`
+	// PreviousVoteExtensionVersion is the vote extension version that is being
+	// phased out. Vote extensions with this version are accepted alongside the
+	// current version until the VoteExtensionTransitionHeight is reached.
+	PreviousVoteExtensionVersion uint32 `protobuf:"varint,2,opt,name=previous_vote_extension_version,json=previousVoteExtensionVersion,proto3" json:"previous_vote_extension_version,omitempty"`
+	// VoteExtensionTransitionHeight is the block height (exclusive) until which
+	// vote extensions created with either the current or previous vote extension
+	// version are accepted. Vote extensions are checked against the height they
+	// were created at, so vote extensions created at the previous height are
+	// still accepted at the transition height. A value of zero indicates that no
+	// transition is in progress.
+	VoteExtensionTransitionHeight uint64 `protobuf:"varint,3,opt,name=vote_extension_transition_height,json=voteExtensionTransitionHeight,proto3" json:"vote_extension_transition_height,omitempty"`
+	// MaxPriceAgeMs is the maximum age, in milliseconds, of a price reported in
+	// a vote extension for it to be included in price aggregation. Prices that
+	// do not report an age are always included. A value of zero disables the
+	// check.
+	MaxPriceAgeMs uint32 `protobuf:"varint,4,opt,name=max_price_age_ms,json=maxPriceAgeMs,proto3" json:"max_price_age_ms,omitempty"`
+	// AggregationMethod is the function used to aggregate validator prices.
+	AggregationMethod AggregationMethod `protobuf:"varint,5,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=connect.oracle.v2.AggregationMethod" json:"aggregation_method,omitempty"`
+	// PowerThresholdBps is the minimum share of the total bonded stake, in basis
+	// points, that must report a price for a currency pair for it to be
+	// aggregated. A value of zero uses the default threshold of 2/3.
+	PowerThresholdBps uint32 `protobuf:"varint,6,opt,name=power_threshold_bps,json=powerThresholdBps,proto3" json:"power_threshold_bps,omitempty"`
+	// MinValidators is the minimum number of distinct validators that must report
+	// a price for a currency pair for it to be aggregated, in addition to the
+	// power threshold. A value of zero disables the check.
+	MinValidators uint32 `protobuf:"varint,7,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// TrimBps is the share of the reporting stake, in basis points, that is
+	// trimmed from each tail when using the stake-weighted trimmed mean. Must be
+	// less than 5000.
+	TrimBps uint32 `protobuf:"varint,8,opt,name=trim_bps,json=trimBps,proto3" json:"trim_bps,omitempty"`
+	// ParticipationWindowBlocks is the number of blocks, counted per validator
+	// over the blocks in which it was part of the decided commit, over which
+	// validator participation in price updates is tracked. A value of zero
+	// disables participation tracking. Changing this value resets all tracked
+	// participation.
+	ParticipationWindowBlocks uint64 `protobuf:"varint,9,opt,name=participation_window_blocks,json=participationWindowBlocks,proto3" json:"participation_window_blocks,omitempty"`
+	// ParticipationDeviationBps is the deviation, in basis points, from the
+	// aggregated price above which a validator's reported price is recorded as
+	// deviated. A value of zero disables the check.
+	ParticipationDeviationBps uint32 `protobuf:"varint,10,opt,name=participation_deviation_bps,json=participationDeviationBps,proto3" json:"participation_deviation_bps,omitempty"`
+	// PriceUpdateEvents controls the events emitted when prices are written to
+	// state.
+	PriceUpdateEvents PriceUpdateEventMode `protobuf:"varint,11,opt,name=price_update_events,json=priceUpdateEvents,proto3,enum=connect.oracle.v2.PriceUpdateEventMode" json:"price_update_events,omitempty"`
+	// PriceHistoryLength is the number of historical prices, and the
+	// PriceAccumulators used to compute TWAPs over past intervals, retained per
+	// currency pair. Older prices are pruned at the beginning of each block. A
+	// value of zero disables price history.
+	PriceHistoryLength uint64 `protobuf:"varint,12,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	// MaxVoteExtensionBytes is the maximum size, in bytes, of an encoded vote
+	// extension. Validators drop the prices of their lowest priority markets,
+	// as given by the priority in the marketmap ticker metadata, until their
+	// vote extension fits, and vote extensions exceeding the size are rejected.
+	// A value of zero disables the limit.
+	MaxVoteExtensionBytes uint32 `protobuf:"varint,13,opt,name=max_vote_extension_bytes,json=maxVoteExtensionBytes,proto3" json:"max_vote_extension_bytes,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3529c71237e76268, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetVoteExtensionVersion() uint32 {
+	if m != nil {
+		return m.VoteExtensionVersion
+	}
+	return 0
+}
+
+func (m *Params) GetPreviousVoteExtensionVersion() uint32 {
+	if m != nil {
+		return m.PreviousVoteExtensionVersion
+	}
+	return 0
+}
+
+func (m *Params) GetVoteExtensionTransitionHeight() uint64 {
+	if m != nil {
+		return m.VoteExtensionTransitionHeight
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceAgeMs() uint32 {
+	if m != nil {
+		return m.MaxPriceAgeMs
+	}
+	return 0
+}
+
+func (m *Params) GetAggregationMethod() AggregationMethod {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN
+}
+
+func (m *Params) GetPowerThresholdBps() uint32 {
+	if m != nil {
+		return m.PowerThresholdBps
+	}
+	return 0
+}
+
+func (m *Params) GetMinValidators() uint32 {
+	if m != nil {
+		return m.MinValidators
+	}
+	return 0
+}
+
+func (m *Params) GetTrimBps() uint32 {
+	if m != nil {
+		return m.TrimBps
+	}
+	return 0
+}
+
+func (m *Params) GetParticipationWindowBlocks() uint64 {
+	if m != nil {
+		return m.ParticipationWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetParticipationDeviationBps() uint32 {
+	if m != nil {
+		return m.ParticipationDeviationBps
+	}
+	return 0
+}
+
+func (m *Params) GetPriceUpdateEvents() PriceUpdateEventMode {
+	if m != nil {
+		return m.PriceUpdateEvents
+	}
+	return PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_DISABLED
+}
+
+func (m *Params) GetPriceHistoryLength() uint64 {
+	if m != nil {
+		return m.PriceHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVoteExtensionBytes() uint32 {
+	if m != nil {
+		return m.MaxVoteExtensionBytes
+	}
+	return 0
+}
+
+// MarketQuorum defines the quorum that validator votes for a single currency
+// pair must reach for a price to be aggregated. When set for a currency pair,
+// it replaces the module-wide PowerThresholdBps and MinValidators in Params.
+type MarketQuorum struct {
+	// CurrencyPair is the currency pair that this quorum applies to.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// PowerThresholdBps is the minimum share of the total bonded stake, in basis
+	// points, that must report a price for the currency pair. Must be non-zero.
+	PowerThresholdBps uint32 `protobuf:"varint,2,opt,name=power_threshold_bps,json=powerThresholdBps,proto3" json:"power_threshold_bps,omitempty"`
+	// MinValidators is the minimum number of distinct validators that must report
+	// a price for the currency pair. A value of zero disables the check.
+	MinValidators uint32 `protobuf:"varint,3,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+}
+
+func (m *MarketQuorum) Reset()         { *m = MarketQuorum{} }
+func (m *MarketQuorum) String() string { return proto.CompactTextString(m) }
+func (*MarketQuorum) ProtoMessage()    {}
+func (*MarketQuorum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3529c71237e76268, []int{1}
+}
+func (m *MarketQuorum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketQuorum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketQuorum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketQuorum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketQuorum.Merge(m, src)
+}
+func (m *MarketQuorum) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketQuorum) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketQuorum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketQuorum proto.InternalMessageInfo
+
+func (m *MarketQuorum) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *MarketQuorum) GetPowerThresholdBps() uint32 {
+	if m != nil {
+		return m.PowerThresholdBps
+	}
+	return 0
+}
+
+func (m *MarketQuorum) GetMinValidators() uint32 {
+	if m != nil {
+		return m.MinValidators
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("connect.oracle.v2.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
+	proto.RegisterEnum("connect.oracle.v2.PriceUpdateEventMode", PriceUpdateEventMode_name, PriceUpdateEventMode_value)
+	proto.RegisterType((*Params)(nil), "connect.oracle.v2.Params")
+	proto.RegisterType((*MarketQuorum)(nil), "connect.oracle.v2.MarketQuorum")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/params.proto", fileDescriptor_3529c71237e76268) }
+
+var fileDescriptor_3529c71237e76268 = []byte{
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0xc7, 0xad, 0x34, 0x4b, 0x3b, 0x36, 0x29, 0x6c, 0xd6, 0x1b, 0xd4, 0x6e, 0x53, 0xbd, 0x20,
+	0x5d, 0x8d, 0x62, 0x95, 0x07, 0x6f, 0xc0, 0x6e, 0x03, 0xe4, 0x8a, 0xb0, 0x8d, 0x55, 0x8e, 0xa7,
+	0xa8, 0x0e, 0xb0, 0x0b, 0x41, 0xcb, 0x84, 0x44, 0xc4, 0x12, 0x05, 0x92, 0x56, 0xec, 0xeb, 0xae,
+	0xbb, 0xec, 0x4f, 0xd9, 0x9f, 0xd1, 0x63, 0x8e, 0x3b, 0x0d, 0x43, 0xf2, 0x8f, 0x0c, 0xa2, 0xa2,
+	0x6c, 0x76, 0x6c, 0x6c, 0x27, 0xcb, 0xef, 0xfb, 0x79, 0x8f, 0xef, 0x17, 0x09, 0xac, 0x90, 0xa7,
+	0x29, 0x0d, 0x55, 0x87, 0x0b, 0x12, 0xce, 0x69, 0x27, 0xef, 0x76, 0x32, 0x22, 0x48, 0x22, 0xed,
+	0x4c, 0x70, 0xc5, 0x61, 0xe3, 0x56, 0xb7, 0x4b, 0xdd, 0xce, 0xbb, 0xcf, 0x9b, 0x11, 0x8f, 0xb8,
+	0x56, 0x3b, 0xc5, 0x57, 0x09, 0x3e, 0x3f, 0xa9, 0x02, 0xa9, 0x55, 0x46, 0x65, 0x11, 0x27, 0x5c,
+	0x08, 0x41, 0xd3, 0x70, 0x85, 0x33, 0xc2, 0x44, 0x49, 0x1d, 0xff, 0x72, 0x00, 0x0e, 0xc6, 0x3a,
+	0x3e, 0xfc, 0x0e, 0x7c, 0x9a, 0x73, 0x45, 0x31, 0x5d, 0x2a, 0x9a, 0x4a, 0xc6, 0x53, 0x9c, 0x53,
+	0x51, 0xfc, 0x9a, 0x46, 0xcb, 0x68, 0x1f, 0xf9, 0xcd, 0x42, 0x45, 0x95, 0x38, 0x29, 0x35, 0x88,
+	0xc0, 0x8b, 0x4c, 0xd0, 0x9c, 0xf1, 0x85, 0xc4, 0x3b, 0xdc, 0xf7, 0xb4, 0xfb, 0xe7, 0x15, 0x36,
+	0xd9, 0x16, 0xa6, 0x0f, 0x5a, 0x1b, 0xde, 0x4a, 0x90, 0x54, 0x32, 0x55, 0x7c, 0xc6, 0x94, 0x45,
+	0xb1, 0x32, 0x1f, 0xb4, 0x8c, 0xf6, 0xbe, 0xff, 0xc5, 0x5a, 0x1a, 0xc1, 0x1d, 0x35, 0xd0, 0x10,
+	0x7c, 0x05, 0xea, 0x09, 0x59, 0xe2, 0x4c, 0xb0, 0x90, 0x62, 0x12, 0x51, 0x9c, 0x48, 0x73, 0x5f,
+	0x27, 0x70, 0x94, 0x90, 0xe5, 0xb8, 0x30, 0x3b, 0x11, 0xf5, 0x24, 0x3c, 0x03, 0x90, 0x44, 0x91,
+	0xa0, 0x11, 0xd1, 0x67, 0x24, 0x54, 0xc5, 0x7c, 0x66, 0x7e, 0xd4, 0x32, 0xda, 0x4f, 0xba, 0x27,
+	0xf6, 0xbd, 0x2e, 0xdb, 0xce, 0x3f, 0xb0, 0xa7, 0x59, 0xbf, 0x41, 0x36, 0x4d, 0xd0, 0x06, 0x4f,
+	0x33, 0x7e, 0x49, 0x05, 0x56, 0xb1, 0xa0, 0x32, 0xe6, 0xf3, 0x19, 0x9e, 0x66, 0xd2, 0x3c, 0xd0,
+	0x09, 0x34, 0xb4, 0x14, 0x54, 0x4a, 0x2f, 0x93, 0xf0, 0x25, 0x78, 0x92, 0xb0, 0x14, 0xe7, 0x64,
+	0xce, 0x66, 0x44, 0x71, 0x21, 0xcd, 0x87, 0xb7, 0xb9, 0xb2, 0x74, 0x72, 0x67, 0x84, 0xcf, 0xc0,
+	0x23, 0x25, 0x58, 0xa2, 0x63, 0x3d, 0xd2, 0xc0, 0xc3, 0xe2, 0x7f, 0x11, 0xe1, 0x07, 0xf0, 0x59,
+	0x46, 0x84, 0x62, 0x21, 0xcb, 0xca, 0x42, 0x2e, 0x59, 0x3a, 0xe3, 0x97, 0x78, 0x3a, 0xe7, 0xe1,
+	0x85, 0x34, 0x3f, 0xd6, 0x3d, 0x7b, 0xb6, 0x86, 0x9c, 0x6b, 0xa2, 0xa7, 0x81, 0xfb, 0xfe, 0x33,
+	0x9a, 0xb3, 0xf2, 0xab, 0x38, 0x0d, 0xe8, 0xd3, 0xd6, 0xfd, 0xdd, 0x8a, 0x28, 0xce, 0x3f, 0x07,
+	0x4f, 0xcb, 0x5e, 0x2f, 0xb2, 0x19, 0x29, 0x06, 0x98, 0xd3, 0x54, 0x49, 0xf3, 0xb1, 0xee, 0xe3,
+	0xab, 0x2d, 0x7d, 0xd4, 0x23, 0x78, 0xaf, 0x61, 0x54, 0xb0, 0x1e, 0x9f, 0x51, 0xbf, 0x91, 0x6d,
+	0x58, 0x25, 0xfc, 0x06, 0x34, 0xcb, 0xc0, 0x31, 0x93, 0x8a, 0x8b, 0x15, 0x9e, 0xd3, 0x34, 0x52,
+	0xb1, 0x79, 0xa8, 0x2b, 0x82, 0x5a, 0x1b, 0x94, 0xd2, 0x3b, 0xad, 0xc0, 0xef, 0x81, 0x59, 0x8c,
+	0x7e, 0x63, 0x8f, 0xa6, 0x2b, 0x45, 0xa5, 0x79, 0xa4, 0xeb, 0xf8, 0x24, 0x21, 0xcb, 0xb5, 0xf5,
+	0xeb, 0x15, 0xe2, 0xf1, 0xef, 0x06, 0x38, 0xf4, 0x88, 0xb8, 0xa0, 0xea, 0xa7, 0x05, 0x17, 0x8b,
+	0x04, 0x0e, 0xc1, 0xd1, 0xda, 0x65, 0xd1, 0x37, 0xe0, 0x71, 0xd7, 0xba, 0x2b, 0x47, 0xdf, 0xa9,
+	0xa2, 0x9a, 0xb7, 0xb7, 0xd8, 0x98, 0x30, 0xd1, 0xdb, 0xff, 0xf0, 0xe7, 0x8b, 0x9a, 0x7f, 0x18,
+	0xfe, 0xcb, 0xb6, 0x6b, 0x23, 0xf6, 0xfe, 0xff, 0x46, 0x3c, 0xd8, 0xb2, 0x11, 0xaf, 0x17, 0xa0,
+	0x71, 0x6f, 0x21, 0xe1, 0xd7, 0xa0, 0xed, 0xf4, 0xfb, 0x3e, 0xea, 0x3b, 0xc1, 0xf0, 0x74, 0x84,
+	0x3d, 0x14, 0x0c, 0x4e, 0x5d, 0x7c, 0x16, 0x38, 0x3f, 0x22, 0x7c, 0x8e, 0x86, 0xfd, 0x41, 0x80,
+	0x5c, 0xec, 0x21, 0x77, 0xe8, 0x8c, 0xea, 0x35, 0xd8, 0x05, 0xf6, 0x7f, 0xd3, 0x81, 0x3f, 0xf4,
+	0x3c, 0xed, 0xe5, 0x8c, 0xea, 0xc6, 0xeb, 0x5f, 0x0d, 0xd0, 0xdc, 0x36, 0x40, 0x78, 0x02, 0x5a,
+	0x63, 0x7f, 0xf8, 0x16, 0xe1, 0xf7, 0x63, 0xd7, 0x09, 0x10, 0x46, 0x13, 0x34, 0x0a, 0xb0, 0x77,
+	0xea, 0x22, 0xec, 0x0e, 0xcf, 0x9c, 0xde, 0x3b, 0xe4, 0xd6, 0x6b, 0xf0, 0x25, 0xf8, 0x72, 0x17,
+	0x35, 0x46, 0x3e, 0xd6, 0x5a, 0xdd, 0x80, 0x5f, 0x81, 0xe3, 0x5d, 0x58, 0x95, 0x31, 0x72, 0xeb,
+	0x7b, 0xbd, 0xfe, 0x87, 0x6b, 0xcb, 0xb8, 0xba, 0xb6, 0x8c, 0xbf, 0xae, 0x2d, 0xe3, 0xb7, 0x1b,
+	0xab, 0x76, 0x75, 0x63, 0xd5, 0xfe, 0xb8, 0xb1, 0x6a, 0x3f, 0xbf, 0x89, 0x98, 0x8a, 0x17, 0x53,
+	0x3b, 0xe4, 0x49, 0x47, 0x5e, 0xb0, 0xec, 0x4d, 0x42, 0xf3, 0x4e, 0xf5, 0x20, 0xe6, 0xdd, 0xce,
+	0xb2, 0x7a, 0x5e, 0xf5, 0x20, 0xa7, 0x07, 0xfa, 0x31, 0xfc, 0xf6, 0xef, 0x01, 0x00, 0x09, 0x82,
+	0xae, 0xf5, 0x7d, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxVoteExtensionBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVoteExtensionBytes))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PriceHistoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceHistoryLength))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PriceUpdateEvents != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceUpdateEvents))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ParticipationDeviationBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParticipationDeviationBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ParticipationWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParticipationWindowBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TrimBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TrimBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinValidators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinValidators))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PowerThresholdBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PowerThresholdBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AggregationMethod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AggregationMethod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPriceAgeMs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAgeMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VoteExtensionTransitionHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteExtensionTransitionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousVoteExtensionVersion != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PreviousVoteExtensionVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VoteExtensionVersion != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteExtensionVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketQuorum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketQuorum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketQuorum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinValidators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PowerThresholdBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PowerThresholdBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteExtensionVersion != 0 {
+		n += 1 + sovParams(uint64(m.VoteExtensionVersion))
+	}
+	if m.PreviousVoteExtensionVersion != 0 {
+		n += 1 + sovParams(uint64(m.PreviousVoteExtensionVersion))
+	}
+	if m.VoteExtensionTransitionHeight != 0 {
+		n += 1 + sovParams(uint64(m.VoteExtensionTransitionHeight))
+	}
+	if m.MaxPriceAgeMs != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAgeMs))
+	}
+	if m.AggregationMethod != 0 {
+		n += 1 + sovParams(uint64(m.AggregationMethod))
+	}
+	if m.PowerThresholdBps != 0 {
+		n += 1 + sovParams(uint64(m.PowerThresholdBps))
+	}
+	if m.MinValidators != 0 {
+		n += 1 + sovParams(uint64(m.MinValidators))
+	}
+	if m.TrimBps != 0 {
+		n += 1 + sovParams(uint64(m.TrimBps))
+	}
+	if m.ParticipationWindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ParticipationWindowBlocks))
+	}
+	if m.ParticipationDeviationBps != 0 {
+		n += 1 + sovParams(uint64(m.ParticipationDeviationBps))
+	}
+	if m.PriceUpdateEvents != 0 {
+		n += 1 + sovParams(uint64(m.PriceUpdateEvents))
+	}
+	if m.PriceHistoryLength != 0 {
+		n += 1 + sovParams(uint64(m.PriceHistoryLength))
+	}
+	if m.MaxVoteExtensionBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxVoteExtensionBytes))
+	}
+	return n
+}
+
+func (m *MarketQuorum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.PowerThresholdBps != 0 {
+		n += 1 + sovParams(uint64(m.PowerThresholdBps))
+	}
+	if m.MinValidators != 0 {
+		n += 1 + sovParams(uint64(m.MinValidators))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionVersion", wireType)
+			}
+			m.VoteExtensionVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteExtensionVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVoteExtensionVersion", wireType)
+			}
+			m.PreviousVoteExtensionVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousVoteExtensionVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionTransitionHeight", wireType)
+			}
+			m.VoteExtensionTransitionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteExtensionTransitionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAgeMs", wireType)
+			}
+			m.MaxPriceAgeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAgeMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+			}
+			m.AggregationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerThresholdBps", wireType)
+			}
+			m.PowerThresholdBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerThresholdBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+			}
+			m.MinValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimBps", wireType)
+			}
+			m.TrimBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrimBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationWindowBlocks", wireType)
+			}
+			m.ParticipationWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipationWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationDeviationBps", wireType)
+			}
+			m.ParticipationDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipationDeviationBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUpdateEvents", wireType)
+			}
+			m.PriceUpdateEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceUpdateEvents |= PriceUpdateEventMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+			}
+			m.PriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteExtensionBytes", wireType)
+			}
+			m.MaxVoteExtensionBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteExtensionBytes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketQuorum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketQuorum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketQuorum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerThresholdBps", wireType)
+			}
+			m.PowerThresholdBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerThresholdBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+			}
+			m.MinValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
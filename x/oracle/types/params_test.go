@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	tcs := []struct {
+		name       string
+		params     types.Params
+		expectPass bool
+	}{
+		{
+			"default params - pass",
+			types.DefaultParams(),
+			true,
+		},
+		{
+			"previous version without a transition height - fail",
+			types.NewParams(1, 2, 0),
+			false,
+		},
+		{
+			"previous version equal to the current version - fail",
+			types.NewParams(1, 1, 100),
+			false,
+		},
+		{
+			"valid transition - pass",
+			types.NewParams(1, 0, 100),
+			true,
+		},
+		{
+			"unknown aggregation method - fail",
+			types.Params{AggregationMethod: types.AggregationMethod(100)},
+			false,
+		},
+		{
+			"trimmed mean aggregation - pass",
+			types.Params{
+				AggregationMethod: types.AggregationMethod_AGGREGATION_METHOD_STAKE_WEIGHTED_TRIMMED_MEAN,
+				PowerThresholdBps: types.MaxBps,
+				MinValidators:     3,
+				TrimBps:           types.MaxTrimBps - 1,
+			},
+			true,
+		},
+		{
+			"power threshold above 100% - fail",
+			types.Params{PowerThresholdBps: types.MaxBps + 1},
+			false,
+		},
+		{
+			"trimming half of the stake - fail",
+			types.Params{TrimBps: types.MaxTrimBps},
+			false,
+		},
+		{
+			"aggregated price update events - pass",
+			types.Params{PriceUpdateEvents: types.PriceUpdateEventMode_PRICE_UPDATE_EVENT_MODE_AGGREGATED},
+			true,
+		},
+		{
+			"unknown price update event mode - fail",
+			types.Params{PriceUpdateEvents: types.PriceUpdateEventMode(100)},
+			false,
+		},
+		{
+			"price history at the maximum length - pass",
+			types.Params{PriceHistoryLength: types.MaxPriceHistoryLength},
+			true,
+		},
+		{
+			"price history above the maximum length - fail",
+			types.Params{PriceHistoryLength: types.MaxPriceHistoryLength + 1},
+			false,
+		},
+		{
+			"participation window at the maximum - pass",
+			types.Params{ParticipationWindowBlocks: types.MaxParticipationWindowBlocks, ParticipationDeviationBps: 100},
+			true,
+		},
+		{
+			"participation window above the maximum - fail",
+			types.Params{ParticipationWindowBlocks: types.MaxParticipationWindowBlocks + 1},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsIsVoteExtensionVersionAccepted(t *testing.T) {
+	params := types.NewParams(2, 1, 100)
+
+	// the current version is always accepted
+	require.True(t, params.IsVoteExtensionVersionAccepted(2, 50))
+	require.True(t, params.IsVoteExtensionVersionAccepted(2, 150))
+
+	// the previous version is only accepted for vote extensions created before the transition height
+	require.True(t, params.IsVoteExtensionVersionAccepted(1, 99))
+	require.False(t, params.IsVoteExtensionVersionAccepted(1, 100))
+
+	// unknown versions are never accepted
+	require.False(t, params.IsVoteExtensionVersionAccepted(0, 50))
+}
+
+func TestParamsIsPriceAgeExceeded(t *testing.T) {
+	params := types.DefaultParams()
+
+	// a zero max price age disables the check
+	require.False(t, params.IsPriceAgeExceeded(1_000_000))
+
+	params.MaxPriceAgeMs = 1000
+	require.False(t, params.IsPriceAgeExceeded(0))
+	require.False(t, params.IsPriceAgeExceeded(1000))
+	require.True(t, params.IsPriceAgeExceeded(1001))
+}
+
+func TestParamsPowerThreshold(t *testing.T) {
+	params := types.DefaultParams()
+
+	// an unset power threshold falls back to the default
+	require.Equal(t, types.DefaultPowerThreshold, params.PowerThreshold())
+
+	params.PowerThresholdBps = 5_000
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), params.PowerThreshold())
+}
+
+func TestBpsToDec(t *testing.T) {
+	require.Equal(t, math.LegacyZeroDec(), types.BpsToDec(0))
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 4), types.BpsToDec(1))
+	require.Equal(t, math.LegacyOneDec(), types.BpsToDec(types.MaxBps))
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"math/big"
+	"sort"
+)
+
+// MaxParticipationWindowBlocks is the maximum number of blocks in a validator's participation window. This bounds
+// the number of ParticipationRecords stored per validator.
+const MaxParticipationWindowBlocks uint64 = 10_000
+
+// ClassifyParticipation returns the ParticipationStatus of a validator for a single currency pair in a block. A
+// validator whose vote was not included in the commit is absent, a validator that did not report a price is missing
+// a price, and a validator whose price deviates from the final price by more than deviationBps is deviated. A zero
+// deviationBps, or a missing final price, disables the deviation check.
+func ClassifyParticipation(absent bool, price, finalPrice *big.Int, deviationBps uint32) ParticipationStatus {
+	switch {
+	case absent:
+		return ParticipationStatus_PARTICIPATION_STATUS_ABSENT
+	case price == nil:
+		return ParticipationStatus_PARTICIPATION_STATUS_MISSING_PRICE
+	case deviationBps != 0 && finalPrice != nil && finalPrice.Sign() > 0 && exceedsDeviation(price, finalPrice, deviationBps):
+		return ParticipationStatus_PARTICIPATION_STATUS_DEVIATED
+	default:
+		return ParticipationStatus_PARTICIPATION_STATUS_REPORTED
+	}
+}
+
+// exceedsDeviation returns true if |price - ref| * MaxBps > ref * bps, i.e. if price deviates from ref by more
+// than bps basis points.
+func exceedsDeviation(price, ref *big.Int, bps uint32) bool {
+	diff := new(big.Int).Sub(price, ref)
+	diff.Abs(diff).Mul(diff, big.NewInt(int64(MaxBps)))
+
+	bound := new(big.Int).Mul(ref, big.NewInt(int64(bps)))
+	return diff.Cmp(bound) > 0
+}
+
+// Add adds delta to the count of the given status. Unknown statuses are ignored, and counts never go below zero.
+func (c *ParticipationCounts) Add(status ParticipationStatus, delta int64) {
+	var count *uint64
+	switch status {
+	case ParticipationStatus_PARTICIPATION_STATUS_REPORTED:
+		count = &c.Reported
+	case ParticipationStatus_PARTICIPATION_STATUS_ABSENT:
+		count = &c.Absent
+	case ParticipationStatus_PARTICIPATION_STATUS_MISSING_PRICE:
+		count = &c.MissingPrice
+	case ParticipationStatus_PARTICIPATION_STATUS_DEVIATED:
+		count = &c.Deviated
+	default:
+		return
+	}
+
+	if delta < 0 && uint64(-delta) > *count {
+		*count = 0
+		return
+	}
+
+	*count = uint64(int64(*count) + delta) //nolint:gosec
+}
+
+// Total returns the number of blocks counted across all statuses.
+func (c *ParticipationCounts) Total() uint64 {
+	return c.Reported + c.Absent + c.MissingPrice + c.Deviated
+}
+
+// Merge adds the counts of other to the counts.
+func (c *ParticipationCounts) Merge(other ParticipationCounts) {
+	c.Reported += other.Reported
+	c.Absent += other.Absent
+	c.MissingPrice += other.MissingPrice
+	c.Deviated += other.Deviated
+}
+
+// Set sets the ParticipationStatus of the currency pair with the given x/oracle ID. Currency pairs must be set in
+// ascending order of ID.
+func (r *ParticipationRecord) Set(id uint64, status ParticipationStatus) {
+	r.CurrencyPairIds = append(r.CurrencyPairIds, id)
+	r.Statuses = append(r.Statuses, byte(status)) //nolint:gosec
+}
+
+// Len returns the number of currency pairs in the record.
+func (r *ParticipationRecord) Len() int {
+	return min(len(r.CurrencyPairIds), len(r.Statuses))
+}
+
+// Status returns the x/oracle ID and ParticipationStatus of the i-th currency pair in the record.
+func (r *ParticipationRecord) Status(i int) (uint64, ParticipationStatus) {
+	return r.CurrencyPairIds[i], ParticipationStatus(r.Statuses[i])
+}
+
+// AddRecord adds delta to the count of the status of each currency pair in the given ParticipationRecord. Currency
+// pairs that are no longer counted, i.e. that have been removed, are ignored when removing a record, and currency
+// pairs whose counts drop to zero are removed.
+func (c *ValidatorParticipationCounts) AddRecord(record ParticipationRecord, delta int64) {
+	index := make(map[uint64]int, len(c.Markets))
+	for i, m := range c.Markets {
+		index[m.CurrencyPairId] = i
+	}
+
+	for i := 0; i < record.Len(); i++ {
+		id, status := record.Status(i)
+		j, ok := index[id]
+		if !ok {
+			if delta < 0 {
+				continue
+			}
+
+			c.Markets = append(c.Markets, MarketParticipationCounts{CurrencyPairId: id})
+			j = len(c.Markets) - 1
+			index[id] = j
+		}
+
+		c.Markets[j].Counts.Add(status, delta)
+	}
+
+	markets := c.Markets[:0]
+	for _, m := range c.Markets {
+		if m.Counts.Total() > 0 {
+			markets = append(markets, m)
+		}
+	}
+
+	sort.Slice(markets, func(i, j int) bool {
+		return markets[i].CurrencyPairId < markets[j].CurrencyPairId
+	})
+	c.Markets = markets
+}
+
+// Get returns the participation counts of the currency pair with the given x/oracle ID, and whether it is counted.
+func (c *ValidatorParticipationCounts) Get(id uint64) (ParticipationCounts, bool) {
+	i := sort.Search(len(c.Markets), func(i int) bool {
+		return c.Markets[i].CurrencyPairId >= id
+	})
+	if i < len(c.Markets) && c.Markets[i].CurrencyPairId == id {
+		return c.Markets[i].Counts, true
+	}
+
+	return ParticipationCounts{}, false
+}
+
+// Remove removes the participation counts of the currency pair with the given x/oracle ID, and returns whether it
+// was counted.
+func (c *ValidatorParticipationCounts) Remove(id uint64) bool {
+	for i, m := range c.Markets {
+		if m.CurrencyPairId == id {
+			c.Markets = append(c.Markets[:i], c.Markets[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Total returns the participation counts summed across all currency pairs.
+func (c *ValidatorParticipationCounts) Total() ParticipationCounts {
+	var total ParticipationCounts
+	for _, m := range c.Markets {
+		total.Merge(m.Counts)
+	}
+
+	return total
+}
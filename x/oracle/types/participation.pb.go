@@ -0,0 +1,1643 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/participation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/skip-mev/connect/v2/pkg/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ParticipationStatus is how a validator participated in the price update of
+// a currency pair in a single block.
+type ParticipationStatus int32
+
+const (
+	// PARTICIPATION_STATUS_UNSPECIFIED is an unset status.
+	ParticipationStatus_PARTICIPATION_STATUS_UNSPECIFIED ParticipationStatus = 0
+	// PARTICIPATION_STATUS_REPORTED indicates that the validator reported a price
+	// for the currency pair.
+	ParticipationStatus_PARTICIPATION_STATUS_REPORTED ParticipationStatus = 1
+	// PARTICIPATION_STATUS_ABSENT indicates that the validator's vote was not
+	// included in the commit.
+	ParticipationStatus_PARTICIPATION_STATUS_ABSENT ParticipationStatus = 2
+	// PARTICIPATION_STATUS_MISSING_PRICE indicates that the validator's vote was
+	// included in the commit, but did not report a price for the currency pair.
+	ParticipationStatus_PARTICIPATION_STATUS_MISSING_PRICE ParticipationStatus = 3
+	// PARTICIPATION_STATUS_DEVIATED indicates that the validator reported a
+	// price that deviated from the aggregated price by more than the
+	// ParticipationDeviationBps in Params.
+	ParticipationStatus_PARTICIPATION_STATUS_DEVIATED ParticipationStatus = 4
+)
+
+var ParticipationStatus_name = map[int32]string{
+	0: "PARTICIPATION_STATUS_UNSPECIFIED",
+	1: "PARTICIPATION_STATUS_REPORTED",
+	2: "PARTICIPATION_STATUS_ABSENT",
+	3: "PARTICIPATION_STATUS_MISSING_PRICE",
+	4: "PARTICIPATION_STATUS_DEVIATED",
+}
+
+var ParticipationStatus_value = map[string]int32{
+	"PARTICIPATION_STATUS_UNSPECIFIED":   0,
+	"PARTICIPATION_STATUS_REPORTED":      1,
+	"PARTICIPATION_STATUS_ABSENT":        2,
+	"PARTICIPATION_STATUS_MISSING_PRICE": 3,
+	"PARTICIPATION_STATUS_DEVIATED":      4,
+}
+
+func (x ParticipationStatus) String() string {
+	return proto.EnumName(ParticipationStatus_name, int32(x))
+}
+
+func (ParticipationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1ad52b794f848b6f, []int{0}
+}
+
+// ParticipationCounts are the number of blocks in a validator's participation
+// window with each ParticipationStatus.
+type ParticipationCounts struct {
+	Reported     uint64 `protobuf:"varint,1,opt,name=reported,proto3" json:"reported,omitempty"`
+	Absent       uint64 `protobuf:"varint,2,opt,name=absent,proto3" json:"absent,omitempty"`
+	MissingPrice uint64 `protobuf:"varint,3,opt,name=missing_price,json=missingPrice,proto3" json:"missing_price,omitempty"`
+	Deviated     uint64 `protobuf:"varint,4,opt,name=deviated,proto3" json:"deviated,omitempty"`
+}
+
+func (m *ParticipationCounts) Reset()         { *m = ParticipationCounts{} }
+func (m *ParticipationCounts) String() string { return proto.CompactTextString(m) }
+func (*ParticipationCounts) ProtoMessage()    {}
+func (*ParticipationCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad52b794f848b6f, []int{0}
+}
+func (m *ParticipationCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipationCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipationCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipationCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipationCounts.Merge(m, src)
+}
+func (m *ParticipationCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipationCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipationCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipationCounts proto.InternalMessageInfo
+
+func (m *ParticipationCounts) GetReported() uint64 {
+	if m != nil {
+		return m.Reported
+	}
+	return 0
+}
+
+func (m *ParticipationCounts) GetAbsent() uint64 {
+	if m != nil {
+		return m.Absent
+	}
+	return 0
+}
+
+func (m *ParticipationCounts) GetMissingPrice() uint64 {
+	if m != nil {
+		return m.MissingPrice
+	}
+	return 0
+}
+
+func (m *ParticipationCounts) GetDeviated() uint64 {
+	if m != nil {
+		return m.Deviated
+	}
+	return 0
+}
+
+// ValidatorParticipationInfo is the participation tracking state of a single
+// validator.
+type ValidatorParticipationInfo struct {
+	// BlocksTracked is the number of blocks in which the validator's
+	// participation has been recorded since tracking started.
+	BlocksTracked uint64 `protobuf:"varint,1,opt,name=blocks_tracked,json=blocksTracked,proto3" json:"blocks_tracked,omitempty"`
+	// LastRecordedHeight is the height of the last block in which the
+	// validator's participation was recorded.
+	LastRecordedHeight int64 `protobuf:"varint,2,opt,name=last_recorded_height,json=lastRecordedHeight,proto3" json:"last_recorded_height,omitempty"`
+}
+
+func (m *ValidatorParticipationInfo) Reset()         { *m = ValidatorParticipationInfo{} }
+func (m *ValidatorParticipationInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipationInfo) ProtoMessage()    {}
+func (*ValidatorParticipationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad52b794f848b6f, []int{1}
+}
+func (m *ValidatorParticipationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorParticipationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationInfo.Merge(m, src)
+}
+func (m *ValidatorParticipationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipationInfo proto.InternalMessageInfo
+
+func (m *ValidatorParticipationInfo) GetBlocksTracked() uint64 {
+	if m != nil {
+		return m.BlocksTracked
+	}
+	return 0
+}
+
+func (m *ValidatorParticipationInfo) GetLastRecordedHeight() int64 {
+	if m != nil {
+		return m.LastRecordedHeight
+	}
+	return 0
+}
+
+// MarketParticipation is the participation of a validator in the price
+// updates of a currency pair over its participation window.
+type MarketParticipation struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// CurrencyPair is the currency pair that the participation is recorded for.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Counts are the number of blocks with each participation status.
+	Counts ParticipationCounts `protobuf:"bytes,3,opt,name=counts,proto3" json:"counts"`
+}
+
+func (m *MarketParticipation) Reset()         { *m = MarketParticipation{} }
+func (m *MarketParticipation) String() string { return proto.CompactTextString(m) }
+func (*MarketParticipation) ProtoMessage()    {}
+func (*MarketParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad52b794f848b6f, []int{2}
+}
+func (m *MarketParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketParticipation.Merge(m, src)
+}
+func (m *MarketParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketParticipation proto.InternalMessageInfo
+
+func (m *MarketParticipation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MarketParticipation) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *MarketParticipation) GetCounts() ParticipationCounts {
+	if m != nil {
+		return m.Counts
+	}
+	return ParticipationCounts{}
+}
+
+// MarketParticipationCounts are the participation counts of a validator for a
+// single currency pair over its participation window.
+type MarketParticipationCounts struct {
+	// CurrencyPairId is the x/oracle ID of the currency pair.
+	CurrencyPairId uint64 `protobuf:"varint,1,opt,name=currency_pair_id,json=currencyPairId,proto3" json:"currency_pair_id,omitempty"`
+	// Counts are the number of blocks with each participation status.
+	Counts ParticipationCounts `protobuf:"bytes,2,opt,name=counts,proto3" json:"counts"`
+}
+
+func (m *MarketParticipationCounts) Reset()         { *m = MarketParticipationCounts{} }
+func (m *MarketParticipationCounts) String() string { return proto.CompactTextString(m) }
+func (*MarketParticipationCounts) ProtoMessage()    {}
+func (*MarketParticipationCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad52b794f848b6f, []int{3}
+}
+func (m *MarketParticipationCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketParticipationCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketParticipationCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketParticipationCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketParticipationCounts.Merge(m, src)
+}
+func (m *MarketParticipationCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketParticipationCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketParticipationCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketParticipationCounts proto.InternalMessageInfo
+
+func (m *MarketParticipationCounts) GetCurrencyPairId() uint64 {
+	if m != nil {
+		return m.CurrencyPairId
+	}
+	return 0
+}
+
+func (m *MarketParticipationCounts) GetCounts() ParticipationCounts {
+	if m != nil {
+		return m.Counts
+	}
+	return ParticipationCounts{}
+}
+
+// ValidatorParticipationCounts are the participation counts of a validator for
+// each currency pair over its participation window.
+type ValidatorParticipationCounts struct {
+	// Markets are the participation counts of each currency pair, ordered by
+	// currency pair ID.
+	Markets []MarketParticipationCounts `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+}
+
+func (m *ValidatorParticipationCounts) Reset()         { *m = ValidatorParticipationCounts{} }
+func (m *ValidatorParticipationCounts) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipationCounts) ProtoMessage()    {}
+func (*ValidatorParticipationCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad52b794f848b6f, []int{4}
+}
+func (m *ValidatorParticipationCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipationCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorParticipationCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationCounts.Merge(m, src)
+}
+func (m *ValidatorParticipationCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipationCounts proto.InternalMessageInfo
+
+func (m *ValidatorParticipationCounts) GetMarkets() []MarketParticipationCounts {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+// ParticipationRecord is the participation of a validator in the price updates
+// of every currency pair in a single block.
+type ParticipationRecord struct {
+	// CurrencyPairIds are the x/oracle IDs of the currency pairs tracked in the
+	// block, in ascending order.
+	CurrencyPairIds []uint64 `protobuf:"varint,1,rep,packed,name=currency_pair_ids,json=currencyPairIds,proto3" json:"currency_pair_ids,omitempty"`
+	// Statuses are the participation statuses of the validator, one byte per
+	// ParticipationStatus, in the order of CurrencyPairIds.
+	Statuses []byte `protobuf:"bytes,2,opt,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (m *ParticipationRecord) Reset()         { *m = ParticipationRecord{} }
+func (m *ParticipationRecord) String() string { return proto.CompactTextString(m) }
+func (*ParticipationRecord) ProtoMessage()    {}
+func (*ParticipationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad52b794f848b6f, []int{5}
+}
+func (m *ParticipationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipationRecord.Merge(m, src)
+}
+func (m *ParticipationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipationRecord proto.InternalMessageInfo
+
+func (m *ParticipationRecord) GetCurrencyPairIds() []uint64 {
+	if m != nil {
+		return m.CurrencyPairIds
+	}
+	return nil
+}
+
+func (m *ParticipationRecord) GetStatuses() []byte {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("connect.oracle.v2.ParticipationStatus", ParticipationStatus_name, ParticipationStatus_value)
+	proto.RegisterType((*ParticipationCounts)(nil), "connect.oracle.v2.ParticipationCounts")
+	proto.RegisterType((*ValidatorParticipationInfo)(nil), "connect.oracle.v2.ValidatorParticipationInfo")
+	proto.RegisterType((*MarketParticipation)(nil), "connect.oracle.v2.MarketParticipation")
+	proto.RegisterType((*MarketParticipationCounts)(nil), "connect.oracle.v2.MarketParticipationCounts")
+	proto.RegisterType((*ValidatorParticipationCounts)(nil), "connect.oracle.v2.ValidatorParticipationCounts")
+	proto.RegisterType((*ParticipationRecord)(nil), "connect.oracle.v2.ParticipationRecord")
+}
+
+func init() {
+	proto.RegisterFile("connect/oracle/v2/participation.proto", fileDescriptor_1ad52b794f848b6f)
+}
+
+var fileDescriptor_1ad52b794f848b6f = []byte{
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x49, 0xc4, 0xf7, 0x31, 0xfc, 0x34, 0x0c, 0xa8, 0x4a, 0x53, 0x6a, 0x68, 0x0a, 0x08,
+	0xa1, 0x62, 0x57, 0xee, 0x13, 0x84, 0xc4, 0xa5, 0x96, 0x4a, 0xb0, 0x6c, 0xc3, 0xa2, 0x52, 0x65,
+	0x4d, 0xc6, 0xd3, 0x30, 0x4a, 0xe2, 0xb1, 0xc6, 0x13, 0xab, 0xbc, 0x42, 0xbb, 0xe9, 0x43, 0x75,
+	0x81, 0xba, 0x62, 0xd9, 0x55, 0x55, 0xc1, 0x8b, 0x54, 0x1e, 0xdb, 0x34, 0x49, 0xcd, 0xaa, 0xbb,
+	0xcc, 0x3d, 0x27, 0xe7, 0x9c, 0x7b, 0xef, 0x78, 0xc0, 0x1e, 0x66, 0x61, 0x48, 0xb0, 0xd0, 0x19,
+	0x47, 0x78, 0x44, 0xf4, 0xc4, 0xd0, 0x23, 0xc4, 0x05, 0xc5, 0x34, 0x42, 0x82, 0xb2, 0x50, 0x8b,
+	0x38, 0x13, 0x0c, 0xae, 0xe7, 0x34, 0x2d, 0xa3, 0x69, 0x89, 0xd1, 0xdc, 0x1c, 0xb0, 0x01, 0x93,
+	0xa8, 0x9e, 0xfe, 0xca, 0x88, 0xcd, 0xdd, 0x42, 0x4f, 0x5c, 0x45, 0x24, 0x4e, 0xe5, 0xf0, 0x84,
+	0x73, 0x12, 0xe2, 0x2b, 0x3f, 0x42, 0x94, 0x67, 0xac, 0xd6, 0x67, 0x05, 0x6c, 0xd8, 0xd3, 0x36,
+	0x1d, 0x36, 0x09, 0x45, 0x0c, 0x9b, 0xe0, 0x7f, 0x4e, 0x22, 0xc6, 0x05, 0x09, 0x1a, 0xca, 0x8e,
+	0x72, 0x50, 0x73, 0xee, 0xcf, 0xf0, 0x31, 0x58, 0x44, 0xfd, 0x98, 0x84, 0xa2, 0xb1, 0x20, 0x91,
+	0xfc, 0x04, 0x5f, 0x80, 0xd5, 0x31, 0x8d, 0x63, 0x1a, 0x0e, 0xfc, 0x88, 0x53, 0x4c, 0x1a, 0x55,
+	0x09, 0xaf, 0xe4, 0x45, 0x3b, 0xad, 0xa5, 0xc2, 0x01, 0x49, 0x28, 0x4a, 0x85, 0x6b, 0x99, 0x70,
+	0x71, 0x6e, 0x4d, 0x40, 0xf3, 0x02, 0x8d, 0x68, 0x80, 0x04, 0xe3, 0x33, 0xa1, 0xac, 0xf0, 0x23,
+	0x83, 0x7b, 0x60, 0xad, 0x3f, 0x62, 0x78, 0x18, 0xfb, 0x82, 0x23, 0x3c, 0xbc, 0x0f, 0xb6, 0x9a,
+	0x55, 0xbd, 0xac, 0x08, 0x5f, 0x81, 0xcd, 0x11, 0x8a, 0x85, 0xcf, 0x09, 0x66, 0x3c, 0x20, 0x81,
+	0x7f, 0x49, 0xe8, 0xe0, 0x32, 0xcb, 0x5a, 0x75, 0x60, 0x8a, 0x39, 0x39, 0xf4, 0x56, 0x22, 0xad,
+	0x6f, 0x0a, 0xd8, 0x38, 0x45, 0x7c, 0x48, 0xc4, 0x8c, 0x29, 0xdc, 0x02, 0x4b, 0x49, 0x11, 0x47,
+	0x7a, 0x2d, 0x39, 0x7f, 0x0a, 0xd0, 0x02, 0xab, 0x33, 0x03, 0x95, 0x06, 0xcb, 0x86, 0xaa, 0x15,
+	0x0b, 0x92, 0x73, 0xd7, 0x12, 0x43, 0xeb, 0xe4, 0x34, 0x1b, 0x51, 0x7e, 0x5c, 0xbb, 0xfe, 0xb9,
+	0x5d, 0x71, 0x56, 0xf0, 0x54, 0x0d, 0x76, 0xc1, 0x22, 0x96, 0x63, 0x97, 0x13, 0x5b, 0x36, 0xf6,
+	0xb5, 0xbf, 0x96, 0xac, 0x95, 0x2c, 0x29, 0xd7, 0xca, 0xff, 0xdb, 0xfa, 0xa2, 0x80, 0x27, 0x25,
+	0x6d, 0xe4, 0x0b, 0x3d, 0x00, 0xf5, 0x99, 0xb8, 0x3e, 0x2d, 0xe6, 0xb7, 0x36, 0x9d, 0xc5, 0x0a,
+	0xa6, 0xd2, 0x2c, 0xfc, 0x43, 0x9a, 0x11, 0xd8, 0x2a, 0xdf, 0x65, 0x9e, 0xe7, 0x1d, 0xf8, 0x6f,
+	0x2c, 0xc3, 0xc6, 0x0d, 0x65, 0xa7, 0x7a, 0xb0, 0x6c, 0xbc, 0x2c, 0xb1, 0x79, 0xb0, 0x9d, 0xdc,
+	0xac, 0x90, 0x68, 0x7d, 0x98, 0xbb, 0xc5, 0xd9, 0x86, 0xe1, 0x21, 0x58, 0x9f, 0x6f, 0x3a, 0xb3,
+	0xab, 0x39, 0x8f, 0x66, 0xbb, 0x96, 0x37, 0x3e, 0x16, 0x48, 0x4c, 0x62, 0x92, 0x35, 0xbe, 0xe2,
+	0xdc, 0x9f, 0x0f, 0xbf, 0xcf, 0x7f, 0x25, 0xae, 0x44, 0xe0, 0x2e, 0xd8, 0xb1, 0xdb, 0x8e, 0x67,
+	0x75, 0x2c, 0xbb, 0xed, 0x59, 0x67, 0x3d, 0xdf, 0xf5, 0xda, 0xde, 0xb9, 0xeb, 0x9f, 0xf7, 0x5c,
+	0xdb, 0xec, 0x58, 0x6f, 0x2c, 0xb3, 0x5b, 0xaf, 0xc0, 0xe7, 0xe0, 0x59, 0x29, 0xcb, 0x31, 0xed,
+	0x33, 0xc7, 0x33, 0xbb, 0x75, 0x05, 0x6e, 0x83, 0xa7, 0xa5, 0x94, 0xf6, 0xb1, 0x6b, 0xf6, 0xbc,
+	0xfa, 0x02, 0xdc, 0x07, 0xad, 0x52, 0xc2, 0xa9, 0xe5, 0xba, 0x56, 0xef, 0xc4, 0xb7, 0x1d, 0xab,
+	0x63, 0xd6, 0xab, 0x0f, 0x7a, 0x75, 0xcd, 0x0b, 0xab, 0x9d, 0x7a, 0xd5, 0x8e, 0x4f, 0xae, 0x6f,
+	0x55, 0xe5, 0xe6, 0x56, 0x55, 0x7e, 0xdd, 0xaa, 0xca, 0xd7, 0x3b, 0xb5, 0x72, 0x73, 0xa7, 0x56,
+	0x7e, 0xdc, 0xa9, 0x95, 0xf7, 0x47, 0x03, 0x2a, 0x2e, 0x27, 0x7d, 0x0d, 0xb3, 0xb1, 0x1e, 0x0f,
+	0x69, 0x74, 0x34, 0x26, 0x89, 0x5e, 0x3c, 0x23, 0x89, 0xa1, 0x7f, 0x2a, 0xde, 0x26, 0x79, 0xb5,
+	0xfb, 0x8b, 0xf2, 0x09, 0x79, 0xfd, 0x7b, 0x00, 0x15, 0x30, 0xd1, 0x44, 0xba, 0x04, 0x00, 0x00,
+}
+
+func (m *ParticipationCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipationCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipationCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deviated != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Deviated))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissingPrice != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.MissingPrice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Absent != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Absent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Reported != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Reported))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRecordedHeight != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.LastRecordedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlocksTracked != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.BlocksTracked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Counts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParticipation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParticipation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketParticipationCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketParticipationCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketParticipationCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Counts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParticipation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CurrencyPairId != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.CurrencyPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		i -= len(m.Statuses)
+		copy(dAtA[i:], m.Statuses)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Statuses)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		dAtA5 := make([]byte, len(m.CurrencyPairIds)*10)
+		var j4 int
+		for _, num := range m.CurrencyPairIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintParticipation(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParticipationCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reported != 0 {
+		n += 1 + sovParticipation(uint64(m.Reported))
+	}
+	if m.Absent != 0 {
+		n += 1 + sovParticipation(uint64(m.Absent))
+	}
+	if m.MissingPrice != 0 {
+		n += 1 + sovParticipation(uint64(m.MissingPrice))
+	}
+	if m.Deviated != 0 {
+		n += 1 + sovParticipation(uint64(m.Deviated))
+	}
+	return n
+}
+
+func (m *ValidatorParticipationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksTracked != 0 {
+		n += 1 + sovParticipation(uint64(m.BlocksTracked))
+	}
+	if m.LastRecordedHeight != 0 {
+		n += 1 + sovParticipation(uint64(m.LastRecordedHeight))
+	}
+	return n
+}
+
+func (m *MarketParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovParticipation(uint64(l))
+	l = m.Counts.Size()
+	n += 1 + l + sovParticipation(uint64(l))
+	return n
+}
+
+func (m *MarketParticipationCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyPairId != 0 {
+		n += 1 + sovParticipation(uint64(m.CurrencyPairId))
+	}
+	l = m.Counts.Size()
+	n += 1 + l + sovParticipation(uint64(l))
+	return n
+}
+
+func (m *ValidatorParticipationCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovParticipation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParticipationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		l = 0
+		for _, e := range m.CurrencyPairIds {
+			l += sovParticipation(uint64(e))
+		}
+		n += 1 + sovParticipation(uint64(l)) + l
+	}
+	l = len(m.Statuses)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	return n
+}
+
+func sovParticipation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipation(x uint64) (n int) {
+	return sovParticipation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParticipationCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipationCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipationCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reported", wireType)
+			}
+			m.Reported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reported |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Absent", wireType)
+			}
+			m.Absent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Absent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingPrice", wireType)
+			}
+			m.MissingPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviated", wireType)
+			}
+			m.Deviated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deviated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksTracked", wireType)
+			}
+			m.BlocksTracked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksTracked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordedHeight", wireType)
+			}
+			m.LastRecordedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecordedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketParticipationCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketParticipationCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketParticipationCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairId", wireType)
+			}
+			m.CurrencyPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrencyPairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipationCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipationCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipationCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, MarketParticipationCounts{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParticipation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CurrencyPairIds = append(m.CurrencyPairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParticipation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParticipation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParticipation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CurrencyPairIds) == 0 {
+					m.CurrencyPairIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParticipation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CurrencyPairIds = append(m.CurrencyPairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses[:0], dAtA[iNdEx:postIndex]...)
+			if m.Statuses == nil {
+				m.Statuses = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParticipation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParticipation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParticipation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParticipation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParticipation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParticipation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParticipation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestClassifyParticipation(t *testing.T) {
+	tcs := []struct {
+		name         string
+		absent       bool
+		price        *big.Int
+		finalPrice   *big.Int
+		deviationBps uint32
+		expected     types.ParticipationStatus
+	}{
+		{
+			"absent validators are absent, regardless of price",
+			true,
+			big.NewInt(100),
+			big.NewInt(100),
+			0,
+			types.ParticipationStatus_PARTICIPATION_STATUS_ABSENT,
+		},
+		{
+			"a nil price is missing",
+			false,
+			nil,
+			big.NewInt(100),
+			0,
+			types.ParticipationStatus_PARTICIPATION_STATUS_MISSING_PRICE,
+		},
+		{
+			"a deviation at the bound is reported",
+			false,
+			big.NewInt(101),
+			big.NewInt(100),
+			100,
+			types.ParticipationStatus_PARTICIPATION_STATUS_REPORTED,
+		},
+		{
+			"a deviation above the bound is deviated",
+			false,
+			big.NewInt(98),
+			big.NewInt(100),
+			100,
+			types.ParticipationStatus_PARTICIPATION_STATUS_DEVIATED,
+		},
+		{
+			"a zero deviation bound disables the check",
+			false,
+			big.NewInt(1),
+			big.NewInt(100),
+			0,
+			types.ParticipationStatus_PARTICIPATION_STATUS_REPORTED,
+		},
+		{
+			"a missing final price disables the check",
+			false,
+			big.NewInt(1),
+			nil,
+			100,
+			types.ParticipationStatus_PARTICIPATION_STATUS_REPORTED,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.ClassifyParticipation(tc.absent, tc.price, tc.finalPrice, tc.deviationBps))
+		})
+	}
+}
+
+func TestParticipationCountsAdd(t *testing.T) {
+	var counts types.ParticipationCounts
+	counts.Add(types.ParticipationStatus_PARTICIPATION_STATUS_REPORTED, 1)
+	counts.Add(types.ParticipationStatus_PARTICIPATION_STATUS_ABSENT, 1)
+	counts.Add(types.ParticipationStatus_PARTICIPATION_STATUS_ABSENT, 1)
+	counts.Add(types.ParticipationStatus_PARTICIPATION_STATUS_DEVIATED, -1)
+	counts.Add(types.ParticipationStatus_PARTICIPATION_STATUS_UNSPECIFIED, 1)
+
+	require.Equal(t, types.ParticipationCounts{Reported: 1, Absent: 2}, counts)
+	require.Equal(t, uint64(3), counts.Total())
+}
+
+func TestValidatorParticipationCountsAddRecord(t *testing.T) {
+	var first, second types.ParticipationRecord
+	first.Set(1, types.ParticipationStatus_PARTICIPATION_STATUS_REPORTED)
+	first.Set(3, types.ParticipationStatus_PARTICIPATION_STATUS_ABSENT)
+	second.Set(2, types.ParticipationStatus_PARTICIPATION_STATUS_DEVIATED)
+	second.Set(3, types.ParticipationStatus_PARTICIPATION_STATUS_MISSING_PRICE)
+
+	var counts types.ValidatorParticipationCounts
+	counts.AddRecord(first, 1)
+	counts.AddRecord(second, 1)
+	require.Equal(t, []types.MarketParticipationCounts{
+		{CurrencyPairId: 1, Counts: types.ParticipationCounts{Reported: 1}},
+		{CurrencyPairId: 2, Counts: types.ParticipationCounts{Deviated: 1}},
+		{CurrencyPairId: 3, Counts: types.ParticipationCounts{Absent: 1, MissingPrice: 1}},
+	}, counts.Markets)
+	require.Equal(t, types.ParticipationCounts{Reported: 1, Absent: 1, MissingPrice: 1, Deviated: 1}, counts.Total())
+
+	// removing a currency pair ignores it when its records are evicted
+	require.True(t, counts.Remove(2))
+	require.False(t, counts.Remove(2))
+	counts.AddRecord(second, -1)
+
+	// currency pairs without any remaining counts are dropped
+	counts.AddRecord(first, -1)
+	require.Empty(t, counts.Markets)
+
+	_, ok := counts.Get(1)
+	require.False(t, ok)
+}
+
+func TestNewValidatorMissRate(t *testing.T) {
+	validator := sdk.ConsAddress("validator")
+
+	// a validator with no participation has no misses
+	require.Equal(t, math.LegacyZeroDec(), types.NewValidatorMissRate(validator, types.ParticipationCounts{}).MissRate)
+
+	// absent and missing prices are misses, deviated prices are not
+	missRate := types.NewValidatorMissRate(validator, types.ParticipationCounts{Reported: 4, Absent: 2, MissingPrice: 1, Deviated: 1})
+	require.Equal(t, validator, missRate.Validator)
+	require.Equal(t, math.LegacyNewDecWithPrec(375, 3), missRate.MissRate)
+}
@@ -5,24 +5,31 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/skip-mev/connect/v2/pkg/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +38,19 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GetAllCurrencyPairsRequest struct {
+	// Pagination defines an optional pagination for the request. If unset, all
+	// matching currency pairs are returned.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// EnabledOnly restricts the results to markets that are enabled in the
+	// market map.
+	EnabledOnly bool `protobuf:"varint,2,opt,name=enabled_only,json=enabledOnly,proto3" json:"enabled_only,omitempty"`
+	// Provider restricts the results to markets with a provider config for the
+	// named provider.
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Base restricts the results to markets with the given base asset.
+	Base string `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	// Quote restricts the results to markets with the given quote asset.
+	Quote string `protobuf:"bytes,5,opt,name=quote,proto3" json:"quote,omitempty"`
 }
 
 func (m *GetAllCurrencyPairsRequest) Reset()         { *m = GetAllCurrencyPairsRequest{} }
@@ -66,10 +86,47 @@ func (m *GetAllCurrencyPairsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetAllCurrencyPairsRequest proto.InternalMessageInfo
 
+func (m *GetAllCurrencyPairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *GetAllCurrencyPairsRequest) GetEnabledOnly() bool {
+	if m != nil {
+		return m.EnabledOnly
+	}
+	return false
+}
+
+func (m *GetAllCurrencyPairsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *GetAllCurrencyPairsRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *GetAllCurrencyPairsRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
 // GetAllCurrencyPairsResponse returns all CurrencyPairs that the module is
 // currently tracking.
 type GetAllCurrencyPairsResponse struct {
 	CurrencyPairs []types.CurrencyPair `protobuf:"bytes,1,rep,name=currency_pairs,json=currencyPairs,proto3" json:"currency_pairs"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *GetAllCurrencyPairsResponse) Reset()         { *m = GetAllCurrencyPairsResponse{} }
@@ -112,6 +169,13 @@ func (m *GetAllCurrencyPairsResponse) GetCurrencyPairs() []types.CurrencyPair {
 	return nil
 }
 
+func (m *GetAllCurrencyPairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // GetPriceRequest takes an identifier for the
 // CurrencyPair in the format base/quote.
 type GetPriceRequest struct {
@@ -329,6 +393,19 @@ func (m *GetPricesResponse) GetPrices() []GetPriceResponse {
 
 // GetCurrencyPairMappingRequest is the GetCurrencyPairMapping request type.
 type GetCurrencyPairMappingRequest struct {
+	// Pagination defines an optional pagination for the request. If unset, all
+	// matching currency pairs are returned.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// EnabledOnly restricts the results to markets that are enabled in the
+	// market map.
+	EnabledOnly bool `protobuf:"varint,2,opt,name=enabled_only,json=enabledOnly,proto3" json:"enabled_only,omitempty"`
+	// Provider restricts the results to markets with a provider config for the
+	// named provider.
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Base restricts the results to markets with the given base asset.
+	Base string `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	// Quote restricts the results to markets with the given quote asset.
+	Quote string `protobuf:"bytes,5,opt,name=quote,proto3" json:"quote,omitempty"`
 }
 
 func (m *GetCurrencyPairMappingRequest) Reset()         { *m = GetCurrencyPairMappingRequest{} }
@@ -364,11 +441,48 @@ func (m *GetCurrencyPairMappingRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetCurrencyPairMappingRequest proto.InternalMessageInfo
 
+func (m *GetCurrencyPairMappingRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *GetCurrencyPairMappingRequest) GetEnabledOnly() bool {
+	if m != nil {
+		return m.EnabledOnly
+	}
+	return false
+}
+
+func (m *GetCurrencyPairMappingRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *GetCurrencyPairMappingRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *GetCurrencyPairMappingRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
 // GetCurrencyPairMappingResponse is the GetCurrencyPairMapping response type.
 type GetCurrencyPairMappingResponse struct {
 	// currency_pair_mapping is a mapping of the id representing the currency pair
 	// to the currency pair itself.
 	CurrencyPairMapping map[uint64]types.CurrencyPair `protobuf:"bytes,1,rep,name=currency_pair_mapping,json=currencyPairMapping,proto3" json:"currency_pair_mapping" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *GetCurrencyPairMappingResponse) Reset()         { *m = GetCurrencyPairMappingResponse{} }
@@ -411,678 +525,6101 @@ func (m *GetCurrencyPairMappingResponse) GetCurrencyPairMapping() map[uint64]typ
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "connect.oracle.v2.GetAllCurrencyPairsRequest")
-	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "connect.oracle.v2.GetAllCurrencyPairsResponse")
-	proto.RegisterType((*GetPriceRequest)(nil), "connect.oracle.v2.GetPriceRequest")
-	proto.RegisterType((*GetPriceResponse)(nil), "connect.oracle.v2.GetPriceResponse")
-	proto.RegisterType((*GetPricesRequest)(nil), "connect.oracle.v2.GetPricesRequest")
-	proto.RegisterType((*GetPricesResponse)(nil), "connect.oracle.v2.GetPricesResponse")
-	proto.RegisterType((*GetCurrencyPairMappingRequest)(nil), "connect.oracle.v2.GetCurrencyPairMappingRequest")
-	proto.RegisterType((*GetCurrencyPairMappingResponse)(nil), "connect.oracle.v2.GetCurrencyPairMappingResponse")
-	proto.RegisterMapType((map[uint64]types.CurrencyPair)(nil), "connect.oracle.v2.GetCurrencyPairMappingResponse.CurrencyPairMappingEntry")
+func (m *GetCurrencyPairMappingResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("connect/oracle/v2/query.proto", fileDescriptor_85b187574238e3d2) }
+// ParamsRequest is the request type for the Query/Params RPC method.
+type ParamsRequest struct {
+}
 
-var fileDescriptor_85b187574238e3d2 = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0xa6, 0x49, 0xd5, 0x4e, 0xe9, 0xdf, 0xb6, 0xa0, 0x28, 0x34, 0x4e, 0xe5, 0x06, 0xa9,
-	0xaa, 0xa8, 0x0d, 0xa6, 0x42, 0xc0, 0x01, 0xa9, 0x45, 0x28, 0x02, 0x84, 0xd4, 0xfa, 0xc0, 0x81,
-	0x4b, 0xe4, 0x3a, 0x8b, 0x59, 0xea, 0x78, 0x5d, 0xef, 0x26, 0x22, 0x07, 0x2e, 0x88, 0x7b, 0x91,
-	0x78, 0x01, 0xde, 0x81, 0x97, 0xe8, 0xb1, 0x12, 0x17, 0xc4, 0x01, 0xa1, 0x96, 0x07, 0x41, 0xd9,
-	0x5d, 0xa7, 0x09, 0x75, 0x42, 0x7b, 0xb3, 0xfd, 0x7d, 0x33, 0xf3, 0xcd, 0xcc, 0x37, 0x86, 0x8a,
-	0xcf, 0xa2, 0x88, 0xf8, 0xc2, 0x66, 0x89, 0xe7, 0x87, 0xc4, 0xee, 0x38, 0xf6, 0x61, 0x9b, 0x24,
-	0x5d, 0x2b, 0x4e, 0x98, 0x60, 0x78, 0x51, 0xc3, 0x96, 0x82, 0xad, 0x8e, 0x53, 0x5e, 0x0e, 0x58,
-	0xc0, 0x24, 0x6a, 0xf7, 0x9e, 0x14, 0xb1, 0xbc, 0x12, 0x30, 0x16, 0x84, 0xc4, 0xf6, 0x62, 0x6a,
-	0x7b, 0x51, 0xc4, 0x84, 0x27, 0x28, 0x8b, 0xb8, 0x46, 0xab, 0x17, 0xab, 0x04, 0x24, 0x22, 0x9c,
-	0xa6, 0x84, 0x5a, 0x4a, 0x10, 0xdd, 0x98, 0xf0, 0x1e, 0xee, 0xb7, 0x93, 0x84, 0x44, 0x7e, 0xb7,
-	0x11, 0x7b, 0x34, 0x51, 0x2c, 0x73, 0x05, 0xca, 0x75, 0x22, 0xb6, 0xc3, 0xf0, 0x89, 0x06, 0x77,
-	0x3d, 0x9a, 0x70, 0x97, 0x1c, 0xb6, 0x09, 0x17, 0xe6, 0x3b, 0xb8, 0x99, 0x89, 0xf2, 0x98, 0x45,
-	0x9c, 0xe0, 0x17, 0x30, 0x37, 0x94, 0x93, 0x97, 0xd0, 0xea, 0xc4, 0xfa, 0x8c, 0x63, 0x58, 0x69,
-	0x8f, 0xb2, 0xb6, 0xd5, 0x71, 0xac, 0xc1, 0x04, 0x3b, 0x85, 0xe3, 0x5f, 0xd5, 0x9c, 0x3b, 0xeb,
-	0x0f, 0x26, 0x35, 0xef, 0xc3, 0x7c, 0x9d, 0x88, 0xdd, 0x84, 0xfa, 0x44, 0x97, 0xc7, 0x6b, 0x30,
-	0x3b, 0x94, 0xbf, 0x84, 0x56, 0xd1, 0xfa, 0xb4, 0x7b, 0x6d, 0x30, 0xd0, 0x3c, 0x42, 0xb0, 0x70,
-	0x1e, 0xa8, 0x95, 0x3d, 0x84, 0x62, 0xdc, 0xfb, 0x20, 0x23, 0x66, 0x9c, 0x8a, 0x75, 0x61, 0xe8,
-	0xd6, 0x5e, 0x9b, 0x09, 0x22, 0xa3, 0xa4, 0x1e, 0xe4, 0xaa, 0x08, 0xbc, 0x0c, 0xc5, 0x88, 0x45,
-	0x3e, 0x29, 0xe5, 0x57, 0xd1, 0x7a, 0xc1, 0x55, 0x2f, 0xb8, 0x0c, 0x53, 0x4d, 0xe2, 0xd3, 0x96,
-	0x17, 0xf2, 0xd2, 0x84, 0x04, 0xfa, 0xef, 0x78, 0x0e, 0xf2, 0xb4, 0x59, 0x2a, 0xc8, 0xaf, 0x79,
-	0xda, 0x34, 0x1f, 0x9f, 0x0b, 0x4a, 0x27, 0x89, 0x37, 0x60, 0x71, 0xa8, 0x95, 0x06, 0x6d, 0xaa,
-	0x69, 0x4d, 0xbb, 0xf3, 0x83, 0xed, 0x3c, 0x6b, 0x72, 0xf3, 0x15, 0x2c, 0x0e, 0xc4, 0xeb, 0x8e,
-	0xb6, 0x61, 0x52, 0xea, 0x4b, 0x67, 0xbc, 0x96, 0xd1, 0xd2, 0xbf, 0x63, 0xd0, 0x83, 0xd6, 0x81,
-	0x66, 0x15, 0x2a, 0x75, 0x22, 0x06, 0x37, 0xf1, 0xd2, 0x8b, 0x63, 0x1a, 0x05, 0xe9, 0xba, 0x8f,
-	0xf2, 0x60, 0x8c, 0x62, 0x68, 0x19, 0x9f, 0x10, 0x5c, 0x1f, 0x6e, 0xa4, 0xa5, 0x18, 0x5a, 0xd6,
-	0xf3, 0x6c, 0x59, 0x63, 0x52, 0x5a, 0x19, 0xd8, 0xd3, 0x48, 0x24, 0x5d, 0xad, 0x7e, 0xc9, 0xbf,
-	0x88, 0x97, 0xdf, 0x40, 0x69, 0x54, 0x18, 0x5e, 0x80, 0x89, 0x03, 0xd2, 0x95, 0x9b, 0x2f, 0xb8,
-	0xbd, 0x47, 0xbc, 0x05, 0xc5, 0x8e, 0x17, 0xb6, 0xd5, 0x4a, 0xff, 0x6b, 0x4f, 0x57, 0x91, 0x1f,
-	0xe5, 0x1f, 0x20, 0xe7, 0x67, 0x01, 0x8a, 0x7b, 0xbd, 0xe3, 0xc5, 0x5f, 0x11, 0x2c, 0x65, 0xdc,
-	0x02, 0xde, 0xcc, 0x6e, 0x78, 0xc4, 0x45, 0x95, 0xad, 0xcb, 0xd2, 0xd5, 0x70, 0xcc, 0x8d, 0x8f,
-	0xdf, 0xff, 0x7c, 0xc9, 0xd7, 0xb0, 0x69, 0x67, 0xdd, 0xbb, 0x68, 0x78, 0x61, 0xd8, 0x10, 0xd4,
-	0x3f, 0x20, 0x09, 0xc7, 0x5d, 0x98, 0x4a, 0x1d, 0x80, 0xcd, 0xb1, 0xf6, 0x50, 0x5a, 0x2e, 0x63,
-	0x21, 0xb3, 0x26, 0x05, 0x18, 0x78, 0x65, 0x84, 0x00, 0x75, 0x34, 0x1f, 0x60, 0xba, 0x6f, 0x59,
-	0x3c, 0x2e, 0x6f, 0x7f, 0x10, 0xb5, 0xf1, 0x24, 0x5d, 0xfd, 0x96, 0xac, 0x5e, 0xc5, 0x95, 0x71,
-	0xd5, 0x39, 0xfe, 0x86, 0xe0, 0x46, 0xb6, 0xcb, 0xf0, 0x9d, 0x2b, 0x18, 0x52, 0x29, 0xbb, 0x7b,
-	0x65, 0x0b, 0x9b, 0x5b, 0x52, 0xa6, 0x85, 0x6f, 0x8f, 0x90, 0x99, 0x79, 0x31, 0x3b, 0xf5, 0xe3,
-	0x53, 0x03, 0x9d, 0x9c, 0x1a, 0xe8, 0xf7, 0xa9, 0x81, 0x3e, 0x9f, 0x19, 0xb9, 0x93, 0x33, 0x23,
-	0xf7, 0xe3, 0xcc, 0xc8, 0xbd, 0xde, 0x0c, 0xa8, 0x78, 0xdb, 0xde, 0xb7, 0x7c, 0xd6, 0xb2, 0xf9,
-	0x01, 0x8d, 0x37, 0x5b, 0xa4, 0xd3, 0x4f, 0xdd, 0x71, 0xec, 0xf7, 0x69, 0x7e, 0x69, 0xe0, 0xfd,
-	0x49, 0xf9, 0x2f, 0xbf, 0xf7, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x5b, 0xa5, 0x89, 0x7a, 0x06,
-	0x00, 0x00,
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{8}
+}
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// ParamsResponse is the response type for the Query/Params RPC method.
+type ParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Get all the currency pairs the x/oracle module is tracking price-data for.
-	GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error)
-	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
-	// that CurrencyPair.
-	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
-	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
-	// Get the mapping of currency pair ID -> currency pair. This is useful for
-	// indexers that have access to the ID of a currency pair, but no way to get
-	// the underlying currency pair from it.
-	GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error)
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{9}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// MarketQuorumsRequest is the request type for the Query/MarketQuorums RPC
+// method.
+type MarketQuorumsRequest struct {
 }
 
-func (c *queryClient) GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error) {
-	out := new(GetAllCurrencyPairsResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetAllCurrencyPairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketQuorumsRequest) Reset()         { *m = MarketQuorumsRequest{} }
+func (m *MarketQuorumsRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketQuorumsRequest) ProtoMessage()    {}
+func (*MarketQuorumsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{10}
+}
+func (m *MarketQuorumsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketQuorumsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketQuorumsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MarketQuorumsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketQuorumsRequest.Merge(m, src)
+}
+func (m *MarketQuorumsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketQuorumsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketQuorumsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error) {
-	out := new(GetPriceResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MarketQuorumsRequest proto.InternalMessageInfo
+
+// MarketQuorumsResponse is the response type for the Query/MarketQuorums RPC
+// method.
+type MarketQuorumsResponse struct {
+	MarketQuorums []MarketQuorum `protobuf:"bytes,1,rep,name=market_quorums,json=marketQuorums,proto3" json:"market_quorums"`
 }
 
-func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error) {
-	out := new(GetPricesResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketQuorumsResponse) Reset()         { *m = MarketQuorumsResponse{} }
+func (m *MarketQuorumsResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketQuorumsResponse) ProtoMessage()    {}
+func (*MarketQuorumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{11}
+}
+func (m *MarketQuorumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketQuorumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketQuorumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MarketQuorumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketQuorumsResponse.Merge(m, src)
+}
+func (m *MarketQuorumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketQuorumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketQuorumsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error) {
-	out := new(GetCurrencyPairMappingResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetCurrencyPairMapping", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketQuorumsResponse proto.InternalMessageInfo
+
+func (m *MarketQuorumsResponse) GetMarketQuorums() []MarketQuorum {
+	if m != nil {
+		return m.MarketQuorums
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Get all the currency pairs the x/oracle module is tracking price-data for.
-	GetAllCurrencyPairs(context.Context, *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error)
-	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
-	// that CurrencyPair.
-	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
-	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
-	// Get the mapping of currency pair ID -> currency pair. This is useful for
-	// indexers that have access to the ID of a currency pair, but no way to get
-	// the underlying currency pair from it.
-	GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error)
+// CircuitBreakersRequest is the request type for the Query/CircuitBreakers RPC
+// method.
+type CircuitBreakersRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *CircuitBreakersRequest) Reset()         { *m = CircuitBreakersRequest{} }
+func (m *CircuitBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakersRequest) ProtoMessage()    {}
+func (*CircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{12}
 }
-
-func (*UnimplementedQueryServer) GetAllCurrencyPairs(ctx context.Context, req *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCurrencyPairs not implemented")
+func (m *CircuitBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPrice(ctx context.Context, req *GetPriceRequest) (*GetPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+func (m *CircuitBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetPrices(ctx context.Context, req *GetPricesRequest) (*GetPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
+func (m *CircuitBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakersRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetCurrencyPairMapping(ctx context.Context, req *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMapping not implemented")
+func (m *CircuitBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakersRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_CircuitBreakersRequest proto.InternalMessageInfo
+
+// CircuitBreakersResponse is the response type for the Query/CircuitBreakers
+// RPC method.
+type CircuitBreakersResponse struct {
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
 }
 
-func _Query_GetAllCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCurrencyPairsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllCurrencyPairs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetAllCurrencyPairs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllCurrencyPairs(ctx, req.(*GetAllCurrencyPairsRequest))
+func (m *CircuitBreakersResponse) Reset()         { *m = CircuitBreakersResponse{} }
+func (m *CircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakersResponse) ProtoMessage()    {}
+func (*CircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{13}
+}
+func (m *CircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakersResponse.Merge(m, src)
+}
+func (m *CircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakersResponse.DiscardUnknown(m)
 }
 
-func _Query_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPrice(ctx, req.(*GetPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_CircuitBreakersResponse proto.InternalMessageInfo
 
-func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPrices(ctx, req.(*GetPricesRequest))
+func (m *CircuitBreakersResponse) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCurrencyPairMappingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCurrencyPairMapping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetCurrencyPairMapping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCurrencyPairMapping(ctx, req.(*GetCurrencyPairMappingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MarketHaltsRequest is the request type for the Query/MarketHalts RPC method.
+type MarketHaltsRequest struct {
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.oracle.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAllCurrencyPairs",
-			Handler:    _Query_GetAllCurrencyPairs_Handler,
-		},
-		{
-			MethodName: "GetPrice",
-			Handler:    _Query_GetPrice_Handler,
-		},
-		{
-			MethodName: "GetPrices",
-			Handler:    _Query_GetPrices_Handler,
-		},
-		{
-			MethodName: "GetCurrencyPairMapping",
-			Handler:    _Query_GetCurrencyPairMapping_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/oracle/v2/query.proto",
+func (m *MarketHaltsRequest) Reset()         { *m = MarketHaltsRequest{} }
+func (m *MarketHaltsRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketHaltsRequest) ProtoMessage()    {}
+func (*MarketHaltsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{14}
 }
-
-func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketHaltsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketHaltsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketHaltsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketHaltsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHaltsRequest.Merge(m, src)
 }
-
-func (m *GetAllCurrencyPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarketHaltsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *GetAllCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MarketHaltsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHaltsRequest.DiscardUnknown(m)
 }
 
-func (m *GetAllCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MarketHaltsRequest proto.InternalMessageInfo
+
+// MarketHaltsResponse is the response type for the Query/MarketHalts RPC
+// method.
+type MarketHaltsResponse struct {
+	MarketHalts []MarketHalt `protobuf:"bytes,1,rep,name=market_halts,json=marketHalts,proto3" json:"market_halts"`
 }
 
-func (m *GetAllCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MarketHaltsResponse) Reset()         { *m = MarketHaltsResponse{} }
+func (m *MarketHaltsResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketHaltsResponse) ProtoMessage()    {}
+func (*MarketHaltsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{15}
+}
+func (m *MarketHaltsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketHaltsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketHaltsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MarketHaltsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHaltsResponse.Merge(m, src)
+}
+func (m *MarketHaltsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketHaltsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHaltsResponse.DiscardUnknown(m)
 }
 
-func (m *GetPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketHaltsResponse proto.InternalMessageInfo
+
+func (m *MarketHaltsResponse) GetMarketHalts() []MarketHalt {
+	if m != nil {
+		return m.MarketHalts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorParticipationRequest is the request type for the
+// Query/ValidatorParticipation RPC method.
+type ValidatorParticipationRequest struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *GetPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPair) > 0 {
-		i -= len(m.CurrencyPair)
-		copy(dAtA[i:], m.CurrencyPair)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorParticipationRequest) Reset()         { *m = ValidatorParticipationRequest{} }
+func (m *ValidatorParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipationRequest) ProtoMessage()    {}
+func (*ValidatorParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{16}
+}
+func (m *ValidatorParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationRequest.Merge(m, src)
+}
+func (m *ValidatorParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationRequest.DiscardUnknown(m)
 }
 
-func (m *GetPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorParticipationRequest proto.InternalMessageInfo
+
+func (m *ValidatorParticipationRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorParticipationResponse is the response type for the
+// Query/ValidatorParticipation RPC method.
+type ValidatorParticipationResponse struct {
+	// BlocksInWindow is the number of blocks in the validator's participation
+	// window, i.e. the number of blocks tracked up to the window size.
+	BlocksInWindow uint64 `protobuf:"varint,1,opt,name=blocks_in_window,json=blocksInWindow,proto3" json:"blocks_in_window,omitempty"`
+	// Participation is the validator's participation in each currency pair.
+	Participation []MarketParticipation `protobuf:"bytes,2,rep,name=participation,proto3" json:"participation"`
 }
 
-func (m *GetPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Price != nil {
-		{
-			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ValidatorParticipationResponse) Reset()         { *m = ValidatorParticipationResponse{} }
+func (m *ValidatorParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipationResponse) ProtoMessage()    {}
+func (*ValidatorParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{17}
+}
+func (m *ValidatorParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationResponse.Merge(m, src)
+}
+func (m *ValidatorParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationResponse.DiscardUnknown(m)
 }
 
-func (m *GetPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorParticipationResponse proto.InternalMessageInfo
+
+func (m *ValidatorParticipationResponse) GetBlocksInWindow() uint64 {
+	if m != nil {
+		return m.BlocksInWindow
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorParticipationResponse) GetParticipation() []MarketParticipation {
+	if m != nil {
+		return m.Participation
+	}
+	return nil
 }
 
-func (m *GetPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CurrencyPairIds[iNdEx])
-			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+// MarketParticipationRequest is the request type for the
+// Query/MarketParticipation RPC method.
+type MarketParticipationRequest struct {
+	// CurrencyPair is the stringified currency pair (base/quote).
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+}
+
+func (m *MarketParticipationRequest) Reset()         { *m = MarketParticipationRequest{} }
+func (m *MarketParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketParticipationRequest) ProtoMessage()    {}
+func (*MarketParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{18}
+}
+func (m *MarketParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MarketParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketParticipationRequest.Merge(m, src)
+}
+func (m *MarketParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketParticipationRequest.DiscardUnknown(m)
 }
 
-func (m *GetPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketParticipationRequest proto.InternalMessageInfo
+
+func (m *MarketParticipationRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketParticipationResponse is the response type for the
+// Query/MarketParticipation RPC method.
+type MarketParticipationResponse struct {
+	// Participation is each validator's participation in the currency pair.
+	Participation []MarketParticipation `protobuf:"bytes,1,rep,name=participation,proto3" json:"participation"`
 }
 
-func (m *GetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MarketParticipationResponse) Reset()         { *m = MarketParticipationResponse{} }
+func (m *MarketParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketParticipationResponse) ProtoMessage()    {}
+func (*MarketParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{19}
+}
+func (m *MarketParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetCurrencyPairMappingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MarketParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketParticipationResponse.Merge(m, src)
 }
-
-func (m *GetCurrencyPairMappingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketParticipationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *GetCurrencyPairMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarketParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketParticipationResponse.DiscardUnknown(m)
 }
 
-func (m *GetCurrencyPairMappingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketParticipationResponse proto.InternalMessageInfo
+
+func (m *MarketParticipationResponse) GetParticipation() []MarketParticipation {
+	if m != nil {
+		return m.Participation
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetCurrencyPairMappingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetPriceHistoryRequest is the request type for the Query/GetPriceHistory RPC
+// method.
+type GetPriceHistoryRequest struct {
+	// CurrencyPair is the stringified currency pair (base/quote).
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// StartHeight is the minimum (inclusive) block height of the returned
+	// prices. A value of zero leaves the range unbounded.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// EndHeight is the maximum (inclusive) block height of the returned prices.
+	// A value of zero leaves the range unbounded.
+	EndHeight uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// StartTime is the minimum (inclusive) block time of the returned prices.
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// EndTime is the maximum (inclusive) block time of the returned prices.
+	EndTime *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetCurrencyPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairMapping) > 0 {
-		for k := range m.CurrencyPairMapping {
-			v := m.CurrencyPairMapping[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *GetPriceHistoryRequest) Reset()         { *m = GetPriceHistoryRequest{} }
+func (m *GetPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryRequest) ProtoMessage()    {}
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{20}
+}
+func (m *GetPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryRequest.Merge(m, src)
+}
+func (m *GetPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_GetPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *GetPriceHistoryRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *GetAllCurrencyPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetPriceHistoryRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *GetAllCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *GetPriceHistoryRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return n
+	return 0
 }
 
-func (m *GetPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CurrencyPair)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetPriceHistoryRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return n
+	return nil
 }
 
-func (m *GetPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovQuery(uint64(m.Decimals))
-	}
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *GetPriceHistoryRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return n
+	return nil
 }
 
-func (m *GetPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// GetPriceHistoryResponse is the response type for the Query/GetPriceHistory
+// RPC method.
+type GetPriceHistoryResponse struct {
+	// Prices are the historical prices of the currency pair, ordered by block
+	// height.
+	Prices []QuotePrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetPriceHistoryResponse) Reset()         { *m = GetPriceHistoryResponse{} }
+func (m *GetPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryResponse) ProtoMessage()    {}
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{21}
+}
+func (m *GetPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *GetPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryResponse.Merge(m, src)
+}
+func (m *GetPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *GetPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+var xxx_messageInfo_GetPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPriceHistoryResponse) GetPrices() []QuotePrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *GetPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetTWAPRequest is the request type for the Query/GetTWAP RPC method.
+type GetTWAPRequest struct {
+	// CurrencyPair is the stringified currency pair (base/quote).
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// StartTime is the start of the averaging interval.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// EndTime is the end of the averaging interval. If unset, the current block
+	// time is used.
+	EndTime *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *GetTWAPRequest) Reset()         { *m = GetTWAPRequest{} }
+func (m *GetTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPRequest) ProtoMessage()    {}
+func (*GetTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{22}
+}
+func (m *GetTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPRequest.Merge(m, src)
+}
+func (m *GetTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTWAPRequest proto.InternalMessageInfo
+
+func (m *GetTWAPRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *GetTWAPRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *GetTWAPRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// GetTWAPResponse is the response type for the Query/GetTWAP RPC method.
+type GetTWAPResponse struct {
+	// Price is the time weighted average price of the currency pair over the
+	// interval.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// Decimals is the number of decimals of the price.
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *GetTWAPResponse) Reset()         { *m = GetTWAPResponse{} }
+func (m *GetTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPResponse) ProtoMessage()    {}
+func (*GetTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{23}
+}
+func (m *GetTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPResponse.Merge(m, src)
+}
+func (m *GetTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTWAPResponse proto.InternalMessageInfo
+
+func (m *GetTWAPResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// GetFreshPriceRequest is the request type for the Query/GetFreshPrice RPC
+// method.
+type GetFreshPriceRequest struct {
+	// CurrencyPair is the stringified currency pair (base/quote).
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// MaxAgeBlocks is the maximum number of blocks since the price was updated.
+	// If both max ages are zero, the currency pair's PriceMaxAge is enforced.
+	MaxAgeBlocks uint64 `protobuf:"varint,2,opt,name=max_age_blocks,json=maxAgeBlocks,proto3" json:"max_age_blocks,omitempty"`
+	// MaxAgeSeconds is the maximum number of seconds since the price was
+	// updated. If both max ages are zero, the currency pair's PriceMaxAge is
+	// enforced.
+	MaxAgeSeconds uint64 `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+}
+
+func (m *GetFreshPriceRequest) Reset()         { *m = GetFreshPriceRequest{} }
+func (m *GetFreshPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFreshPriceRequest) ProtoMessage()    {}
+func (*GetFreshPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{24}
+}
+func (m *GetFreshPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFreshPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFreshPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFreshPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFreshPriceRequest.Merge(m, src)
+}
+func (m *GetFreshPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFreshPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFreshPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFreshPriceRequest proto.InternalMessageInfo
+
+func (m *GetFreshPriceRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *GetFreshPriceRequest) GetMaxAgeBlocks() uint64 {
+	if m != nil {
+		return m.MaxAgeBlocks
+	}
+	return 0
+}
+
+func (m *GetFreshPriceRequest) GetMaxAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+// GetFreshPriceResponse is the response type for the Query/GetFreshPrice RPC
+// method.
+type GetFreshPriceResponse struct {
+	// Price is the fresh price of the currency pair.
+	Price QuotePrice `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	// Nonce is the number of updates to the price of the currency pair.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Decimals is the number of decimals of the price.
+	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// ID is the ID of the currency pair.
+	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// MaxAge is the max age that was enforced.
+	MaxAge PriceMaxAge `protobuf:"bytes,5,opt,name=max_age,json=maxAge,proto3" json:"max_age"`
+}
+
+func (m *GetFreshPriceResponse) Reset()         { *m = GetFreshPriceResponse{} }
+func (m *GetFreshPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFreshPriceResponse) ProtoMessage()    {}
+func (*GetFreshPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{25}
+}
+func (m *GetFreshPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFreshPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFreshPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFreshPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFreshPriceResponse.Merge(m, src)
+}
+func (m *GetFreshPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFreshPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFreshPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFreshPriceResponse proto.InternalMessageInfo
+
+func (m *GetFreshPriceResponse) GetPrice() QuotePrice {
+	if m != nil {
+		return m.Price
+	}
+	return QuotePrice{}
+}
+
+func (m *GetFreshPriceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *GetFreshPriceResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *GetFreshPriceResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetFreshPriceResponse) GetMaxAge() PriceMaxAge {
+	if m != nil {
+		return m.MaxAge
+	}
+	return PriceMaxAge{}
+}
+
+// PriceMaxAgesRequest is the request type for the Query/PriceMaxAges RPC
+// method.
+type PriceMaxAgesRequest struct {
+}
+
+func (m *PriceMaxAgesRequest) Reset()         { *m = PriceMaxAgesRequest{} }
+func (m *PriceMaxAgesRequest) String() string { return proto.CompactTextString(m) }
+func (*PriceMaxAgesRequest) ProtoMessage()    {}
+func (*PriceMaxAgesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{26}
+}
+func (m *PriceMaxAgesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMaxAgesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMaxAgesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceMaxAgesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMaxAgesRequest.Merge(m, src)
+}
+func (m *PriceMaxAgesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceMaxAgesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMaxAgesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceMaxAgesRequest proto.InternalMessageInfo
+
+// PriceMaxAgesResponse is the response type for the Query/PriceMaxAges RPC
+// method.
+type PriceMaxAgesResponse struct {
+	PriceMaxAges []PriceMaxAge `protobuf:"bytes,1,rep,name=price_max_ages,json=priceMaxAges,proto3" json:"price_max_ages"`
+}
+
+func (m *PriceMaxAgesResponse) Reset()         { *m = PriceMaxAgesResponse{} }
+func (m *PriceMaxAgesResponse) String() string { return proto.CompactTextString(m) }
+func (*PriceMaxAgesResponse) ProtoMessage()    {}
+func (*PriceMaxAgesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{27}
+}
+func (m *PriceMaxAgesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMaxAgesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMaxAgesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceMaxAgesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMaxAgesResponse.Merge(m, src)
+}
+func (m *PriceMaxAgesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceMaxAgesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMaxAgesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceMaxAgesResponse proto.InternalMessageInfo
+
+func (m *PriceMaxAgesResponse) GetPriceMaxAges() []PriceMaxAge {
+	if m != nil {
+		return m.PriceMaxAges
+	}
+	return nil
+}
+
+// InvariantsRequest is the request type for the Query/Invariants RPC method.
+type InvariantsRequest struct {
+}
+
+func (m *InvariantsRequest) Reset()         { *m = InvariantsRequest{} }
+func (m *InvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*InvariantsRequest) ProtoMessage()    {}
+func (*InvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{28}
+}
+func (m *InvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantsRequest.Merge(m, src)
+}
+func (m *InvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantsRequest proto.InternalMessageInfo
+
+// InvariantsResponse is the response type for the Query/Invariants RPC
+// method.
+type InvariantsResponse struct {
+	// Invariants are the results of each x/oracle invariant, in registration
+	// order.
+	Invariants []InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+}
+
+func (m *InvariantsResponse) Reset()         { *m = InvariantsResponse{} }
+func (m *InvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*InvariantsResponse) ProtoMessage()    {}
+func (*InvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{29}
+}
+func (m *InvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantsResponse.Merge(m, src)
+}
+func (m *InvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantsResponse proto.InternalMessageInfo
+
+func (m *InvariantsResponse) GetInvariants() []InvariantResult {
+	if m != nil {
+		return m.Invariants
+	}
+	return nil
+}
+
+// InvariantResult is the result of an x/oracle invariant.
+type InvariantResult struct {
+	// Name is the route of the invariant.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Broken is true if the invariant does not hold.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// Violations describes each violation of the invariant.
+	Violations []string `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{30}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetViolations() []string {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "connect.oracle.v2.GetAllCurrencyPairsRequest")
+	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "connect.oracle.v2.GetAllCurrencyPairsResponse")
+	proto.RegisterType((*GetPriceRequest)(nil), "connect.oracle.v2.GetPriceRequest")
+	proto.RegisterType((*GetPriceResponse)(nil), "connect.oracle.v2.GetPriceResponse")
+	proto.RegisterType((*GetPricesRequest)(nil), "connect.oracle.v2.GetPricesRequest")
+	proto.RegisterType((*GetPricesResponse)(nil), "connect.oracle.v2.GetPricesResponse")
+	proto.RegisterType((*GetCurrencyPairMappingRequest)(nil), "connect.oracle.v2.GetCurrencyPairMappingRequest")
+	proto.RegisterType((*GetCurrencyPairMappingResponse)(nil), "connect.oracle.v2.GetCurrencyPairMappingResponse")
+	proto.RegisterMapType((map[uint64]types.CurrencyPair)(nil), "connect.oracle.v2.GetCurrencyPairMappingResponse.CurrencyPairMappingEntry")
+	proto.RegisterType((*ParamsRequest)(nil), "connect.oracle.v2.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "connect.oracle.v2.ParamsResponse")
+	proto.RegisterType((*MarketQuorumsRequest)(nil), "connect.oracle.v2.MarketQuorumsRequest")
+	proto.RegisterType((*MarketQuorumsResponse)(nil), "connect.oracle.v2.MarketQuorumsResponse")
+	proto.RegisterType((*CircuitBreakersRequest)(nil), "connect.oracle.v2.CircuitBreakersRequest")
+	proto.RegisterType((*CircuitBreakersResponse)(nil), "connect.oracle.v2.CircuitBreakersResponse")
+	proto.RegisterType((*MarketHaltsRequest)(nil), "connect.oracle.v2.MarketHaltsRequest")
+	proto.RegisterType((*MarketHaltsResponse)(nil), "connect.oracle.v2.MarketHaltsResponse")
+	proto.RegisterType((*ValidatorParticipationRequest)(nil), "connect.oracle.v2.ValidatorParticipationRequest")
+	proto.RegisterType((*ValidatorParticipationResponse)(nil), "connect.oracle.v2.ValidatorParticipationResponse")
+	proto.RegisterType((*MarketParticipationRequest)(nil), "connect.oracle.v2.MarketParticipationRequest")
+	proto.RegisterType((*MarketParticipationResponse)(nil), "connect.oracle.v2.MarketParticipationResponse")
+	proto.RegisterType((*GetPriceHistoryRequest)(nil), "connect.oracle.v2.GetPriceHistoryRequest")
+	proto.RegisterType((*GetPriceHistoryResponse)(nil), "connect.oracle.v2.GetPriceHistoryResponse")
+	proto.RegisterType((*GetTWAPRequest)(nil), "connect.oracle.v2.GetTWAPRequest")
+	proto.RegisterType((*GetTWAPResponse)(nil), "connect.oracle.v2.GetTWAPResponse")
+	proto.RegisterType((*GetFreshPriceRequest)(nil), "connect.oracle.v2.GetFreshPriceRequest")
+	proto.RegisterType((*GetFreshPriceResponse)(nil), "connect.oracle.v2.GetFreshPriceResponse")
+	proto.RegisterType((*PriceMaxAgesRequest)(nil), "connect.oracle.v2.PriceMaxAgesRequest")
+	proto.RegisterType((*PriceMaxAgesResponse)(nil), "connect.oracle.v2.PriceMaxAgesResponse")
+	proto.RegisterType((*InvariantsRequest)(nil), "connect.oracle.v2.InvariantsRequest")
+	proto.RegisterType((*InvariantsResponse)(nil), "connect.oracle.v2.InvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "connect.oracle.v2.InvariantResult")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/query.proto", fileDescriptor_85b187574238e3d2) }
+
+var fileDescriptor_85b187574238e3d2 = []byte{
+	// 1827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdb, 0x6f, 0x1b, 0x59,
+	0x19, 0xcf, 0x71, 0x2e, 0x4d, 0xbe, 0x5c, 0xdc, 0x9c, 0x5c, 0xd6, 0x75, 0x1b, 0x27, 0x99, 0x5c,
+	0x9b, 0x6d, 0x66, 0xa8, 0x59, 0x71, 0x5b, 0x2d, 0x28, 0xa9, 0x68, 0x92, 0x85, 0x8a, 0x74, 0x58,
+	0xed, 0x4a, 0x48, 0xcb, 0xe8, 0x78, 0x7c, 0xea, 0x8c, 0xe2, 0xb9, 0x64, 0xe6, 0xd8, 0xad, 0x1f,
+	0x90, 0x10, 0x82, 0x87, 0x45, 0x02, 0x56, 0x42, 0xf0, 0x08, 0xbc, 0xf0, 0x07, 0xa0, 0x45, 0xe2,
+	0x2f, 0x40, 0xda, 0x17, 0xa4, 0x8a, 0x7d, 0x59, 0xf1, 0xb0, 0xa0, 0x96, 0x3f, 0x04, 0xcd, 0x39,
+	0x67, 0xc6, 0x33, 0xf6, 0x78, 0xec, 0x94, 0xbe, 0xec, 0x9b, 0xcf, 0x77, 0xfd, 0x9d, 0xef, 0x3b,
+	0xf3, 0x5d, 0x0c, 0x6b, 0xa6, 0xeb, 0x38, 0xd4, 0x64, 0x9a, 0xeb, 0x13, 0xb3, 0x49, 0xb5, 0x76,
+	0x55, 0xbb, 0x6a, 0x51, 0xbf, 0xa3, 0x7a, 0xbe, 0xcb, 0x5c, 0xbc, 0x28, 0xd9, 0xaa, 0x60, 0xab,
+	0xed, 0x6a, 0x79, 0xb9, 0xe1, 0x36, 0x5c, 0xce, 0xd5, 0xc2, 0x5f, 0x42, 0xb0, 0x7c, 0xa7, 0xe1,
+	0xba, 0x8d, 0x26, 0xd5, 0x88, 0x67, 0x69, 0xc4, 0x71, 0x5c, 0x46, 0x98, 0xe5, 0x3a, 0x81, 0xe4,
+	0xae, 0x4b, 0x2e, 0x3f, 0xd5, 0x5a, 0x4f, 0x34, 0x66, 0xd9, 0x34, 0x60, 0xc4, 0xf6, 0xa4, 0xc0,
+	0x81, 0xe9, 0x06, 0xb6, 0x1b, 0x68, 0x35, 0x12, 0x50, 0x01, 0x40, 0x6b, 0xdf, 0xaf, 0x51, 0x46,
+	0xee, 0x6b, 0x1e, 0x69, 0x58, 0x0e, 0xb7, 0x16, 0x19, 0xeb, 0x87, 0xdc, 0xa0, 0x0e, 0x0d, 0xac,
+	0xc8, 0xdb, 0x76, 0x24, 0xc0, 0x3a, 0x1e, 0x0d, 0x42, 0xbe, 0xd9, 0xf2, 0x7d, 0xea, 0x98, 0x1d,
+	0xc3, 0x23, 0x96, 0x2f, 0xa5, 0x2a, 0xfd, 0x66, 0x3c, 0xe2, 0x13, 0x3b, 0xb2, 0xb2, 0xd7, 0xcf,
+	0x37, 0x2d, 0xdf, 0x6c, 0x59, 0xcc, 0xa8, 0xf9, 0x94, 0x5c, 0xd2, 0xc8, 0xd0, 0x4e, 0xa6, 0x21,
+	0x66, 0x99, 0x96, 0x37, 0x04, 0xb6, 0x4d, 0x9e, 0x19, 0xa4, 0x41, 0xa5, 0xc0, 0x2d, 0x11, 0x03,
+	0x43, 0xc4, 0x56, 0x1c, 0x04, 0x4b, 0xf9, 0x07, 0x82, 0xf2, 0x09, 0x65, 0x47, 0xcd, 0xe6, 0x03,
+	0x79, 0x93, 0x73, 0x62, 0xf9, 0x81, 0x4e, 0xaf, 0x5a, 0x34, 0x60, 0xf8, 0x21, 0x40, 0x37, 0x4a,
+	0x25, 0xb4, 0x81, 0xf6, 0x67, 0xab, 0xbb, 0xaa, 0xb4, 0x10, 0x86, 0x54, 0x15, 0x39, 0x95, 0x21,
+	0x55, 0xcf, 0x49, 0x83, 0x4a, 0x5d, 0x3d, 0xa1, 0x89, 0x37, 0x61, 0x8e, 0x3a, 0xa4, 0xd6, 0xa4,
+	0x75, 0xc3, 0x75, 0x9a, 0x9d, 0x52, 0x61, 0x03, 0xed, 0x4f, 0xeb, 0xb3, 0x92, 0xf6, 0x03, 0xa7,
+	0xd9, 0xc1, 0x65, 0x98, 0xf6, 0x7c, 0xb7, 0x6d, 0xd5, 0xa9, 0x5f, 0x1a, 0xdf, 0x40, 0xfb, 0x33,
+	0x7a, 0x7c, 0xc6, 0x18, 0x26, 0x42, 0x67, 0xa5, 0x09, 0x4e, 0xe7, 0xbf, 0xf1, 0x32, 0x4c, 0x5e,
+	0xb5, 0x5c, 0x46, 0x4b, 0x93, 0x9c, 0x28, 0x0e, 0xca, 0x27, 0x08, 0x6e, 0x67, 0xde, 0x27, 0xf0,
+	0x5c, 0x27, 0xa0, 0xf8, 0x7b, 0xb0, 0x90, 0x4a, 0x59, 0x50, 0x42, 0x1b, 0xe3, 0xfb, 0xb3, 0xd5,
+	0x8a, 0x1a, 0xbd, 0x47, 0x9e, 0x5a, 0xb5, 0x5d, 0x55, 0x93, 0x06, 0x8e, 0x27, 0x3e, 0xfd, 0x62,
+	0x7d, 0x4c, 0x9f, 0x37, 0x93, 0x46, 0xf1, 0x49, 0x2a, 0x3a, 0x05, 0x1e, 0x9d, 0xbd, 0xa1, 0xd1,
+	0x11, 0x48, 0x92, 0xe1, 0x51, 0xbe, 0x06, 0xc5, 0x13, 0xca, 0xce, 0x7d, 0xcb, 0x8c, 0xa2, 0x87,
+	0xb7, 0x60, 0x3e, 0x05, 0x94, 0x07, 0x7f, 0x46, 0x9f, 0x4b, 0x22, 0x50, 0x7e, 0x83, 0xe0, 0x66,
+	0x57, 0x51, 0x5e, 0xf1, 0x9b, 0x30, 0xe9, 0x85, 0x04, 0x99, 0xae, 0x35, 0xb5, 0xef, 0x4b, 0x53,
+	0x1f, 0x87, 0xb1, 0xe2, 0x5a, 0xfc, 0x62, 0x48, 0x17, 0x1a, 0x61, 0x4c, 0x1d, 0xd7, 0x31, 0x29,
+	0xbf, 0xcb, 0x84, 0x2e, 0x0e, 0x61, 0x66, 0xea, 0xd4, 0xb4, 0x6c, 0xd2, 0x0c, 0x78, 0x66, 0x26,
+	0xf4, 0xf8, 0x8c, 0x17, 0xa0, 0x60, 0xd5, 0x79, 0x5e, 0x26, 0xf4, 0x82, 0x55, 0x57, 0xbe, 0xdd,
+	0x05, 0x14, 0x3f, 0xa2, 0x03, 0x58, 0x4c, 0x5d, 0xc5, 0xb0, 0xea, 0x22, 0xec, 0x33, 0x7a, 0x31,
+	0x79, 0x9d, 0xb3, 0x7a, 0xa0, 0xbc, 0x0f, 0x8b, 0x09, 0x7d, 0x79, 0xa3, 0x23, 0x98, 0xe2, 0xf8,
+	0xa2, 0x64, 0x6d, 0x65, 0x5c, 0xa9, 0x37, 0x0c, 0x32, 0x63, 0x52, 0x51, 0x79, 0x8e, 0x60, 0xed,
+	0x84, 0xb2, 0x64, 0x4e, 0x1f, 0x11, 0xcf, 0xb3, 0x9c, 0xc6, 0x97, 0xf6, 0xa9, 0x7f, 0x5e, 0x80,
+	0xca, 0xa0, 0x2b, 0xc9, 0xc0, 0xfd, 0x1c, 0xc1, 0x4a, 0x3a, 0xf4, 0xb6, 0x90, 0x90, 0x81, 0x7c,
+	0x37, 0x3b, 0x90, 0x39, 0x26, 0xd5, 0x0c, 0xde, 0x77, 0x1d, 0xe6, 0x77, 0x64, 0xbc, 0x97, 0xcc,
+	0x7e, 0xfe, 0x6b, 0xfb, 0x4e, 0xca, 0x4f, 0xa0, 0x34, 0xc8, 0x3f, 0xbe, 0x09, 0xe3, 0x97, 0xb4,
+	0xc3, 0x13, 0x37, 0xa1, 0x87, 0x3f, 0xf1, 0x5b, 0x30, 0xd9, 0x26, 0xcd, 0x16, 0x95, 0x1e, 0x87,
+	0x7c, 0xe2, 0xba, 0x10, 0xfe, 0x56, 0xe1, 0x1b, 0x48, 0x29, 0xc2, 0xfc, 0x39, 0xaf, 0xd8, 0x32,
+	0xc1, 0xca, 0x19, 0x2c, 0x44, 0x04, 0x19, 0xda, 0xaf, 0xc3, 0x94, 0x28, 0xea, 0xf2, 0xa9, 0xdc,
+	0xca, 0x08, 0xa5, 0x50, 0x89, 0x5f, 0x22, 0x3f, 0x29, 0xab, 0xb0, 0xfc, 0x88, 0xf8, 0x97, 0x94,
+	0x3d, 0x6e, 0xb9, 0x7e, 0xab, 0xeb, 0x82, 0xc2, 0x4a, 0x0f, 0x5d, 0x7a, 0xfa, 0x3e, 0x2c, 0xd8,
+	0x9c, 0x61, 0x5c, 0x09, 0x8e, 0x4c, 0xde, 0x7a, 0x86, 0xc7, 0xa4, 0x85, 0xa8, 0x66, 0xd9, 0x49,
+	0xab, 0x4a, 0x09, 0x56, 0x1f, 0x88, 0x66, 0x73, 0x2c, 0x7a, 0x4d, 0x0c, 0xc0, 0x86, 0x37, 0xfa,
+	0x38, 0x12, 0x82, 0x0e, 0x37, 0x7b, 0x3a, 0x54, 0x04, 0x62, 0x33, 0x03, 0x44, 0xda, 0x8a, 0x84,
+	0x51, 0x34, 0xd3, 0xb6, 0x95, 0x65, 0xc0, 0x02, 0xed, 0x29, 0x69, 0xb2, 0x18, 0xc4, 0x87, 0xb0,
+	0x94, 0xa2, 0x4a, 0x00, 0x0f, 0x61, 0x4e, 0xc6, 0xe0, 0x22, 0xa4, 0x4b, 0xe7, 0x6b, 0x03, 0x23,
+	0x10, 0x6a, 0x4b, 0xc7, 0xb3, 0x76, 0xd7, 0x9e, 0xf2, 0x0e, 0xac, 0xbd, 0x4f, 0x9a, 0x56, 0x9d,
+	0x30, 0xd7, 0x3f, 0x4f, 0xb6, 0xd2, 0xa8, 0x0a, 0xdc, 0x81, 0x99, 0x76, 0x24, 0x20, 0x4b, 0x6e,
+	0x97, 0xa0, 0xfc, 0x01, 0x41, 0x65, 0x90, 0xbe, 0x44, 0xba, 0x0f, 0x37, 0x6b, 0x4d, 0xd7, 0xbc,
+	0x0c, 0x0c, 0xcb, 0x31, 0x9e, 0x5a, 0x4e, 0xdd, 0x7d, 0x2a, 0xdf, 0xe4, 0x82, 0xa0, 0x9f, 0x39,
+	0x1f, 0x70, 0x2a, 0xd6, 0x61, 0x3e, 0xd5, 0xcd, 0x4b, 0x85, 0x8d, 0x71, 0x59, 0x73, 0xb2, 0x2f,
+	0x95, 0x72, 0x18, 0x65, 0x37, 0x65, 0x42, 0x39, 0x82, 0x72, 0x86, 0xec, 0xb5, 0x7a, 0xca, 0x15,
+	0xdc, 0xce, 0x34, 0x11, 0x3f, 0x85, 0x1e, 0xd4, 0xe8, 0xff, 0x47, 0xfd, 0xf7, 0x02, 0xac, 0x46,
+	0xf5, 0xfb, 0xd4, 0x0a, 0x98, 0xeb, 0x77, 0xae, 0x03, 0x39, 0x2c, 0xb9, 0x01, 0x23, 0x3e, 0x33,
+	0x2e, 0xa8, 0xd5, 0xb8, 0x60, 0xb2, 0x7b, 0xcd, 0x72, 0xda, 0x29, 0x27, 0xe1, 0x35, 0x00, 0xea,
+	0xd4, 0x23, 0x01, 0xd1, 0xc5, 0x66, 0xa8, 0x53, 0x97, 0xec, 0xef, 0x00, 0x08, 0x0b, 0xe1, 0xf8,
+	0xc8, 0x6b, 0xef, 0x6c, 0xb5, 0xac, 0x8a, 0xd9, 0x52, 0x8d, 0x66, 0x4b, 0xf5, 0xbd, 0x68, 0xb6,
+	0x3c, 0x9e, 0xf8, 0xf8, 0xdf, 0xeb, 0x48, 0x9f, 0xe1, 0x3a, 0x21, 0x15, 0xbf, 0x0d, 0xd3, 0xa1,
+	0x7d, 0xae, 0x3e, 0x39, 0xa2, 0xfa, 0x0d, 0xea, 0xd4, 0xb9, 0x72, 0xba, 0xf5, 0x4c, 0xbd, 0x6a,
+	0xeb, 0x51, 0xfe, 0x88, 0xe0, 0x8d, 0xbe, 0x38, 0xca, 0xbc, 0xbd, 0xdd, 0xd3, 0x43, 0x47, 0x18,
+	0x0b, 0xe2, 0xee, 0xf9, 0xfa, 0x06, 0x9d, 0xbf, 0x21, 0x58, 0x38, 0xa1, 0xec, 0xbd, 0x0f, 0x8e,
+	0xce, 0xaf, 0x95, 0xe1, 0x07, 0xa9, 0xfc, 0x14, 0x86, 0x06, 0x78, 0x3a, 0x84, 0x9f, 0x97, 0xa3,
+	0xf1, 0x6b, 0xe6, 0x48, 0xf1, 0xa0, 0x18, 0x03, 0x8f, 0xc7, 0x92, 0xc4, 0xa0, 0x35, 0x73, 0xfc,
+	0x66, 0xe8, 0xf3, 0x5f, 0x5f, 0xac, 0xaf, 0x88, 0xb8, 0x04, 0xf5, 0x4b, 0xd5, 0x72, 0x35, 0x9b,
+	0xb0, 0x0b, 0xf5, 0xcc, 0x61, 0xff, 0xfc, 0xeb, 0x21, 0xc8, 0x80, 0x9d, 0x39, 0x2c, 0x1a, 0xb8,
+	0x92, 0xa3, 0x55, 0x21, 0x3d, 0x5a, 0x29, 0x1f, 0x21, 0x58, 0x3e, 0xa1, 0xec, 0xa1, 0x4f, 0x83,
+	0x8b, 0x6b, 0x8f, 0x86, 0x78, 0x1b, 0x16, 0xe4, 0x12, 0x60, 0x88, 0xba, 0x23, 0xed, 0xcf, 0xd9,
+	0xe4, 0xd9, 0x51, 0x83, 0x1e, 0x73, 0x1a, 0xde, 0x85, 0x62, 0x24, 0x15, 0x50, 0xd3, 0x75, 0xea,
+	0xd1, 0x84, 0x37, 0x2f, 0xc4, 0x7e, 0x28, 0x88, 0xca, 0x67, 0x08, 0x56, 0x7a, 0xb0, 0xbc, 0xf2,
+	0xb4, 0x39, 0xf6, 0xda, 0xa6, 0x4d, 0xfc, 0x0e, 0xdc, 0x90, 0xf0, 0x4b, 0x93, 0x3d, 0x3d, 0x3e,
+	0xd1, 0x85, 0x43, 0x67, 0x8f, 0xc4, 0xad, 0xe5, 0xb3, 0x16, 0x97, 0x53, 0x56, 0x60, 0x29, 0xc1,
+	0x8c, 0x7b, 0x50, 0x0d, 0x96, 0xd3, 0x64, 0x79, 0xd5, 0x77, 0x61, 0x81, 0x03, 0x37, 0xa4, 0xcf,
+	0xfe, 0xdd, 0x21, 0xcf, 0xe9, 0x9c, 0x97, 0xb0, 0xa9, 0x2c, 0xc1, 0xe2, 0x99, 0xd3, 0x26, 0xbe,
+	0x45, 0x9c, 0x6e, 0xf3, 0xfb, 0x31, 0xe0, 0x24, 0x51, 0xba, 0x3d, 0x05, 0xb0, 0x62, 0xaa, 0x74,
+	0xa9, 0x64, 0xb8, 0x8c, 0x55, 0x75, 0x1a, 0xb4, 0xe2, 0xf6, 0x97, 0xd0, 0x55, 0x3e, 0x84, 0x62,
+	0x8f, 0x50, 0x38, 0x6e, 0x3a, 0xc4, 0x96, 0x4f, 0x58, 0xe7, 0xbf, 0xf1, 0x2a, 0x4c, 0xd5, 0x7c,
+	0xf7, 0x92, 0x3a, 0x72, 0x76, 0x95, 0x27, 0x5c, 0x01, 0x68, 0x5b, 0x6e, 0x53, 0xec, 0xdf, 0xa5,
+	0x71, 0x3e, 0xc0, 0x27, 0x28, 0xd5, 0x8f, 0x16, 0x61, 0xf2, 0x71, 0x58, 0x07, 0xf0, 0x9f, 0x10,
+	0x2c, 0x65, 0x6c, 0x61, 0xf8, 0x30, 0x7b, 0xde, 0x1c, 0xb0, 0x7d, 0x96, 0xd5, 0x51, 0xc5, 0x45,
+	0xa4, 0x94, 0x83, 0x9f, 0x7d, 0xf6, 0xdf, 0xdf, 0x16, 0xb6, 0xb1, 0xa2, 0x65, 0x2d, 0xf2, 0xcc,
+	0x20, 0xcd, 0xa6, 0xc1, 0x2c, 0x33, 0x1c, 0x3f, 0x70, 0x07, 0xa6, 0xa3, 0x52, 0x89, 0x95, 0xdc,
+	0x7d, 0x42, 0x60, 0x19, 0x65, 0xe7, 0x50, 0xb6, 0x39, 0x80, 0x0a, 0xbe, 0x33, 0x00, 0x80, 0x78,
+	0xf7, 0x3f, 0x81, 0x99, 0x48, 0x33, 0xc0, 0x79, 0x76, 0xe3, 0x40, 0x6c, 0xe7, 0x0b, 0x49, 0xef,
+	0x3b, 0xdc, 0xfb, 0x3a, 0x5e, 0xcb, 0xf3, 0x1e, 0xe0, 0xdf, 0x23, 0x28, 0xf6, 0x74, 0x09, 0x7c,
+	0x37, 0xc7, 0x41, 0xba, 0x23, 0x97, 0x0f, 0x46, 0x11, 0x95, 0x88, 0xee, 0x71, 0x44, 0xbb, 0x78,
+	0x3b, 0x0f, 0x91, 0x71, 0x21, 0x41, 0xfc, 0x1a, 0xc1, 0x7c, 0xaa, 0xc8, 0xe0, 0xbd, 0x6c, 0x5f,
+	0x7d, 0x25, 0xb1, 0xbc, 0x3f, 0x5c, 0x70, 0xc4, 0x37, 0xf2, 0x24, 0x54, 0x91, 0x89, 0xfa, 0x25,
+	0x82, 0xb9, 0x64, 0x25, 0xc0, 0xbb, 0xf9, 0x5f, 0x7a, 0x9c, 0xaf, 0xbd, 0xa1, 0x72, 0x12, 0xcd,
+	0x5d, 0x8e, 0x66, 0x0b, 0x6f, 0x66, 0xa0, 0x49, 0xd7, 0x1a, 0xfc, 0x53, 0x04, 0xd0, 0xad, 0x0e,
+	0x78, 0x3b, 0xaf, 0x02, 0xc4, 0x40, 0x76, 0x86, 0x48, 0x8d, 0xf0, 0x72, 0xba, 0xf5, 0x03, 0xb7,
+	0xe0, 0x86, 0xec, 0x81, 0x78, 0x33, 0x3b, 0xe0, 0x89, 0xc6, 0x5e, 0x56, 0xf2, 0x44, 0xa4, 0xe3,
+	0x2d, 0xee, 0x78, 0x0d, 0xdf, 0x1e, 0x90, 0x0d, 0xf6, 0x94, 0x78, 0xf8, 0x13, 0xc4, 0xc7, 0xc3,
+	0x8c, 0xcd, 0x0f, 0x7f, 0xe5, 0x1a, 0x0b, 0xac, 0x40, 0x75, 0xff, 0xda, 0x2b, 0xaf, 0xf2, 0x16,
+	0x07, 0xa9, 0xe2, 0x7b, 0x03, 0x40, 0x66, 0x6e, 0xd8, 0xd8, 0x83, 0x29, 0xb1, 0xff, 0xe1, 0x8d,
+	0x81, 0xab, 0x61, 0x04, 0x6a, 0x33, 0x47, 0x42, 0x82, 0xd8, 0xe4, 0x20, 0x6e, 0xe3, 0x5b, 0xda,
+	0xa0, 0x7f, 0x17, 0xf1, 0xaf, 0x10, 0xcc, 0xa7, 0x56, 0xc8, 0xcc, 0xef, 0x27, 0x6b, 0xf9, 0x2c,
+	0xef, 0x0f, 0x17, 0x1c, 0xe1, 0xc5, 0xa6, 0xd7, 0x54, 0xfc, 0x3b, 0x04, 0xc5, 0x9e, 0x8d, 0x32,
+	0xb3, 0xd0, 0x64, 0xef, 0xa3, 0xe5, 0x83, 0x51, 0x44, 0x25, 0xaa, 0x37, 0x39, 0xaa, 0x1d, 0xbc,
+	0xa5, 0x0d, 0xfd, 0x6f, 0x35, 0xc0, 0xbf, 0x40, 0x30, 0x9b, 0x58, 0x32, 0xf1, 0x4e, 0xee, 0x1a,
+	0x19, 0xe3, 0xd9, 0x1d, 0x26, 0x26, 0xb1, 0xec, 0x71, 0x2c, 0x9b, 0x78, 0x7d, 0x70, 0x84, 0xf8,
+	0x12, 0x8b, 0xff, 0x82, 0x60, 0x35, 0x7b, 0x9b, 0xcc, 0x7c, 0xd7, 0xb9, 0x8b, 0x6b, 0xf9, 0xfe,
+	0x35, 0x34, 0x24, 0xd0, 0x2a, 0x07, 0x7a, 0x0f, 0x1f, 0x64, 0x00, 0x8d, 0x77, 0x5e, 0x23, 0xb5,
+	0xaa, 0xe1, 0x3f, 0xa3, 0x68, 0x41, 0x4f, 0x03, 0x3e, 0x1c, 0x6d, 0xff, 0xcb, 0xeb, 0xec, 0x39,
+	0x5b, 0xa7, 0xa2, 0x71, 0xa8, 0x77, 0xf1, 0xde, 0xe0, 0x98, 0xa6, 0x70, 0x1e, 0x9f, 0x7c, 0xfa,
+	0xa2, 0x82, 0x9e, 0xbf, 0xa8, 0xa0, 0xff, 0xbc, 0xa8, 0xa0, 0x8f, 0x5f, 0x56, 0xc6, 0x9e, 0xbf,
+	0xac, 0x8c, 0x7d, 0xfe, 0xb2, 0x32, 0xf6, 0xa3, 0xc3, 0x86, 0xc5, 0x2e, 0x5a, 0x35, 0xd5, 0x74,
+	0x6d, 0x2d, 0xb8, 0xb4, 0xbc, 0x43, 0x9b, 0xb6, 0x63, 0xab, 0xed, 0xaa, 0xf6, 0x2c, 0x32, 0xcd,
+	0xff, 0x25, 0xaa, 0x4d, 0xf1, 0xd5, 0xe0, 0xab, 0xff, 0x1b, 0x00, 0x0c, 0x6e, 0x82, 0x47, 0xcf,
+	0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Get all the currency pairs the x/oracle module is tracking price-data for,
+	// optionally filtered and paginated.
+	GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error)
+	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
+	// that CurrencyPair.
+	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
+	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
+	// GetPriceHistory returns the historical prices of a CurrencyPair, ordered
+	// by block height, optionally filtered by block height and block time.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// GetFreshPrice returns the price of a CurrencyPair only if it was updated
+	// within a max age of the current block, and fails otherwise.
+	GetFreshPrice(ctx context.Context, in *GetFreshPriceRequest, opts ...grpc.CallOption) (*GetFreshPriceResponse, error)
+	// PriceMaxAges returns the per-currency-pair default price max ages set in
+	// the x/oracle module.
+	PriceMaxAges(ctx context.Context, in *PriceMaxAgesRequest, opts ...grpc.CallOption) (*PriceMaxAgesResponse, error)
+	// Invariants runs the x/oracle invariants, including the consistency checks
+	// between x/oracle and x/marketmap, and returns the result of each.
+	Invariants(ctx context.Context, in *InvariantsRequest, opts ...grpc.CallOption) (*InvariantsResponse, error)
+	// GetTWAP returns the time weighted average price of a CurrencyPair between
+	// two block times.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
+	// Get the mapping of currency pair ID -> currency pair. This is useful for
+	// indexers that have access to the ID of a currency pair, but no way to get
+	// the underlying currency pair from it.
+	GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// MarketQuorums returns the per-currency-pair quorums set in the x/oracle
+	// module. Currency pairs without a quorum use the quorum in Params.
+	MarketQuorums(ctx context.Context, in *MarketQuorumsRequest, opts ...grpc.CallOption) (*MarketQuorumsResponse, error)
+	// CircuitBreakers returns the per-currency-pair circuit breakers set in the
+	// x/oracle module.
+	CircuitBreakers(ctx context.Context, in *CircuitBreakersRequest, opts ...grpc.CallOption) (*CircuitBreakersResponse, error)
+	// MarketHalts returns the currency pairs whose price updates are halted by
+	// their circuit breakers.
+	MarketHalts(ctx context.Context, in *MarketHaltsRequest, opts ...grpc.CallOption) (*MarketHaltsResponse, error)
+	// ValidatorParticipation returns the participation of a validator in the
+	// price updates of each currency pair over its participation window.
+	ValidatorParticipation(ctx context.Context, in *ValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipationResponse, error)
+	// MarketParticipation returns the participation of each validator in the
+	// price updates of a currency pair over their participation windows.
+	MarketParticipation(ctx context.Context, in *MarketParticipationRequest, opts ...grpc.CallOption) (*MarketParticipationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error) {
+	out := new(GetAllCurrencyPairsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetAllCurrencyPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error) {
+	out := new(GetPriceResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error) {
+	out := new(GetPricesResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFreshPrice(ctx context.Context, in *GetFreshPriceRequest, opts ...grpc.CallOption) (*GetFreshPriceResponse, error) {
+	out := new(GetFreshPriceResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetFreshPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceMaxAges(ctx context.Context, in *PriceMaxAgesRequest, opts ...grpc.CallOption) (*PriceMaxAgesResponse, error) {
+	out := new(PriceMaxAgesResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/PriceMaxAges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *InvariantsRequest, opts ...grpc.CallOption) (*InvariantsResponse, error) {
+	out := new(InvariantsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error) {
+	out := new(GetCurrencyPairMappingResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetCurrencyPairMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketQuorums(ctx context.Context, in *MarketQuorumsRequest, opts ...grpc.CallOption) (*MarketQuorumsResponse, error) {
+	out := new(MarketQuorumsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/MarketQuorums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *CircuitBreakersRequest, opts ...grpc.CallOption) (*CircuitBreakersResponse, error) {
+	out := new(CircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/CircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketHalts(ctx context.Context, in *MarketHaltsRequest, opts ...grpc.CallOption) (*MarketHaltsResponse, error) {
+	out := new(MarketHaltsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/MarketHalts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorParticipation(ctx context.Context, in *ValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipationResponse, error) {
+	out := new(ValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/ValidatorParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketParticipation(ctx context.Context, in *MarketParticipationRequest, opts ...grpc.CallOption) (*MarketParticipationResponse, error) {
+	out := new(MarketParticipationResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/MarketParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Get all the currency pairs the x/oracle module is tracking price-data for,
+	// optionally filtered and paginated.
+	GetAllCurrencyPairs(context.Context, *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error)
+	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
+	// that CurrencyPair.
+	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
+	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
+	// GetPriceHistory returns the historical prices of a CurrencyPair, ordered
+	// by block height, optionally filtered by block height and block time.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// GetFreshPrice returns the price of a CurrencyPair only if it was updated
+	// within a max age of the current block, and fails otherwise.
+	GetFreshPrice(context.Context, *GetFreshPriceRequest) (*GetFreshPriceResponse, error)
+	// PriceMaxAges returns the per-currency-pair default price max ages set in
+	// the x/oracle module.
+	PriceMaxAges(context.Context, *PriceMaxAgesRequest) (*PriceMaxAgesResponse, error)
+	// Invariants runs the x/oracle invariants, including the consistency checks
+	// between x/oracle and x/marketmap, and returns the result of each.
+	Invariants(context.Context, *InvariantsRequest) (*InvariantsResponse, error)
+	// GetTWAP returns the time weighted average price of a CurrencyPair between
+	// two block times.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
+	// Get the mapping of currency pair ID -> currency pair. This is useful for
+	// indexers that have access to the ID of a currency pair, but no way to get
+	// the underlying currency pair from it.
+	GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// MarketQuorums returns the per-currency-pair quorums set in the x/oracle
+	// module. Currency pairs without a quorum use the quorum in Params.
+	MarketQuorums(context.Context, *MarketQuorumsRequest) (*MarketQuorumsResponse, error)
+	// CircuitBreakers returns the per-currency-pair circuit breakers set in the
+	// x/oracle module.
+	CircuitBreakers(context.Context, *CircuitBreakersRequest) (*CircuitBreakersResponse, error)
+	// MarketHalts returns the currency pairs whose price updates are halted by
+	// their circuit breakers.
+	MarketHalts(context.Context, *MarketHaltsRequest) (*MarketHaltsResponse, error)
+	// ValidatorParticipation returns the participation of a validator in the
+	// price updates of each currency pair over its participation window.
+	ValidatorParticipation(context.Context, *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error)
+	// MarketParticipation returns the participation of each validator in the
+	// price updates of a currency pair over their participation windows.
+	MarketParticipation(context.Context, *MarketParticipationRequest) (*MarketParticipationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GetAllCurrencyPairs(ctx context.Context, req *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCurrencyPairs not implemented")
+}
+func (*UnimplementedQueryServer) GetPrice(ctx context.Context, req *GetPriceRequest) (*GetPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+}
+func (*UnimplementedQueryServer) GetPrices(ctx context.Context, req *GetPricesRequest) (*GetPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
+}
+func (*UnimplementedQueryServer) GetPriceHistory(ctx context.Context, req *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetFreshPrice(ctx context.Context, req *GetFreshPriceRequest) (*GetFreshPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreshPrice not implemented")
+}
+func (*UnimplementedQueryServer) PriceMaxAges(ctx context.Context, req *PriceMaxAgesRequest) (*PriceMaxAgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceMaxAges not implemented")
+}
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *InvariantsRequest) (*InvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (*UnimplementedQueryServer) GetTWAP(ctx context.Context, req *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
+func (*UnimplementedQueryServer) GetCurrencyPairMapping(ctx context.Context, req *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMapping not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) MarketQuorums(ctx context.Context, req *MarketQuorumsRequest) (*MarketQuorumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketQuorums not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakers(ctx context.Context, req *CircuitBreakersRequest) (*CircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
+func (*UnimplementedQueryServer) MarketHalts(ctx context.Context, req *MarketHaltsRequest) (*MarketHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketHalts not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorParticipation(ctx context.Context, req *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorParticipation not implemented")
+}
+func (*UnimplementedQueryServer) MarketParticipation(ctx context.Context, req *MarketParticipationRequest) (*MarketParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketParticipation not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetAllCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCurrencyPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllCurrencyPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetAllCurrencyPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllCurrencyPairs(ctx, req.(*GetAllCurrencyPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPrice(ctx, req.(*GetPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPrices(ctx, req.(*GetPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFreshPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreshPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFreshPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetFreshPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFreshPrice(ctx, req.(*GetFreshPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceMaxAges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceMaxAgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceMaxAges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/PriceMaxAges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceMaxAges(ctx, req.(*PriceMaxAgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*InvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyPairMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCurrencyPairMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetCurrencyPairMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCurrencyPairMapping(ctx, req.(*GetCurrencyPairMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketQuorums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketQuorumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketQuorums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/MarketQuorums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketQuorums(ctx, req.(*MarketQuorumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/CircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*CircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketHaltsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/MarketHalts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketHalts(ctx, req.(*MarketHaltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/ValidatorParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorParticipation(ctx, req.(*ValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/MarketParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketParticipation(ctx, req.(*MarketParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.oracle.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllCurrencyPairs",
+			Handler:    _Query_GetAllCurrencyPairs_Handler,
+		},
+		{
+			MethodName: "GetPrice",
+			Handler:    _Query_GetPrice_Handler,
+		},
+		{
+			MethodName: "GetPrices",
+			Handler:    _Query_GetPrices_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetFreshPrice",
+			Handler:    _Query_GetFreshPrice_Handler,
+		},
+		{
+			MethodName: "PriceMaxAges",
+			Handler:    _Query_PriceMaxAges_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
+		{
+			MethodName: "GetCurrencyPairMapping",
+			Handler:    _Query_GetCurrencyPairMapping_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "MarketQuorums",
+			Handler:    _Query_MarketQuorums_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
+		{
+			MethodName: "MarketHalts",
+			Handler:    _Query_MarketHalts_Handler,
+		},
+		{
+			MethodName: "ValidatorParticipation",
+			Handler:    _Query_ValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "MarketParticipation",
+			Handler:    _Query_MarketParticipation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/oracle/v2/query.proto",
+}
+
+func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllCurrencyPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EnabledOnly {
+		i--
+		if m.EnabledOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EnabledOnly {
+		i--
+		if m.EnabledOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrencyPairMapping) > 0 {
+		for k := range m.CurrencyPairMapping {
+			v := m.CurrencyPairMapping[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketQuorumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketQuorumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketQuorumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketQuorumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketQuorumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketQuorumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketQuorums) > 0 {
+		for iNdEx := len(m.MarketQuorums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketQuorums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketHaltsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketHaltsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketHaltsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketHaltsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketHaltsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketHaltsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketHalts) > 0 {
+		for iNdEx := len(m.MarketHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participation) > 0 {
+		for iNdEx := len(m.Participation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlocksInWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksInWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participation) > 0 {
+		for iNdEx := len(m.Participation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFreshPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFreshPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFreshPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAgeBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAgeBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFreshPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFreshPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFreshPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxAge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceMaxAgesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMaxAgesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMaxAgesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceMaxAgesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMaxAgesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMaxAgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceMaxAges) > 0 {
+		for iNdEx := len(m.PriceMaxAges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceMaxAges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Violations[iNdEx])
+			copy(dAtA[i:], m.Violations[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Violations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetAllCurrencyPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EnabledOnly {
+		n += 2
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GetPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetCurrencyPairMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EnabledOnly {
+		n += 2
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCurrencyPairMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairMapping) > 0 {
+		for k, v := range m.CurrencyPairMapping {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketQuorumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketQuorumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketQuorums) > 0 {
+		for _, e := range m.MarketQuorums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CircuitBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketHaltsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketHaltsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketHalts) > 0 {
+		for _, e := range m.MarketHalts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksInWindow != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksInWindow))
+	}
+	if len(m.Participation) > 0 {
+		for _, e := range m.Participation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participation) > 0 {
+		for _, e := range m.Participation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *GetFreshPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxAgeBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAgeBlocks))
+	}
+	if m.MaxAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAgeSeconds))
+	}
+	return n
+}
+
+func (m *GetFreshPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = m.MaxAge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PriceMaxAgesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PriceMaxAgesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceMaxAges) > 0 {
+		for _, e := range m.PriceMaxAges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *GetCurrencyPairMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	if len(m.Violations) > 0 {
+		for _, s := range m.Violations {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnabledOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, GetPriceResponse{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnabledOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrencyPairMapping == nil {
+				m.CurrencyPairMapping = make(map[uint64]types.CurrencyPair)
+			}
+			var mapkey uint64
+			mapvalue := &types.CurrencyPair{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.CurrencyPair{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CurrencyPairMapping[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketQuorumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketQuorumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketQuorumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketQuorumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketQuorumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketQuorumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketQuorums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketQuorums = append(m.MarketQuorums, MarketQuorum{})
+			if err := m.MarketQuorums[len(m.MarketQuorums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketHaltsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketHaltsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketHaltsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketHaltsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketHaltsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketHaltsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketHalts = append(m.MarketHalts, MarketHalt{})
+			if err := m.MarketHalts[len(m.MarketHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksInWindow", wireType)
+			}
+			m.BlocksInWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksInWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participation = append(m.Participation, MarketParticipation{})
+			if err := m.Participation[len(m.Participation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participation = append(m.Participation, MarketParticipation{})
+			if err := m.Participation[len(m.Participation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, QuotePrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GetCurrencyPairMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairMapping) > 0 {
-		for k, v := range m.CurrencyPairMapping {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1105,12 +6642,113 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1132,7 +6770,7 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1155,17 +6793,17 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1175,26 +6813,45 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1216,7 +6873,7 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *GetFreshPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1239,10 +6896,10 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFreshPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFreshPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1277,6 +6934,44 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeBlocks", wireType)
+			}
+			m.MaxAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
+			}
+			m.MaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1298,7 +6993,7 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *GetFreshPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1321,10 +7016,10 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFreshPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFreshPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1356,9 +7051,6 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
-			}
 			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1420,6 +7112,39 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1441,7 +7166,7 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *PriceMaxAgesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1464,44 +7189,12 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceMaxAgesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceMaxAgesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1523,7 +7216,7 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *PriceMaxAgesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1546,15 +7239,15 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceMaxAgesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceMaxAgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMaxAges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1581,8 +7274,8 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, GetPriceResponse{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceMaxAges = append(m.PriceMaxAges, PriceMaxAge{})
+			if err := m.PriceMaxAges[len(m.PriceMaxAges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1607,7 +7300,7 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *InvariantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1630,10 +7323,10 @@ func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvariantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1657,7 +7350,7 @@ func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *InvariantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1680,15 +7373,15 @@ func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvariantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1715,91 +7408,144 @@ func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrencyPairMapping == nil {
-				m.CurrencyPairMapping = make(map[uint64]types.CurrencyPair)
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey uint64
-			mapvalue := &types.CurrencyPair{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &types.CurrencyPair{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.CurrencyPairMapping[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Violations = append(m.Violations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
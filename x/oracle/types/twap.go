@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// NewPriceAccumulator returns a new PriceAccumulator starting at the given price, with a cumulative price of zero.
+func NewPriceAccumulator(qp QuotePrice) PriceAccumulator {
+	return PriceAccumulator{
+		CumulativePrice: math.ZeroInt(),
+		Price:           qp.Price,
+		BlockTimestamp:  qp.BlockTimestamp,
+		BlockHeight:     qp.BlockHeight,
+	}
+}
+
+// ValidateBasic validates that the cumulative price and price of the PriceAccumulator are set and non-negative.
+func (a *PriceAccumulator) ValidateBasic() error {
+	if a.CumulativePrice.IsNil() || a.Price.IsNil() {
+		return fmt.Errorf("price accumulator must set the cumulative price and price")
+	}
+
+	if a.CumulativePrice.IsNegative() || a.Price.IsNegative() {
+		return fmt.Errorf("price accumulator prices cannot be negative")
+	}
+
+	return nil
+}
+
+// Advance returns the PriceAccumulator after the given price is written. The cumulative price is advanced by the
+// current price for the milliseconds elapsed until the new price's block time.
+func (a PriceAccumulator) Advance(qp QuotePrice) PriceAccumulator {
+	return PriceAccumulator{
+		CumulativePrice: a.CumulativePriceAt(qp.BlockTimestamp),
+		Price:           qp.Price,
+		BlockTimestamp:  qp.BlockTimestamp,
+		BlockHeight:     qp.BlockHeight,
+	}
+}
+
+// CumulativePriceAt returns the cumulative price at time t, assuming the current price remains the latest price until
+// then. Times before the accumulator's block time are treated as the block time.
+func (a PriceAccumulator) CumulativePriceAt(t time.Time) math.Int {
+	elapsed := t.Sub(a.BlockTimestamp).Milliseconds()
+	if elapsed <= 0 {
+		return a.CumulativePrice
+	}
+
+	return a.CumulativePrice.Add(a.Price.MulRaw(elapsed))
+}
+
+// ComputeTWAP returns the time weighted average price between start and end, given the PriceAccumulators that were
+// current at each time. The result is rounded down.
+func ComputeTWAP(startAcc PriceAccumulator, start time.Time, endAcc PriceAccumulator, end time.Time) (math.Int, error) {
+	elapsed := end.Sub(start).Milliseconds()
+	if elapsed <= 0 {
+		return math.Int{}, fmt.Errorf("twap end time %s must be at least 1ms after start time %s", end, start)
+	}
+
+	diff := endAcc.CumulativePriceAt(end).Sub(startAcc.CumulativePriceAt(start))
+	return diff.QuoRaw(elapsed), nil
+}
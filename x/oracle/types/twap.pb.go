@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/twap.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceAccumulator is a cumulative price for a currency pair, from which time
+// weighted average prices (TWAPs) are derived. The accumulator is advanced
+// each time a price is written, by the previous price multiplied by the
+// milliseconds elapsed since it was written.
+type PriceAccumulator struct {
+	// CumulativePrice is the sum of each price written for the currency pair
+	// multiplied by the number of milliseconds it was the latest price.
+	CumulativePrice cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_price"`
+	// Price is the latest price written for the currency pair.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// BlockTimestamp is the block time at which the latest price was written.
+	BlockTimestamp time.Time `protobuf:"bytes,3,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
+	// BlockHeight is the height at which the latest price was written.
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PriceAccumulator) Reset()         { *m = PriceAccumulator{} }
+func (m *PriceAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PriceAccumulator) ProtoMessage()    {}
+func (*PriceAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2451b11cb9e2ff31, []int{0}
+}
+func (m *PriceAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAccumulator.Merge(m, src)
+}
+func (m *PriceAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAccumulator proto.InternalMessageInfo
+
+func (m *PriceAccumulator) GetBlockTimestamp() time.Time {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return time.Time{}
+}
+
+func (m *PriceAccumulator) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PriceAccumulator)(nil), "connect.oracle.v2.PriceAccumulator")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/twap.proto", fileDescriptor_2451b11cb9e2ff31) }
+
+var fileDescriptor_2451b11cb9e2ff31 = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x33, 0xbd, 0xbd, 0x97, 0x6b, 0x2a, 0xb6, 0x06, 0x85, 0x5a, 0x24, 0xa9, 0xae, 0x0a,
+	0xd2, 0x19, 0x88, 0x4f, 0xd0, 0x6e, 0xb4, 0x0b, 0x41, 0x8a, 0xb8, 0x70, 0x53, 0x92, 0x71, 0x4c,
+	0x86, 0x66, 0x72, 0x42, 0x32, 0x89, 0xfa, 0x16, 0x7d, 0x03, 0x5f, 0xc2, 0x87, 0xe8, 0xb2, 0xb8,
+	0x12, 0x17, 0x55, 0xda, 0x17, 0x91, 0xce, 0x24, 0x75, 0xed, 0x6e, 0xce, 0xf9, 0xce, 0xf9, 0xff,
+	0xf9, 0x39, 0xe6, 0x31, 0x85, 0x38, 0x66, 0x54, 0x12, 0x48, 0x3d, 0x1a, 0x31, 0x52, 0xb8, 0x44,
+	0x3e, 0x7a, 0x09, 0x4e, 0x52, 0x90, 0x60, 0xed, 0x97, 0x14, 0x6b, 0x8a, 0x0b, 0xb7, 0x73, 0x10,
+	0x40, 0x00, 0x8a, 0x92, 0xcd, 0x4b, 0x0f, 0x76, 0x8e, 0x28, 0x64, 0x02, 0xb2, 0x89, 0x06, 0xba,
+	0x28, 0x91, 0x13, 0x00, 0x04, 0x11, 0x23, 0xaa, 0xf2, 0xf3, 0x07, 0x22, 0xb9, 0x60, 0x99, 0xf4,
+	0x44, 0x69, 0x72, 0xfa, 0x52, 0x33, 0x5b, 0xd7, 0x29, 0xa7, 0x6c, 0x40, 0x69, 0x2e, 0xf2, 0xc8,
+	0x93, 0x90, 0x5a, 0xb7, 0x66, 0xab, 0x2c, 0x78, 0xc1, 0x26, 0xc9, 0x06, 0xb7, 0x51, 0x17, 0xf5,
+	0x76, 0x86, 0x67, 0xf3, 0xa5, 0x63, 0x7c, 0x2c, 0x9d, 0x43, 0xed, 0x92, 0xdd, 0x4f, 0x31, 0x07,
+	0x22, 0x3c, 0x19, 0xe2, 0x51, 0x2c, 0xdf, 0x5e, 0xfb, 0x66, 0x69, 0x3f, 0x8a, 0xe5, 0xb8, 0xf9,
+	0x23, 0xa2, 0x2c, 0xac, 0x81, 0xf9, 0x57, 0x8b, 0xd5, 0x7e, 0x2f, 0xa6, 0x37, 0xad, 0x2b, 0xb3,
+	0xe9, 0x47, 0x40, 0xa7, 0x93, 0x6d, 0x90, 0xf6, 0x9f, 0x2e, 0xea, 0x35, 0xdc, 0x0e, 0xd6, 0x51,
+	0x71, 0x15, 0x15, 0xdf, 0x54, 0x13, 0xc3, 0xff, 0x1b, 0xa3, 0xd9, 0xa7, 0x83, 0xc6, 0x7b, 0x6a,
+	0x79, 0x4b, 0xac, 0x13, 0x73, 0x57, 0xcb, 0x85, 0x8c, 0x07, 0xa1, 0x6c, 0xd7, 0xbb, 0xa8, 0x57,
+	0x1f, 0x37, 0x54, 0xef, 0x52, 0xb5, 0x86, 0x17, 0xf3, 0x95, 0x8d, 0x16, 0x2b, 0x1b, 0x7d, 0xad,
+	0x6c, 0x34, 0x5b, 0xdb, 0xc6, 0x62, 0x6d, 0x1b, 0xef, 0x6b, 0xdb, 0xb8, 0xeb, 0x07, 0x5c, 0x86,
+	0xb9, 0x8f, 0x29, 0x08, 0x92, 0x4d, 0x79, 0xd2, 0x17, 0xac, 0x20, 0xd5, 0x49, 0x0b, 0x97, 0x3c,
+	0x55, 0x77, 0x95, 0xcf, 0x09, 0xcb, 0xfc, 0x7f, 0xea, 0x67, 0xe7, 0xdf, 0x03, 0x00, 0x2c, 0x93,
+	0xd8, 0x7b, 0xf6, 0x01, 0x00, 0x00,
+}
+
+func (m *PriceAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTimestamp):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTwap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovTwap(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovTwap(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovTwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwap(x uint64) (n int) {
+	return sovTwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwap = fmt.Errorf("proto: unexpected end of group")
+)
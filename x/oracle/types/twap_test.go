@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestPriceAccumulator(t *testing.T) {
+	start := time.Unix(1_000, 0).UTC()
+	quotePrice := func(price int64, offset time.Duration, height uint64) types.QuotePrice {
+		return types.QuotePrice{Price: math.NewInt(price), BlockTimestamp: start.Add(offset), BlockHeight: height}
+	}
+
+	acc := types.NewPriceAccumulator(quotePrice(100, 0, 1))
+	require.Equal(t, math.ZeroInt(), acc.CumulativePrice)
+	require.Equal(t, uint64(1), acc.BlockHeight)
+
+	t.Run("the cumulative price is extrapolated from the latest price", func(t *testing.T) {
+		require.Equal(t, math.NewInt(100*500), acc.CumulativePriceAt(start.Add(500*time.Millisecond)))
+		require.Equal(t, math.ZeroInt(), acc.CumulativePriceAt(start.Add(-time.Second)))
+	})
+
+	t.Run("advancing accumulates the previous price", func(t *testing.T) {
+		next := acc.Advance(quotePrice(200, 2*time.Second, 2))
+		require.Equal(t, math.NewInt(100*2_000), next.CumulativePrice)
+		require.Equal(t, math.NewInt(200), next.Price)
+		require.Equal(t, start.Add(2*time.Second), next.BlockTimestamp)
+		require.Equal(t, uint64(2), next.BlockHeight)
+	})
+
+	t.Run("the twap is weighted by time", func(t *testing.T) {
+		next := acc.Advance(quotePrice(200, 3*time.Second, 2))
+
+		// 100 for 3s, then 200 for 1s
+		twap, err := types.ComputeTWAP(acc, start, next, start.Add(4*time.Second))
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(125), twap)
+
+		// 200 for 2s
+		twap, err = types.ComputeTWAP(next, start.Add(3*time.Second), next, start.Add(5*time.Second))
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(200), twap)
+	})
+
+	t.Run("empty intervals are rejected", func(t *testing.T) {
+		_, err := types.ComputeTWAP(acc, start, acc, start)
+		require.Error(t, err)
+
+		_, err = types.ComputeTWAP(acc, start.Add(time.Second), acc, start)
+		require.Error(t, err)
+	})
+}
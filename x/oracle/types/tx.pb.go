@@ -225,380 +225,3574 @@ func (m *MsgRemoveCurrencyPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveCurrencyPairsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "connect.oracle.v2.MsgAddCurrencyPairs")
-	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgAddCurrencyPairsResponse")
-	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "connect.oracle.v2.MsgRemoveCurrencyPairs")
-	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgRemoveCurrencyPairsResponse")
+// MsgUpdateParams defines the Msg/UpdateParams request type. It contains the
+// new parameters for the x/oracle module.
+type MsgUpdateParams struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's Params
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the new parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func init() { proto.RegisterFile("connect/oracle/v2/tx.proto", fileDescriptor_83be677051d99dbe) }
-
-var fileDescriptor_83be677051d99dbe = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd2, 0x2f, 0xa9,
-	0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xe9, 0x41, 0xe4, 0xf4, 0xca, 0x8c,
-	0xa4, 0xe4, 0x31, 0x95, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0xf4, 0x48, 0x49, 0x26,
-	0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x1c, 0xc2, 0xd3,
-	0xcf, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50, 0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c,
-	0x7d, 0x30, 0x09, 0x15, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x98, 0x01, 0x62, 0x41, 0x45, 0x55,
-	0x60, 0xb6, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x83, 0x2c, 0x4f, 0x2e, 0x2d, 0x2a, 0x4a, 0xcd, 0x4b,
-	0xae, 0x8c, 0x2f, 0x48, 0xcc, 0x2c, 0x82, 0xa8, 0x52, 0xba, 0xce, 0xc8, 0x25, 0xec, 0x5b, 0x9c,
-	0xee, 0x98, 0x92, 0xe2, 0x0c, 0x95, 0x0d, 0x48, 0xcc, 0x2c, 0x2a, 0x16, 0x32, 0xe3, 0xe2, 0x4c,
-	0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92,
-	0xb8, 0xb4, 0x45, 0x57, 0x04, 0xea, 0x48, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92,
-	0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x6f, 0x2e, 0x3e, 0x14, 0x6b, 0x8a, 0x25, 0x98,
-	0x14, 0x98, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0x60, 0xe1, 0x03, 0x76, 0x8e, 0x5e, 0x99, 0x91, 0x1e,
-	0xb2, 0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xf1, 0x26, 0x23, 0x3b, 0xc2, 0xca, 0xfa,
-	0xc5, 0x02, 0x79, 0x86, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x16, 0x74, 0x3d, 0xdf, 0xa0, 0x05, 0xf7,
-	0x59, 0x05, 0x2c, 0x64, 0xb1, 0xf8, 0x40, 0x49, 0x96, 0x4b, 0x1a, 0x8b, 0x70, 0x50, 0x6a, 0x71,
-	0x41, 0x7e, 0x5e, 0x71, 0xaa, 0xd2, 0x4e, 0x46, 0x2e, 0x31, 0xdf, 0xe2, 0xf4, 0xa0, 0xd4, 0xdc,
-	0xfc, 0xb2, 0x54, 0xea, 0xf8, 0x5d, 0x8b, 0x4b, 0x10, 0xc5, 0xef, 0xf1, 0x99, 0x29, 0x10, 0xef,
-	0x73, 0x06, 0xf1, 0x23, 0x7b, 0xcc, 0x33, 0x85, 0x34, 0xaf, 0x05, 0xa7, 0x96, 0xa0, 0x7a, 0x4d,
-	0x81, 0x4b, 0x0e, 0xbb, 0xd3, 0x61, 0xbe, 0x33, 0x7a, 0xcb, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e,
-	0x94, 0xc5, 0x25, 0x80, 0x11, 0xb5, 0x6a, 0x7a, 0x18, 0x49, 0x55, 0x0f, 0x4b, 0x48, 0x49, 0xe9,
-	0x11, 0xa7, 0x0e, 0x66, 0xa7, 0x50, 0x31, 0x97, 0x30, 0xb6, 0xd0, 0xd4, 0xc4, 0x6e, 0x0c, 0x16,
-	0xa5, 0x52, 0x86, 0x44, 0x2b, 0x85, 0x59, 0x2a, 0xc5, 0xda, 0xf0, 0x7c, 0x83, 0x16, 0xa3, 0x93,
-	0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0x67, 0x16, 0xe8, 0xe6, 0xa6, 0x96, 0xe9,
-	0xc3, 0x42, 0xb9, 0xcc, 0x08, 0x11, 0xd0, 0xe0, 0x74, 0x99, 0xc4, 0x06, 0xce, 0x16, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0xe1, 0x78, 0x05, 0xeb, 0x03, 0x00, 0x00,
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// AddCurrencyPairs will be used only by governance to update the set of
-	// available CurrencyPairs. Given a set of CurrencyPair objects, update
-	// the available currency pairs in the module .
-	AddCurrencyPairs(ctx context.Context, in *MsgAddCurrencyPairs, opts ...grpc.CallOption) (*MsgAddCurrencyPairsResponse, error)
-	// RemoveCurrencyPairs will be used explicitly by governance to remove the
-	// given set of currency-pairs from the module's state. Thus these
-	// CurrencyPairs will no longer have price-data available from this module.
-	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddCurrencyPairs(ctx context.Context, in *MsgAddCurrencyPairs, opts ...grpc.CallOption) (*MsgAddCurrencyPairsResponse, error) {
-	out := new(MsgAddCurrencyPairsResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/AddCurrencyPairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error) {
-	out := new(MsgRemoveCurrencyPairsResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/RemoveCurrencyPairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return out, nil
+	return Params{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AddCurrencyPairs will be used only by governance to update the set of
-	// available CurrencyPairs. Given a set of CurrencyPair objects, update
-	// the available currency pairs in the module .
-	AddCurrencyPairs(context.Context, *MsgAddCurrencyPairs) (*MsgAddCurrencyPairsResponse, error)
-	// RemoveCurrencyPairs will be used explicitly by governance to remove the
-	// given set of currency-pairs from the module's state. Thus these
-	// CurrencyPairs will no longer have price-data available from this module.
-	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{5}
 }
-
-func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAddCurrencyPairs) (*MsgAddCurrencyPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCurrencyPairs not implemented")
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetMarketQuorums defines the Msg/SetMarketQuorums request type. It contains
+// the per-currency-pair quorums to be set in the x/oracle module.
+type MsgSetMarketQuorums struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's MarketQuorums
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// market_quorums are the quorums to be set, at most one per currency pair.
+	MarketQuorums []MarketQuorum `protobuf:"bytes,2,rep,name=market_quorums,json=marketQuorums,proto3" json:"market_quorums"`
 }
 
-func _Msg_AddCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddCurrencyPairs)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddCurrencyPairs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Msg/AddCurrencyPairs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddCurrencyPairs(ctx, req.(*MsgAddCurrencyPairs))
+func (m *MsgSetMarketQuorums) Reset()         { *m = MsgSetMarketQuorums{} }
+func (m *MsgSetMarketQuorums) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarketQuorums) ProtoMessage()    {}
+func (*MsgSetMarketQuorums) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{6}
+}
+func (m *MsgSetMarketQuorums) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarketQuorums) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarketQuorums.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetMarketQuorums) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarketQuorums.Merge(m, src)
+}
+func (m *MsgSetMarketQuorums) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarketQuorums) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarketQuorums.DiscardUnknown(m)
 }
 
-func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveCurrencyPairs)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveCurrencyPairs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Msg/RemoveCurrencyPairs",
+var xxx_messageInfo_MsgSetMarketQuorums proto.InternalMessageInfo
+
+func (m *MsgSetMarketQuorums) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveCurrencyPairs(ctx, req.(*MsgRemoveCurrencyPairs))
+	return ""
+}
+
+func (m *MsgSetMarketQuorums) GetMarketQuorums() []MarketQuorum {
+	if m != nil {
+		return m.MarketQuorums
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.oracle.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddCurrencyPairs",
-			Handler:    _Msg_AddCurrencyPairs_Handler,
-		},
-		{
-			MethodName: "RemoveCurrencyPairs",
-			Handler:    _Msg_RemoveCurrencyPairs_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/oracle/v2/tx.proto",
+// MsgSetMarketQuorumsResponse defines the Msg/SetMarketQuorums response type.
+type MsgSetMarketQuorumsResponse struct {
 }
 
-func (m *MsgAddCurrencyPairs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMarketQuorumsResponse) Reset()         { *m = MsgSetMarketQuorumsResponse{} }
+func (m *MsgSetMarketQuorumsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarketQuorumsResponse) ProtoMessage()    {}
+func (*MsgSetMarketQuorumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{7}
+}
+func (m *MsgSetMarketQuorumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarketQuorumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarketQuorumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetMarketQuorumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarketQuorumsResponse.Merge(m, src)
+}
+func (m *MsgSetMarketQuorumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarketQuorumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarketQuorumsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddCurrencyPairs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetMarketQuorumsResponse proto.InternalMessageInfo
+
+// MsgRemoveMarketQuorums defines the Msg/RemoveMarketQuorums request type. It
+// contains the currency pairs whose quorums are to be removed. Currency pairs
+// without a quorum are skipped.
+type MsgRemoveMarketQuorums struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's MarketQuorums
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pair_ids are the stringified representation of the currency-pairs
+	// (base/quote) whose quorums are to be removed
+	CurrencyPairIds []string `protobuf:"bytes,2,rep,name=currency_pair_ids,json=currencyPairIds,proto3" json:"currency_pair_ids,omitempty"`
 }
 
-func (m *MsgAddCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgRemoveMarketQuorums) Reset()         { *m = MsgRemoveMarketQuorums{} }
+func (m *MsgRemoveMarketQuorums) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMarketQuorums) ProtoMessage()    {}
+func (*MsgRemoveMarketQuorums) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{8}
+}
+func (m *MsgRemoveMarketQuorums) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMarketQuorums) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMarketQuorums.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRemoveMarketQuorums) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMarketQuorums.Merge(m, src)
+}
+func (m *MsgRemoveMarketQuorums) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMarketQuorums) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMarketQuorums.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMarketQuorums proto.InternalMessageInfo
+
+func (m *MsgRemoveMarketQuorums) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveMarketQuorums) GetCurrencyPairIds() []string {
+	if m != nil {
+		return m.CurrencyPairIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAddCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveMarketQuorumsResponse defines the Msg/RemoveMarketQuorums response
+// type.
+type MsgRemoveMarketQuorumsResponse struct {
 }
 
-func (m *MsgAddCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveMarketQuorumsResponse) Reset()         { *m = MsgRemoveMarketQuorumsResponse{} }
+func (m *MsgRemoveMarketQuorumsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMarketQuorumsResponse) ProtoMessage()    {}
+func (*MsgRemoveMarketQuorumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{9}
+}
+func (m *MsgRemoveMarketQuorumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMarketQuorumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMarketQuorumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMarketQuorumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMarketQuorumsResponse.Merge(m, src)
+}
+func (m *MsgRemoveMarketQuorumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMarketQuorumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMarketQuorumsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveCurrencyPairs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveMarketQuorumsResponse proto.InternalMessageInfo
+
+// MsgSetCircuitBreakers defines the Msg/SetCircuitBreakers request type. It
+// contains the per-currency-pair circuit breakers to be set in the x/oracle
+// module.
+type MsgSetCircuitBreakers struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's CircuitBreakers
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// circuit_breakers are the circuit breakers to be set, at most one per
+	// currency pair.
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,2,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+}
+
+func (m *MsgSetCircuitBreakers) Reset()         { *m = MsgSetCircuitBreakers{} }
+func (m *MsgSetCircuitBreakers) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreakers) ProtoMessage()    {}
+func (*MsgSetCircuitBreakers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{10}
+}
+func (m *MsgSetCircuitBreakers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreakers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreakers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetCircuitBreakers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreakers.Merge(m, src)
+}
+func (m *MsgSetCircuitBreakers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreakers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreakers.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveCurrencyPairs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetCircuitBreakers proto.InternalMessageInfo
+
+func (m *MsgSetCircuitBreakers) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgRemoveCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CurrencyPairIds[iNdEx])
-			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetCircuitBreakers) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+// MsgSetCircuitBreakersResponse defines the Msg/SetCircuitBreakers response
+// type.
+type MsgSetCircuitBreakersResponse struct {
+}
+
+func (m *MsgSetCircuitBreakersResponse) Reset()         { *m = MsgSetCircuitBreakersResponse{} }
+func (m *MsgSetCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreakersResponse) ProtoMessage()    {}
+func (*MsgSetCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{11}
+}
+func (m *MsgSetCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreakersResponse.Merge(m, src)
+}
+func (m *MsgSetCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCircuitBreakersResponse proto.InternalMessageInfo
+
+// MsgRemoveCircuitBreakers defines the Msg/RemoveCircuitBreakers request type.
+// It contains the currency pairs whose circuit breakers are to be removed.
+// Currency pairs without a circuit breaker are skipped.
+type MsgRemoveCircuitBreakers struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's CircuitBreakers
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pair_ids are the stringified representation of the currency-pairs
+	// (base/quote) whose circuit breakers are to be removed
+	CurrencyPairIds []string `protobuf:"bytes,2,rep,name=currency_pair_ids,json=currencyPairIds,proto3" json:"currency_pair_ids,omitempty"`
+}
+
+func (m *MsgRemoveCircuitBreakers) Reset()         { *m = MsgRemoveCircuitBreakers{} }
+func (m *MsgRemoveCircuitBreakers) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCircuitBreakers) ProtoMessage()    {}
+func (*MsgRemoveCircuitBreakers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{12}
+}
+func (m *MsgRemoveCircuitBreakers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCircuitBreakers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCircuitBreakers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveCircuitBreakers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCircuitBreakers.Merge(m, src)
+}
+func (m *MsgRemoveCircuitBreakers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCircuitBreakers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCircuitBreakers.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveCircuitBreakers proto.InternalMessageInfo
+
+func (m *MsgRemoveCircuitBreakers) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveCircuitBreakers) GetCurrencyPairIds() []string {
+	if m != nil {
+		return m.CurrencyPairIds
+	}
+	return nil
 }
 
-func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRemoveCircuitBreakersResponse defines the Msg/RemoveCircuitBreakers
+// response type.
+type MsgRemoveCircuitBreakersResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRemoveCircuitBreakersResponse) Reset()         { *m = MsgRemoveCircuitBreakersResponse{} }
+func (m *MsgRemoveCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCircuitBreakersResponse) ProtoMessage()    {}
+func (*MsgRemoveCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{13}
+}
+func (m *MsgRemoveCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgAddCurrencyPairs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCircuitBreakersResponse.Merge(m, src)
+}
+func (m *MsgRemoveCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveCircuitBreakersResponse proto.InternalMessageInfo
+
+// MsgClearMarketHalts defines the Msg/ClearMarketHalts request type. It
+// contains the currency pairs whose halts are to be cleared. Currency pairs
+// that are not halted are skipped.
+type MsgClearMarketHalts struct {
+	// authority is the address of the module authority, or of one of the
+	// x/marketmap market authorities
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pair_ids are the stringified representation of the currency-pairs
+	// (base/quote) whose halts are to be cleared
+	CurrencyPairIds []string `protobuf:"bytes,2,rep,name=currency_pair_ids,json=currencyPairIds,proto3" json:"currency_pair_ids,omitempty"`
+}
+
+func (m *MsgClearMarketHalts) Reset()         { *m = MsgClearMarketHalts{} }
+func (m *MsgClearMarketHalts) String() string { return proto.CompactTextString(m) }
+func (*MsgClearMarketHalts) ProtoMessage()    {}
+func (*MsgClearMarketHalts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{14}
+}
+func (m *MsgClearMarketHalts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearMarketHalts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearMarketHalts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgClearMarketHalts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearMarketHalts.Merge(m, src)
+}
+func (m *MsgClearMarketHalts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearMarketHalts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearMarketHalts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearMarketHalts proto.InternalMessageInfo
+
+func (m *MsgClearMarketHalts) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgClearMarketHalts) GetCurrencyPairIds() []string {
+	if m != nil {
+		return m.CurrencyPairIds
+	}
+	return nil
+}
+
+// MsgClearMarketHaltsResponse defines the Msg/ClearMarketHalts response type.
+type MsgClearMarketHaltsResponse struct {
+}
+
+func (m *MsgClearMarketHaltsResponse) Reset()         { *m = MsgClearMarketHaltsResponse{} }
+func (m *MsgClearMarketHaltsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClearMarketHaltsResponse) ProtoMessage()    {}
+func (*MsgClearMarketHaltsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{15}
+}
+func (m *MsgClearMarketHaltsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearMarketHaltsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearMarketHaltsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearMarketHaltsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearMarketHaltsResponse.Merge(m, src)
+}
+func (m *MsgClearMarketHaltsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearMarketHaltsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearMarketHaltsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearMarketHaltsResponse proto.InternalMessageInfo
+
+// MsgSetPriceMaxAges defines the Msg/SetPriceMaxAges request type. It contains
+// the per-currency-pair default price max ages to be set in the x/oracle
+// module.
+type MsgSetPriceMaxAges struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's PriceMaxAges
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// price_max_ages are the max ages to be set, at most one per currency pair.
+	PriceMaxAges []PriceMaxAge `protobuf:"bytes,2,rep,name=price_max_ages,json=priceMaxAges,proto3" json:"price_max_ages"`
+}
+
+func (m *MsgSetPriceMaxAges) Reset()         { *m = MsgSetPriceMaxAges{} }
+func (m *MsgSetPriceMaxAges) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPriceMaxAges) ProtoMessage()    {}
+func (*MsgSetPriceMaxAges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{16}
+}
+func (m *MsgSetPriceMaxAges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPriceMaxAges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPriceMaxAges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPriceMaxAges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPriceMaxAges.Merge(m, src)
+}
+func (m *MsgSetPriceMaxAges) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPriceMaxAges) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPriceMaxAges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPriceMaxAges proto.InternalMessageInfo
+
+func (m *MsgSetPriceMaxAges) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPriceMaxAges) GetPriceMaxAges() []PriceMaxAge {
+	if m != nil {
+		return m.PriceMaxAges
+	}
+	return nil
+}
+
+// MsgSetPriceMaxAgesResponse defines the Msg/SetPriceMaxAges response type.
+type MsgSetPriceMaxAgesResponse struct {
+}
+
+func (m *MsgSetPriceMaxAgesResponse) Reset()         { *m = MsgSetPriceMaxAgesResponse{} }
+func (m *MsgSetPriceMaxAgesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPriceMaxAgesResponse) ProtoMessage()    {}
+func (*MsgSetPriceMaxAgesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{17}
+}
+func (m *MsgSetPriceMaxAgesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPriceMaxAgesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPriceMaxAgesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPriceMaxAgesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPriceMaxAgesResponse.Merge(m, src)
+}
+func (m *MsgSetPriceMaxAgesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPriceMaxAgesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPriceMaxAgesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPriceMaxAgesResponse proto.InternalMessageInfo
+
+// MsgRemovePriceMaxAges defines the Msg/RemovePriceMaxAges request type. It
+// contains the currency pairs whose max ages are to be removed. Currency pairs
+// without a max age are skipped.
+type MsgRemovePriceMaxAges struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's PriceMaxAges
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pair_ids are the stringified representation of the currency-pairs
+	// (base/quote) whose max ages are to be removed
+	CurrencyPairIds []string `protobuf:"bytes,2,rep,name=currency_pair_ids,json=currencyPairIds,proto3" json:"currency_pair_ids,omitempty"`
+}
+
+func (m *MsgRemovePriceMaxAges) Reset()         { *m = MsgRemovePriceMaxAges{} }
+func (m *MsgRemovePriceMaxAges) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePriceMaxAges) ProtoMessage()    {}
+func (*MsgRemovePriceMaxAges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{18}
+}
+func (m *MsgRemovePriceMaxAges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePriceMaxAges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePriceMaxAges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePriceMaxAges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePriceMaxAges.Merge(m, src)
+}
+func (m *MsgRemovePriceMaxAges) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePriceMaxAges) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePriceMaxAges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePriceMaxAges proto.InternalMessageInfo
+
+func (m *MsgRemovePriceMaxAges) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemovePriceMaxAges) GetCurrencyPairIds() []string {
+	if m != nil {
+		return m.CurrencyPairIds
+	}
+	return nil
+}
+
+// MsgRemovePriceMaxAgesResponse defines the Msg/RemovePriceMaxAges response
+// type.
+type MsgRemovePriceMaxAgesResponse struct {
+}
+
+func (m *MsgRemovePriceMaxAgesResponse) Reset()         { *m = MsgRemovePriceMaxAgesResponse{} }
+func (m *MsgRemovePriceMaxAgesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePriceMaxAgesResponse) ProtoMessage()    {}
+func (*MsgRemovePriceMaxAgesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{19}
+}
+func (m *MsgRemovePriceMaxAgesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePriceMaxAgesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePriceMaxAgesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePriceMaxAgesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePriceMaxAgesResponse.Merge(m, src)
+}
+func (m *MsgRemovePriceMaxAgesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePriceMaxAgesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePriceMaxAgesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePriceMaxAgesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "connect.oracle.v2.MsgAddCurrencyPairs")
+	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgAddCurrencyPairsResponse")
+	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "connect.oracle.v2.MsgRemoveCurrencyPairs")
+	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgRemoveCurrencyPairsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "connect.oracle.v2.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "connect.oracle.v2.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetMarketQuorums)(nil), "connect.oracle.v2.MsgSetMarketQuorums")
+	proto.RegisterType((*MsgSetMarketQuorumsResponse)(nil), "connect.oracle.v2.MsgSetMarketQuorumsResponse")
+	proto.RegisterType((*MsgRemoveMarketQuorums)(nil), "connect.oracle.v2.MsgRemoveMarketQuorums")
+	proto.RegisterType((*MsgRemoveMarketQuorumsResponse)(nil), "connect.oracle.v2.MsgRemoveMarketQuorumsResponse")
+	proto.RegisterType((*MsgSetCircuitBreakers)(nil), "connect.oracle.v2.MsgSetCircuitBreakers")
+	proto.RegisterType((*MsgSetCircuitBreakersResponse)(nil), "connect.oracle.v2.MsgSetCircuitBreakersResponse")
+	proto.RegisterType((*MsgRemoveCircuitBreakers)(nil), "connect.oracle.v2.MsgRemoveCircuitBreakers")
+	proto.RegisterType((*MsgRemoveCircuitBreakersResponse)(nil), "connect.oracle.v2.MsgRemoveCircuitBreakersResponse")
+	proto.RegisterType((*MsgClearMarketHalts)(nil), "connect.oracle.v2.MsgClearMarketHalts")
+	proto.RegisterType((*MsgClearMarketHaltsResponse)(nil), "connect.oracle.v2.MsgClearMarketHaltsResponse")
+	proto.RegisterType((*MsgSetPriceMaxAges)(nil), "connect.oracle.v2.MsgSetPriceMaxAges")
+	proto.RegisterType((*MsgSetPriceMaxAgesResponse)(nil), "connect.oracle.v2.MsgSetPriceMaxAgesResponse")
+	proto.RegisterType((*MsgRemovePriceMaxAges)(nil), "connect.oracle.v2.MsgRemovePriceMaxAges")
+	proto.RegisterType((*MsgRemovePriceMaxAgesResponse)(nil), "connect.oracle.v2.MsgRemovePriceMaxAgesResponse")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/tx.proto", fileDescriptor_83be677051d99dbe) }
+
+var fileDescriptor_83be677051d99dbe = []byte{
+	// 930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x4f, 0xdb, 0x48,
+	0x18, 0x8e, 0x61, 0x17, 0x2d, 0x03, 0x4b, 0x20, 0xc0, 0x12, 0xbc, 0x8b, 0x93, 0xf5, 0xee, 0x42,
+	0x36, 0xab, 0xd8, 0x10, 0xa4, 0x45, 0xca, 0x6a, 0x57, 0x22, 0x1c, 0xf6, 0xab, 0x91, 0x68, 0x50,
+	0x2f, 0x3d, 0x34, 0x32, 0xce, 0xc8, 0x18, 0x70, 0xec, 0xce, 0x38, 0x51, 0x72, 0xab, 0x7a, 0xec,
+	0xa9, 0x3f, 0xa1, 0x3f, 0x81, 0x43, 0xd5, 0xaa, 0x1f, 0x3f, 0x80, 0x23, 0xaa, 0x2a, 0x95, 0x53,
+	0x55, 0x85, 0x03, 0xfd, 0x19, 0x55, 0x32, 0x63, 0xc7, 0x1f, 0x63, 0x70, 0x68, 0x0e, 0xbd, 0x40,
+	0x3c, 0xef, 0x93, 0xf7, 0x79, 0x9f, 0xf7, 0x6b, 0x1c, 0xc0, 0xab, 0x66, 0xa3, 0x01, 0x55, 0x5b,
+	0x36, 0x91, 0xa2, 0x1e, 0x43, 0xb9, 0x55, 0x94, 0xed, 0xb6, 0x64, 0x21, 0xd3, 0x36, 0x53, 0x73,
+	0xd4, 0x26, 0x11, 0x9b, 0xd4, 0x2a, 0xf2, 0x99, 0x30, 0x5c, 0x83, 0x0d, 0x88, 0x75, 0x4c, 0xbe,
+	0xc3, 0x2f, 0xab, 0x26, 0x36, 0x4c, 0x5c, 0xeb, 0x3f, 0xc9, 0xe4, 0x81, 0x9a, 0x96, 0xc8, 0x93,
+	0x6c, 0x60, 0x4d, 0x6e, 0x6d, 0xf4, 0xfe, 0x51, 0xc3, 0x9c, 0x62, 0xe8, 0x0d, 0x53, 0xee, 0xff,
+	0xa5, 0x47, 0x0b, 0x9a, 0xa9, 0x99, 0xc4, 0x47, 0xef, 0x13, 0x3d, 0xfd, 0xd9, 0x61, 0xb7, 0x3b,
+	0x16, 0xc4, 0x3d, 0x72, 0xb5, 0x89, 0x10, 0x6c, 0xa8, 0x9d, 0x9a, 0xa5, 0xe8, 0x88, 0xa2, 0x84,
+	0x70, 0x8c, 0x96, 0x82, 0x14, 0xc3, 0x89, 0x63, 0x2d, 0x6c, 0x57, 0x75, 0xa4, 0x36, 0x75, 0xbb,
+	0xb6, 0x8f, 0xa0, 0x72, 0x04, 0x1d, 0x47, 0x0c, 0xb1, 0x86, 0xd2, 0xae, 0x29, 0x1a, 0x24, 0x00,
+	0xf1, 0x1d, 0x07, 0xe6, 0x2b, 0x58, 0xdb, 0xae, 0xd7, 0x77, 0x68, 0x1c, 0xbb, 0x8a, 0x8e, 0x70,
+	0xea, 0x77, 0x30, 0xa9, 0x34, 0xed, 0x03, 0x13, 0xe9, 0x76, 0x27, 0xcd, 0x65, 0xb9, 0xdc, 0x64,
+	0x39, 0xfd, 0xe6, 0x69, 0x61, 0x81, 0xa6, 0x63, 0xbb, 0x5e, 0x47, 0x10, 0xe3, 0x3d, 0x1b, 0xe9,
+	0x0d, 0xad, 0x3a, 0x80, 0xa6, 0xfe, 0x07, 0x33, 0x3e, 0x41, 0x38, 0x3d, 0x96, 0x1d, 0xcf, 0x4d,
+	0x15, 0x05, 0xc9, 0xa9, 0x44, 0x5f, 0xb8, 0xd4, 0x2a, 0x4a, 0x5e, 0xc2, 0xf2, 0x57, 0xa7, 0xef,
+	0x33, 0x89, 0xea, 0xb7, 0xaa, 0x37, 0x88, 0xd2, 0x1f, 0x1f, 0x9f, 0x64, 0x12, 0x0f, 0x2f, 0x4f,
+	0xf2, 0x03, 0x82, 0x47, 0x97, 0x27, 0x79, 0x37, 0x87, 0x6d, 0x47, 0x16, 0x43, 0x81, 0xb8, 0x02,
+	0xbe, 0x67, 0x1c, 0x57, 0x21, 0xb6, 0xcc, 0x06, 0x86, 0xe2, 0x0b, 0x0e, 0x7c, 0x57, 0xc1, 0x5a,
+	0x15, 0x1a, 0x66, 0x0b, 0x8e, 0x46, 0x7b, 0x1e, 0xcc, 0xf9, 0xb4, 0xd7, 0xf4, 0x3a, 0x91, 0x3f,
+	0x59, 0x4d, 0x7a, 0x85, 0xfd, 0x5b, 0x1f, 0x4e, 0xda, 0x1e, 0xb4, 0xfd, 0xd2, 0xb2, 0x40, 0x60,
+	0x87, 0xee, 0xaa, 0x7b, 0xc5, 0x81, 0x64, 0x05, 0x6b, 0x77, 0xac, 0xba, 0x62, 0xc3, 0xdd, 0x7e,
+	0xeb, 0xdc, 0x58, 0xd6, 0x16, 0x98, 0x20, 0xcd, 0x97, 0x1e, 0xcb, 0x72, 0xb9, 0xa9, 0xe2, 0xb2,
+	0x14, 0x1a, 0x2a, 0x89, 0x50, 0xd0, 0x2a, 0x52, 0x78, 0x69, 0x8b, 0xad, 0x31, 0xcb, 0xd2, 0xe8,
+	0x8d, 0x54, 0x5c, 0x06, 0x4b, 0x81, 0x23, 0x57, 0xd8, 0x39, 0xe9, 0xd7, 0x3d, 0x68, 0x57, 0x14,
+	0x74, 0x04, 0xed, 0xdb, 0x4d, 0x13, 0x35, 0x3f, 0x43, 0xdc, 0x2d, 0x30, 0x63, 0xf4, 0x1d, 0xd5,
+	0xee, 0x13, 0x4f, 0xb4, 0x5f, 0x33, 0x0c, 0x91, 0x5e, 0x46, 0xa7, 0x61, 0x0d, 0x6f, 0x14, 0xc3,
+	0x56, 0xd5, 0x27, 0x81, 0x36, 0x6c, 0xf0, 0xd8, 0x55, 0xfe, 0xda, 0xdb, 0xb0, 0xa3, 0x11, 0x3f,
+	0x4c, 0xc3, 0xfe, 0xc5, 0x96, 0xb6, 0xc6, 0x92, 0xc6, 0x88, 0xd1, 0xd7, 0xb3, 0x6c, 0x81, 0x5d,
+	0x0e, 0x2c, 0xd2, 0x6e, 0x27, 0xbb, 0xac, 0x4c, 0x56, 0xd9, 0xcd, 0xf5, 0x55, 0xc1, 0x6c, 0x60,
+	0x2d, 0x3a, 0xe5, 0xfd, 0x91, 0x51, 0x5e, 0x3f, 0x2b, 0x2d, 0x70, 0x52, 0xf5, 0xc7, 0x52, 0xfa,
+	0x93, 0x9d, 0x87, 0xd5, 0xa8, 0xc1, 0xf5, 0x7f, 0x5d, 0xcc, 0x80, 0x15, 0xa6, 0xc1, 0xcd, 0xc2,
+	0x73, 0x0e, 0xa4, 0x07, 0xc3, 0x3d, 0xa2, 0x44, 0x0c, 0x53, 0xe8, 0xeb, 0xa6, 0x36, 0x2a, 0x38,
+	0x51, 0x04, 0xd9, 0x28, 0x9b, 0xab, 0xee, 0x19, 0x19, 0xdf, 0x9d, 0x63, 0xa8, 0x20, 0xd2, 0x05,
+	0xff, 0x28, 0xc7, 0xf6, 0x97, 0xb7, 0x72, 0x83, 0x01, 0xd2, 0xe1, 0x0c, 0x1e, 0xbb, 0xba, 0xde,
+	0x72, 0x20, 0x45, 0xea, 0xba, 0x8b, 0x74, 0x15, 0x56, 0x94, 0xf6, 0xb6, 0x06, 0x6f, 0x2e, 0xeb,
+	0x3f, 0x30, 0x63, 0xf5, 0xfc, 0xd4, 0xe8, 0x65, 0x1d, 0xbe, 0x45, 0x3d, 0xab, 0x77, 0x40, 0x48,
+	0x7b, 0x76, 0xda, 0x1a, 0x1c, 0xe1, 0x52, 0x89, 0x2d, 0xfb, 0xa7, 0x88, 0x86, 0xf5, 0xc6, 0x2f,
+	0xfe, 0x00, 0xf8, 0xf0, 0xa9, 0x2b, 0xfa, 0x25, 0x19, 0x58, 0x52, 0xf1, 0x91, 0xe8, 0x1e, 0xa6,
+	0x9c, 0xc3, 0x0c, 0x62, 0x38, 0x44, 0x3a, 0x88, 0x61, 0x83, 0xa3, 0xae, 0xd8, 0xfd, 0x06, 0x8c,
+	0x57, 0xb0, 0x96, 0x3a, 0x04, 0xb3, 0xa1, 0xb7, 0xa3, 0x55, 0xd6, 0xed, 0x10, 0x7e, 0xd9, 0xe0,
+	0xa5, 0x78, 0x38, 0x87, 0x33, 0x85, 0xc1, 0x3c, 0xeb, 0x85, 0xe4, 0x57, 0xb6, 0x1b, 0x06, 0x94,
+	0xdf, 0x88, 0x0d, 0x75, 0x49, 0xef, 0x81, 0x69, 0xdf, 0x7b, 0x82, 0xc8, 0x76, 0xe1, 0xc5, 0xf0,
+	0xf9, 0xeb, 0x31, 0xae, 0xff, 0x43, 0x30, 0x1b, 0xba, 0xae, 0x23, 0x12, 0x18, 0xc4, 0xf1, 0x52,
+	0x3c, 0x5c, 0x38, 0x81, 0x7e, 0xba, 0x2b, 0x13, 0xe8, 0x67, 0xdc, 0x88, 0x0d, 0x75, 0x49, 0x2d,
+	0x90, 0x62, 0x5c, 0x5a, 0xb9, 0xc8, 0xd0, 0x03, 0x48, 0x7e, 0x3d, 0x2e, 0xd2, 0x65, 0xec, 0x80,
+	0x45, 0xf6, 0x05, 0xf1, 0xdb, 0x95, 0xe5, 0x0f, 0xf0, 0x6e, 0x0e, 0x01, 0xf6, 0x56, 0x33, 0xb4,
+	0xbd, 0x23, 0xaa, 0x19, 0xc4, 0xf1, 0x52, 0x3c, 0x9c, 0xcb, 0xa5, 0x81, 0x64, 0x70, 0xa3, 0xfe,
+	0x12, 0x99, 0x2b, 0x2f, 0x8c, 0x2f, 0xc4, 0x82, 0x79, 0x2b, 0xc8, 0xd8, 0x62, 0xb9, 0xab, 0xf2,
+	0xe3, 0xa3, 0x5b, 0x8f, 0x8b, 0x74, 0x18, 0xf9, 0xaf, 0x1f, 0x5c, 0x9e, 0xe4, 0xb9, 0xf2, 0xdf,
+	0xa7, 0x5d, 0x81, 0x3b, 0xeb, 0x0a, 0xdc, 0x87, 0xae, 0xc0, 0x3d, 0xbe, 0x10, 0x12, 0x67, 0x17,
+	0x42, 0xe2, 0xfc, 0x42, 0x48, 0xdc, 0x2d, 0x68, 0xba, 0x7d, 0xd0, 0xdc, 0x97, 0x54, 0xd3, 0x90,
+	0xf1, 0x91, 0x6e, 0x15, 0x0c, 0xd8, 0x92, 0x9d, 0xdd, 0xd6, 0x2a, 0x0e, 0xd6, 0x5b, 0xff, 0xf7,
+	0xd4, 0xfe, 0x44, 0xff, 0xe7, 0xdc, 0xe6, 0xa7, 0x01, 0x00, 0x0d, 0xa5, 0xbf, 0x40, 0x0d, 0x0f,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddCurrencyPairs will be used only by governance to update the set of
+	// available CurrencyPairs. Given a set of CurrencyPair objects, update
+	// the available currency pairs in the module .
+	AddCurrencyPairs(ctx context.Context, in *MsgAddCurrencyPairs, opts ...grpc.CallOption) (*MsgAddCurrencyPairsResponse, error)
+	// RemoveCurrencyPairs will be used explicitly by governance to remove the
+	// given set of currency-pairs from the module's state. Thus these
+	// CurrencyPairs will no longer have price-data available from this module.
+	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetMarketQuorums will be used only by governance to set the quorum that
+	// validator votes must reach for each of the given currency pairs. Existing
+	// quorums for these currency pairs are overwritten.
+	SetMarketQuorums(ctx context.Context, in *MsgSetMarketQuorums, opts ...grpc.CallOption) (*MsgSetMarketQuorumsResponse, error)
+	// RemoveMarketQuorums will be used only by governance to remove the quorums
+	// set for the given currency pairs, after which they fall back to the
+	// module-wide quorum in Params.
+	RemoveMarketQuorums(ctx context.Context, in *MsgRemoveMarketQuorums, opts ...grpc.CallOption) (*MsgRemoveMarketQuorumsResponse, error)
+	// SetCircuitBreakers will be used only by governance to set the circuit
+	// breaker for each of the given currency pairs. Existing circuit breakers
+	// for these currency pairs are overwritten.
+	SetCircuitBreakers(ctx context.Context, in *MsgSetCircuitBreakers, opts ...grpc.CallOption) (*MsgSetCircuitBreakersResponse, error)
+	// RemoveCircuitBreakers will be used only by governance to remove the
+	// circuit breakers set for the given currency pairs.
+	RemoveCircuitBreakers(ctx context.Context, in *MsgRemoveCircuitBreakers, opts ...grpc.CallOption) (*MsgRemoveCircuitBreakersResponse, error)
+	// ClearMarketHalts will be used by governance or an x/marketmap market
+	// authority to resume price updates for currency pairs halted by their
+	// circuit breakers.
+	ClearMarketHalts(ctx context.Context, in *MsgClearMarketHalts, opts ...grpc.CallOption) (*MsgClearMarketHaltsResponse, error)
+	// SetPriceMaxAges will be used only by governance to set the default max
+	// age of the price of each of the given currency pairs. Existing max ages
+	// for these currency pairs are overwritten.
+	SetPriceMaxAges(ctx context.Context, in *MsgSetPriceMaxAges, opts ...grpc.CallOption) (*MsgSetPriceMaxAgesResponse, error)
+	// RemovePriceMaxAges will be used only by governance to remove the default
+	// max ages set for the given currency pairs.
+	RemovePriceMaxAges(ctx context.Context, in *MsgRemovePriceMaxAges, opts ...grpc.CallOption) (*MsgRemovePriceMaxAgesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddCurrencyPairs(ctx context.Context, in *MsgAddCurrencyPairs, opts ...grpc.CallOption) (*MsgAddCurrencyPairsResponse, error) {
+	out := new(MsgAddCurrencyPairsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/AddCurrencyPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error) {
+	out := new(MsgRemoveCurrencyPairsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/RemoveCurrencyPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMarketQuorums(ctx context.Context, in *MsgSetMarketQuorums, opts ...grpc.CallOption) (*MsgSetMarketQuorumsResponse, error) {
+	out := new(MsgSetMarketQuorumsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/SetMarketQuorums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMarketQuorums(ctx context.Context, in *MsgRemoveMarketQuorums, opts ...grpc.CallOption) (*MsgRemoveMarketQuorumsResponse, error) {
+	out := new(MsgRemoveMarketQuorumsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/RemoveMarketQuorums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCircuitBreakers(ctx context.Context, in *MsgSetCircuitBreakers, opts ...grpc.CallOption) (*MsgSetCircuitBreakersResponse, error) {
+	out := new(MsgSetCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/SetCircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveCircuitBreakers(ctx context.Context, in *MsgRemoveCircuitBreakers, opts ...grpc.CallOption) (*MsgRemoveCircuitBreakersResponse, error) {
+	out := new(MsgRemoveCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/RemoveCircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearMarketHalts(ctx context.Context, in *MsgClearMarketHalts, opts ...grpc.CallOption) (*MsgClearMarketHaltsResponse, error) {
+	out := new(MsgClearMarketHaltsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/ClearMarketHalts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPriceMaxAges(ctx context.Context, in *MsgSetPriceMaxAges, opts ...grpc.CallOption) (*MsgSetPriceMaxAgesResponse, error) {
+	out := new(MsgSetPriceMaxAgesResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/SetPriceMaxAges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemovePriceMaxAges(ctx context.Context, in *MsgRemovePriceMaxAges, opts ...grpc.CallOption) (*MsgRemovePriceMaxAgesResponse, error) {
+	out := new(MsgRemovePriceMaxAgesResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/RemovePriceMaxAges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddCurrencyPairs will be used only by governance to update the set of
+	// available CurrencyPairs. Given a set of CurrencyPair objects, update
+	// the available currency pairs in the module .
+	AddCurrencyPairs(context.Context, *MsgAddCurrencyPairs) (*MsgAddCurrencyPairsResponse, error)
+	// RemoveCurrencyPairs will be used explicitly by governance to remove the
+	// given set of currency-pairs from the module's state. Thus these
+	// CurrencyPairs will no longer have price-data available from this module.
+	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetMarketQuorums will be used only by governance to set the quorum that
+	// validator votes must reach for each of the given currency pairs. Existing
+	// quorums for these currency pairs are overwritten.
+	SetMarketQuorums(context.Context, *MsgSetMarketQuorums) (*MsgSetMarketQuorumsResponse, error)
+	// RemoveMarketQuorums will be used only by governance to remove the quorums
+	// set for the given currency pairs, after which they fall back to the
+	// module-wide quorum in Params.
+	RemoveMarketQuorums(context.Context, *MsgRemoveMarketQuorums) (*MsgRemoveMarketQuorumsResponse, error)
+	// SetCircuitBreakers will be used only by governance to set the circuit
+	// breaker for each of the given currency pairs. Existing circuit breakers
+	// for these currency pairs are overwritten.
+	SetCircuitBreakers(context.Context, *MsgSetCircuitBreakers) (*MsgSetCircuitBreakersResponse, error)
+	// RemoveCircuitBreakers will be used only by governance to remove the
+	// circuit breakers set for the given currency pairs.
+	RemoveCircuitBreakers(context.Context, *MsgRemoveCircuitBreakers) (*MsgRemoveCircuitBreakersResponse, error)
+	// ClearMarketHalts will be used by governance or an x/marketmap market
+	// authority to resume price updates for currency pairs halted by their
+	// circuit breakers.
+	ClearMarketHalts(context.Context, *MsgClearMarketHalts) (*MsgClearMarketHaltsResponse, error)
+	// SetPriceMaxAges will be used only by governance to set the default max
+	// age of the price of each of the given currency pairs. Existing max ages
+	// for these currency pairs are overwritten.
+	SetPriceMaxAges(context.Context, *MsgSetPriceMaxAges) (*MsgSetPriceMaxAgesResponse, error)
+	// RemovePriceMaxAges will be used only by governance to remove the default
+	// max ages set for the given currency pairs.
+	RemovePriceMaxAges(context.Context, *MsgRemovePriceMaxAges) (*MsgRemovePriceMaxAgesResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAddCurrencyPairs) (*MsgAddCurrencyPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCurrencyPairs not implemented")
+}
+func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetMarketQuorums(ctx context.Context, req *MsgSetMarketQuorums) (*MsgSetMarketQuorumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketQuorums not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMarketQuorums(ctx context.Context, req *MsgRemoveMarketQuorums) (*MsgRemoveMarketQuorumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketQuorums not implemented")
+}
+func (*UnimplementedMsgServer) SetCircuitBreakers(ctx context.Context, req *MsgSetCircuitBreakers) (*MsgSetCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCircuitBreakers not implemented")
+}
+func (*UnimplementedMsgServer) RemoveCircuitBreakers(ctx context.Context, req *MsgRemoveCircuitBreakers) (*MsgRemoveCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCircuitBreakers not implemented")
+}
+func (*UnimplementedMsgServer) ClearMarketHalts(ctx context.Context, req *MsgClearMarketHalts) (*MsgClearMarketHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearMarketHalts not implemented")
+}
+func (*UnimplementedMsgServer) SetPriceMaxAges(ctx context.Context, req *MsgSetPriceMaxAges) (*MsgSetPriceMaxAgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceMaxAges not implemented")
+}
+func (*UnimplementedMsgServer) RemovePriceMaxAges(ctx context.Context, req *MsgRemovePriceMaxAges) (*MsgRemovePriceMaxAgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePriceMaxAges not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCurrencyPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCurrencyPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/AddCurrencyPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCurrencyPairs(ctx, req.(*MsgAddCurrencyPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveCurrencyPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveCurrencyPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/RemoveCurrencyPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveCurrencyPairs(ctx, req.(*MsgRemoveCurrencyPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarketQuorums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarketQuorums)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarketQuorums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/SetMarketQuorums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarketQuorums(ctx, req.(*MsgSetMarketQuorums))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMarketQuorums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarketQuorums)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarketQuorums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/RemoveMarketQuorums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarketQuorums(ctx, req.(*MsgRemoveMarketQuorums))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/SetCircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCircuitBreakers(ctx, req.(*MsgSetCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/RemoveCircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveCircuitBreakers(ctx, req.(*MsgRemoveCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearMarketHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearMarketHalts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearMarketHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/ClearMarketHalts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearMarketHalts(ctx, req.(*MsgClearMarketHalts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPriceMaxAges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPriceMaxAges)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPriceMaxAges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/SetPriceMaxAges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPriceMaxAges(ctx, req.(*MsgSetPriceMaxAges))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePriceMaxAges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePriceMaxAges)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePriceMaxAges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/RemovePriceMaxAges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePriceMaxAges(ctx, req.(*MsgRemovePriceMaxAges))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.oracle.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCurrencyPairs",
+			Handler:    _Msg_AddCurrencyPairs_Handler,
+		},
+		{
+			MethodName: "RemoveCurrencyPairs",
+			Handler:    _Msg_RemoveCurrencyPairs_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetMarketQuorums",
+			Handler:    _Msg_SetMarketQuorums_Handler,
+		},
+		{
+			MethodName: "RemoveMarketQuorums",
+			Handler:    _Msg_RemoveMarketQuorums_Handler,
+		},
+		{
+			MethodName: "SetCircuitBreakers",
+			Handler:    _Msg_SetCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "RemoveCircuitBreakers",
+			Handler:    _Msg_RemoveCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "ClearMarketHalts",
+			Handler:    _Msg_ClearMarketHalts_Handler,
+		},
+		{
+			MethodName: "SetPriceMaxAges",
+			Handler:    _Msg_SetPriceMaxAges_Handler,
+		},
+		{
+			MethodName: "RemovePriceMaxAges",
+			Handler:    _Msg_RemovePriceMaxAges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/oracle/v2/tx.proto",
+}
+
+func (m *MsgAddCurrencyPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCurrencyPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCurrencyPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCurrencyPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarketQuorums) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarketQuorums) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarketQuorums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketQuorums) > 0 {
+		for iNdEx := len(m.MarketQuorums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketQuorums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarketQuorumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarketQuorumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarketQuorumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketQuorums) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketQuorums) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketQuorums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketQuorumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketQuorumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketQuorumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreakers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreakers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreakers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCircuitBreakers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCircuitBreakers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCircuitBreakers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearMarketHalts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearMarketHalts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearMarketHalts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearMarketHaltsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearMarketHaltsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearMarketHaltsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPriceMaxAges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPriceMaxAges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPriceMaxAges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceMaxAges) > 0 {
+		for iNdEx := len(m.PriceMaxAges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceMaxAges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPriceMaxAgesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPriceMaxAgesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPriceMaxAgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePriceMaxAges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePriceMaxAges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePriceMaxAges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePriceMaxAgesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePriceMaxAgesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePriceMaxAgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMarketQuorums) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MarketQuorums) > 0 {
+		for _, e := range m.MarketQuorums {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetMarketQuorumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMarketQuorums) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketQuorumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCircuitBreakers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveCircuitBreakers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearMarketHalts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClearMarketHaltsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPriceMaxAges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PriceMaxAges) > 0 {
+		for _, e := range m.PriceMaxAges {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetPriceMaxAgesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePriceMaxAges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemovePriceMaxAgesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMarketQuorums) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarketQuorums: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarketQuorums: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketQuorums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketQuorums = append(m.MarketQuorums, MarketQuorum{})
+			if err := m.MarketQuorums[len(m.MarketQuorums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMarketQuorumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarketQuorumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarketQuorumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketQuorums) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketQuorums: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketQuorums: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketQuorumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketQuorumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketQuorumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCircuitBreakers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCircuitBreakers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCircuitBreakers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCircuitBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCircuitBreakers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCircuitBreakers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCircuitBreakers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgAddCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveCurrencyPairs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCircuitBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgClearMarketHalts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearMarketHalts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearMarketHalts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgClearMarketHaltsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearMarketHaltsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearMarketHaltsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPriceMaxAges) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,10 +3815,10 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPriceMaxAges: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPriceMaxAges: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -661,7 +3855,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMaxAges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -688,8 +3882,8 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceMaxAges = append(m.PriceMaxAges, PriceMaxAge{})
+			if err := m.PriceMaxAges[len(m.PriceMaxAges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -714,7 +3908,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPriceMaxAgesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -737,10 +3931,10 @@ func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPriceMaxAgesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPriceMaxAgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -764,7 +3958,7 @@ func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePriceMaxAges) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -787,10 +3981,10 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePriceMaxAges: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePriceMaxAges: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -878,7 +4072,7 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePriceMaxAgesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -901,10 +4095,10 @@ func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePriceMaxAgesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePriceMaxAgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: